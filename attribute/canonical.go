@@ -0,0 +1,317 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Type tags of the canonical encoding.
+const (
+	tagBool         = "bool"
+	tagInt64        = "int64"
+	tagFloat64      = "float64"
+	tagString       = "string"
+	tagBoolSlice    = "bool[]"
+	tagInt64Slice   = "int64[]"
+	tagFloat64Slice = "float64[]"
+	tagStringSlice  = "string[]"
+	tagBytes        = "bytes"
+	tagSlice        = "slice"
+	tagMap          = "map"
+	tagEmpty        = "empty"
+)
+
+const (
+	// pairSep separates the KeyValue pairs of an encoded Set.
+	pairSep = ","
+	// elemTerm terminates each element of an encoded slice or map value.
+	elemTerm = ","
+)
+
+var (
+	errMissingValue = errors.New("missing value")
+	errMissingKey   = errors.New("missing key")
+	errUnknownType  = errors.New("unknown type")
+	errElemTerm     = errors.New("unterminated element")
+)
+
+// EncodeKeyValue returns the canonical string encoding of kv.
+//
+// A KeyValue holding a STRING value is encoded as "key=value", the syntax used
+// by the OTEL_RESOURCE_ATTRIBUTES environment variable. Any other value is
+// encoded as "key:type=value" where type is one of "bool", "int64",
+// "float64", "bool[]", "int64[]", "float64[]", "string[]", "bytes", "slice",
+// "map", or "empty". The key and value are both percent-encoded.
+//
+// The elements of slice and map values are each percent-encoded and followed
+// by a ",". BYTESLICE values are encoded using standard base64 encoding.
+//
+// The returned value can be decoded with [Parse].
+func EncodeKeyValue(kv KeyValue) string {
+	var b strings.Builder
+	tag, text := encodeValue(kv.Value)
+	b.WriteString(escape(string(kv.Key)))
+	if tag != tagString {
+		b.WriteByte(':')
+		b.WriteString(tag)
+	}
+	b.WriteByte('=')
+	b.WriteString(escape(text))
+	return b.String()
+}
+
+// Parse decodes the canonical string encoding of a KeyValue, as returned by
+// [EncodeKeyValue]. A pair with no type, e.g. "key=value", is decoded as a
+// STRING value. A ":" in the key is only interpreted as the start of a type
+// if it is followed by one of the types returned by [EncodeKeyValue], so the
+// key of "k8s:cluster=prod" is "k8s:cluster". Leading and trailing whitespace
+// around the key and value is ignored.
+func Parse(s string) (KeyValue, error) {
+	k, v, found := strings.Cut(s, "=")
+	if !found {
+		return KeyValue{}, fmt.Errorf("attribute: invalid %q: %w", s, errMissingValue)
+	}
+	k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+
+	tag := tagString
+	if i := strings.LastIndexByte(k, ':'); i >= 0 && isTag(k[i+1:]) {
+		k, tag = k[:i], k[i+1:]
+	}
+	key, err := url.PathUnescape(k)
+	if err != nil {
+		return KeyValue{}, fmt.Errorf("attribute: invalid key %q: %w", k, err)
+	}
+	if key == "" {
+		return KeyValue{}, fmt.Errorf("attribute: invalid %q: %w", s, errMissingKey)
+	}
+	text, err := url.PathUnescape(v)
+	if err != nil {
+		return KeyValue{}, fmt.Errorf("attribute: invalid value %q: %w", v, err)
+	}
+
+	val, err := decodeValue(tag, text)
+	if err != nil {
+		return KeyValue{}, fmt.Errorf("attribute: invalid %s value for key %q: %w", tag, key, err)
+	}
+	return KeyValue{Key: Key(key), Value: val}, nil
+}
+
+// Encode returns the canonical string encoding of the set. Each KeyValue is
+// encoded with [EncodeKeyValue], and the pairs are separated by ",", e.g.
+// "service.name=checkout,retries:int64=3".
+//
+// A set containing only STRING values is encoded using the syntax of the
+// OTEL_RESOURCE_ATTRIBUTES environment variable. The returned value can be
+// decoded with [Decode].
+func (l *Set) Encode() string {
+	if l == nil || l.Len() == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	iter := l.Iter()
+	for iter.Next() {
+		i, kv := iter.IndexedAttribute()
+		if i > 0 {
+			b.WriteString(pairSep)
+		}
+		b.WriteString(EncodeKeyValue(kv))
+	}
+	return b.String()
+}
+
+// Decode returns the Set encoded in s, as returned by [Set.Encode]. Any
+// OTEL_RESOURCE_ATTRIBUTES environment variable value is a valid input and
+// all of its attributes are decoded as STRING values.
+//
+// An error is returned if any pair of s is invalid. Empty pairs are ignored.
+func Decode(s string) (Set, error) {
+	var kvs []KeyValue
+	for p := range strings.SplitSeq(s, pairSep) {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		kv, err := Parse(p)
+		if err != nil {
+			return NewSet(), err
+		}
+		kvs = append(kvs, kv)
+	}
+	return NewSet(kvs...), nil
+}
+
+// encodeValue returns the type tag and unescaped text encoding of v.
+func encodeValue(v Value) (string, string) {
+	switch v.Type() {
+	case BOOL:
+		return tagBool, strconv.FormatBool(v.AsBool())
+	case INT64:
+		return tagInt64, strconv.FormatInt(v.AsInt64(), 10)
+	case FLOAT64:
+		return tagFloat64, strconv.FormatFloat(v.AsFloat64(), 'g', -1, 64)
+	case STRING:
+		return tagString, v.AsString()
+	case BOOLSLICE:
+		return tagBoolSlice, encodeElems(v.asBoolSlice(), strconv.FormatBool)
+	case INT64SLICE:
+		return tagInt64Slice, encodeElems(v.asInt64Slice(), func(i int64) string {
+			return strconv.FormatInt(i, 10)
+		})
+	case FLOAT64SLICE:
+		return tagFloat64Slice, encodeElems(v.asFloat64Slice(), func(f float64) string {
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		})
+	case STRINGSLICE:
+		return tagStringSlice, encodeElems(v.asStringSlice(), func(s string) string { return s })
+	case BYTESLICE:
+		return tagBytes, base64.StdEncoding.EncodeToString(v.asByteSlice())
+	case SLICE:
+		return tagSlice, encodeElems(v.asSlice(), func(e Value) string {
+			tag, text := encodeValue(e)
+			return tag + "=" + text
+		})
+	case MAP:
+		return tagMap, encodeElems(v.asMap(), EncodeKeyValue)
+	default:
+		return tagEmpty, ""
+	}
+}
+
+// isTag reports whether s is a type tag of the canonical encoding.
+func isTag(s string) bool {
+	switch s {
+	case tagBool, tagInt64, tagFloat64, tagString, tagBoolSlice, tagInt64Slice,
+		tagFloat64Slice, tagStringSlice, tagBytes, tagSlice, tagMap, tagEmpty:
+		return true
+	}
+	return false
+}
+
+// encodeElems returns the elements of s, each encoded with f, percent-encoded
+// and followed by elemTerm.
+func encodeElems[T any](s []T, f func(T) string) string {
+	var b strings.Builder
+	for _, e := range s {
+		b.WriteString(escape(f(e)))
+		b.WriteString(elemTerm)
+	}
+	return b.String()
+}
+
+// decodeValue decodes the unescaped text of a value with the type tag.
+func decodeValue(tag, text string) (Value, error) {
+	switch tag {
+	case tagBool:
+		b, err := strconv.ParseBool(text)
+		return BoolValue(b), err
+	case tagInt64:
+		i, err := strconv.ParseInt(text, 10, 64)
+		return Int64Value(i), err
+	case tagFloat64:
+		f, err := strconv.ParseFloat(text, 64)
+		return Float64Value(f), err
+	case tagString:
+		return StringValue(text), nil
+	case tagBoolSlice:
+		s, err := decodeElems(text, strconv.ParseBool)
+		return BoolSliceValue(s), err
+	case tagInt64Slice:
+		s, err := decodeElems(text, func(e string) (int64, error) {
+			return strconv.ParseInt(e, 10, 64)
+		})
+		return Int64SliceValue(s), err
+	case tagFloat64Slice:
+		s, err := decodeElems(text, func(e string) (float64, error) {
+			return strconv.ParseFloat(e, 64)
+		})
+		return Float64SliceValue(s), err
+	case tagStringSlice:
+		s, err := decodeElems(text, func(e string) (string, error) { return e, nil })
+		return StringSliceValue(s), err
+	case tagBytes:
+		b, err := base64.StdEncoding.DecodeString(text)
+		return ByteSliceValue(b), err
+	case tagSlice:
+		s, err := decodeElems(text, func(e string) (Value, error) {
+			t, txt, ok := strings.Cut(e, "=")
+			if !ok {
+				return Value{}, errMissingValue
+			}
+			return decodeValue(t, txt)
+		})
+		return SliceValue(s...), err
+	case tagMap:
+		s, err := decodeElems(text, Parse)
+		return MapValue(s...), err
+	case tagEmpty:
+		return Value{}, nil
+	default:
+		return Value{}, fmt.Errorf("%w: %q", errUnknownType, tag)
+	}
+}
+
+// decodeElems decodes the elemTerm terminated and percent-encoded elements
+// of text using f.
+func decodeElems[T any](text string, f func(string) (T, error)) ([]T, error) {
+	if text == "" {
+		return nil, nil
+	}
+	if !strings.HasSuffix(text, elemTerm) {
+		return nil, errElemTerm
+	}
+	parts := strings.Split(strings.TrimSuffix(text, elemTerm), elemTerm)
+	out := make([]T, 0, len(parts))
+	for _, p := range parts {
+		e, err := url.PathUnescape(p)
+		if err != nil {
+			return nil, err
+		}
+		v, err := f(e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// escape percent-encodes every byte of s that is not an unreserved character
+// as defined by RFC 3986.
+func escape(s string) string {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if !isUnreserved(s[i]) {
+			n++
+		}
+	}
+	if n == 0 {
+		return s
+	}
+
+	const hex = "0123456789ABCDEF"
+	b := make([]byte, 0, len(s)+2*n)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isUnreserved(c) {
+			b = append(b, c)
+			continue
+		}
+		b = append(b, '%', hex[c>>4], hex[c&0xF])
+	}
+	return string(b)
+}
+
+func isUnreserved(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	}
+	return c == '-' || c == '.' || c == '_' || c == '~'
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestEncodeKeyValueParse(t *testing.T) {
+	tests := []struct {
+		kv   attribute.KeyValue
+		want string
+	}{
+		{attribute.String("service.name", "checkout"), "service.name=checkout"},
+		{attribute.String("k", "a b,c=d%"), "k=a%20b%2Cc%3Dd%25"},
+		{attribute.String("key:with=sep", ""), "key%3Awith%3Dsep="},
+		{attribute.Bool("b", true), "b:bool=true"},
+		{attribute.Int64("i", -42), "i:int64=-42"},
+		{attribute.Float64("f", 1.5), "f:float64=1.5"},
+		{attribute.Float64("f", math.Inf(-1)), "f:float64=-Inf"},
+		{attribute.BoolSlice("bs", []bool{true, false}), "bs:bool[]=true%2Cfalse%2C"},
+		{attribute.Int64Slice("is", []int64{1, 2}), "is:int64[]=1%2C2%2C"},
+		{attribute.Float64Slice("fs", []float64{0.5}), "fs:float64[]=0.5%2C"},
+		{attribute.StringSlice("ss", []string{"a,b", ""}), "ss:string[]=a%252Cb%2C%2C"},
+		{attribute.StringSlice("ss", []string{}), "ss:string[]="},
+		{attribute.ByteSlice("by", []byte("hi")), "by:bytes=aGk%3D"},
+		{
+			attribute.Slice("s", attribute.StringValue("x"), attribute.Int64Value(1)),
+			"s:slice=string%253Dx%2Cint64%253D1%2C",
+		},
+		{
+			attribute.Map("m", attribute.String("a", "x"), attribute.Bool("b", false)),
+			"m:map=a%253Dx%2Cb%253Abool%253Dfalse%2C",
+		},
+		{attribute.KeyValue{Key: "e"}, "e:empty="},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, attribute.EncodeKeyValue(tt.kv))
+
+			got, err := attribute.Parse(tt.want)
+			require.NoError(t, err)
+			assert.Equal(t, tt.kv.Key, got.Key)
+			assert.Equal(t, tt.kv.Value.Type(), got.Value.Type())
+			assert.Equal(t, tt.kv.Value.String(), got.Value.String())
+		})
+	}
+}
+
+func TestParseNaN(t *testing.T) {
+	kv, err := attribute.Parse(attribute.EncodeKeyValue(attribute.Float64("f", math.NaN())))
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(kv.Value.AsFloat64()))
+}
+
+func TestParseUntyped(t *testing.T) {
+	kv, err := attribute.Parse(" key = some%20value ")
+	require.NoError(t, err)
+	assert.Equal(t, attribute.String("key", "some value"), kv)
+
+	kv, err = attribute.Parse("key:string=v")
+	require.NoError(t, err)
+	assert.Equal(t, attribute.String("key", "v"), kv)
+
+	// A ":" not followed by a type is part of the key.
+	kv, err = attribute.Parse("k8s:cluster=prod")
+	require.NoError(t, err)
+	assert.Equal(t, attribute.String("k8s:cluster", "prod"), kv)
+
+	kv, err = attribute.Parse("a:b:int64=1")
+	require.NoError(t, err)
+	assert.Equal(t, attribute.Int64("a:b", 1), kv)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, s := range []string{
+		"novalue",
+		"=value",
+		"k:bool=maybe",
+		"k:int64=1.5",
+		"k:int64[]=1",
+		"k:bytes=!",
+		"k=%zz",
+		"%zz=v",
+		"k:slice=nosep%2C",
+		"k:slice=unknown%253Dv%2C",
+	} {
+		_, err := attribute.Parse(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSetEncodeDecode(t *testing.T) {
+	set := attribute.NewSet(
+		attribute.String("service.name", "checkout"),
+		attribute.Int64("retries", 3),
+		attribute.StringSlice("tags", []string{"a", "b"}),
+		attribute.Map("m", attribute.Float64("f", 2)),
+	)
+	enc := set.Encode()
+	assert.Equal(t, "m:map=f%253Afloat64%253D2%2C,retries:int64=3,service.name=checkout,tags:string[]=a%2Cb%2C", enc)
+
+	got, err := attribute.Decode(enc)
+	require.NoError(t, err)
+	assert.Equal(t, set.Encode(), got.Encode())
+	assert.Equal(t, set.Len(), got.Len())
+}
+
+func TestDecodeResourceAttributes(t *testing.T) {
+	got, err := attribute.Decode("service.name=checkout, deployment.environment=prod%20eu,")
+	require.NoError(t, err)
+	want := attribute.NewSet(
+		attribute.String("service.name", "checkout"),
+		attribute.String("deployment.environment", "prod eu"),
+	)
+	assert.True(t, want.Equals(&got))
+
+	_, err = attribute.Decode("a=b,invalid")
+	assert.Error(t, err)
+
+	got, err = attribute.Decode("k8s:cluster=prod,retries:int64=3")
+	require.NoError(t, err)
+	want = attribute.NewSet(attribute.String("k8s:cluster", "prod"), attribute.Int64("retries", 3))
+	assert.True(t, want.Equals(&got))
+}
+
+func TestSetEncodeEmpty(t *testing.T) {
+	var set *attribute.Set
+	assert.Empty(t, set.Encode())
+	empty := attribute.NewSet()
+	assert.Empty(t, empty.Encode())
+
+	got, err := attribute.Decode("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.Len())
+}
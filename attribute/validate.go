@@ -0,0 +1,229 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrInvalidKeyValue is returned by Validate for a KeyValue that is not
+	// valid, see KeyValue.Valid.
+	ErrInvalidKeyValue = errors.New("invalid attribute")
+
+	// ErrInvalidUTF8 is returned by Validate for a KeyValue with a key or a
+	// string that is not valid UTF-8.
+	ErrInvalidUTF8 = errors.New("attribute is not valid UTF-8")
+
+	// ErrKeyTooLong is returned by Validate for a KeyValue with a key longer
+	// than the MaxKeyLength of the policy.
+	ErrKeyTooLong = errors.New("attribute key too long")
+
+	// ErrValueTooLong is returned by Validate for a KeyValue with a string
+	// longer than the MaxValueLength of the policy.
+	ErrValueTooLong = errors.New("attribute value too long")
+
+	// ErrReservedKey is returned by Validate for a KeyValue with a key
+	// starting with one of the ReservedPrefixes of the policy.
+	ErrReservedKey = errors.New("attribute key has a reserved prefix")
+)
+
+// ValidationPolicy defines the constraints a KeyValue is validated against by
+// Validate and sanitized to comply with by Sanitize.
+//
+// The zero value only validates that the KeyValue is valid, see
+// KeyValue.Valid.
+type ValidationPolicy struct {
+	// RequireUTF8 requires the key and all the strings of the value to be
+	// valid UTF-8. Byte slice values are not required to be valid UTF-8.
+	//
+	// Strings that are not valid UTF-8 fail to be encoded by protocols
+	// requiring it, e.g. OTLP.
+	RequireUTF8 bool
+
+	// MaxKeyLength is the maximum length of the key in characters. The keys
+	// of map values are also limited. If it is less than one, the length of
+	// keys is not limited.
+	MaxKeyLength int
+
+	// MaxValueLength is the maximum length in characters of the strings of
+	// the value, including the elements of string slices and the strings
+	// nested in slice and map values. If it is less than one, the length of
+	// values is not limited.
+	MaxValueLength int
+
+	// ReservedPrefixes are the prefixes the key must not start with, e.g.
+	// "otel." for the keys reserved by OpenTelemetry.
+	ReservedPrefixes []string
+}
+
+// Validate returns an error if kv does not comply with policy, and nil
+// otherwise. The returned error wraps one of ErrInvalidKeyValue,
+// ErrInvalidUTF8, ErrKeyTooLong, ErrValueTooLong, or ErrReservedKey for each
+// constraint kv violates.
+func Validate(kv KeyValue, policy ValidationPolicy) error {
+	if !kv.Valid() {
+		return fmt.Errorf("%w: %q", ErrInvalidKeyValue, kv.Key)
+	}
+
+	var errs []error
+	for _, prefix := range policy.ReservedPrefixes {
+		if strings.HasPrefix(string(kv.Key), prefix) {
+			errs = append(errs, fmt.Errorf("%w: %q has prefix %q", ErrReservedKey, kv.Key, prefix))
+			break
+		}
+	}
+
+	var v validator
+	v.key(kv.Key, policy)
+	v.value(kv.Value, policy)
+	if v.invalidUTF8 {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrInvalidUTF8, kv.Key))
+	}
+	if v.keyTooLong {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrKeyTooLong, kv.Key))
+	}
+	if v.valueTooLong {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrValueTooLong, kv.Key))
+	}
+	return errors.Join(errs...)
+}
+
+// validator records the constraints of a ValidationPolicy violated by keys
+// and values.
+type validator struct {
+	invalidUTF8  bool
+	keyTooLong   bool
+	valueTooLong bool
+}
+
+func (v *validator) key(k Key, policy ValidationPolicy) {
+	if policy.RequireUTF8 && !utf8.ValidString(string(k)) {
+		v.invalidUTF8 = true
+	}
+	if policy.MaxKeyLength > 0 && utf8.RuneCountInString(string(k)) > policy.MaxKeyLength {
+		v.keyTooLong = true
+	}
+}
+
+func (v *validator) str(s string, policy ValidationPolicy) {
+	if policy.RequireUTF8 && !utf8.ValidString(s) {
+		v.invalidUTF8 = true
+	}
+	if policy.MaxValueLength > 0 && utf8.RuneCountInString(s) > policy.MaxValueLength {
+		v.valueTooLong = true
+	}
+}
+
+func (v *validator) value(val Value, policy ValidationPolicy) {
+	switch val.Type() {
+	case STRING:
+		v.str(val.AsString(), policy)
+	case STRINGSLICE:
+		for _, s := range val.AsStringSlice() {
+			v.str(s, policy)
+		}
+	case SLICE:
+		for _, e := range val.AsSlice() {
+			v.value(e, policy)
+		}
+	case MAP:
+		for _, kv := range val.AsMap() {
+			v.key(kv.Key, policy)
+			v.value(kv.Value, policy)
+		}
+	}
+}
+
+// Sanitize returns kv modified to comply with the RequireUTF8 and
+// MaxValueLength constraints of policy, and whether it was modified. The
+// invalid UTF-8 sequences of the key and strings are replaced with the
+// Unicode replacement character U+FFFD, and the strings are truncated to
+// MaxValueLength characters.
+//
+// The other constraints cannot be complied with by modifying kv, e.g. its key
+// could collide with another one if it was truncated. Use Validate to check
+// them.
+func Sanitize(kv KeyValue, policy ValidationPolicy) (KeyValue, bool) {
+	if !policy.RequireUTF8 && policy.MaxValueLength < 1 {
+		return kv, false
+	}
+
+	key, keyChanged := sanitizeKey(kv.Key, policy)
+	val, valChanged := sanitizeValue(kv.Value, policy)
+	if !keyChanged && !valChanged {
+		return kv, false
+	}
+	return KeyValue{Key: key, Value: val}, true
+}
+
+func sanitizeKey(k Key, policy ValidationPolicy) (Key, bool) {
+	if policy.RequireUTF8 && !utf8.ValidString(string(k)) {
+		return Key(strings.ToValidUTF8(string(k), string(utf8.RuneError))), true
+	}
+	return k, false
+}
+
+func sanitizeString(s string, policy ValidationPolicy) (string, bool) {
+	var changed bool
+	if policy.RequireUTF8 && !utf8.ValidString(s) {
+		s, changed = strings.ToValidUTF8(s, string(utf8.RuneError)), true
+	}
+	if policy.MaxValueLength > 0 && len(s) > policy.MaxValueLength {
+		var n int
+		for i := range s {
+			if n == policy.MaxValueLength {
+				return s[:i], true
+			}
+			n++
+		}
+	}
+	return s, changed
+}
+
+func sanitizeValue(v Value, policy ValidationPolicy) (Value, bool) {
+	switch v.Type() {
+	case STRING:
+		if s, ok := sanitizeString(v.AsString(), policy); ok {
+			return StringValue(s), true
+		}
+	case STRINGSLICE:
+		ss := v.AsStringSlice()
+		var changed bool
+		for i, s := range ss {
+			if s, ok := sanitizeString(s, policy); ok {
+				ss[i], changed = s, true
+			}
+		}
+		if changed {
+			return StringSliceValue(ss), true
+		}
+	case SLICE:
+		vals := v.AsSlice()
+		var changed bool
+		for i, e := range vals {
+			if e, ok := sanitizeValue(e, policy); ok {
+				vals[i], changed = e, true
+			}
+		}
+		if changed {
+			return SliceValue(vals...), true
+		}
+	case MAP:
+		kvs := v.AsMap()
+		var changed bool
+		for i, kv := range kvs {
+			if kv, ok := Sanitize(kv, policy); ok {
+				kvs[i], changed = kv, true
+			}
+		}
+		if changed {
+			return MapValue(kvs...), true
+		}
+	}
+	return v, false
+}
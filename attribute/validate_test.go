@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const invalidUTF8 = "a\xffb"
+
+func TestValidate(t *testing.T) {
+	policy := attribute.ValidationPolicy{
+		RequireUTF8:      true,
+		MaxKeyLength:     8,
+		MaxValueLength:   4,
+		ReservedPrefixes: []string{"otel."},
+	}
+	tests := []struct {
+		name   string
+		kv     attribute.KeyValue
+		policy attribute.ValidationPolicy
+		want   []error
+	}{
+		{name: "Valid", kv: attribute.String("key", "héé"), policy: policy},
+		{name: "ZeroPolicy", kv: attribute.String("otel."+invalidUTF8, invalidUTF8+"long")},
+		{name: "EmptyKey", kv: attribute.String("", "v"), want: []error{attribute.ErrInvalidKeyValue}},
+		{
+			name:   "InvalidUTF8Key",
+			kv:     attribute.Int(invalidUTF8, 1),
+			policy: policy,
+			want:   []error{attribute.ErrInvalidUTF8},
+		},
+		{
+			name:   "InvalidUTF8String",
+			kv:     attribute.String("key", invalidUTF8),
+			policy: policy,
+			want:   []error{attribute.ErrInvalidUTF8},
+		},
+		{
+			name:   "InvalidUTF8StringSlice",
+			kv:     attribute.StringSlice("key", []string{"a", invalidUTF8}),
+			policy: policy,
+			want:   []error{attribute.ErrInvalidUTF8},
+		},
+		{
+			name:   "InvalidUTF8MapKey",
+			kv:     attribute.Map("key", attribute.Int(invalidUTF8, 1)),
+			policy: policy,
+			want:   []error{attribute.ErrInvalidUTF8},
+		},
+		{
+			name:   "ByteSliceNotUTF8",
+			kv:     attribute.ByteSlice("key", []byte(invalidUTF8)),
+			policy: policy,
+		},
+		{
+			name:   "KeyTooLong",
+			kv:     attribute.Bool("very.long.key", true),
+			policy: policy,
+			want:   []error{attribute.ErrKeyTooLong},
+		},
+		{
+			name:   "ValueTooLong",
+			kv:     attribute.String("key", "héééé"),
+			policy: policy,
+			want:   []error{attribute.ErrValueTooLong},
+		},
+		{
+			name:   "NestedValueTooLong",
+			kv:     attribute.Slice("key", attribute.StringValue("a"), attribute.MapValue(attribute.String("k", "value"))),
+			policy: policy,
+			want:   []error{attribute.ErrValueTooLong},
+		},
+		{
+			name:   "ReservedKey",
+			kv:     attribute.Int("otel.key", 1),
+			policy: policy,
+			want:   []error{attribute.ErrReservedKey},
+		},
+		{
+			name:   "Multiple",
+			kv:     attribute.String("otel.very.long", invalidUTF8+"long"),
+			policy: policy,
+			want: []error{
+				attribute.ErrReservedKey,
+				attribute.ErrInvalidUTF8,
+				attribute.ErrKeyTooLong,
+				attribute.ErrValueTooLong,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := attribute.Validate(tt.kv, tt.policy)
+			if len(tt.want) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tt.want {
+				assert.ErrorIs(t, err, want)
+			}
+		})
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	policy := attribute.ValidationPolicy{RequireUTF8: true, MaxValueLength: 4}
+	tests := []struct {
+		name   string
+		kv     attribute.KeyValue
+		policy attribute.ValidationPolicy
+		want   attribute.KeyValue
+	}{
+		{
+			name:   "Valid",
+			kv:     attribute.String("key", "héé"),
+			policy: policy,
+			want:   attribute.String("key", "héé"),
+		},
+		{
+			name: "ZeroPolicy",
+			kv:   attribute.String("key", invalidUTF8+"long"),
+			want: attribute.String("key", invalidUTF8+"long"),
+		},
+		{
+			name:   "Key",
+			kv:     attribute.Int(invalidUTF8, 1),
+			policy: policy,
+			want:   attribute.Int("a�b", 1),
+		},
+		{
+			name:   "String",
+			kv:     attribute.String("key", invalidUTF8),
+			policy: policy,
+			want:   attribute.String("key", "a�b"),
+		},
+		{
+			name:   "Truncate",
+			kv:     attribute.String("key", "héééé"),
+			policy: policy,
+			want:   attribute.String("key", "hééé"),
+		},
+		{
+			name:   "InvalidUTF8Truncate",
+			kv:     attribute.String("key", invalidUTF8+"long"),
+			policy: policy,
+			want:   attribute.String("key", "a�bl"),
+		},
+		{
+			name:   "OnlyTruncate",
+			kv:     attribute.String("key", "long"+invalidUTF8),
+			policy: attribute.ValidationPolicy{MaxValueLength: 5},
+			want:   attribute.String("key", "longa"),
+		},
+		{
+			name:   "StringSlice",
+			kv:     attribute.StringSlice("key", []string{"ok", invalidUTF8, "too long"}),
+			policy: policy,
+			want:   attribute.StringSlice("key", []string{"ok", "a�b", "too "}),
+		},
+		{
+			name:   "Slice",
+			kv:     attribute.Slice("key", attribute.IntValue(1), attribute.StringValue("too long")),
+			policy: policy,
+			want:   attribute.Slice("key", attribute.IntValue(1), attribute.StringValue("too ")),
+		},
+		{
+			name:   "Map",
+			kv:     attribute.Map("key", attribute.String(invalidUTF8, "too long")),
+			policy: policy,
+			want:   attribute.Map("key", attribute.String("a�b", "too ")),
+		},
+		{
+			name:   "ByteSlice",
+			kv:     attribute.ByteSlice("key", []byte(invalidUTF8+"long")),
+			policy: policy,
+			want:   attribute.ByteSlice("key", []byte(invalidUTF8+"long")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := attribute.Sanitize(tt.kv, tt.policy)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want != tt.kv, changed, "changed")
+			assert.NoError(t, attribute.Validate(got, attribute.ValidationPolicy{
+				RequireUTF8:    tt.policy.RequireUTF8,
+				MaxValueLength: tt.policy.MaxValueLength,
+			}), "sanitized attribute not valid")
+		})
+	}
+}
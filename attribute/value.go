@@ -1282,3 +1282,66 @@ func (v Value) MarshalJSON() ([]byte, error) {
 	jsonVal.Value = v.AsInterface()
 	return json.Marshal(jsonVal)
 }
+
+// UnmarshalJSON decodes the JSON encoding of a Value, as returned by
+// MarshalJSON, into v.
+func (v *Value) UnmarshalJSON(data []byte) error {
+	var jsonVal struct {
+		Type  string
+		Value json.RawMessage
+	}
+	if err := json.Unmarshal(data, &jsonVal); err != nil {
+		return err
+	}
+
+	var (
+		val Value
+		err error
+	)
+	switch jsonVal.Type {
+	case EMPTY.String():
+	case BOOL.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, BoolValue)
+	case INT64.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, Int64Value)
+	case FLOAT64.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, Float64Value)
+	case STRING.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, StringValue)
+	case BOOLSLICE.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, BoolSliceValue)
+	case INT64SLICE.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, Int64SliceValue)
+	case FLOAT64SLICE.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, Float64SliceValue)
+	case STRINGSLICE.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, StringSliceValue)
+	case BYTESLICE.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, ByteSliceValue)
+	case SLICE.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, func(vals []Value) Value {
+			return SliceValue(vals...)
+		})
+	case MAP.String():
+		val, err = unmarshalJSONValue(jsonVal.Value, func(kvs []KeyValue) Value {
+			return MapValue(kvs...)
+		})
+	default:
+		return fmt.Errorf("attribute: invalid JSON value type %q", jsonVal.Type)
+	}
+	if err != nil {
+		return err
+	}
+	*v = val
+	return nil
+}
+
+// unmarshalJSONValue returns the Value created by newValue from the JSON
+// encoding of a T in data.
+func unmarshalJSONValue[T any](data json.RawMessage, newValue func(T) Value) (Value, error) {
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return Value{}, err
+	}
+	return newValue(t), nil
+}
@@ -4,11 +4,13 @@
 package attribute_test
 
 import (
+	"encoding/json"
 	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -1046,3 +1048,48 @@ func TestValueString(t *testing.T) {
 		})
 	}
 }
+
+func TestValueJSONRoundTrip(t *testing.T) {
+	for _, v := range []attribute.Value{
+		{},
+		attribute.BoolValue(true),
+		attribute.Int64Value(math.MaxInt64),
+		attribute.Float64Value(1.5),
+		attribute.StringValue("foo"),
+		attribute.BoolSliceValue([]bool{true, false}),
+		attribute.Int64SliceValue([]int64{1, math.MinInt64}),
+		attribute.Float64SliceValue([]float64{1.5, -2}),
+		attribute.StringSliceValue([]string{"foo", "bar"}),
+		attribute.ByteSliceValue([]byte{0, 1, 0xff}),
+		attribute.SliceValue(attribute.StringValue("foo"), attribute.Int64Value(1), attribute.Value{}),
+		attribute.MapValue(
+			attribute.String("foo", "bar"),
+			attribute.KeyValue{
+				Key:   "nested",
+				Value: attribute.MapValue(attribute.Int64("baz", 2)),
+			},
+		),
+	} {
+		t.Run(v.Type().String(), func(t *testing.T) {
+			data, err := json.Marshal(v)
+			require.NoError(t, err)
+
+			var got attribute.Value
+			require.NoError(t, json.Unmarshal(data, &got))
+			assert.Equal(t, v.Type(), got.Type())
+			assert.Equal(t, v.Emit(), got.Emit())
+		})
+	}
+}
+
+func TestValueUnmarshalJSONError(t *testing.T) {
+	for _, data := range []string{
+		`{"Type":"UNKNOWN","Value":1}`,
+		`{"Type":"INT64","Value":"1"}`,
+		`{"Type":"MAP","Value":[{"Key":"a","Value":{"Type":"BOOL","Value":1}}]}`,
+		`[]`,
+	} {
+		var v attribute.Value
+		assert.Error(t, json.Unmarshal([]byte(data), &v), data)
+	}
+}
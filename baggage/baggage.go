@@ -116,12 +116,12 @@ func newInvalidProperty() Property {
 // parseProperty attempts to decode a Property from the passed string. It
 // returns an error if the input is invalid according to the W3C Baggage
 // specification.
-func parseProperty(property string) (Property, error) {
+func parseProperty(property string, mode ParseMode) (Property, error) {
 	if property == "" {
 		return newInvalidProperty(), nil
 	}
 
-	p, ok := parsePropertyInternal(property)
+	p, ok := parsePropertyInternal(property, mode)
 	if !ok {
 		return newInvalidProperty(), fmt.Errorf("%w: %q", errInvalidProperty, property)
 	}
@@ -309,14 +309,14 @@ func newInvalidMember() Member {
 
 // parseMember attempts to decode a Member from the passed string. It returns
 // an error if the input is invalid according to the W3C Baggage
-// specification.
-func parseMember(member string) (Member, error) {
+// specification and mode.
+func parseMember(member string, mode ParseMode) (Member, error) {
 	var props properties
 	keyValue, properties, found := strings.Cut(member, propertyDelimiter)
 	if found {
 		// Parse the member properties.
 		for pStr := range strings.SplitSeq(properties, propertyDelimiter) {
-			p, err := parseProperty(pStr)
+			p, err := parseProperty(pStr, mode)
 			if err != nil {
 				return newInvalidMember(), err
 			}
@@ -338,17 +338,16 @@ func parseMember(member string) (Member, error) {
 	}
 
 	rawVal := strings.TrimSpace(v)
-	if !validateValue(rawVal) {
-		return newInvalidMember(), fmt.Errorf("%w: %q", errInvalidValue, v)
+	for _, c := range rawVal {
+		if !mode.validateValueChar(c) {
+			return newInvalidMember(), fmt.Errorf("%w: %q", errInvalidValue, v)
+		}
 	}
 
-	// Decode a percent-encoded value.
-	unescapeVal, err := url.PathUnescape(rawVal)
+	value, err := mode.unescape(rawVal)
 	if err != nil {
 		return newInvalidMember(), fmt.Errorf("%w: %w", errInvalidValue, err)
 	}
-
-	value := replaceInvalidUTF8Sequences(len(rawVal), unescapeVal)
 	return Member{key: key, value: value, properties: props, hasData: true}, nil
 }
 
@@ -509,7 +508,45 @@ func New(members ...Member) (Baggage, error) {
 //
 // Invalid members are skipped and the error is returned along with the
 // partial result containing the valid members.
+//
+// Parse is equivalent to ParseWithMode(bStr, ParseDefault).
 func Parse(bStr string) (Baggage, error) {
+	return ParseWithMode(bStr, ParseDefault)
+}
+
+// ParseMode is the level of compliance with the W3C Baggage specification a
+// baggage-string is parsed with.
+//
+// The mode only applies to parsing. The String methods always percent-encode
+// values as required by the W3C Baggage specification.
+type ParseMode int
+
+const (
+	// ParseDefault parses the list-members that comply with the W3C Baggage
+	// specification. Invalid list-members are skipped and percent-decoded
+	// values that are not valid UTF-8 have the invalid sequences replaced
+	// with U+FFFD.
+	ParseDefault ParseMode = iota
+
+	// ParseStrict parses a baggage-string only if it fully complies with the
+	// W3C Baggage specification. If the baggage-string contains an invalid
+	// list-member, exceeds the limits of the specification, or contains a
+	// value that is not valid UTF-8 once percent-decoded, an empty Baggage
+	// is returned with an error.
+	ParseStrict
+
+	// ParsePermissive is ParseDefault, but additionally accepts values that
+	// contain unencoded UTF-8 characters, and values with a percent sign
+	// that does not start a valid percent-encoded octet, which are kept
+	// as-is. This provides interoperability with systems that do not
+	// percent-encode baggage values.
+	ParsePermissive
+)
+
+// ParseWithMode attempts to decode a baggage-string from the passed string
+// complying with the W3C Baggage specification according to mode. It
+// behaves like Parse for the limits and duplicate keys.
+func ParseWithMode(bStr string, mode ParseMode) (Baggage, error) {
 	if bStr == "" {
 		return Baggage{}, nil
 	}
@@ -530,7 +567,7 @@ func Parse(bStr string) (Baggage, error) {
 			break
 		}
 
-		m, err := parseMember(memberStr)
+		m, err := parseMember(memberStr, mode)
 		if err != nil {
 			parseErrors++
 			if parseErrors <= maxParseErrors {
@@ -575,12 +612,38 @@ func Parse(bStr string) (Baggage, error) {
 		truncateErr = errors.Join(truncateErr, fmt.Errorf("and %d more invalid member(s)", dropped))
 	}
 
-	if len(b) == 0 {
+	if len(b) == 0 || (mode == ParseStrict && truncateErr != nil) {
 		return Baggage{}, truncateErr
 	}
 	return Baggage{b}, truncateErr
 }
 
+// validateValueChar reports whether c is allowed in a value parsed with mode.
+func (mode ParseMode) validateValueChar(c rune) bool {
+	if mode == ParsePermissive && c >= utf8.RuneSelf && c != utf8.RuneError {
+		return true
+	}
+	return validateValueChar(c)
+}
+
+// unescape decodes the percent-encoded value s parsed with mode.
+func (mode ParseMode) unescape(s string) (string, error) {
+	value, err := url.PathUnescape(s)
+	if err != nil {
+		if mode == ParsePermissive {
+			return s, nil
+		}
+		return "", err
+	}
+	if utf8.ValidString(value) {
+		return value, nil
+	}
+	if mode == ParseStrict {
+		return "", fmt.Errorf("invalid UTF-8: %q", s)
+	}
+	return replaceInvalidUTF8Sequences(len(s), value), nil
+}
+
 // Member returns the baggage list-member identified by key.
 //
 // If there is no list-member matching the passed key the returned Member will
@@ -708,7 +771,7 @@ func (b Baggage) String() string {
 
 // parsePropertyInternal attempts to decode a Property from the passed string.
 // It follows the spec at https://www.w3.org/TR/baggage/#definition.
-func parsePropertyInternal(s string) (p Property, ok bool) {
+func parsePropertyInternal(s string, mode ParseMode) (p Property, ok bool) {
 	// For the entire function we will use "   key    =    value  " as an example.
 	// Attempting to parse the key.
 	// First skip spaces at the beginning "<   >key    =    value  " (they could be empty).
@@ -756,10 +819,10 @@ func parsePropertyInternal(s string) (p Property, ok bool) {
 	valueStart := index
 	valueEnd := index
 	for _, c := range s[valueStart:] {
-		if !validateValueChar(c) {
+		if !mode.validateValueChar(c) {
 			break
 		}
-		valueEnd++
+		valueEnd += utf8.RuneLen(c)
 	}
 
 	// Skip all trailing whitespaces: "   key    =    value<  >".
@@ -772,13 +835,10 @@ func parsePropertyInternal(s string) (p Property, ok bool) {
 		return p, ok
 	}
 
-	// Decode a percent-encoded value.
-	rawVal := s[valueStart:valueEnd]
-	unescapeVal, err := url.PathUnescape(rawVal)
+	value, err := mode.unescape(s[valueStart:valueEnd])
 	if err != nil {
 		return p, ok
 	}
-	value := replaceInvalidUTF8Sequences(len(rawVal), unescapeVal)
 
 	ok = true
 	p.key = s[keyStart:keyEnd]
@@ -98,7 +98,7 @@ func TestParseProperty(t *testing.T) {
 	}
 
 	for _, tc := range testcases {
-		actual, err := parseProperty(tc.in)
+		actual, err := parseProperty(tc.in, ParseDefault)
 
 		if !assert.NoError(t, err) {
 			continue
@@ -114,7 +114,7 @@ func TestParseProperty(t *testing.T) {
 }
 
 func TestParsePropertyError(t *testing.T) {
-	_, err := parseProperty(",;,")
+	_, err := parseProperty(",;,", ParseDefault)
 	assert.ErrorIs(t, err, errInvalidProperty)
 }
 
@@ -634,6 +634,111 @@ func TestBaggageParseValue(t *testing.T) {
 	}
 }
 
+func TestBaggageParseWithMode(t *testing.T) {
+	tooMany := make([]string, maxMembers+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("k%d=v", i)
+	}
+
+	testcases := []struct {
+		name    string
+		in      string
+		mode    ParseMode
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "default skips invalid member",
+			in:      "k1=v1,k2=v 2",
+			mode:    ParseDefault,
+			want:    map[string]string{"k1": "v1"},
+			wantErr: true,
+		},
+		{
+			name:    "strict rejects invalid member",
+			in:      "k1=v1,k2=v 2",
+			mode:    ParseStrict,
+			wantErr: true,
+		},
+		{
+			name:    "strict rejects too many members",
+			in:      strings.Join(tooMany, ","),
+			mode:    ParseStrict,
+			wantErr: true,
+		},
+		{
+			name:    "strict rejects invalid UTF-8",
+			in:      "k1=v1,k2=aa%ffcc",
+			mode:    ParseStrict,
+			wantErr: true,
+		},
+		{
+			name:    "strict rejects invalid UTF-8 property",
+			in:      "k1=v1;p=aa%ffcc",
+			mode:    ParseStrict,
+			wantErr: true,
+		},
+		{
+			name: "strict accepts valid baggage",
+			in:   "k1=v1, k2 = %E2%82%AC;p=1",
+			mode: ParseStrict,
+			want: map[string]string{"k1": "v1", "k2": "€"},
+		},
+		{
+			name:    "default rejects unencoded UTF-8",
+			in:      "k1=€",
+			mode:    ParseDefault,
+			wantErr: true,
+		},
+		{
+			name: "permissive accepts unencoded UTF-8",
+			in:   "k1=€uro;p=π,k2=%E2%82%AC",
+			mode: ParsePermissive,
+			want: map[string]string{"k1": "€uro", "k2": "€"},
+		},
+		{
+			name: "permissive keeps invalid percent-encoding",
+			in:   "k1=100%",
+			mode: ParsePermissive,
+			want: map[string]string{"k1": "100%"},
+		},
+		{
+			name:    "permissive rejects invalid characters",
+			in:      "k1=a\\b",
+			mode:    ParsePermissive,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := ParseWithMode(tc.in, tc.mode)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			got := make(map[string]string, b.Len())
+			for _, m := range b.Members() {
+				got[m.Key()] = m.Value()
+			}
+			if tc.want == nil {
+				assert.Empty(t, got)
+			} else {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBaggageParsePermissiveString(t *testing.T) {
+	b, err := ParseWithMode("k=€;p=π", ParsePermissive)
+	require.NoError(t, err)
+	// Values are percent-encoded when propagated.
+	assert.Equal(t, "k=%E2%82%AC;p=%CF%80", b.String())
+}
+
 func TestBaggageString(t *testing.T) {
 	testcases := []struct {
 		name    string
@@ -1171,8 +1171,10 @@ func TestConvertKV(t *testing.T) {
 			expected: attribute.StringValue("stringer"),
 		},
 		{
-			value:    metricdata.Histogram[float64]{},
-			expected: attribute.StringValue("unhandled attribute value: {DataPoints:[] Temporality:undefinedTemporality}"),
+			value: metricdata.Histogram[float64]{},
+			expected: attribute.StringValue(
+				"unhandled attribute value: {DataPoints:[] Temporality:undefinedTemporality IsGauge:false}",
+			),
 		},
 	} {
 		t.Run(fmt.Sprintf("%v(%+v)", reflect.TypeOf(tt.value), tt.value), func(t *testing.T) {
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/retry"
 )
@@ -65,9 +66,13 @@ func newClient(cfg config) (*client, error) {
 	if c.conn == nil {
 		// If the caller did not provide a ClientConn when the client was
 		// created, create one using the configuration they did provide.
-		dialOpts := newGRPCDialOptions(cfg)
+		target, dialOpts := failover.DialOptions(
+			cfg.endpoint.Value,
+			cfg.failoverEndpoints.Value,
+			newGRPCDialOptions(cfg),
+		)
 
-		conn, err := newGRPCClientFn(cfg.endpoint.Value, dialOpts...)
+		conn, err := newGRPCClientFn(target, dialOpts...)
 		if err != nil {
 			return nil, err
 		}
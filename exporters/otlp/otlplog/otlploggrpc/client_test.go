@@ -626,6 +626,31 @@ func TestConfig(t *testing.T) {
 		require.Contains(t, got, additionalKey)
 		assert.Equal(t, []string{headers[key]}, got[key])
 	})
+
+	t.Run("WithFailoverEndpoints", func(t *testing.T) {
+		// The primary endpoint cannot be connected to.
+		ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+		require.NoError(t, err)
+		unavailable := ln.Addr().String()
+		require.NoError(t, ln.Close())
+
+		coll, err := newGRPCCollector(t.Context(), "", nil)
+		require.NoError(t, err)
+		t.Cleanup(coll.srv.Stop)
+
+		ctx := t.Context()
+		exp, err := New(
+			ctx,
+			WithEndpoint(unavailable),
+			WithInsecure(),
+			WithFailoverEndpoints(coll.listener.Addr().String()),
+		)
+		require.NoError(t, err)
+		require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+		require.NoError(t, exp.Shutdown(ctx))
+
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
 }
 
 // SetExporterID sets the exporter ID counter to v and returns the previous
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +24,9 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 )
 
+// unixScheme is the endpoint URL scheme of a Unix domain socket.
+const unixScheme = "unix"
+
 // Default values.
 var (
 	defaultEndpoint       = "localhost:4317"
@@ -98,6 +102,7 @@ type config struct {
 	// gRPC configurations
 	gRPCCredentials    setting[credentials.TransportCredentials]
 	serviceConfig      setting[string]
+	failoverEndpoints  setting[[]string]
 	reconnectionPeriod setting[time.Duration]
 	dialOptions        setting[[]grpc.DialOption]
 	gRPCConn           setting[*grpc.ClientConn]
@@ -200,6 +205,11 @@ func WithEndpoint(endpoint string) Option {
 // If both this option and WithEndpoint are used, the last used option will
 // take precedence.
 //
+// A Unix domain socket is targeted using the "unix" scheme, e.g.
+// "unix:///var/run/otel/collector.sock". Connections to a Unix domain socket
+// do not use client security.
+// Windows named pipes are not supported as endpoints.
+//
 // If an invalid URL is provided, the default value will be kept.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -213,7 +223,11 @@ func WithEndpointURL(rawURL string) Option {
 		return fnOpt(func(c config) config { return c })
 	}
 	return fnOpt(func(c config) config {
-		c.endpoint = newSetting(u.Host)
+		if isUnix(u) {
+			c.endpoint = newSetting(unixEndpoint(u))
+		} else {
+			c.endpoint = newSetting(u.Host)
+		}
 		c.insecure = insecureFromScheme(c.insecure, u.Scheme)
 		return c
 	})
@@ -299,6 +313,9 @@ func WithTLSCredentials(credential credentials.TransportCredentials) Option {
 
 // WithServiceConfig defines the default gRPC service config used.
 //
+// By default, the round_robin load balancing policy is used, or the
+// pick_first policy if WithFailoverEndpoints is used.
+//
 // This option has no effect if WithGRPCConn is used.
 func WithServiceConfig(serviceConfig string) Option {
 	return fnOpt(func(c config) config {
@@ -307,6 +324,30 @@ func WithServiceConfig(serviceConfig string) Option {
 	})
 }
 
+// WithFailoverEndpoints sets the endpoints (host and port) the Exporter will
+// connect to, in order, when the endpoint set with WithEndpoint or
+// WithEndpointURL cannot be reached, e.g. when its name cannot be resolved or
+// its connection is lost. The provided endpoints should resemble
+// "example.com:4317" (no scheme or path).
+//
+// All endpoints are resolved again when the connection is lost, so the
+// Exporter is not stranded on the address of a collector that was redeployed
+// with a new one. Once connected to a failover endpoint, the Exporter only
+// connects back to the endpoint once that connection is lost.
+//
+// By default, no failover endpoint is used and the requests are balanced over
+// all the addresses resolved for the endpoint with the round_robin gRPC load
+// balancing policy. With failover endpoints, the pick_first policy is used
+// instead. A service config set with WithServiceConfig takes precedence.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithFailoverEndpoints(endpoints ...string) Option {
+	return fnOpt(func(c config) config {
+		c.failoverEndpoints = newSetting(endpoints)
+		return c
+	})
+}
+
 // WithDialOption sets explicit grpc.DialOptions to use when establishing a
 // gRPC connection. The options here are appended to the internal grpc.DialOptions
 // used so they will take precedence over any other internal grpc.DialOptions
@@ -411,9 +452,30 @@ func convEndpoint(s string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if isUnix(u) {
+		return unixEndpoint(u), nil
+	}
 	return u.Host, nil
 }
 
+// isUnix reports whether u targets a Unix domain socket.
+func isUnix(u *url.URL) bool {
+	return strings.EqualFold(u.Scheme, unixScheme)
+}
+
+// unixEndpoint returns the endpoint targeting the Unix domain socket
+// identified by u. The returned value is also a valid gRPC target.
+func unixEndpoint(u *url.URL) string {
+	p := u.Path
+	if p == "" {
+		p = u.Opaque
+	}
+	if path.IsAbs(p) {
+		return unixScheme + "://" + p
+	}
+	return unixScheme + ":" + p
+}
+
 // convInsecure converts s from string to bool without case sensitivity.
 // If s is not valid returns error.
 func convInsecure(s string) (bool, error) {
@@ -110,6 +110,7 @@ func TestNewConfig(t *testing.T) {
 				WithHeaders(headers),
 				WithTLSCredentials(credentials.NewTLS(tlsCfg)),
 				WithServiceConfig("{}"),
+				WithFailoverEndpoints("failover:4317"),
 				WithDialOption(dialOptions...),
 				WithGRPCConn(&grpc.ClientConn{}),
 				WithMaxRequestSize(1),
@@ -126,6 +127,7 @@ func TestNewConfig(t *testing.T) {
 				retryCfg:           newSetting(rc),
 				gRPCCredentials:    newSetting(credentials.NewTLS(tlsCfg)),
 				serviceConfig:      newSetting("{}"),
+				failoverEndpoints:  newSetting([]string{"failover:4317"}),
 				reconnectionPeriod: newSetting(time.Second),
 				gRPCConn:           newSetting(&grpc.ClientConn{}),
 				dialOptions:        newSetting(dialOptions),
@@ -143,6 +145,18 @@ func TestNewConfig(t *testing.T) {
 				retryCfg: newSetting(defaultRetryCfg),
 			},
 		},
+		{
+			name: "WithEndpointURLUnixSocket",
+			options: []Option{
+				WithEndpointURL("unix:///var/run/otel/collector.sock"),
+			},
+			want: config{
+				endpoint: newSetting("unix:///var/run/otel/collector.sock"),
+				insecure: newSetting(true),
+				timeout:  newSetting(defaultTimeout),
+				retryCfg: newSetting(defaultRetryCfg),
+			},
+		},
 		{
 			name: "EndpointPrecedence",
 			options: []Option{
@@ -384,6 +398,18 @@ func TestNewConfig(t *testing.T) {
 				timeout:  newSetting(defaultTimeout),
 			},
 		},
+		{
+			name: "EnvEndpointUnixSocket",
+			envars: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:collector.sock",
+			},
+			want: config{
+				endpoint: newSetting("unix:collector.sock"),
+				insecure: newSetting(true),
+				retryCfg: newSetting(defaultRetryCfg),
+				timeout:  newSetting(defaultTimeout),
+			},
+		},
 		{
 			name: "DefaultEndpointWithEnvInsecure",
 			envars: map[string]string{
@@ -18,3 +18,6 @@ package internal
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log.go.tmpl "--data={}" --out=transform/log.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_attr_test.go.tmpl "--data={}" --out=transform/log_attr_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_test.go.tmpl "--data={}" --out=transform/log_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover.go.tmpl "--data={}" --out=failover/failover.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover_test.go.tmpl "--data={}" --out=failover/failover_test.go
@@ -294,7 +294,7 @@ func ServerAddrAttrs(target string) []attribute.KeyValue {
 	addr, port, err := ParseCanonicalTarget(target)
 	if err != nil || (addr == "" && port < 0) {
 		if err != nil {
-			global.Debug("failed to parse target", "target", target, "error", err)
+			global.Component(global.ComponentOTLPClient).Debug("failed to parse target", "target", target, "error", err)
 		}
 		return nil
 	}
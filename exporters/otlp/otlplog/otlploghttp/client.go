@@ -66,14 +66,29 @@ func newHTTPClient(ctx context.Context, cfg config) (*client, error) {
 		return nil, errInsecureEndpointWithTLS
 	}
 
+	host := cfg.endpoint.Value
+	socket, isUnix := unixSocketPath(host)
+	if isUnix {
+		// Requests are sent over the socket, the host is only used for the
+		// Host header.
+		host = "localhost"
+	}
+
 	hc := cfg.httpClient
+	if hc == nil && cfg.httpTransport != nil {
+		// Use the transport as is, it can be shared with other exporters.
+		hc = &http.Client{
+			Transport: cfg.httpTransport,
+			Timeout:   cfg.timeout.Value,
+		}
+	}
 	if hc == nil {
 		hc = &http.Client{
 			Transport: ourTransport,
 			Timeout:   cfg.timeout.Value,
 		}
 
-		if cfg.tlsCfg.Value != nil || cfg.proxy.Value != nil {
+		if cfg.tlsCfg.Value != nil || cfg.proxy.Value != nil || isUnix {
 			clonedTransport := ourTransport.Clone()
 			hc.Transport = clonedTransport
 
@@ -83,12 +98,16 @@ func newHTTPClient(ctx context.Context, cfg config) (*client, error) {
 			if cfg.proxy.Value != nil {
 				clonedTransport.Proxy = cfg.proxy.Value
 			}
+			if isUnix {
+				clonedTransport.Proxy = nil
+				clonedTransport.DialContext = unixDialContext(socket)
+			}
 		}
 	}
 
 	u := &url.URL{
 		Scheme: "https",
-		Host:   cfg.endpoint.Value,
+		Host:   host,
 		Path:   cfg.path.Value,
 	}
 	if cfg.insecure.Value {
@@ -111,11 +130,13 @@ func newHTTPClient(ctx context.Context, cfg config) (*client, error) {
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
 	c := &httpClient{
-		compression:    cfg.compression.Value,
-		maxRequestSize: cfg.maxRequestSize.Value,
-		req:            req,
-		requestFunc:    cfg.retryCfg.Value.RequestFunc(evaluate),
-		client:         hc,
+		compression:          cfg.compression.Value,
+		compressionThreshold: cfg.compressionThreshold.Value,
+		gzPool:               newGzipPool(cfg.compressionLevel.Value),
+		maxRequestSize:       cfg.maxRequestSize.Value,
+		req:                  req,
+		requestFunc:          cfg.retryCfg.Value.RequestFunc(evaluate),
+		client:               hc,
 	}
 
 	id := nextExporterID()
@@ -124,13 +145,27 @@ func newHTTPClient(ctx context.Context, cfg config) (*client, error) {
 	return &client{uploadLogs: c.uploadLogs}, err
 }
 
+// unixDialContext returns a DialContext function that connects to the Unix
+// domain socket at path regardless of the network address requested.
+func unixDialContext(path string) func(context.Context, string, string) (net.Conn, error) {
+	d := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
 type httpClient struct {
 	// req is cloned for every upload the client makes.
-	req            *http.Request
-	compression    Compression
-	maxRequestSize int
-	requestFunc    retry.RequestFunc
-	client         *http.Client
+	req                  *http.Request
+	compression          Compression
+	compressionThreshold int
+	gzPool               *sync.Pool
+	maxRequestSize       int
+	requestFunc          retry.RequestFunc
+	client               *http.Client
 
 	inst *observ.Instrumentation
 }
@@ -276,18 +311,27 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 	}))
 }
 
-var gzPool = sync.Pool{
-	New: func() any {
-		w := gzip.NewWriter(io.Discard)
-		return w
-	},
+// newGzipPool returns a pool of gzip writers compressing with level.
+func newGzipPool(level int) *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			// The level is validated by the options setting it.
+			w, _ := gzip.NewWriterLevel(io.Discard, level)
+			return w
+		},
+	}
 }
 
 func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, error) {
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
-	switch c.compression {
+	compression := c.compression
+	if compression != NoCompression && len(body) < c.compressionThreshold {
+		// Compressing small payloads costs more than it saves.
+		compression = NoCompression
+	}
+	switch compression {
 	case NoCompression:
 		r.ContentLength = int64(len(body))
 		req.bodyReader = bodyReader(body)
@@ -297,10 +341,10 @@ func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, erro
 		r.ContentLength = -1
 		r.Header.Set("Content-Encoding", "gzip")
 
-		gz := gzPool.Get().(*gzip.Writer)
+		gz := c.gzPool.Get().(*gzip.Writer)
 		defer func() {
 			gz.Reset(io.Discard)
-			gzPool.Put(gz)
+			c.gzPool.Put(gz)
 		}()
 
 		var b bytes.Buffer
@@ -22,6 +22,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -1322,8 +1324,120 @@ func TestWithEndpointURLNoPathUsesRootPath(t *testing.T) {
 	assert.Equal(t, "/", got, "a pathless endpoint URL must target the root path, not the default logs path")
 }
 
+func TestWithEndpointURLUnixSocket(t *testing.T) {
+	// Unix socket paths are limited in length, avoid the long t.TempDir path.
+	dir, err := os.MkdirTemp("", "otlp")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "collector.sock")
+
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	pathCh := make(chan string, 1)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pathCh <- r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.Listener = ln
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := New(ctx, WithEndpointURL("unix://"+socket), WithRetry(RetryConfig{Enabled: false}))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+
+	got, ok := <-pathCh
+	require.True(t, ok, "request was not received")
+	assert.Equal(t, defaultPath, got)
+}
+
 type roundTripperFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 	return f(r)
 }
+
+// okTransport returns a RoundTripper responding with an empty 200 response
+// and counting the requests in calls.
+func okTransport(calls *int) http.RoundTripper {
+	return roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		*calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+}
+
+func TestWithHTTPTransport(t *testing.T) {
+	ctx := t.Context()
+
+	var calls int
+	c, err := newHTTPClient(ctx, newConfig([]Option{
+		WithHTTPTransport(okTransport(&calls)),
+		WithInsecure(),
+	}))
+	require.NoError(t, err)
+	require.NoError(t, c.UploadLogs(ctx, resourceLogs))
+	assert.Equal(t, 1, calls)
+
+	t.Run("HTTPClientPrecedence", func(t *testing.T) {
+		var transportCalls, clientCalls int
+		c, err := newHTTPClient(ctx, newConfig([]Option{
+			WithHTTPTransport(okTransport(&transportCalls)),
+			WithHTTPClient(&http.Client{Transport: okTransport(&clientCalls)}),
+			WithInsecure(),
+		}))
+		require.NoError(t, err)
+		require.NoError(t, c.UploadLogs(ctx, resourceLogs))
+		assert.Equal(t, 0, transportCalls)
+		assert.Equal(t, 1, clientCalls)
+	})
+}
+
+func TestCompressionThreshold(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		encodings []string
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	export := func(opts ...Option) {
+		opts = append(opts,
+			WithEndpoint(server.Listener.Addr().String()),
+			WithInsecure(),
+			WithCompression(GzipCompression),
+		)
+		cfg := newConfig(opts)
+		client, err := newHTTPClient(t.Context(), cfg)
+		require.NoError(t, err)
+
+		exporter, err := newExporter(client, cfg)
+		require.NoError(t, err)
+		ctx := t.Context()
+		defer func() { _ = exporter.Shutdown(ctx) }()
+		require.NoError(t, exporter.Export(ctx, make([]log.Record, 1)))
+	}
+
+	export(WithCompressionThreshold(1 << 20))
+	export(WithCompressionLevel(gzip.BestSpeed))
+	export(WithCompressionLevel(100))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"", "gzip", "gzip"}, encodings)
+}
@@ -4,6 +4,7 @@
 package otlploghttp
 
 import (
+	"compress/gzip"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -18,17 +20,22 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp/internal/transport"
 	"go.opentelemetry.io/otel/internal/global"
 )
 
+// unixScheme is the endpoint URL scheme of a Unix domain socket.
+const unixScheme = "unix"
+
 // Default values.
 var (
-	defaultEndpoint                              = "localhost:4318"
-	defaultPath                                  = "/v1/logs"
-	defaultTimeout                               = 10 * time.Second
-	defaultMaxRequestSize                        = 64 * 1024 * 1024
-	defaultProxy          HTTPTransportProxyFunc = http.ProxyFromEnvironment
-	defaultRetryCfg                              = retry.DefaultConfig
+	defaultEndpoint                                = "localhost:4318"
+	defaultPath                                    = "/v1/logs"
+	defaultTimeout                                 = 10 * time.Second
+	defaultMaxRequestSize                          = 64 * 1024 * 1024
+	defaultCompressionLevel                        = gzip.DefaultCompression
+	defaultProxy            HTTPTransportProxyFunc = http.ProxyFromEnvironment
+	defaultRetryCfg                                = retry.DefaultConfig
 )
 
 // Environment variable keys.
@@ -90,17 +97,20 @@ type fnOpt func(config) config
 func (f fnOpt) applyHTTPOption(c config) config { return f(c) }
 
 type config struct {
-	endpoint       setting[string]
-	path           setting[string]
-	insecure       setting[bool]
-	tlsCfg         setting[*tls.Config]
-	headers        setting[map[string]string]
-	compression    setting[Compression]
-	maxRequestSize setting[int]
-	timeout        setting[time.Duration]
-	proxy          setting[HTTPTransportProxyFunc]
-	retryCfg       setting[retry.Config]
-	httpClient     *http.Client
+	endpoint             setting[string]
+	path                 setting[string]
+	insecure             setting[bool]
+	tlsCfg               setting[*tls.Config]
+	headers              setting[map[string]string]
+	compression          setting[Compression]
+	compressionLevel     setting[int]
+	compressionThreshold setting[int]
+	maxRequestSize       setting[int]
+	timeout              setting[time.Duration]
+	proxy                setting[HTTPTransportProxyFunc]
+	retryCfg             setting[retry.Config]
+	httpClient           *http.Client
+	httpTransport        http.RoundTripper
 }
 
 func newConfig(options []Option) config {
@@ -131,6 +141,9 @@ func newConfig(options []Option) config {
 	c.compression = c.compression.Resolve(
 		getenv[Compression](envCompression, convCompression),
 	)
+	c.compressionLevel = c.compressionLevel.Resolve(
+		fallback[int](defaultCompressionLevel),
+	)
 	c.timeout = c.timeout.Resolve(
 		getenv[time.Duration](envTimeout, convDuration),
 		fallback[time.Duration](defaultTimeout),
@@ -181,6 +194,11 @@ func WithEndpoint(endpoint string) Option {
 // If both this option and WithEndpoint are used, the last used option will
 // take precedence.
 //
+// A Unix domain socket is targeted using the "unix" scheme, e.g.
+// "unix:///var/run/otel/collector.sock". Requests sent to a Unix domain
+// socket do not use client security and are sent to the default logs path.
+// Windows named pipes are not supported as endpoints.
+//
 // If an invalid URL is provided, the default value will be kept.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -191,6 +209,13 @@ func WithEndpointURL(rawURL string) Option {
 		global.Error(err, "otlplog: parse endpoint url", "url", rawURL)
 		return fnOpt(func(c config) config { return c })
 	}
+	if isUnix(u) {
+		return fnOpt(func(c config) config {
+			c.endpoint = newSetting(unixEndpoint(u))
+			c.insecure = newSetting(true)
+			return c
+		})
+	}
 	return fnOpt(func(c config) config {
 		c.endpoint = newSetting(u.Host)
 		c.path = newSetting(u.Path)
@@ -227,6 +252,42 @@ func WithCompression(compression Compression) Option {
 	})
 }
 
+// WithCompressionLevel sets the gzip compression level the Exporter will use
+// to compress the HTTP body. The level needs to be gzip.HuffmanOnly,
+// gzip.DefaultCompression, or between gzip.NoCompression and
+// gzip.BestCompression. An invalid level is ignored.
+//
+// Lower levels use less CPU at the cost of larger payloads.
+//
+// By default, if this option is not passed, gzip.DefaultCompression is used.
+//
+// This option has no effect unless gzip compression is used.
+func WithCompressionLevel(level int) Option {
+	return fnOpt(func(c config) config {
+		if level < gzip.HuffmanOnly || level > gzip.BestCompression {
+			global.Warn("otlploghttp: ignoring invalid gzip compression level", "level", level)
+			return c
+		}
+		c.compressionLevel = newSetting(level)
+		return c
+	})
+}
+
+// WithCompressionThreshold sets the minimum size, in bytes, of a serialized
+// export request for it to be compressed. Smaller requests are sent
+// uncompressed, as compressing them costs more CPU than it saves bandwidth.
+//
+// By default, if this option is not passed, or size is less than or equal to
+// zero, all requests are compressed.
+//
+// This option has no effect unless compression is used.
+func WithCompressionThreshold(size int) Option {
+	return fnOpt(func(c config) config {
+		c.compressionThreshold = newSetting(size)
+		return c
+	})
+}
+
 // WithURLPath sets the URL path the Exporter will send requests to.
 //
 // If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_LOGS_ENDPOINT
@@ -386,6 +447,40 @@ func WithHTTPClient(c *http.Client) Option {
 	})
 }
 
+// WithHTTPTransport sets the transport used by the exporter to send HTTP
+// requests.
+//
+// Passing the same transport to the trace, metric, and log OTLP HTTP exporters
+// makes them share a single pool of connections. This reduces the number of
+// connections and TLS handshakes of a process exporting all signals to the
+// same collector. Use [NewHTTPTransport] to create such a transport.
+//
+// This option will take precedence over [WithProxy], [WithTLSClientConfig]
+// options as well as OTEL_EXPORTER_OTLP_CERTIFICATE and
+// OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE environment variables. The timeout set
+// with [WithTimeout] still applies. [WithHTTPClient] takes precedence over
+// this option.
+//
+// The transport is used as is, an endpoint using a Unix domain socket is not
+// supported.
+func WithHTTPTransport(rt http.RoundTripper) Option {
+	return fnOpt(func(cfg config) config {
+		cfg.httpTransport = rt
+		return cfg
+	})
+}
+
+// NewHTTPTransport returns an [http.Transport] that can be passed to the
+// WithHTTPTransport option of the trace, metric, and log OTLP HTTP exporters
+// so they share a single pool of connections. It connects to the collector
+// using tlsCfg, which is cloned, and the proxy returned by proxy. If proxy is
+// nil, [http.ProxyFromEnvironment] is used.
+//
+// The returned transport can be further configured before it is used.
+func NewHTTPTransport(tlsCfg *tls.Config, proxy HTTPTransportProxyFunc) *http.Transport {
+	return transport.New(tlsCfg, proxy)
+}
+
 // setting is a configuration setting value.
 type setting[T any] struct {
 	Value T
@@ -535,9 +630,42 @@ func convEndpoint(s string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if isUnix(u) {
+		return unixEndpoint(u), nil
+	}
 	return u.Host, nil
 }
 
+// isUnix reports whether u targets a Unix domain socket.
+func isUnix(u *url.URL) bool {
+	return strings.EqualFold(u.Scheme, unixScheme)
+}
+
+// unixEndpoint returns the endpoint targeting the Unix domain socket
+// identified by u. The returned value is also a valid gRPC target.
+func unixEndpoint(u *url.URL) string {
+	p := u.Path
+	if p == "" {
+		p = u.Opaque
+	}
+	if path.IsAbs(p) {
+		return unixScheme + "://" + p
+	}
+	return unixScheme + ":" + p
+}
+
+// unixSocketPath returns the path of the Unix domain socket endpoint targets
+// and true. If endpoint does not target a Unix domain socket, an empty string
+// and false are returned.
+func unixSocketPath(endpoint string) (string, bool) {
+	p, ok := strings.CutPrefix(endpoint, unixScheme+":")
+	if !ok {
+		return "", false
+	}
+	p = strings.TrimPrefix(p, "//")
+	return p, p != ""
+}
+
 // convPathExact converts s from a URL string to the exact path if s is a valid
 // URL. Otherwise, "" and an error are returned.
 //
@@ -547,6 +675,9 @@ func convPathExact(s string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if isUnix(u) {
+		return defaultPath, nil
+	}
 	if u.Path == "" {
 		return "/", nil
 	}
@@ -560,6 +691,9 @@ func convPath(s string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if isUnix(u) {
+		return defaultPath, nil
+	}
 	return u.Path + "/v1/logs", nil
 }
 
@@ -4,6 +4,7 @@
 package otlploghttp
 
 import (
+	"compress/gzip"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -106,6 +107,8 @@ func TestNewConfig(t *testing.T) {
 				WithInsecure(),
 				WithTLSClientConfig(tlsCfg),
 				WithCompression(GzipCompression),
+				WithCompressionLevel(gzip.BestSpeed),
+				WithCompressionThreshold(1024),
 				WithHeaders(headers),
 				WithMaxRequestSize(1),
 				WithTimeout(time.Second),
@@ -113,15 +116,17 @@ func TestNewConfig(t *testing.T) {
 				// Do not test WithProxy. Requires func comparison.
 			},
 			want: config{
-				endpoint:       newSetting("test"),
-				path:           newSetting("/path"),
-				insecure:       newSetting(true),
-				tlsCfg:         newSetting(tlsCfg),
-				headers:        newSetting(headers),
-				compression:    newSetting(GzipCompression),
-				maxRequestSize: newSetting(1),
-				timeout:        newSetting(time.Second),
-				retryCfg:       newSetting(rc),
+				endpoint:             newSetting("test"),
+				path:                 newSetting("/path"),
+				insecure:             newSetting(true),
+				tlsCfg:               newSetting(tlsCfg),
+				headers:              newSetting(headers),
+				compression:          newSetting(GzipCompression),
+				compressionLevel:     newSetting(gzip.BestSpeed),
+				compressionThreshold: newSetting(1024),
+				maxRequestSize:       newSetting(1),
+				timeout:              newSetting(time.Second),
+				retryCfg:             newSetting(rc),
 			},
 		},
 		{
@@ -137,6 +142,19 @@ func TestNewConfig(t *testing.T) {
 				retryCfg: newSetting(defaultRetryCfg),
 			},
 		},
+		{
+			name: "WithEndpointURLUnixSocket",
+			options: []Option{
+				WithEndpointURL("unix:///var/run/otel/collector.sock"),
+			},
+			want: config{
+				endpoint: newSetting("unix:///var/run/otel/collector.sock"),
+				path:     newSetting(defaultPath),
+				insecure: newSetting(true),
+				timeout:  newSetting(defaultTimeout),
+				retryCfg: newSetting(defaultRetryCfg),
+			},
+		},
 		{
 			name: "EndpointPrecedence",
 			options: []Option{
@@ -436,6 +454,19 @@ func TestNewConfig(t *testing.T) {
 				timeout:  newSetting(defaultTimeout),
 			},
 		},
+		{
+			name: "EnvEndpointUnixSocket",
+			envars: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:///var/run/otel/collector.sock",
+			},
+			want: config{
+				endpoint: newSetting("unix:///var/run/otel/collector.sock"),
+				path:     newSetting(defaultPath),
+				insecure: newSetting(true),
+				retryCfg: newSetting(defaultRetryCfg),
+				timeout:  newSetting(defaultTimeout),
+			},
+		},
 		{
 			name: "DefaultEndpointWithEnvInsecure",
 			envars: map[string]string{
@@ -514,6 +545,9 @@ func TestNewConfig(t *testing.T) {
 			if !tc.want.maxRequestSize.Set {
 				tc.want.maxRequestSize = newSetting(64 * 1024 * 1024)
 			}
+			if !tc.want.compressionLevel.Set {
+				tc.want.compressionLevel = newSetting(gzip.DefaultCompression)
+			}
 
 			// Do not compare pointer values.
 			assertTLSConfig(t, tc.want.tlsCfg, c.tlsCfg)
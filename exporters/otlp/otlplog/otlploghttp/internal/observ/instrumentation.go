@@ -162,7 +162,7 @@ func ServerAddrAttrs(target string) []attribute.KeyValue {
 	host, port, err := parseTarget(target)
 	if err != nil || (host == "" && port < 0) {
 		if err != nil {
-			global.Debug("failed to parse target", "target", target, "error", err)
+			global.Component(global.ComponentOTLPClient).Debug("failed to parse target", "target", target, "error", err)
 		}
 		return nil
 	}
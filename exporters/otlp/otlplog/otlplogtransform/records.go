@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlplogtransform
+
+import (
+	"fmt"
+	"time"
+
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Records transforms OTLP ResourceLogs into log records, in the order they
+// appear in rls. Each record references its resource and instrumentation
+// scope.
+//
+// OTLP arrays are transformed into [attribute.SLICE] values. The dropped
+// attributes count and flags other than the trace flags are not restored.
+//
+// An error is returned if rls contains an invalid trace or span ID. Records
+// are built with [logtest.RecordFactory] and this function is intended to be
+// used in tests only.
+func Records(rls []*lpb.ResourceLogs) ([]log.Record, error) {
+	var out []log.Record
+	for _, rl := range rls {
+		res := resource.NewWithAttributes(rl.GetSchemaUrl(), keyValues(rl.GetResource().GetAttributes())...)
+		for _, sl := range rl.GetScopeLogs() {
+			scope := &instrumentation.Scope{
+				Name:      sl.GetScope().GetName(),
+				Version:   sl.GetScope().GetVersion(),
+				SchemaURL: sl.GetSchemaUrl(),
+			}
+			scope.Attributes = attribute.NewSet(keyValues(sl.GetScope().GetAttributes())...)
+
+			for _, lr := range sl.GetLogRecords() {
+				f, err := recordFactory(lr)
+				if err != nil {
+					return nil, err
+				}
+				f.Resource = res
+				f.InstrumentationScope = scope
+				out = append(out, f.NewRecord())
+			}
+		}
+	}
+	return out, nil
+}
+
+func recordFactory(lr *lpb.LogRecord) (logtest.RecordFactory, error) {
+	f := logtest.RecordFactory{
+		EventName:         lr.GetEventName(),
+		Timestamp:         unixNanoTime(lr.GetTimeUnixNano()),
+		ObservedTimestamp: unixNanoTime(lr.GetObservedTimeUnixNano()),
+		Severity:          api.Severity(lr.GetSeverityNumber()), // nolint:gosec // Same numbering.
+		SeverityText:      lr.GetSeverityText(),
+		Body:              value(lr.GetBody()),
+		Attributes:        keyValues(lr.GetAttributes()),
+		TraceFlags:        trace.TraceFlags(lr.GetFlags() & uint32(lpb.LogRecordFlags_LOG_RECORD_FLAGS_TRACE_FLAGS_MASK)), // nolint:gosec // Masked to 8 bits.
+	}
+
+	switch id := lr.GetTraceId(); len(id) {
+	case 0:
+	case len(f.TraceID):
+		copy(f.TraceID[:], id)
+	default:
+		return f, fmt.Errorf("invalid trace ID length: %d", len(id))
+	}
+	switch id := lr.GetSpanId(); len(id) {
+	case 0:
+	case len(f.SpanID):
+		copy(f.SpanID[:], id)
+	default:
+		return f, fmt.Errorf("invalid span ID length: %d", len(id))
+	}
+	return f, nil
+}
+
+// unixNanoTime returns the time for the Unix time nano, or the zero time if
+// nano is zero.
+func unixNanoTime(nano uint64) time.Time {
+	if nano == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(nano)) // nolint:gosec // Overflow is not expected before 2262.
+}
+
+func keyValues(kvs []*cpb.KeyValue) []attribute.KeyValue {
+	if len(kvs) == 0 {
+		return nil
+	}
+	out := make([]attribute.KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		out = append(out, attribute.KeyValue{Key: attribute.Key(kv.GetKey()), Value: value(kv.GetValue())})
+	}
+	return out
+}
+
+func value(v *cpb.AnyValue) attribute.Value {
+	switch v := v.GetValue().(type) {
+	case *cpb.AnyValue_StringValue:
+		return attribute.StringValue(v.StringValue)
+	case *cpb.AnyValue_BoolValue:
+		return attribute.BoolValue(v.BoolValue)
+	case *cpb.AnyValue_IntValue:
+		return attribute.Int64Value(v.IntValue)
+	case *cpb.AnyValue_DoubleValue:
+		return attribute.Float64Value(v.DoubleValue)
+	case *cpb.AnyValue_BytesValue:
+		return attribute.ByteSliceValue(v.BytesValue)
+	case *cpb.AnyValue_ArrayValue:
+		vals := make([]attribute.Value, 0, len(v.ArrayValue.GetValues()))
+		for _, av := range v.ArrayValue.GetValues() {
+			vals = append(vals, value(av))
+		}
+		return attribute.SliceValue(vals...)
+	case *cpb.AnyValue_KvlistValue:
+		return attribute.MapValue(keyValues(v.KvlistValue.GetValues())...)
+	default:
+		return attribute.Value{}
+	}
+}
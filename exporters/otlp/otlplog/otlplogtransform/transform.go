@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlplogtransform converts between the log records of the
+// OpenTelemetry log SDK and their OTLP representation.
+//
+// The conversion to OTLP is the one used by the OTLP log exporters. Exporter
+// authors can reuse it instead of reimplementing the grouping of records by
+// resource and instrumentation scope and the translation of attributes. The
+// returned OTLP structures can be serialized with
+// [google.golang.org/protobuf/proto.Marshal] or
+// [google.golang.org/protobuf/encoding/protojson.Marshal].
+//
+// The conversion from OTLP is intended for tests, e.g. to replay OTLP files
+// through a [go.opentelemetry.io/otel/sdk/log.Processor] or
+// [go.opentelemetry.io/otel/sdk/log.Exporter].
+package otlplogtransform
+
+import (
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogtransform/internal/transform"
+	"go.opentelemetry.io/otel/sdk/log"
+)
+
+// ResourceLogs transforms records into OTLP ResourceLogs. Records are grouped
+// by their resource and instrumentation scope. The order of the returned
+// ResourceLogs is unspecified, but the records of a scope keep their order in
+// records. If records is empty, nil is returned.
+func ResourceLogs(records []log.Record) []*lpb.ResourceLogs {
+	return transform.ResourceLogs(records)
+}
+
+// LogRecord transforms record into an OTLP LogRecord. The resource and
+// instrumentation scope of record are not included.
+func LogRecord(record log.Record) *lpb.LogRecord {
+	return transform.LogRecord(record)
+}
+
+// KeyValues transforms attrs into OTLP KeyValues. If attrs is empty, nil is
+// returned.
+func KeyValues(attrs []attribute.KeyValue) []*cpb.KeyValue {
+	return transform.Attrs(attrs)
+}
+
+// Value transforms v into an OTLP AnyValue.
+func Value(v attribute.Value) *cpb.AnyValue {
+	return transform.AttrValue(v)
+}
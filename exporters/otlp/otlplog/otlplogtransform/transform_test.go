@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlplogtransform_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlplogtransform"
+	api "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ts  = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	res = resource.NewWithAttributes("https://schema", attribute.String("service.name", "svc"))
+
+	scope = instrumentation.Scope{
+		Name:       "scope",
+		Version:    "v1",
+		SchemaURL:  "https://scope-schema",
+		Attributes: attribute.NewSet(attribute.Bool("scoped", true)),
+	}
+
+	traceID = trace.TraceID{1}
+	spanID  = trace.SpanID{2}
+)
+
+func records() []log.Record {
+	return []log.Record{
+		logtest.RecordFactory{
+			EventName:         "event",
+			Timestamp:         ts,
+			ObservedTimestamp: ts.Add(time.Second),
+			Severity:          api.SeverityWarn2,
+			SeverityText:      "WARN2",
+			Body:              attribute.StringValue("body"),
+			Attributes: []attribute.KeyValue{
+				attribute.Int64("int", 1),
+				attribute.Float64("float", 2.5),
+				attribute.Slice("slice", attribute.StringValue("a"), attribute.BoolValue(true)),
+				attribute.Map("map", attribute.String("k", "v")),
+				attribute.ByteSlice("bytes", []byte("b")),
+			},
+			TraceID:              traceID,
+			SpanID:               spanID,
+			TraceFlags:           trace.FlagsSampled,
+			Resource:             res,
+			InstrumentationScope: &scope,
+		}.NewRecord(),
+		logtest.RecordFactory{
+			Body:                 attribute.MapValue(attribute.String("msg", "second")),
+			Resource:             res,
+			InstrumentationScope: &scope,
+		}.NewRecord(),
+	}
+}
+
+func TestResourceLogs(t *testing.T) {
+	rls := otlplogtransform.ResourceLogs(records())
+	require.Len(t, rls, 1)
+	assert.Equal(t, "https://schema", rls[0].SchemaUrl)
+	require.Len(t, rls[0].ScopeLogs, 1)
+	sl := rls[0].ScopeLogs[0]
+	assert.Equal(t, "scope", sl.Scope.Name)
+	require.Len(t, sl.LogRecords, 2)
+
+	lr := sl.LogRecords[0]
+	assert.Equal(t, "event", lr.EventName)
+	assert.Equal(t, lpb.SeverityNumber_SEVERITY_NUMBER_WARN2, lr.SeverityNumber)
+	assert.Equal(t, uint64(ts.UnixNano()), lr.TimeUnixNano)
+	assert.Equal(t, traceID[:], lr.TraceId)
+	assert.Equal(t, otlplogtransform.Value(attribute.StringValue("body")), lr.Body)
+	assert.Equal(t, otlplogtransform.LogRecord(records()[0]), lr)
+
+	_, err := proto.Marshal(rls[0])
+	require.NoError(t, err)
+
+	assert.Nil(t, otlplogtransform.ResourceLogs(nil))
+}
+
+func TestRecordsRoundTrip(t *testing.T) {
+	want := otlplogtransform.ResourceLogs(records())
+
+	got, err := otlplogtransform.Records(want)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	r := got[0]
+	assert.Equal(t, "event", r.EventName())
+	assert.True(t, ts.Equal(r.Timestamp()))
+	assert.True(t, ts.Add(time.Second).Equal(r.ObservedTimestamp()))
+	assert.Equal(t, api.SeverityWarn2, r.Severity())
+	assert.Equal(t, "WARN2", r.SeverityText())
+	assert.Equal(t, attribute.StringValue("body"), r.Body())
+	assert.Equal(t, traceID, r.TraceID())
+	assert.Equal(t, spanID, r.SpanID())
+	assert.Equal(t, trace.FlagsSampled, r.TraceFlags())
+	assert.Equal(t, res, r.Resource())
+	assert.Equal(t, scope, r.InstrumentationScope())
+	assert.Equal(t, 5, r.AttributesLen())
+
+	assert.Equal(t, want, otlplogtransform.ResourceLogs(got))
+}
+
+func TestRecordsInvalidID(t *testing.T) {
+	rls := []*lpb.ResourceLogs{{
+		ScopeLogs: []*lpb.ScopeLogs{{
+			LogRecords: []*lpb.LogRecord{{TraceId: []byte{1, 2, 3}}},
+		}},
+	}}
+	_, err := otlplogtransform.Records(rls)
+	assert.ErrorContains(t, err, "invalid trace ID length")
+
+	rls[0].ScopeLogs[0].LogRecords[0] = &lpb.LogRecord{SpanId: []byte{1}}
+	_, err = otlplogtransform.Records(rls)
+	assert.ErrorContains(t, err, "invalid span ID length")
+}
+
+func TestKeyValues(t *testing.T) {
+	want := []*cpb.KeyValue{
+		{Key: "k", Value: &cpb.AnyValue{Value: &cpb.AnyValue_StringValue{StringValue: "v"}}},
+	}
+	assert.Equal(t, want, otlplogtransform.KeyValues([]attribute.KeyValue{attribute.String("k", "v")}))
+	assert.Nil(t, otlplogtransform.KeyValues(nil))
+}
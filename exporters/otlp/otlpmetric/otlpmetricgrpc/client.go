@@ -19,6 +19,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/retry"
 )
@@ -57,8 +58,9 @@ func newClient(_ context.Context, cfg oconf.Config) (*client, error) {
 		userAgent := "OTel Go OTLP over gRPC metrics exporter/" + Version()
 		dialOpts := []grpc.DialOption{grpc.WithUserAgent(userAgent)}
 		dialOpts = append(dialOpts, cfg.DialOptions...)
+		target, dialOpts := failover.DialOptions(cfg.Metrics.Endpoint, cfg.FailoverEndpoints, dialOpts)
 
-		conn, err := grpc.NewClient(cfg.Metrics.Endpoint, dialOpts...)
+		conn, err := grpc.NewClient(target, dialOpts...)
 		if err != nil {
 			return nil, err
 		}
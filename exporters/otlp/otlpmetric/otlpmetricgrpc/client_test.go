@@ -5,6 +5,7 @@ package otlpmetricgrpc
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
@@ -17,8 +18,10 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/otest"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
@@ -203,6 +206,31 @@ func TestConfig(t *testing.T) {
 		assert.Len(t, coll.Collect().Dump(), 1)
 	})
 
+	t.Run("WithFailoverEndpoints", func(t *testing.T) {
+		// The primary endpoint cannot be connected to.
+		ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+		require.NoError(t, err)
+		unavailable := ln.Addr().String()
+		require.NoError(t, ln.Close())
+
+		coll, err := otest.NewGRPCCollector("", nil)
+		require.NoError(t, err)
+		t.Cleanup(coll.Shutdown)
+
+		ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		exp, err := New(
+			ctx,
+			WithEndpoint(unavailable),
+			WithInsecure(),
+			WithFailoverEndpoints(coll.Addr().String()),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		assert.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		assert.Len(t, coll.Collect().Dump(), 1)
+	})
+
 	t.Run("WithHeaders", func(t *testing.T) {
 		key := "my-custom-header"
 		headers := map[string]string{key: "custom-value"}
@@ -268,4 +296,34 @@ func TestConfig(t *testing.T) {
 		assert.ErrorContains(t, err, "request message too large")
 		assert.Empty(t, coll.Collect().Dump(), "oversized request must fail before sending")
 	})
+
+	t.Run("WithMaxBatchSize", func(t *testing.T) {
+		exp, coll := factoryFunc(nil, WithMaxBatchSize(2))
+		t.Cleanup(coll.Shutdown)
+
+		ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+		gauge := func(name string, n int) metricdata.Metrics {
+			pts := make([]metricdata.DataPoint[int64], n)
+			for i := range pts {
+				pts[i] = metricdata.DataPoint[int64]{
+					Attributes: attribute.NewSet(attribute.Int("i", i)),
+					Value:      int64(i),
+				}
+			}
+			return metricdata.Metrics{Name: name, Data: metricdata.Gauge[int64]{DataPoints: pts}}
+		}
+		rm := &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{
+			{Scope: instrumentation.Scope{Name: "a"}, Metrics: []metricdata.Metrics{gauge("a", 1), gauge("b", 1)}},
+			{Scope: instrumentation.Scope{Name: "b"}, Metrics: []metricdata.Metrics{gauge("c", 3)}},
+		}}
+		require.NoError(t, exp.Export(ctx, rm))
+
+		got := coll.Collect().Dump()
+		require.Len(t, got, 3, "requests")
+		for _, rm := range got {
+			assert.LessOrEqual(t, resourceDataPoints(rm), 2, "data points of request")
+		}
+	})
 }
@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/retry"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 // Option applies a configuration option to the Exporter.
@@ -92,6 +93,11 @@ func WithEndpoint(endpoint string) Option {
 // If both this option and WithEndpoint are used, the last used option will
 // take precedence.
 //
+// A Unix domain socket is targeted using the "unix" scheme, e.g.
+// "unix:///var/run/otel/collector.sock". Connections to a Unix domain socket
+// do not use client security.
+// Windows named pipes are not supported as endpoints.
+//
 // If an invalid URL is provided, the default value will be kept.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -175,6 +181,9 @@ func WithTLSCredentials(creds credentials.TransportCredentials) Option {
 
 // WithServiceConfig defines the default gRPC service config used.
 //
+// By default, the round_robin load balancing policy is used, or the
+// pick_first policy if WithFailoverEndpoints is used.
+//
 // This option has no effect if WithGRPCConn is used.
 func WithServiceConfig(serviceConfig string) Option {
 	return wrappedOption{oconf.NewGRPCOption(func(cfg oconf.Config) oconf.Config {
@@ -183,6 +192,30 @@ func WithServiceConfig(serviceConfig string) Option {
 	})}
 }
 
+// WithFailoverEndpoints sets the endpoints (host and port) the Exporter will
+// connect to, in order, when the endpoint set with WithEndpoint or
+// WithEndpointURL cannot be reached, e.g. when its name cannot be resolved or
+// its connection is lost. The provided endpoints should resemble
+// "example.com:4317" (no scheme or path).
+//
+// All endpoints are resolved again when the connection is lost, so the
+// Exporter is not stranded on the address of a collector that was redeployed
+// with a new one. Once connected to a failover endpoint, the Exporter only
+// connects back to the endpoint once that connection is lost.
+//
+// By default, no failover endpoint is used and the requests are balanced over
+// all the addresses resolved for the endpoint with the round_robin gRPC load
+// balancing policy. With failover endpoints, the pick_first policy is used
+// instead. A service config set with WithServiceConfig takes precedence.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithFailoverEndpoints(endpoints ...string) Option {
+	return wrappedOption{oconf.NewGRPCOption(func(cfg oconf.Config) oconf.Config {
+		cfg.FailoverEndpoints = endpoints
+		return cfg
+	})}
+}
+
 // WithDialOption sets explicit grpc.DialOptions to use when establishing a
 // gRPC connection. The options here are appended to the internal grpc.DialOptions
 // used so they will take precedence over any other internal grpc.DialOptions
@@ -241,6 +274,22 @@ func WithMaxRequestSize(size int) Option {
 	return wrappedOption{oconf.WithMaxRequestSize(size)}
 }
 
+// WithMaxBatchSize sets the maximum number of data points of an export
+// request. The metrics of a collection holding more data points are split
+// into multiple requests, at the boundaries of their scopes and metrics when
+// possible. A metric holding more data points than points is split across
+// requests.
+//
+// Use it to keep the requests below the maximum message size of the receiver,
+// e.g. the grpc.max_receive_message_size of an OpenTelemetry Collector, when
+// large collections fail to be exported as a whole.
+//
+// If points is less than or equal to zero, the default, requests are not
+// split.
+func WithMaxBatchSize(points int) Option {
+	return wrappedOption{oconf.WithMaxBatchSize(points)}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -260,9 +309,16 @@ func WithRetry(settings RetryConfig) Option {
 }
 
 // WithTemporalitySelector sets the TemporalitySelector the client will use to
-// determine the Temporality of an instrument based on its kind. If this option
-// is not used, the client will use the DefaultTemporalitySelector from the
-// go.opentelemetry.io/otel/sdk/metric package.
+// determine the Temporality of an instrument based on its kind.
+//
+// If the OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE environment
+// variable is set, and this option is not passed, the TemporalitySelector
+// matching that variable value will be used. That value can be either
+// "cumulative", "delta", or "lowmemory".
+//
+// By default, if the environment variable is not set, and this option is not
+// passed, the DefaultTemporalitySelector from the
+// go.opentelemetry.io/otel/sdk/metric package will be used.
 func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
 	return wrappedOption{oconf.WithTemporalitySelector(selector)}
 }
@@ -275,3 +331,26 @@ func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
 func WithAggregationSelector(selector metric.AggregationSelector) Option {
 	return wrappedOption{oconf.WithAggregationSelector(selector)}
 }
+
+// InstrumentKindSelectors holds the Temporality and Aggregation to use for
+// individual instrument kinds.
+type InstrumentKindSelectors struct {
+	// Temporality is the Temporality to use for each instrument kind.
+	Temporality map[metric.InstrumentKind]metricdata.Temporality
+	// Aggregation is the Aggregation to use for each instrument kind.
+	Aggregation map[metric.InstrumentKind]metric.Aggregation
+}
+
+// WithInstrumentKindSelectors sets the Temporality and Aggregation to use for
+// the instrument kinds held by s. They take precedence over the selectors set
+// with [WithTemporalitySelector] and [WithAggregationSelector], or their
+// defaults, which are still used for the other instrument kinds, regardless
+// of the order of the options.
+//
+// If this option is used multiple times, the values are merged. For an
+// instrument kind set multiple times, the last value is used.
+//
+// Use [Exporter.InstrumentKindSelectors] to read the resulting configuration.
+func WithInstrumentKindSelectors(s InstrumentKindSelectors) Option {
+	return wrappedOption{oconf.WithInstrumentKindSelectors(s.Temporality, s.Aggregation)}
+}
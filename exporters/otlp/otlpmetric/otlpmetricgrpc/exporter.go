@@ -33,6 +33,10 @@ type Exporter struct {
 	temporalitySelector metric.TemporalitySelector
 	aggregationSelector metric.AggregationSelector
 
+	// maxBatchSize is the maximum number of data points of an upload. If it
+	// is less than one, uploads are not split.
+	maxBatchSize int
+
 	shutdownOnce sync.Once
 
 	// Self-observability metrics
@@ -52,6 +56,25 @@ func newExporter(c *client, cfg oconf.Config) (*Exporter, error) {
 		as = metric.DefaultAggregationSelector
 	}
 
+	if kt := cfg.Metrics.KindTemporality; len(kt) > 0 {
+		base := ts
+		ts = func(k metric.InstrumentKind) metricdata.Temporality {
+			if t, ok := kt[k]; ok {
+				return t
+			}
+			return base(k)
+		}
+	}
+	if ka := cfg.Metrics.KindAggregation; len(ka) > 0 {
+		base := as
+		as = func(k metric.InstrumentKind) metric.Aggregation {
+			if a, ok := ka[k]; ok {
+				return a
+			}
+			return base(k)
+		}
+	}
+
 	var inst *observ.Instrumentation
 	var initErr error
 	if x.Observability.Enabled() {
@@ -71,6 +94,8 @@ func newExporter(c *client, cfg oconf.Config) (*Exporter, error) {
 		temporalitySelector: ts,
 		aggregationSelector: as,
 
+		maxBatchSize: cfg.Metrics.MaxBatchSize,
+
 		inst: inst,
 	}, initErr
 }
@@ -85,12 +110,41 @@ func (e *Exporter) Aggregation(k metric.InstrumentKind) metric.Aggregation {
 	return e.aggregationSelector(k)
 }
 
+// instrumentKinds are all the instrument kinds.
+var instrumentKinds = []metric.InstrumentKind{
+	metric.InstrumentKindCounter,
+	metric.InstrumentKindUpDownCounter,
+	metric.InstrumentKindHistogram,
+	metric.InstrumentKindObservableCounter,
+	metric.InstrumentKindObservableUpDownCounter,
+	metric.InstrumentKindObservableGauge,
+	metric.InstrumentKindGauge,
+}
+
+// InstrumentKindSelectors returns the Temporality and Aggregation the
+// Exporter uses for every instrument kind.
+//
+// The returned InstrumentKindSelectors can be modified and passed to
+// [WithInstrumentKindSelectors] to create an Exporter adjusting this
+// configuration.
+func (e *Exporter) InstrumentKindSelectors() InstrumentKindSelectors {
+	s := InstrumentKindSelectors{
+		Temporality: make(map[metric.InstrumentKind]metricdata.Temporality, len(instrumentKinds)),
+		Aggregation: make(map[metric.InstrumentKind]metric.Aggregation, len(instrumentKinds)),
+	}
+	for _, k := range instrumentKinds {
+		s.Temporality[k] = e.temporalitySelector(k)
+		s.Aggregation[k] = e.aggregationSelector(k)
+	}
+	return s
+}
+
 // Export transforms and transmits metric data to an OTLP receiver.
 //
 // This method returns an error if called after Shutdown.
 // This method returns an error if the method is canceled by the passed context.
 func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
-	defer global.Debug("OTLP/gRPC exporter export", "Data", rm)
+	defer global.Component(global.ComponentOTLPClient).Debug("OTLP/gRPC exporter export", "Data", rm)
 
 	otlpRm, err := transform.ResourceMetrics(rm)
 
@@ -102,7 +156,13 @@ func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) e
 
 	// Best effort upload of transformable metrics.
 	e.clientMu.Lock()
-	upErr = e.client.UploadMetrics(ctx, otlpRm)
+	for _, batch := range splitResourceMetrics(otlpRm, e.maxBatchSize) {
+		upErr = errors.Join(upErr, e.client.UploadMetrics(ctx, batch))
+		if ctx.Err() != nil {
+			// The remaining batches would fail the same way.
+			break
+		}
+	}
 	e.clientMu.Unlock()
 
 	if upErr != nil {
@@ -39,3 +39,6 @@ package internal
 
 //go:generate gotmpl --body=../../../../../internal/shared/x/x.go.tmpl "--data={ \"pkg\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc\" }" --out=x/x.go
 //go:generate gotmpl --body=../../../../../internal/shared/x/x_test.go.tmpl "--data={}" --out=x/x_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover.go.tmpl "--data={}" --out=failover/failover.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover_test.go.tmpl "--data={}" --out=failover/failover_test.go
@@ -55,6 +55,11 @@ func getOptionsFromEnv() []GenericOption {
 				opts,
 				withEndpointScheme(u),
 				newSplitOption(func(cfg Config) Config {
+					if strings.EqualFold(u.Scheme, unixScheme) {
+						cfg.Metrics.Endpoint = unixEndpoint(u)
+						cfg.Metrics.URLPath = DefaultMetricsPath
+						return cfg
+					}
 					cfg.Metrics.Endpoint = u.Host
 					// For OTLP/HTTP endpoint URLs without a per-signal
 					// configuration, the passed endpoint is used as a base URL
@@ -69,6 +74,11 @@ func getOptionsFromEnv() []GenericOption {
 				opts,
 				withEndpointScheme(u),
 				newSplitOption(func(cfg Config) Config {
+					if strings.EqualFold(u.Scheme, unixScheme) {
+						cfg.Metrics.Endpoint = unixEndpoint(u)
+						cfg.Metrics.URLPath = DefaultMetricsPath
+						return cfg
+					}
 					cfg.Metrics.Endpoint = u.Host
 					// For endpoint URLs for OTLP/HTTP per-signal variables, the
 					// URL MUST be used as-is without any modification. The only
@@ -119,6 +129,10 @@ func getOptionsFromEnv() []GenericOption {
 
 func withEndpointForGRPC(u *url.URL) func(cfg Config) Config {
 	return func(cfg Config) Config {
+		if strings.EqualFold(u.Scheme, unixScheme) {
+			cfg.Metrics.Endpoint = unixEndpoint(u)
+			return cfg
+		}
 		// For OTLP/gRPC endpoints, this is the target to which the
 		// exporter is going to send telemetry.
 		cfg.Metrics.Endpoint = path.Join(u.Host, u.Path)
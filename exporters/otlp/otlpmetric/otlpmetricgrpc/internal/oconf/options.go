@@ -8,8 +8,10 @@
 package oconf
 
 import (
+	"compress/flate"
 	"crypto/tls"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
 	"path"
@@ -25,6 +27,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/retry"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 const (
@@ -38,12 +41,18 @@ const (
 	// DefaultMaxRequestSize is the default maximum size of a serialized export
 	// request, before compression.
 	DefaultMaxRequestSize int = 64 * 1024 * 1024
+	// DefaultCompressionLevel is the default gzip compression level used by
+	// HTTP exporters.
+	DefaultCompressionLevel int = flate.DefaultCompression
 	// DefaultBackoff is a default base backoff time used in the
 	// exponential backoff strategy.
 	DefaultBackoff time.Duration = 300 * time.Millisecond
 	// DefaultTimeout is a default max waiting time for the backend to process
 	// each span or metrics batch.
 	DefaultTimeout time.Duration = 10 * time.Second
+
+	// unixScheme is the endpoint URL scheme of a Unix domain socket.
+	unixScheme = "unix"
 )
 
 type (
@@ -58,18 +67,27 @@ type (
 		Headers        map[string]string
 		Compression    Compression
 		MaxRequestSize int
+		MaxBatchSize   int
 		Timeout        time.Duration
 		URLPath        string
 
 		TemporalitySelector metric.TemporalitySelector
 		AggregationSelector metric.AggregationSelector
 
+		// KindTemporality and KindAggregation override the selectors for
+		// individual instrument kinds.
+		KindTemporality map[metric.InstrumentKind]metricdata.Temporality
+		KindAggregation map[metric.InstrumentKind]metric.Aggregation
+
 		// gRPC configurations
 		GRPCCredentials credentials.TransportCredentials
 
 		// HTTP configurations
-		Proxy      HTTPTransportProxyFunc
-		HTTPClient *http.Client
+		Proxy                HTTPTransportProxyFunc
+		HTTPClient           *http.Client
+		HTTPTransport        http.RoundTripper
+		CompressionLevel     int
+		CompressionThreshold int
 	}
 
 	Config struct {
@@ -81,6 +99,7 @@ type (
 		// gRPC configurations
 		ReconnectionPeriod time.Duration
 		ServiceConfig      string
+		FailoverEndpoints  []string
 		DialOptions        []grpc.DialOption
 		GRPCConn           *grpc.ClientConn
 	}
@@ -91,11 +110,12 @@ type (
 func NewHTTPConfig(opts ...HTTPOption) Config {
 	cfg := Config{
 		Metrics: SignalConfig{
-			Endpoint:       fmt.Sprintf("%s:%d", DefaultCollectorHost, DefaultCollectorHTTPPort),
-			URLPath:        DefaultMetricsPath,
-			Compression:    NoCompression,
-			MaxRequestSize: DefaultMaxRequestSize,
-			Timeout:        DefaultTimeout,
+			Endpoint:         fmt.Sprintf("%s:%d", DefaultCollectorHost, DefaultCollectorHTTPPort),
+			URLPath:          DefaultMetricsPath,
+			Compression:      NoCompression,
+			CompressionLevel: DefaultCompressionLevel,
+			MaxRequestSize:   DefaultMaxRequestSize,
+			Timeout:          DefaultTimeout,
 
 			TemporalitySelector: metric.DefaultTemporalitySelector,
 			AggregationSelector: metric.DefaultAggregationSelector,
@@ -110,6 +130,34 @@ func NewHTTPConfig(opts ...HTTPOption) Config {
 	return cfg
 }
 
+// UnixSocketPath returns the path of the Unix domain socket endpoint targets
+// and true. If endpoint does not target a Unix domain socket, an empty string
+// and false are returned.
+//
+// An endpoint targets a Unix domain socket if it has the form
+// "unix:///path/to/socket" or "unix:relative/path".
+func UnixSocketPath(endpoint string) (string, bool) {
+	p, ok := strings.CutPrefix(endpoint, unixScheme+":")
+	if !ok {
+		return "", false
+	}
+	p = strings.TrimPrefix(p, "//")
+	return p, p != ""
+}
+
+// unixEndpoint returns the endpoint targeting the Unix domain socket
+// identified by u. The returned value is also a valid gRPC target.
+func unixEndpoint(u *url.URL) string {
+	p := u.Path
+	if p == "" {
+		p = u.Opaque
+	}
+	if path.IsAbs(p) {
+		return unixScheme + "://" + p
+	}
+	return unixScheme + ":" + p
+}
+
 // cleanPath returns a path with all spaces trimmed. If urlPath is empty,
 // defaultPath is returned instead.
 func cleanPath(urlPath, defaultPath string) string {
@@ -293,6 +341,12 @@ func WithEndpointURL(v string) GenericOption {
 			return cfg
 		}
 
+		if strings.EqualFold(u.Scheme, unixScheme) {
+			cfg.Metrics.Endpoint = unixEndpoint(u)
+			cfg.Metrics.Insecure = true
+			return cfg
+		}
+
 		cfg.Metrics.Endpoint = u.Host
 		cfg.Metrics.URLPath = u.Path
 		if cfg.Metrics.URLPath == "" {
@@ -313,6 +367,24 @@ func WithCompression(compression Compression) GenericOption {
 	})
 }
 
+func WithCompressionLevel(level int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		if level < flate.HuffmanOnly || level > flate.BestCompression {
+			global.Warn("otlpmetric: ignoring invalid gzip compression level", "level", level)
+			return cfg
+		}
+		cfg.Metrics.CompressionLevel = level
+		return cfg
+	})
+}
+
+func WithCompressionThreshold(size int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.CompressionThreshold = size
+		return cfg
+	})
+}
+
 func WithURLPath(urlPath string) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Metrics.URLPath = urlPath
@@ -372,6 +444,13 @@ func WithMaxRequestSize(size int) GenericOption {
 	})
 }
 
+func WithMaxBatchSize(points int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.MaxBatchSize = points
+		return cfg
+	})
+}
+
 func WithTemporalitySelector(selector metric.TemporalitySelector) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Metrics.TemporalitySelector = selector
@@ -386,6 +465,28 @@ func WithAggregationSelector(selector metric.AggregationSelector) GenericOption
 	})
 }
 
+func WithInstrumentKindSelectors(
+	temporality map[metric.InstrumentKind]metricdata.Temporality,
+	aggregation map[metric.InstrumentKind]metric.Aggregation,
+) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.KindTemporality = mergeKinds(cfg.Metrics.KindTemporality, temporality)
+		cfg.Metrics.KindAggregation = mergeKinds(cfg.Metrics.KindAggregation, aggregation)
+		return cfg
+	})
+}
+
+// mergeKinds returns a copy of dst updated with the values of src.
+func mergeKinds[V any](dst, src map[metric.InstrumentKind]V) map[metric.InstrumentKind]V {
+	if len(src) == 0 {
+		return dst
+	}
+	out := make(map[metric.InstrumentKind]V, len(dst)+len(src))
+	maps.Copy(out, dst)
+	maps.Copy(out, src)
+	return out
+}
+
 func WithProxy(pf HTTPTransportProxyFunc) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Metrics.Proxy = pf
@@ -399,3 +500,10 @@ func WithHTTPClient(c *http.Client) GenericOption {
 		return cfg
 	})
 }
+
+func WithHTTPTransport(rt http.RoundTripper) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.HTTPTransport = rt
+		return cfg
+	})
+}
@@ -92,6 +92,44 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, 1, c.Metrics.MaxRequestSize)
 			},
 		},
+		{
+			name: "Test With Max Batch Size",
+			opts: []GenericOption{
+				WithMaxBatchSize(10),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.Equal(t, 10, c.Metrics.MaxBatchSize)
+			},
+		},
+		{
+			name: "Test With Instrument Kind Selectors",
+			opts: []GenericOption{
+				WithInstrumentKindSelectors(
+					map[metric.InstrumentKind]metricdata.Temporality{
+						metric.InstrumentKindCounter:   metricdata.DeltaTemporality,
+						metric.InstrumentKindHistogram: metricdata.DeltaTemporality,
+					},
+					nil,
+				),
+				WithInstrumentKindSelectors(
+					map[metric.InstrumentKind]metricdata.Temporality{
+						metric.InstrumentKindHistogram: metricdata.CumulativeTemporality,
+					},
+					map[metric.InstrumentKind]metric.Aggregation{
+						metric.InstrumentKindGauge: metric.AggregationDrop{},
+					},
+				),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.Equal(t, map[metric.InstrumentKind]metricdata.Temporality{
+					metric.InstrumentKindCounter:   metricdata.DeltaTemporality,
+					metric.InstrumentKindHistogram: metricdata.CumulativeTemporality,
+				}, c.Metrics.KindTemporality)
+				assert.Equal(t, map[metric.InstrumentKind]metric.Aggregation{
+					metric.InstrumentKindGauge: metric.AggregationDrop{},
+				}, c.Metrics.KindAggregation)
+			},
+		},
 
 		// Endpoint Tests
 		{
@@ -125,6 +163,17 @@ func TestConfigs(t *testing.T) {
 				assert.False(t, c.Metrics.Insecure)
 			},
 		},
+		{
+			name: "Test With Unix Socket Endpoint URL",
+			opts: []GenericOption{
+				WithEndpointURL("unix:///var/run/otel/collector.sock"),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.Equal(t, "unix:///var/run/otel/collector.sock", c.Metrics.Endpoint)
+				assert.Equal(t, "/v1/metrics", c.Metrics.URLPath)
+				assert.True(t, c.Metrics.Insecure)
+			},
+		},
 		{
 			name: "Test With Invalid Endpoint URL",
 			opts: []GenericOption{
@@ -202,6 +251,33 @@ func TestConfigs(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Test Environment Unix Socket Endpoint",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:///var/run/otel/collector.sock",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.True(t, c.Metrics.Insecure)
+				assert.Equal(t, "unix:///var/run/otel/collector.sock", c.Metrics.Endpoint)
+				if !grpcOption {
+					assert.Equal(t, "/v1/metrics", c.Metrics.URLPath)
+				}
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Unix Socket Endpoint",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":         "https://overrode.by.signal.specific/env/var",
+				"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT": "unix:collector.sock",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.True(t, c.Metrics.Insecure)
+				assert.Equal(t, "unix:collector.sock", c.Metrics.Endpoint)
+				if !grpcOption {
+					assert.Equal(t, "/v1/metrics", c.Metrics.URLPath)
+				}
+			},
+		},
 		{
 			name: "Test Environment Signal Specific Endpoint",
 			env: map[string]string{
@@ -665,3 +741,22 @@ func TestCleanPath(t *testing.T) {
 		})
 	}
 }
+
+func TestUnixSocketPath(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		ok       bool
+	}{
+		{endpoint: "unix:///var/run/otel/collector.sock", want: "/var/run/otel/collector.sock", ok: true},
+		{endpoint: "unix:collector.sock", want: "collector.sock", ok: true},
+		{endpoint: "unix://", ok: false},
+		{endpoint: "localhost:4317", ok: false},
+		{endpoint: "unixhost:4317", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := UnixSocketPath(tt.endpoint)
+		assert.Equal(t, tt.ok, ok, tt.endpoint)
+		assert.Equal(t, tt.want, got, tt.endpoint)
+	}
+}
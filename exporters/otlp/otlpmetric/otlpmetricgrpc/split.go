@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricgrpc
+
+import metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+// splitResourceMetrics returns rm split into ResourceMetrics holding at most
+// limit data points each. The metrics are kept whole and grouped by scope in
+// the order of rm, unless a single metric holds more than limit data points.
+// Its data points are then split across multiple ResourceMetrics.
+//
+// If limit is less than one, or rm holds no more than limit data points, rm
+// is returned as the only ResourceMetrics.
+func splitResourceMetrics(rm *metricpb.ResourceMetrics, limit int) []*metricpb.ResourceMetrics {
+	if rm == nil || limit < 1 || resourceDataPoints(rm) <= limit {
+		return []*metricpb.ResourceMetrics{rm}
+	}
+
+	s := splitter{rm: rm, limit: limit}
+	for _, sm := range rm.ScopeMetrics {
+		s.scope, s.sm = sm, nil
+		for _, m := range sm.Metrics {
+			s.add(m)
+		}
+	}
+	return s.out
+}
+
+// splitter accumulates metrics into ResourceMetrics of limited size.
+type splitter struct {
+	rm    *metricpb.ResourceMetrics
+	limit int
+
+	out []*metricpb.ResourceMetrics
+	// n is the number of data points of the last ResourceMetrics of out.
+	n int
+	// scope is the ScopeMetrics of rm the added metrics belong to.
+	scope *metricpb.ScopeMetrics
+	// sm is the ScopeMetrics of scope in the last ResourceMetrics of out. It
+	// is nil if it is not created yet.
+	sm *metricpb.ScopeMetrics
+}
+
+func (s *splitter) add(m *metricpb.Metric) {
+	n := metricDataPoints(m)
+	if len(s.out) == 0 || (s.n > 0 && s.n+n > s.limit) {
+		s.next()
+	}
+	for n > s.limit {
+		var head *metricpb.Metric
+		head, m = splitMetric(m, s.limit)
+		s.append(head, s.limit)
+		s.next()
+		n -= s.limit
+	}
+	s.append(m, n)
+}
+
+// next starts a new ResourceMetrics.
+func (s *splitter) next() {
+	s.out = append(s.out, &metricpb.ResourceMetrics{
+		Resource:  s.rm.Resource,
+		SchemaUrl: s.rm.SchemaUrl,
+	})
+	s.n, s.sm = 0, nil
+}
+
+// append appends m, holding n data points, to the last ResourceMetrics.
+func (s *splitter) append(m *metricpb.Metric, n int) {
+	if s.sm == nil {
+		s.sm = &metricpb.ScopeMetrics{
+			Scope:     s.scope.Scope,
+			SchemaUrl: s.scope.SchemaUrl,
+		}
+		last := s.out[len(s.out)-1]
+		last.ScopeMetrics = append(last.ScopeMetrics, s.sm)
+	}
+	s.sm.Metrics = append(s.sm.Metrics, m)
+	s.n += n
+}
+
+func resourceDataPoints(rm *metricpb.ResourceMetrics) int {
+	var n int
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			n += metricDataPoints(m)
+		}
+	}
+	return n
+}
+
+func metricDataPoints(m *metricpb.Metric) int {
+	switch d := m.Data.(type) {
+	case *metricpb.Metric_Gauge:
+		return len(d.Gauge.GetDataPoints())
+	case *metricpb.Metric_Sum:
+		return len(d.Sum.GetDataPoints())
+	case *metricpb.Metric_Histogram:
+		return len(d.Histogram.GetDataPoints())
+	case *metricpb.Metric_ExponentialHistogram:
+		return len(d.ExponentialHistogram.GetDataPoints())
+	case *metricpb.Metric_Summary:
+		return len(d.Summary.GetDataPoints())
+	}
+	return 0
+}
+
+// splitMetric returns a copy of m holding its first n data points, and a copy
+// of m holding the others.
+func splitMetric(m *metricpb.Metric, n int) (head, tail *metricpb.Metric) {
+	head = &metricpb.Metric{Name: m.Name, Description: m.Description, Unit: m.Unit, Metadata: m.Metadata}
+	tail = &metricpb.Metric{Name: m.Name, Description: m.Description, Unit: m.Unit, Metadata: m.Metadata}
+	switch d := m.Data.(type) {
+	case *metricpb.Metric_Gauge:
+		pts := d.Gauge.DataPoints
+		head.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: pts[:n]}}
+		tail.Data = &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: pts[n:]}}
+	case *metricpb.Metric_Sum:
+		pts := d.Sum.DataPoints
+		head.Data = &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+			DataPoints:             pts[:n],
+			AggregationTemporality: d.Sum.AggregationTemporality,
+			IsMonotonic:            d.Sum.IsMonotonic,
+		}}
+		tail.Data = &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+			DataPoints:             pts[n:],
+			AggregationTemporality: d.Sum.AggregationTemporality,
+			IsMonotonic:            d.Sum.IsMonotonic,
+		}}
+	case *metricpb.Metric_Histogram:
+		pts := d.Histogram.DataPoints
+		head.Data = &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+			DataPoints:             pts[:n],
+			AggregationTemporality: d.Histogram.AggregationTemporality,
+		}}
+		tail.Data = &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+			DataPoints:             pts[n:],
+			AggregationTemporality: d.Histogram.AggregationTemporality,
+		}}
+	case *metricpb.Metric_ExponentialHistogram:
+		pts := d.ExponentialHistogram.DataPoints
+		head.Data = &metricpb.Metric_ExponentialHistogram{ExponentialHistogram: &metricpb.ExponentialHistogram{
+			DataPoints:             pts[:n],
+			AggregationTemporality: d.ExponentialHistogram.AggregationTemporality,
+		}}
+		tail.Data = &metricpb.Metric_ExponentialHistogram{ExponentialHistogram: &metricpb.ExponentialHistogram{
+			DataPoints:             pts[n:],
+			AggregationTemporality: d.ExponentialHistogram.AggregationTemporality,
+		}}
+	case *metricpb.Metric_Summary:
+		pts := d.Summary.DataPoints
+		head.Data = &metricpb.Metric_Summary{Summary: &metricpb.Summary{DataPoints: pts[:n]}}
+		tail.Data = &metricpb.Metric_Summary{Summary: &metricpb.Summary{DataPoints: pts[n:]}}
+	}
+	return head, tail
+}
@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricgrpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	rpb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+func gaugeMetric(name string, values ...int64) *metricpb.Metric {
+	pts := make([]*metricpb.NumberDataPoint, len(values))
+	for i, v := range values {
+		pts[i] = &metricpb.NumberDataPoint{Value: &metricpb.NumberDataPoint_AsInt{AsInt: v}}
+	}
+	return &metricpb.Metric{
+		Name: name,
+		Data: &metricpb.Metric_Gauge{Gauge: &metricpb.Gauge{DataPoints: pts}},
+	}
+}
+
+func sumMetric(name string, values ...int64) *metricpb.Metric {
+	pts := make([]*metricpb.NumberDataPoint, len(values))
+	for i, v := range values {
+		pts[i] = &metricpb.NumberDataPoint{Value: &metricpb.NumberDataPoint_AsInt{AsInt: v}}
+	}
+	return &metricpb.Metric{
+		Name: name,
+		Unit: "1",
+		Data: &metricpb.Metric_Sum{Sum: &metricpb.Sum{
+			DataPoints:             pts,
+			AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+			IsMonotonic:            true,
+		}},
+	}
+}
+
+func TestSplitResourceMetrics(t *testing.T) {
+	res := &rpb.Resource{Attributes: []*cpb.KeyValue{{Key: "service.name"}}}
+	scopeA := &cpb.InstrumentationScope{Name: "a"}
+	scopeB := &cpb.InstrumentationScope{Name: "b"}
+	resourceMetrics := func(sms ...*metricpb.ScopeMetrics) *metricpb.ResourceMetrics {
+		return &metricpb.ResourceMetrics{Resource: res, SchemaUrl: "schema", ScopeMetrics: sms}
+	}
+	scopeMetrics := func(scope *cpb.InstrumentationScope, ms ...*metricpb.Metric) *metricpb.ScopeMetrics {
+		return &metricpb.ScopeMetrics{Scope: scope, SchemaUrl: "scope-schema", Metrics: ms}
+	}
+
+	rm := resourceMetrics(
+		scopeMetrics(scopeA, gaugeMetric("a.0", 1), gaugeMetric("a.1", 2, 3)),
+		scopeMetrics(scopeB, sumMetric("b.0", 4, 5, 6, 7, 8), gaugeMetric("b.1")),
+	)
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []*metricpb.ResourceMetrics
+	}{
+		{name: "Disabled", limit: 0, want: []*metricpb.ResourceMetrics{rm}},
+		{name: "NotExceeded", limit: 8, want: []*metricpb.ResourceMetrics{rm}},
+		{
+			name:  "ScopeBoundary",
+			limit: 5,
+			want: []*metricpb.ResourceMetrics{
+				resourceMetrics(scopeMetrics(scopeA, gaugeMetric("a.0", 1), gaugeMetric("a.1", 2, 3))),
+				resourceMetrics(scopeMetrics(scopeB, sumMetric("b.0", 4, 5, 6, 7, 8), gaugeMetric("b.1"))),
+			},
+		},
+		{
+			name:  "MetricBoundary",
+			limit: 2,
+			want: []*metricpb.ResourceMetrics{
+				resourceMetrics(scopeMetrics(scopeA, gaugeMetric("a.0", 1))),
+				resourceMetrics(scopeMetrics(scopeA, gaugeMetric("a.1", 2, 3))),
+				resourceMetrics(scopeMetrics(scopeB, sumMetric("b.0", 4, 5))),
+				resourceMetrics(scopeMetrics(scopeB, sumMetric("b.0", 6, 7))),
+				resourceMetrics(scopeMetrics(scopeB, sumMetric("b.0", 8), gaugeMetric("b.1"))),
+			},
+		},
+		{
+			name:  "MixedScopes",
+			limit: 4,
+			want: []*metricpb.ResourceMetrics{
+				resourceMetrics(
+					scopeMetrics(scopeA, gaugeMetric("a.0", 1), gaugeMetric("a.1", 2, 3)),
+				),
+				resourceMetrics(scopeMetrics(scopeB, sumMetric("b.0", 4, 5, 6, 7))),
+				resourceMetrics(scopeMetrics(scopeB, sumMetric("b.0", 8), gaugeMetric("b.1"))),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitResourceMetrics(rm, tt.limit)
+			require.Len(t, got, len(tt.want))
+			for i := range got {
+				assert.Equal(t, tt.want[i].String(), got[i].String(), "ResourceMetrics %d", i)
+				if tt.limit > 0 {
+					assert.LessOrEqual(t, resourceDataPoints(got[i]), tt.limit, "data points of ResourceMetrics %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitResourceMetricsScopesShareRequest(t *testing.T) {
+	rm := &metricpb.ResourceMetrics{ScopeMetrics: []*metricpb.ScopeMetrics{
+		{Scope: &cpb.InstrumentationScope{Name: "a"}, Metrics: []*metricpb.Metric{gaugeMetric("a", 1, 2)}},
+		{Scope: &cpb.InstrumentationScope{Name: "b"}, Metrics: []*metricpb.Metric{gaugeMetric("b", 3)}},
+		{Scope: &cpb.InstrumentationScope{Name: "c"}, Metrics: []*metricpb.Metric{gaugeMetric("c", 4, 5)}},
+	}}
+
+	got := splitResourceMetrics(rm, 3)
+	require.Len(t, got, 2)
+	require.Len(t, got[0].ScopeMetrics, 2, "scopes of the first request")
+	assert.Equal(t, "a", got[0].ScopeMetrics[0].Scope.Name)
+	assert.Equal(t, "b", got[0].ScopeMetrics[1].Scope.Name)
+	require.Len(t, got[1].ScopeMetrics, 1, "scopes of the second request")
+	assert.Equal(t, "c", got[1].ScopeMetrics[0].Scope.Name)
+}
+
+func TestSplitMetric(t *testing.T) {
+	hist := func(counts ...uint64) *metricpb.Metric {
+		pts := make([]*metricpb.HistogramDataPoint, len(counts))
+		for i, c := range counts {
+			pts[i] = &metricpb.HistogramDataPoint{Count: c}
+		}
+		return &metricpb.Metric{Name: "h", Data: &metricpb.Metric_Histogram{Histogram: &metricpb.Histogram{
+			DataPoints:             pts,
+			AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		}}}
+	}
+	expHist := func(counts ...uint64) *metricpb.Metric {
+		pts := make([]*metricpb.ExponentialHistogramDataPoint, len(counts))
+		for i, c := range counts {
+			pts[i] = &metricpb.ExponentialHistogramDataPoint{Count: c}
+		}
+		return &metricpb.Metric{Name: "e", Data: &metricpb.Metric_ExponentialHistogram{
+			ExponentialHistogram: &metricpb.ExponentialHistogram{
+				DataPoints:             pts,
+				AggregationTemporality: metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+			},
+		}}
+	}
+	summary := func(counts ...uint64) *metricpb.Metric {
+		pts := make([]*metricpb.SummaryDataPoint, len(counts))
+		for i, c := range counts {
+			pts[i] = &metricpb.SummaryDataPoint{Count: c}
+		}
+		return &metricpb.Metric{Name: "s", Data: &metricpb.Metric_Summary{Summary: &metricpb.Summary{DataPoints: pts}}}
+	}
+
+	tests := []struct {
+		name          string
+		m, head, tail *metricpb.Metric
+	}{
+		{name: "Gauge", m: gaugeMetric("g", 1, 2, 3), head: gaugeMetric("g", 1), tail: gaugeMetric("g", 2, 3)},
+		{name: "Sum", m: sumMetric("s", 1, 2, 3), head: sumMetric("s", 1), tail: sumMetric("s", 2, 3)},
+		{name: "Histogram", m: hist(1, 2, 3), head: hist(1), tail: hist(2, 3)},
+		{name: "ExponentialHistogram", m: expHist(1, 2, 3), head: expHist(1), tail: expHist(2, 3)},
+		{name: "Summary", m: summary(1, 2, 3), head: summary(1), tail: summary(2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			head, tail := splitMetric(tt.m, 1)
+			assert.Equal(t, tt.head.String(), head.String(), "head")
+			assert.Equal(t, tt.tail.String(), tail.String(), "tail")
+			assert.Equal(t, 3, metricDataPoints(tt.m), "data points of the split metric")
+		})
+	}
+}
@@ -31,11 +31,13 @@ import (
 
 type client struct {
 	// req is cloned for every upload the client makes.
-	req            *http.Request
-	compression    Compression
-	maxRequestSize int
-	requestFunc    retry.RequestFunc
-	httpClient     *http.Client
+	req                  *http.Request
+	compression          Compression
+	compressionThreshold int
+	gzPool               *sync.Pool
+	maxRequestSize       int
+	requestFunc          retry.RequestFunc
+	httpClient           *http.Client
 
 	inst *observ.Instrumentation
 }
@@ -72,14 +74,29 @@ func newClient(cfg oconf.Config) (*client, error) {
 		return nil, errInsecureEndpointWithTLS
 	}
 
+	host := cfg.Metrics.Endpoint
+	socket, isUnix := oconf.UnixSocketPath(host)
+	if isUnix {
+		// Requests are sent over the socket, the host is only used for the
+		// Host header.
+		host = "localhost"
+	}
+
 	httpClient := cfg.Metrics.HTTPClient
+	if httpClient == nil && cfg.Metrics.HTTPTransport != nil {
+		// Use the transport as is, it can be shared with other exporters.
+		httpClient = &http.Client{
+			Transport: cfg.Metrics.HTTPTransport,
+			Timeout:   cfg.Metrics.Timeout,
+		}
+	}
 	if httpClient == nil {
 		httpClient = &http.Client{
 			Transport: ourTransport,
 			Timeout:   cfg.Metrics.Timeout,
 		}
 
-		if cfg.Metrics.TLSCfg != nil || cfg.Metrics.Proxy != nil {
+		if cfg.Metrics.TLSCfg != nil || cfg.Metrics.Proxy != nil || isUnix {
 			clonedTransport := ourTransport.Clone()
 			httpClient.Transport = clonedTransport
 
@@ -89,12 +106,16 @@ func newClient(cfg oconf.Config) (*client, error) {
 			if cfg.Metrics.Proxy != nil {
 				clonedTransport.Proxy = cfg.Metrics.Proxy
 			}
+			if isUnix {
+				clonedTransport.Proxy = nil
+				clonedTransport.DialContext = unixDialContext(socket)
+			}
 		}
 	}
 
 	u := &url.URL{
 		Scheme: "https",
-		Host:   cfg.Metrics.Endpoint,
+		Host:   host,
 		Path:   cfg.Metrics.URLPath,
 	}
 	if cfg.Metrics.Insecure {
@@ -120,15 +141,29 @@ func newClient(cfg oconf.Config) (*client, error) {
 	inst, err := observ.NewInstrumentation(counter.NextExporterID(), cfg.Metrics.Endpoint)
 
 	return &client{
-		compression:    Compression(cfg.Metrics.Compression),
-		maxRequestSize: cfg.Metrics.MaxRequestSize,
-		req:            req,
-		requestFunc:    cfg.RetryConfig.RequestFunc(evaluate),
-		httpClient:     httpClient,
-		inst:           inst,
+		compression:          Compression(cfg.Metrics.Compression),
+		compressionThreshold: cfg.Metrics.CompressionThreshold,
+		gzPool:               newGzipPool(cfg.Metrics.CompressionLevel),
+		maxRequestSize:       cfg.Metrics.MaxRequestSize,
+		req:                  req,
+		requestFunc:          cfg.RetryConfig.RequestFunc(evaluate),
+		httpClient:           httpClient,
+		inst:                 inst,
 	}, err
 }
 
+// unixDialContext returns a DialContext function that connects to the Unix
+// domain socket at path regardless of the network address requested.
+func unixDialContext(path string) func(context.Context, string, string) (net.Conn, error) {
+	d := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
 // Shutdown shuts down the client, freeing all resources.
 func (c *client) Shutdown(ctx context.Context) error {
 	// The otlpmetric.Exporter synchronizes access to client methods and
@@ -266,18 +301,27 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	}))
 }
 
-var gzPool = sync.Pool{
-	New: func() any {
-		w := gzip.NewWriter(io.Discard)
-		return w
-	},
+// newGzipPool returns a pool of gzip writers compressing with level.
+func newGzipPool(level int) *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			// The level is validated by the options setting it.
+			w, _ := gzip.NewWriterLevel(io.Discard, level)
+			return w
+		},
+	}
 }
 
 func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
-	switch c.compression {
+	compression := c.compression
+	if compression != NoCompression && len(body) < c.compressionThreshold {
+		// Compressing small payloads costs more than it saves.
+		compression = NoCompression
+	}
+	switch compression {
 	case NoCompression:
 		r.ContentLength = int64(len(body))
 		req.bodyReader = bodyReader(body)
@@ -287,10 +331,10 @@ func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 		r.ContentLength = -1
 		r.Header.Set("Content-Encoding", "gzip")
 
-		gz := gzPool.Get().(*gzip.Writer)
+		gz := c.gzPool.Get().(*gzip.Writer)
 		defer func() {
 			gz.Reset(io.Discard)
-			gzPool.Put(gz)
+			c.gzPool.Put(gz)
 		}()
 
 		var b bytes.Buffer
@@ -11,9 +11,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -342,6 +345,40 @@ func TestConfig(t *testing.T) {
 	})
 }
 
+func TestUnixSocketEndpoint(t *testing.T) {
+	// Unix socket paths are limited in length, avoid the long t.TempDir path.
+	dir, err := os.MkdirTemp("", "otlp")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "collector.sock")
+
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	pathCh := make(chan string, 1)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pathCh <- r.URL.Path
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Listener = ln
+	server.Start()
+	defer server.Close()
+
+	opts := []Option{WithEndpointURL("unix://" + socket)}
+	cfg := oconf.NewHTTPConfig(asHTTPOptions(opts)...)
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+
+	exporter, err := newExporter(client, cfg)
+	require.NoError(t, err)
+	ctx := t.Context()
+	defer func() { _ = exporter.Shutdown(ctx) }()
+
+	require.NoError(t, exporter.Export(ctx, &metricdata.ResourceMetrics{}))
+	assert.Equal(t, oconf.DefaultMetricsPath, <-pathCh)
+}
+
 func TestGetBodyCalledOnRedirect(t *testing.T) {
 	// Test that req.GetBody is set correctly, allowing the HTTP transport
 	// to re-send the body on 307 redirects.
@@ -859,3 +896,84 @@ type roundTripperFunc func(*http.Request) (*http.Response, error)
 func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 	return f(r)
 }
+
+// okTransport returns a RoundTripper responding with an empty 200 response
+// and counting the requests in calls.
+func okTransport(calls *int) http.RoundTripper {
+	return roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		*calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+}
+
+func TestWithHTTPTransport(t *testing.T) {
+	ctx := t.Context()
+
+	var calls int
+	exp, err := New(ctx, WithHTTPTransport(okTransport(&calls)), WithInsecure())
+	require.NoError(t, err)
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	require.NoError(t, exp.Shutdown(ctx))
+	assert.Equal(t, 1, calls)
+
+	t.Run("HTTPClientPrecedence", func(t *testing.T) {
+		var transportCalls, clientCalls int
+		exp, err := New(
+			ctx,
+			WithHTTPTransport(okTransport(&transportCalls)),
+			WithHTTPClient(&http.Client{Transport: okTransport(&clientCalls)}),
+			WithInsecure(),
+		)
+		require.NoError(t, err)
+		require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+		require.NoError(t, exp.Shutdown(ctx))
+		assert.Equal(t, 0, transportCalls)
+		assert.Equal(t, 1, clientCalls)
+	})
+}
+
+func TestCompressionThreshold(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		encodings []string
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	export := func(opts ...Option) {
+		opts = append(opts,
+			WithEndpoint(server.Listener.Addr().String()),
+			WithInsecure(),
+			WithCompression(GzipCompression),
+		)
+		cfg := oconf.NewHTTPConfig(asHTTPOptions(opts)...)
+		client, err := newClient(cfg)
+		require.NoError(t, err)
+
+		exporter, err := newExporter(client, cfg)
+		require.NoError(t, err)
+		ctx := t.Context()
+		defer func() { _ = exporter.Shutdown(ctx) }()
+		require.NoError(t, exporter.Export(ctx, &metricdata.ResourceMetrics{}))
+	}
+
+	export(WithCompressionThreshold(1 << 20))
+	export(WithCompressionLevel(gzip.BestSpeed))
+	export(WithCompressionLevel(100))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"", "gzip", "gzip"}, encodings)
+}
@@ -11,7 +11,9 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/transport"
 	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 // Compression describes the compression used for payloads sent to the
@@ -84,6 +86,11 @@ func WithEndpoint(endpoint string) Option {
 // If both this option and WithEndpoint are used, the last used option will
 // take precedence.
 //
+// A Unix domain socket is targeted using the "unix" scheme, e.g.
+// "unix:///var/run/otel/collector.sock". Requests sent to a Unix domain
+// socket do not use client security and are sent to the default metrics path.
+// Windows named pipes are not supported as endpoints.
+//
 // If an invalid URL is provided, the default value will be kept.
 //
 // The path of the provided URL is used as-is; with one exception: if the URL has
@@ -114,6 +121,32 @@ func WithCompression(compression Compression) Option {
 	return wrappedOption{oconf.WithCompression(oconf.Compression(compression))}
 }
 
+// WithCompressionLevel sets the gzip compression level the Exporter will use
+// to compress the HTTP body. The level needs to be gzip.HuffmanOnly,
+// gzip.DefaultCompression, or between gzip.NoCompression and
+// gzip.BestCompression. An invalid level is ignored.
+//
+// Lower levels use less CPU at the cost of larger payloads.
+//
+// By default, if this option is not passed, gzip.DefaultCompression is used.
+//
+// This option has no effect unless gzip compression is used.
+func WithCompressionLevel(level int) Option {
+	return wrappedOption{oconf.WithCompressionLevel(level)}
+}
+
+// WithCompressionThreshold sets the minimum size, in bytes, of a serialized
+// export request for it to be compressed. Smaller requests are sent
+// uncompressed, as compressing them costs more CPU than it saves bandwidth.
+//
+// By default, if this option is not passed, or size is less than or equal to
+// zero, all requests are compressed.
+//
+// This option has no effect unless compression is used.
+func WithCompressionThreshold(size int) Option {
+	return wrappedOption{oconf.WithCompressionThreshold(size)}
+}
+
 // WithURLPath sets the URL path the Exporter will send requests to.
 //
 // If the OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_METRICS_ENDPOINT
@@ -215,9 +248,16 @@ func WithRetry(rc RetryConfig) Option {
 }
 
 // WithTemporalitySelector sets the TemporalitySelector the client will use to
-// determine the Temporality of an instrument based on its kind. If this option
-// is not used, the client will use the DefaultTemporalitySelector from the
-// go.opentelemetry.io/otel/sdk/metric package.
+// determine the Temporality of an instrument based on its kind.
+//
+// If the OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE environment
+// variable is set, and this option is not passed, the TemporalitySelector
+// matching that variable value will be used. That value can be either
+// "cumulative", "delta", or "lowmemory".
+//
+// By default, if the environment variable is not set, and this option is not
+// passed, the DefaultTemporalitySelector from the
+// go.opentelemetry.io/otel/sdk/metric package will be used.
 func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
 	return wrappedOption{oconf.WithTemporalitySelector(selector)}
 }
@@ -231,6 +271,29 @@ func WithAggregationSelector(selector metric.AggregationSelector) Option {
 	return wrappedOption{oconf.WithAggregationSelector(selector)}
 }
 
+// InstrumentKindSelectors holds the Temporality and Aggregation to use for
+// individual instrument kinds.
+type InstrumentKindSelectors struct {
+	// Temporality is the Temporality to use for each instrument kind.
+	Temporality map[metric.InstrumentKind]metricdata.Temporality
+	// Aggregation is the Aggregation to use for each instrument kind.
+	Aggregation map[metric.InstrumentKind]metric.Aggregation
+}
+
+// WithInstrumentKindSelectors sets the Temporality and Aggregation to use for
+// the instrument kinds held by s. They take precedence over the selectors set
+// with [WithTemporalitySelector] and [WithAggregationSelector], or their
+// defaults, which are still used for the other instrument kinds, regardless
+// of the order of the options.
+//
+// If this option is used multiple times, the values are merged. For an
+// instrument kind set multiple times, the last value is used.
+//
+// Use [Exporter.InstrumentKindSelectors] to read the resulting configuration.
+func WithInstrumentKindSelectors(s InstrumentKindSelectors) Option {
+	return wrappedOption{oconf.WithInstrumentKindSelectors(s.Temporality, s.Aggregation)}
+}
+
 // WithProxy sets the Proxy function the client will use to determine the
 // proxy to use for an HTTP request. If this option is not used, the client
 // will use [http.ProxyFromEnvironment].
@@ -253,3 +316,34 @@ func WithProxy(pf HTTPTransportProxyFunc) Option {
 func WithHTTPClient(c *http.Client) Option {
 	return wrappedOption{oconf.WithHTTPClient(c)}
 }
+
+// WithHTTPTransport sets the transport used by the exporter to send HTTP
+// requests.
+//
+// Passing the same transport to the trace, metric, and log OTLP HTTP exporters
+// makes them share a single pool of connections. This reduces the number of
+// connections and TLS handshakes of a process exporting all signals to the
+// same collector. Use [NewHTTPTransport] to create such a transport.
+//
+// This option will take precedence over [WithProxy], [WithTLSClientConfig]
+// options as well as OTEL_EXPORTER_OTLP_CERTIFICATE and
+// OTEL_EXPORTER_OTLP_METRICS_CERTIFICATE environment variables. The timeout set
+// with [WithTimeout] still applies. [WithHTTPClient] takes precedence over
+// this option.
+//
+// The transport is used as is, an endpoint using a Unix domain socket is not
+// supported.
+func WithHTTPTransport(rt http.RoundTripper) Option {
+	return wrappedOption{oconf.WithHTTPTransport(rt)}
+}
+
+// NewHTTPTransport returns an [http.Transport] that can be passed to the
+// WithHTTPTransport option of the trace, metric, and log OTLP HTTP exporters
+// so they share a single pool of connections. It connects to the collector
+// using tlsCfg, which is cloned, and the proxy returned by proxy. If proxy is
+// nil, [http.ProxyFromEnvironment] is used.
+//
+// The returned transport can be further configured before it is used.
+func NewHTTPTransport(tlsCfg *tls.Config, proxy HTTPTransportProxyFunc) *http.Transport {
+	return transport.New(tlsCfg, proxy)
+}
@@ -46,6 +46,25 @@ func newExporter(c *client, cfg oconf.Config) (*Exporter, error) {
 		as = metric.DefaultAggregationSelector
 	}
 
+	if kt := cfg.Metrics.KindTemporality; len(kt) > 0 {
+		base := ts
+		ts = func(k metric.InstrumentKind) metricdata.Temporality {
+			if t, ok := kt[k]; ok {
+				return t
+			}
+			return base(k)
+		}
+	}
+	if ka := cfg.Metrics.KindAggregation; len(ka) > 0 {
+		base := as
+		as = func(k metric.InstrumentKind) metric.Aggregation {
+			if a, ok := ka[k]; ok {
+				return a
+			}
+			return base(k)
+		}
+	}
+
 	return &Exporter{
 		client: c,
 
@@ -64,12 +83,41 @@ func (e *Exporter) Aggregation(k metric.InstrumentKind) metric.Aggregation {
 	return e.aggregationSelector(k)
 }
 
+// instrumentKinds are all the instrument kinds.
+var instrumentKinds = []metric.InstrumentKind{
+	metric.InstrumentKindCounter,
+	metric.InstrumentKindUpDownCounter,
+	metric.InstrumentKindHistogram,
+	metric.InstrumentKindObservableCounter,
+	metric.InstrumentKindObservableUpDownCounter,
+	metric.InstrumentKindObservableGauge,
+	metric.InstrumentKindGauge,
+}
+
+// InstrumentKindSelectors returns the Temporality and Aggregation the
+// Exporter uses for every instrument kind.
+//
+// The returned InstrumentKindSelectors can be modified and passed to
+// [WithInstrumentKindSelectors] to create an Exporter adjusting this
+// configuration.
+func (e *Exporter) InstrumentKindSelectors() InstrumentKindSelectors {
+	s := InstrumentKindSelectors{
+		Temporality: make(map[metric.InstrumentKind]metricdata.Temporality, len(instrumentKinds)),
+		Aggregation: make(map[metric.InstrumentKind]metric.Aggregation, len(instrumentKinds)),
+	}
+	for _, k := range instrumentKinds {
+		s.Temporality[k] = e.temporalitySelector(k)
+		s.Aggregation[k] = e.aggregationSelector(k)
+	}
+	return s
+}
+
 // Export transforms and transmits metric data to an OTLP receiver.
 //
 // This method returns an error if called after Shutdown.
 // This method returns an error if the method is canceled by the passed context.
 func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
-	defer global.Debug("OTLP/HTTP exporter export", "Data", rm)
+	defer global.Component(global.ComponentOTLPClient).Debug("OTLP/HTTP exporter export", "Data", rm)
 
 	otlpRm, err := transform.ResourceMetrics(rm)
 	// Best effort upload of transformable metrics.
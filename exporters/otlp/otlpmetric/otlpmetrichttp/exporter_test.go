@@ -4,6 +4,7 @@
 package otlpmetrichttp
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -106,3 +107,63 @@ func TestExporterDoesNotBlockTemporalityAndAggregation(t *testing.T) {
 	close(rCh)
 	wg.Wait()
 }
+
+func TestExporterTemporalityPreferenceEnv(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE", "delta")
+
+	exp, err := New(t.Context(), WithInsecure())
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, exp.Shutdown(context.Background())) })
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(metric.InstrumentKindCounter))
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(metric.InstrumentKindUpDownCounter))
+
+	// The option takes precedence over the environment variable.
+	optExp, err := New(t.Context(), WithInsecure(), WithTemporalitySelector(metric.CumulativeTemporalitySelector))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, optExp.Shutdown(context.Background())) })
+	assert.Equal(t, metricdata.CumulativeTemporality, optExp.Temporality(metric.InstrumentKindCounter))
+}
+
+func TestExporterInstrumentKindSelectors(t *testing.T) {
+	exp, err := New(
+		t.Context(),
+		WithInsecure(),
+		WithInstrumentKindSelectors(InstrumentKindSelectors{
+			Temporality: map[metric.InstrumentKind]metricdata.Temporality{
+				metric.InstrumentKindCounter: metricdata.CumulativeTemporality,
+			},
+			Aggregation: map[metric.InstrumentKind]metric.Aggregation{
+				metric.InstrumentKindHistogram: metric.AggregationBase2ExponentialHistogram{MaxSize: 160},
+			},
+		}),
+		// The instrument kind selectors take precedence regardless of the
+		// order of the options.
+		WithTemporalitySelector(metric.DeltaTemporalitySelector),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, exp.Shutdown(context.Background())) })
+
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(metric.InstrumentKindCounter))
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(metric.InstrumentKindHistogram))
+	assert.Equal(
+		t,
+		metric.AggregationBase2ExponentialHistogram{MaxSize: 160},
+		exp.Aggregation(metric.InstrumentKindHistogram),
+	)
+	assert.Equal(t, metric.AggregationSum{}, exp.Aggregation(metric.InstrumentKindCounter))
+
+	got := exp.InstrumentKindSelectors()
+	assert.Len(t, got.Temporality, 7)
+	assert.Len(t, got.Aggregation, 7)
+	for k, temporality := range got.Temporality {
+		assert.Equal(t, exp.Temporality(k), temporality, "temporality of %s", k)
+		assert.Equal(t, exp.Aggregation(k), got.Aggregation[k], "aggregation of %s", k)
+	}
+
+	// The effective configuration can be modified to create a new exporter.
+	got.Temporality[metric.InstrumentKindGauge] = metricdata.CumulativeTemporality
+	modified, err := New(t.Context(), WithInsecure(), WithInstrumentKindSelectors(got))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, modified.Shutdown(context.Background())) })
+	assert.Equal(t, got, modified.InstrumentKindSelectors())
+}
@@ -174,7 +174,7 @@ func BaseAttrs(id int64, endpoint string) []attribute.KeyValue {
 	host, port, err := parseEndpoint(endpoint)
 	if err != nil || (host == "" && port < 0) {
 		if err != nil {
-			global.Debug("failed to parse endpoint", "endpoint", endpoint, "error", err)
+			global.Component(global.ComponentOTLPClient).Debug("failed to parse endpoint", "endpoint", endpoint, "error", err)
 		}
 		return []attribute.KeyValue{
 			semconv.OTelComponentName(ComponentName(id)),
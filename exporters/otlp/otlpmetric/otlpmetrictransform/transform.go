@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlpmetrictransform converts the metric data produced by the
+// OpenTelemetry metric SDK into its OTLP representation.
+//
+// It provides the conversion used by the OTLP metric exporters so bespoke
+// exporters, e.g. ones writing to Kafka, to files, or using a vendor protocol
+// wrapping OTLP, can reuse it instead of reimplementing it. The returned OTLP
+// structures can be serialized with [google.golang.org/protobuf/proto.Marshal]
+// or [google.golang.org/protobuf/encoding/protojson.Marshal].
+package otlpmetrictransform
+
+import (
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrictransform/internal/transform"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ResourceMetrics transforms rm into OTLP ResourceMetrics.
+//
+// If rm contains invalid data, e.g. an unknown aggregation or temporality, an
+// error is returned along with ResourceMetrics containing the valid metrics.
+func ResourceMetrics(rm *metricdata.ResourceMetrics) (*mpb.ResourceMetrics, error) {
+	return transform.ResourceMetrics(rm)
+}
+
+// ScopeMetrics transforms sms into OTLP ScopeMetrics.
+//
+// If sms contains invalid data, an error is returned along with ScopeMetrics
+// containing the valid metrics.
+func ScopeMetrics(sms []metricdata.ScopeMetrics) ([]*mpb.ScopeMetrics, error) {
+	return transform.ScopeMetrics(sms)
+}
+
+// Metrics transforms ms into OTLP Metrics.
+//
+// If ms contains invalid data, an error is returned along with the valid
+// Metrics.
+func Metrics(ms []metricdata.Metrics) ([]*mpb.Metric, error) {
+	return transform.Metrics(ms)
+}
+
+// KeyValues transforms attrs into OTLP KeyValues. If attrs is empty, nil is
+// returned.
+func KeyValues(attrs []attribute.KeyValue) []*cpb.KeyValue {
+	return transform.KeyValues(attrs)
+}
+
+// Value transforms v into an OTLP AnyValue.
+func Value(v attribute.Value) *cpb.AnyValue {
+	return transform.Value(v)
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetrictransform_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrictransform"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+var (
+	start = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end   = start.Add(time.Minute)
+
+	sum = metricdata.Metrics{
+		Name: "requests",
+		Unit: "{request}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: attribute.NewSet(attribute.String("method", "GET")),
+				StartTime:  start,
+				Time:       end,
+				Value:      3,
+			}},
+		},
+	}
+)
+
+func TestResourceMetrics(t *testing.T) {
+	rm := &metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "svc")),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope:   instrumentation.Scope{Name: "scope", Version: "v1"},
+			Metrics: []metricdata.Metrics{sum},
+		}},
+	}
+
+	got, err := otlpmetrictransform.ResourceMetrics(rm)
+	require.NoError(t, err)
+	assert.Equal(t, otlpmetrictransform.KeyValues([]attribute.KeyValue{
+		attribute.String("service.name", "svc"),
+	}), got.Resource.Attributes)
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, "scope", got.ScopeMetrics[0].Scope.Name)
+	require.Len(t, got.ScopeMetrics[0].Metrics, 1)
+
+	m := got.ScopeMetrics[0].Metrics[0]
+	assert.Equal(t, "requests", m.Name)
+	assert.Equal(t, "{request}", m.Unit)
+	s := m.GetSum()
+	require.NotNil(t, s)
+	assert.True(t, s.IsMonotonic)
+	assert.Equal(t, mpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE, s.AggregationTemporality)
+	require.Len(t, s.DataPoints, 1)
+	assert.Equal(t, int64(3), s.DataPoints[0].GetAsInt())
+	assert.Equal(t, uint64(end.UnixNano()), s.DataPoints[0].TimeUnixNano)
+
+	// The result can be serialized in a custom wire format.
+	_, err = proto.Marshal(got)
+	require.NoError(t, err)
+}
+
+func TestMetricsInvalid(t *testing.T) {
+	invalid := metricdata.Metrics{
+		Name: "invalid",
+		Data: metricdata.Sum[int64]{Temporality: metricdata.Temporality(100)},
+	}
+
+	got, err := otlpmetrictransform.Metrics([]metricdata.Metrics{sum, invalid})
+	assert.Error(t, err)
+	require.NotEmpty(t, got)
+	assert.Equal(t, "requests", got[0].Name, "valid metrics not returned")
+
+	sms, err := otlpmetrictransform.ScopeMetrics([]metricdata.ScopeMetrics{{Metrics: []metricdata.Metrics{invalid}}})
+	assert.Error(t, err)
+	assert.Len(t, sms, 1)
+}
+
+func TestValue(t *testing.T) {
+	want := &cpb.AnyValue{Value: &cpb.AnyValue_BoolValue{BoolValue: true}}
+	assert.Equal(t, want, otlpmetrictransform.Value(attribute.BoolValue(true)))
+	assert.Nil(t, otlpmetrictransform.KeyValues(nil))
+}
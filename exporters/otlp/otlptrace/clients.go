@@ -41,3 +41,19 @@ type Client interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
 }
+
+// RawClient is a Client that can also upload traces already encoded in the
+// OTLP protobuf wire format. This allows proxies and routers forwarding OTLP
+// payloads to avoid decoding and re-encoding them.
+//
+// The clients of the go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp
+// and go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc
+// packages implement RawClient.
+type RawClient interface {
+	Client
+
+	// UploadRawTraces sends payload, an ExportTraceServiceRequest encoded in
+	// the protobuf wire format, to the collector as-is. The payload is not
+	// validated. May be called concurrently.
+	UploadRawTraces(ctx context.Context, payload []byte) error
+}
@@ -22,9 +22,11 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/counter"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/failover"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/retry"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type client struct {
@@ -55,7 +57,7 @@ type client struct {
 }
 
 // Compile time check *client implements otlptrace.Client.
-var _ otlptrace.Client = (*client)(nil)
+var _ otlptrace.RawClient = (*client)(nil)
 
 // NewClient creates a new gRPC trace client.
 func NewClient(opts ...Option) otlptrace.Client {
@@ -67,12 +69,13 @@ func newClient(opts ...Option) *client {
 
 	ctx, cancel := context.WithCancel(context.Background()) //nolint:gosec  // cancel called in client shutdown.
 
+	endpoint, dialOpts := failover.DialOptions(cfg.Traces.Endpoint, cfg.FailoverEndpoints, cfg.DialOptions)
 	c := &client{
-		endpoint:       cfg.Traces.Endpoint,
+		endpoint:       endpoint,
 		exportTimeout:  cfg.Traces.Timeout,
 		maxRequestSize: cfg.Traces.MaxRequestSize,
 		requestFunc:    cfg.RetryConfig.RequestFunc(retryable),
-		dialOpts:       cfg.DialOptions,
+		dialOpts:       dialOpts,
 		stopCtx:        ctx,
 		stopFunc:       cancel,
 		conn:           cfg.GRPCConn,
@@ -194,7 +197,57 @@ var errShutdown = errors.New("the client is shutdown")
 //
 // Retryable errors from the server will be handled according to any
 // RetryConfig the client was created with.
-func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) (uploadErr error) {
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	pbRequest := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: protoSpans,
+	}
+
+	spanCount := -1
+	if c.inst != nil {
+		spanCount = 0
+		for _, rs := range protoSpans {
+			for _, ss := range rs.ScopeSpans {
+				spanCount += len(ss.Spans)
+			}
+		}
+	}
+
+	return c.upload(ctx, spanCount, func() int { return proto.Size(pbRequest) }, func(
+		ctx context.Context,
+		tsc coltracepb.TraceServiceClient,
+	) (*coltracepb.ExportTraceServiceResponse, error) {
+		return tsc.Export(ctx, pbRequest)
+	})
+}
+
+// UploadRawTraces sends payload, an already encoded OTLP
+// ExportTraceServiceRequest protobuf message, to the collector as is.
+func (c *client) UploadRawTraces(ctx context.Context, payload []byte) error {
+	return c.upload(ctx, -1, func() int { return len(payload) }, func(
+		ctx context.Context,
+		_ coltracepb.TraceServiceClient,
+	) (*coltracepb.ExportTraceServiceResponse, error) {
+		resp := new(coltracepb.ExportTraceServiceResponse)
+		err := c.conn.Invoke(
+			ctx,
+			exportMethod,
+			rawMessage(payload),
+			resp,
+			grpc.ForceCodecV2(rawCodec{}),
+		)
+		return resp, err
+	})
+}
+
+// upload sends a request of size bytes containing spanCount spans to the
+// collector using export. If spanCount is negative, the export is not
+// instrumented.
+func (c *client) upload(
+	ctx context.Context,
+	spanCount int,
+	size func() int,
+	export func(context.Context, coltracepb.TraceServiceClient) (*coltracepb.ExportTraceServiceResponse, error),
+) (uploadErr error) {
 	// Hold a read lock to ensure a shut down initiated after this starts does
 	// not abandon the export. This read lock acquire has less priority than a
 	// write lock acquire (i.e. Stop), meaning if the client is shutting down
@@ -209,28 +262,18 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 	ctx, cancel := c.exportContext(ctx)
 	defer cancel()
 
-	pbRequest := &coltracepb.ExportTraceServiceRequest{
-		ResourceSpans: protoSpans,
-	}
-
 	code := codes.Unknown
-	if c.inst != nil {
-		var spanCount int
-		for _, rs := range protoSpans {
-			for _, ss := range rs.ScopeSpans {
-				spanCount += len(ss.Spans)
-			}
-		}
+	if c.inst != nil && spanCount >= 0 {
 		op := c.inst.ExportSpans(ctx, spanCount)
 		defer func() { op.End(uploadErr, code) }()
 	}
 
-	if maxSize := c.maxRequestSize; maxSize > 0 && proto.Size(pbRequest) > maxSize {
+	if maxSize := c.maxRequestSize; maxSize > 0 && size() > maxSize {
 		return fmt.Errorf("request message too large: exceeded %d bytes", maxSize)
 	}
 
 	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.tsc.Export(iCtx, pbRequest)
+		resp, err := export(iCtx, c.tsc)
 		if resp != nil && resp.PartialSuccess != nil {
 			msg := resp.PartialSuccess.GetErrorMessage()
 			n := resp.PartialSuccess.GetRejectedSpans()
@@ -241,9 +284,13 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 		}
 		// nil is converted to OK.
 		code = status.Code(err)
-		if code == codes.OK {
+		switch code {
+		case codes.OK:
 			// Success.
 			return uploadErr
+		case codes.Unauthenticated, codes.PermissionDenied:
+			// The credentials are rejected, all exports will fail the same way.
+			err = &tracesdk.PermanentExportError{Err: err}
 		}
 		return errors.Join(uploadErr, err)
 	})
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -18,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
 	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 	"go.uber.org/goleak"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
@@ -26,6 +29,7 @@ import (
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -145,6 +149,52 @@ func TestWithEndpointURL(t *testing.T) {
 	otlptracetest.RunEndToEndTest(ctx, t, exp, mc)
 }
 
+func TestWithFailoverEndpoints(t *testing.T) {
+	// The primary endpoint cannot be connected to.
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	unavailable := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	mc := runMockCollector(t)
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp := newGRPCExporter(t, ctx, unavailable, otlptracegrpc.WithFailoverEndpoints(mc.endpoint))
+	t.Cleanup(func() {
+		ctx, cancel := contextWithTimeout(ctx, t, 10*time.Second)
+		defer cancel()
+
+		require.NoError(t, exp.Shutdown(ctx))
+	})
+
+	// RunEndToEndTest closes mc.
+	otlptracetest.RunEndToEndTest(ctx, t, exp, mc)
+}
+
+func TestWithEndpointURLUnixSocket(t *testing.T) {
+	// Unix socket paths are limited in length, avoid the long t.TempDir path.
+	dir, err := os.MkdirTemp("", "otlp")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "collector.sock")
+
+	mc := runMockCollectorWithConfig(t, &mockConfig{network: "unix", endpoint: socket})
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp := newGRPCExporter(t, ctx, "", []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpointURL("unix://" + socket),
+	}...)
+	t.Cleanup(func() {
+		ctx, cancel := contextWithTimeout(ctx, t, 10*time.Second)
+		defer cancel()
+
+		require.NoError(t, exp.Shutdown(ctx))
+	})
+
+	// RunEndToEndTest closes mc.
+	otlptracetest.RunEndToEndTest(ctx, t, exp, mc)
+}
+
 func newGRPCExporter(
 	tb testing.TB,
 	ctx context.Context,
@@ -470,6 +520,88 @@ func TestPartialSuccess(t *testing.T) {
 	assert.ErrorIs(t, err, want)
 }
 
+func TestPermanentExportError(t *testing.T) {
+	for _, c := range []codes.Code{codes.Unauthenticated, codes.PermissionDenied} {
+		t.Run(c.String(), func(t *testing.T) {
+			mc := runMockCollectorWithConfig(t, &mockConfig{
+				errors: []error{status.Error(c, "rejected")},
+			})
+			t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+			ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+			exp := newGRPCExporter(t, ctx, mc.endpoint)
+			t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+			err := exp.ExportSpans(ctx, roSpans)
+			var pErr *sdktrace.PermanentExportError
+			require.ErrorAs(t, err, &pErr)
+			assert.Equal(t, c, status.Code(pErr.Err))
+		})
+	}
+
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{status.Error(codes.InvalidArgument, "invalid")},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp := newGRPCExporter(t, ctx, mc.endpoint)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	err := exp.ExportSpans(ctx, roSpans)
+	require.Error(t, err)
+	var pErr *sdktrace.PermanentExportError
+	assert.NotErrorAs(t, err, &pErr, "other errors are not permanent")
+}
+
+func TestUploadRawTraces(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	client := otlptracegrpc.NewClient(
+		otlptracegrpc.WithInsecure(),
+		otlptracegrpc.WithEndpoint(mc.endpoint),
+	)
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	require.NoError(t, client.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, client.Stop(ctx)) })
+
+	payload, err := proto.Marshal(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Spans: []*tracepb.Span{{Name: "forwarded"}},
+			}},
+		}},
+	})
+	require.NoError(t, err)
+
+	raw, ok := client.(otlptrace.RawClient)
+	require.True(t, ok, "client does not implement RawClient")
+	require.NoError(t, raw.UploadRawTraces(ctx, payload))
+
+	got := mc.getSpans()
+	require.Len(t, got, 1)
+	assert.Equal(t, "forwarded", got[0].Name)
+}
+
+func TestUploadRawTracesRequestSizeLimit(t *testing.T) {
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	client := otlptracegrpc.NewClient(
+		otlptracegrpc.WithInsecure(),
+		otlptracegrpc.WithEndpoint(mc.endpoint),
+		otlptracegrpc.WithMaxRequestSize(1),
+	)
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	require.NoError(t, client.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, client.Stop(ctx)) })
+
+	err := client.(otlptrace.RawClient).UploadRawTraces(ctx, []byte{0, 1})
+	assert.ErrorContains(t, err, "request message too large")
+	assert.Empty(t, mc.getResourceSpans(), "oversized request must fail before sending")
+}
+
 func TestCustomUserAgent(t *testing.T) {
 	customUserAgent := "custom-user-agent"
 	mc := runMockCollector(t)
@@ -32,3 +32,6 @@ package internal
 
 //go:generate gotmpl --body=../../../../../internal/shared/counter/counter.go.tmpl "--data={}" --out=counter/counter.go
 //go:generate gotmpl --body=../../../../../internal/shared/counter/counter_test.go.tmpl "--data={}" --out=counter/counter_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover.go.tmpl "--data={}" --out=failover/failover.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/failover/failover_test.go.tmpl "--data={}" --out=failover/failover_test.go
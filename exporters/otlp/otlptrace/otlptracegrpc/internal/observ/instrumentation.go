@@ -169,7 +169,7 @@ func BaseAttrs(id int64, target string) []attribute.KeyValue {
 	host, port, err := ParseCanonicalTarget(target)
 	if err != nil || (host == "" && port < 0) {
 		if err != nil {
-			global.Debug("failed to parse target", "target", target, "error", err)
+			global.Component(global.ComponentOTLPClient).Debug("failed to parse target", "target", target, "error", err)
 		}
 		return []attribute.KeyValue{
 			semconv.OTelComponentName(ComponentName(id)),
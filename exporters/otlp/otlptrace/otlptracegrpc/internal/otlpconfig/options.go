@@ -8,6 +8,7 @@
 package otlpconfig
 
 import (
+	"compress/flate"
 	"crypto/tls"
 	"fmt"
 	"net/http"
@@ -34,9 +35,15 @@ const (
 	// DefaultMaxRequestSize is the default maximum size of a serialized export
 	// request, before compression.
 	DefaultMaxRequestSize int = 64 * 1024 * 1024
+	// DefaultCompressionLevel is the default gzip compression level used by
+	// HTTP exporters.
+	DefaultCompressionLevel int = flate.DefaultCompression
 	// DefaultTimeout is a default max waiting time for the backend to process
 	// each span batch.
 	DefaultTimeout time.Duration = 10 * time.Second
+
+	// unixScheme is the endpoint URL scheme of a Unix domain socket.
+	unixScheme = "unix"
 )
 
 type (
@@ -58,8 +65,11 @@ type (
 		GRPCCredentials credentials.TransportCredentials
 
 		// HTTP configurations
-		Proxy      HTTPTransportProxyFunc
-		HTTPClient *http.Client
+		Proxy                HTTPTransportProxyFunc
+		HTTPClient           *http.Client
+		HTTPTransport        http.RoundTripper
+		CompressionLevel     int
+		CompressionThreshold int
 	}
 
 	Config struct {
@@ -71,6 +81,7 @@ type (
 		// gRPC configurations
 		ReconnectionPeriod time.Duration
 		ServiceConfig      string
+		FailoverEndpoints  []string
 		DialOptions        []grpc.DialOption
 		GRPCConn           *grpc.ClientConn
 	}
@@ -81,11 +92,12 @@ type (
 func NewHTTPConfig(opts ...HTTPOption) Config {
 	cfg := Config{
 		Traces: SignalConfig{
-			Endpoint:       fmt.Sprintf("%s:%d", DefaultCollectorHost, DefaultCollectorHTTPPort),
-			URLPath:        DefaultTracesPath,
-			Compression:    NoCompression,
-			MaxRequestSize: DefaultMaxRequestSize,
-			Timeout:        DefaultTimeout,
+			Endpoint:         fmt.Sprintf("%s:%d", DefaultCollectorHost, DefaultCollectorHTTPPort),
+			URLPath:          DefaultTracesPath,
+			Compression:      NoCompression,
+			CompressionLevel: DefaultCompressionLevel,
+			MaxRequestSize:   DefaultMaxRequestSize,
+			Timeout:          DefaultTimeout,
 		},
 		RetryConfig: retry.DefaultConfig,
 	}
@@ -97,6 +109,34 @@ func NewHTTPConfig(opts ...HTTPOption) Config {
 	return cfg
 }
 
+// UnixSocketPath returns the path of the Unix domain socket endpoint targets
+// and true. If endpoint does not target a Unix domain socket, an empty string
+// and false are returned.
+//
+// An endpoint targets a Unix domain socket if it has the form
+// "unix:///path/to/socket" or "unix:relative/path".
+func UnixSocketPath(endpoint string) (string, bool) {
+	p, ok := strings.CutPrefix(endpoint, unixScheme+":")
+	if !ok {
+		return "", false
+	}
+	p = strings.TrimPrefix(p, "//")
+	return p, p != ""
+}
+
+// unixEndpoint returns the endpoint targeting the Unix domain socket
+// identified by u. The returned value is also a valid gRPC target.
+func unixEndpoint(u *url.URL) string {
+	p := u.Path
+	if p == "" {
+		p = u.Opaque
+	}
+	if path.IsAbs(p) {
+		return unixScheme + "://" + p
+	}
+	return unixScheme + ":" + p
+}
+
 // cleanPath returns a path with all spaces trimmed. If urlPath is empty,
 // defaultPath is returned instead.
 func cleanPath(urlPath, defaultPath string) string {
@@ -276,6 +316,11 @@ func WithEndpoint(endpoint string) GenericOption {
 
 // WithEndpointURL configures the trace scheme, host, port, and path; the
 // provided value should resemble "https://example.com:4318/v1/traces".
+//
+// A Unix domain socket is targeted using the "unix" scheme, e.g.
+// "unix:///var/run/otel/collector.sock". Connections to a Unix domain socket
+// are insecure and HTTP requests use the default URL path.
+// Windows named pipes are not supported as endpoints.
 func WithEndpointURL(v string) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		u, err := url.Parse(v)
@@ -284,6 +329,12 @@ func WithEndpointURL(v string) GenericOption {
 			return cfg
 		}
 
+		if strings.EqualFold(u.Scheme, unixScheme) {
+			cfg.Traces.Endpoint = unixEndpoint(u)
+			cfg.Traces.Insecure = true
+			return cfg
+		}
+
 		cfg.Traces.Endpoint = u.Host
 		cfg.Traces.URLPath = u.Path
 		if cfg.Traces.URLPath == "" {
@@ -304,6 +355,24 @@ func WithCompression(compression Compression) GenericOption {
 	})
 }
 
+func WithCompressionLevel(level int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		if level < flate.HuffmanOnly || level > flate.BestCompression {
+			global.Warn("otlptrace: ignoring invalid gzip compression level", "level", level)
+			return cfg
+		}
+		cfg.Traces.CompressionLevel = level
+		return cfg
+	})
+}
+
+func WithCompressionThreshold(size int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.CompressionThreshold = size
+		return cfg
+	})
+}
+
 func WithURLPath(urlPath string) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Traces.URLPath = urlPath
@@ -376,3 +445,10 @@ func WithHTTPClient(c *http.Client) GenericOption {
 		return cfg
 	})
 }
+
+func WithHTTPTransport(rt http.RoundTripper) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.HTTPTransport = rt
+		return cfg
+	})
+}
@@ -103,6 +103,7 @@ type mockCollector struct {
 
 type mockConfig struct {
 	errors   []error
+	network  string
 	endpoint string
 	partial  *collectortracepb.ExportTracePartialSuccess
 }
@@ -162,7 +163,11 @@ func runMockCollectorAtEndpoint(tb testing.TB, endpoint string) *mockCollector {
 
 func runMockCollectorWithConfig(tb testing.TB, mockConfig *mockConfig) *mockCollector {
 	tb.Helper()
-	ln, err := (&net.ListenConfig{}).Listen(tb.Context(), "tcp", mockConfig.endpoint)
+	network := mockConfig.network
+	if network == "" {
+		network = "tcp"
+	}
+	ln, err := (&net.ListenConfig{}).Listen(tb.Context(), network, mockConfig.endpoint)
 	require.NoError(tb, err, "net.Listen")
 
 	srv := grpc.NewServer()
@@ -87,6 +87,11 @@ func WithEndpoint(endpoint string) Option {
 // If both this option and WithEndpoint are used, the last used option will
 // take precedence.
 //
+// A Unix domain socket is targeted using the "unix" scheme, e.g.
+// "unix:///var/run/otel/collector.sock". Connections to a Unix domain socket
+// do not use client security.
+// Windows named pipes are not supported as endpoints.
+//
 // If an invalid URL is provided, the default value will be kept.
 //
 // By default, if an environment variable is not set, and this option is not
@@ -144,6 +149,9 @@ func WithTLSCredentials(creds credentials.TransportCredentials) Option {
 
 // WithServiceConfig defines the default gRPC service config used.
 //
+// By default, the round_robin load balancing policy is used, or the
+// pick_first policy if WithFailoverEndpoints is used.
+//
 // This option has no effect if WithGRPCConn is used.
 func WithServiceConfig(serviceConfig string) Option {
 	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg otlpconfig.Config) otlpconfig.Config {
@@ -152,6 +160,30 @@ func WithServiceConfig(serviceConfig string) Option {
 	})}
 }
 
+// WithFailoverEndpoints sets the endpoints (host and port) the Exporter will
+// connect to, in order, when the endpoint set with WithEndpoint or
+// WithEndpointURL cannot be reached, e.g. when its name cannot be resolved or
+// its connection is lost. The provided endpoints should resemble
+// "example.com:4317" (no scheme or path).
+//
+// All endpoints are resolved again when the connection is lost, so the
+// Exporter is not stranded on the address of a collector that was redeployed
+// with a new one. Once connected to a failover endpoint, the Exporter only
+// connects back to the endpoint once that connection is lost.
+//
+// By default, no failover endpoint is used and the requests are balanced over
+// all the addresses resolved for the endpoint with the round_robin gRPC load
+// balancing policy. With failover endpoints, the pick_first policy is used
+// instead. A service config set with WithServiceConfig takes precedence.
+//
+// This option has no effect if WithGRPCConn is used.
+func WithFailoverEndpoints(endpoints ...string) Option {
+	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg otlpconfig.Config) otlpconfig.Config {
+		cfg.FailoverEndpoints = endpoints
+		return cfg
+	})}
+}
+
 // WithDialOption sets explicit grpc.DialOptions to use when making a
 // connection. The options here are appended to the internal grpc.DialOptions
 // used so they will take precedence over any other internal grpc.DialOptions
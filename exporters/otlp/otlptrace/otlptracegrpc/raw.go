@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracegrpc
+
+import (
+	"google.golang.org/grpc/encoding"
+	// Register the proto codec rawCodec delegates to.
+	_ "google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/mem"
+)
+
+// exportMethod is the full name of the gRPC method exporting traces.
+const exportMethod = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
+
+// rawMessage is an already encoded protobuf message.
+type rawMessage []byte
+
+// rawCodec is the gRPC proto codec sending rawMessage values as is, without
+// encoding them again.
+type rawCodec struct{}
+
+var _ encoding.CodecV2 = rawCodec{}
+
+// Marshal returns the bytes of v if it is a rawMessage. Otherwise, v is
+// marshaled by the gRPC proto codec.
+func (rawCodec) Marshal(v any) (mem.BufferSlice, error) {
+	if m, ok := v.(rawMessage); ok {
+		return mem.BufferSlice{mem.SliceBuffer(m)}, nil
+	}
+	return encoding.GetCodecV2(rawCodec{}.Name()).Marshal(v)
+}
+
+// Unmarshal unmarshals data into v with the gRPC proto codec.
+func (rawCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	return encoding.GetCodecV2(rawCodec{}.Name()).Unmarshal(data, v)
+}
+
+// Name returns the name of the gRPC proto codec, so the content-type of the
+// requests is the one expected by the collector.
+func (rawCodec) Name() string {
+	return "proto"
+}
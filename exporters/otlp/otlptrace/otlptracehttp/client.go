@@ -28,6 +28,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 )
 
 const contentTypeProto = "application/x-protobuf"
@@ -39,11 +40,15 @@ const contentTypeProto = "application/x-protobuf"
 // This is a variable to allow tests to override it.
 var maxResponseBodySize int64 = 4 * 1024 * 1024
 
-var gzPool = sync.Pool{
-	New: func() any {
-		w := gzip.NewWriter(io.Discard)
-		return w
-	},
+// newGzipPool returns a pool of gzip writers compressing with level.
+func newGzipPool(level int) *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			// The level is validated by the options setting it.
+			w, _ := gzip.NewWriterLevel(io.Discard, level)
+			return w
+		},
+	}
 }
 
 // Keep it in sync with golang's DefaultTransport from net/http! We
@@ -73,12 +78,13 @@ type client struct {
 	client      *http.Client
 	stopCh      chan struct{}
 	stopOnce    sync.Once
+	gzPool      *sync.Pool
 
 	instID int64
 	inst   *observ.Instrumentation
 }
 
-var _ otlptrace.Client = (*client)(nil)
+var _ otlptrace.RawClient = (*client)(nil)
 
 // NewClient creates a new HTTP trace client.
 func NewClient(opts ...Option) otlptrace.Client {
@@ -86,13 +92,21 @@ func NewClient(opts ...Option) otlptrace.Client {
 
 	httpClient := cfg.Traces.HTTPClient
 
+	if httpClient == nil && cfg.Traces.HTTPTransport != nil {
+		// Use the transport as is, it can be shared with other exporters.
+		httpClient = &http.Client{
+			Transport: cfg.Traces.HTTPTransport,
+			Timeout:   cfg.Traces.Timeout,
+		}
+	}
 	if httpClient == nil {
 		httpClient = &http.Client{
 			Transport: ourTransport,
 			Timeout:   cfg.Traces.Timeout,
 		}
 
-		if cfg.Traces.TLSCfg != nil || cfg.Traces.Proxy != nil {
+		socket, isUnix := otlpconfig.UnixSocketPath(cfg.Traces.Endpoint)
+		if cfg.Traces.TLSCfg != nil || cfg.Traces.Proxy != nil || isUnix {
 			clonedTransport := ourTransport.Clone()
 			httpClient.Transport = clonedTransport
 
@@ -102,6 +116,10 @@ func NewClient(opts ...Option) otlptrace.Client {
 			if cfg.Traces.Proxy != nil {
 				clonedTransport.Proxy = cfg.Traces.Proxy
 			}
+			if isUnix {
+				clonedTransport.Proxy = nil
+				clonedTransport.DialContext = unixDialContext(socket)
+			}
 		}
 	}
 
@@ -113,10 +131,23 @@ func NewClient(opts ...Option) otlptrace.Client {
 		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
 		stopCh:      stopCh,
 		client:      httpClient,
+		gzPool:      newGzipPool(cfg.Traces.CompressionLevel),
 		instID:      counter.NextExporterID(),
 	}
 }
 
+// unixDialContext returns a DialContext function that connects to the Unix
+// domain socket at path regardless of the network address requested.
+func unixDialContext(path string) func(context.Context, string, string) (net.Conn, error) {
+	d := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return d.DialContext(ctx, "unix", path)
+	}
+}
+
 // Start does nothing in a HTTP client.
 func (c *client) Start(ctx context.Context) error {
 	if c.cfg.Insecure && c.cfg.TLSCfg != nil {
@@ -156,7 +187,7 @@ func (c *client) Stop(ctx context.Context) error {
 }
 
 // UploadTraces sends a batch of spans to the collector.
-func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) (uploadErr error) {
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
 	pbRequest := &coltracepb.ExportTraceServiceRequest{
 		ResourceSpans: protoSpans,
 	}
@@ -165,6 +196,31 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 		return err
 	}
 
+	spanCount := -1
+	if c.inst != nil {
+		spanCount = 0
+		for _, rs := range protoSpans {
+			for _, ss := range rs.ScopeSpans {
+				spanCount += len(ss.Spans)
+			}
+		}
+	}
+	return c.upload(ctx, rawRequest, spanCount)
+}
+
+// UploadRawTraces sends payload, an ExportTraceServiceRequest encoded in the
+// protobuf wire format, to the collector as-is.
+//
+// The payload is not decoded, the spans it contains are not counted by the
+// self-observability metrics of the client.
+func (c *client) UploadRawTraces(ctx context.Context, payload []byte) error {
+	return c.upload(ctx, payload, -1)
+}
+
+// upload sends rawRequest, containing spanCount spans, to the collector. The
+// self-observability metrics of the client are not recorded if spanCount is
+// negative.
+func (c *client) upload(ctx context.Context, rawRequest []byte, spanCount int) (uploadErr error) {
 	ctx, cancel := c.contextWithStop(ctx)
 	defer cancel()
 
@@ -178,13 +234,7 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 	}
 
 	var statusCode int
-	if c.inst != nil {
-		var spanCount int
-		for _, rs := range protoSpans {
-			for _, ss := range rs.ScopeSpans {
-				spanCount += len(ss.Spans)
-			}
-		}
+	if c.inst != nil && spanCount >= 0 {
 		op := c.inst.ExportSpans(ctx, spanCount)
 		defer func() { op.End(uploadErr, statusCode) }()
 	}
@@ -276,6 +326,11 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 			http.StatusGatewayTimeout:
 			// Retryable failure.
 			return newResponseError(resp.Header, bodyErr)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			// The credentials are rejected, all exports will fail the same way.
+			return &tracesdk.PermanentExportError{
+				Err: fmt.Errorf("failed to send to %s: %s (%w)", request.URL, resp.Status, bodyErr),
+			}
 		default:
 			// Non-retryable failure.
 			return fmt.Errorf("failed to send to %s: %s (%w)", request.URL, resp.Status, bodyErr)
@@ -284,7 +339,13 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 }
 
 func (c *client) newRequest(body []byte) (request, error) {
-	u := url.URL{Scheme: c.getScheme(), Host: c.cfg.Endpoint, Path: c.cfg.URLPath}
+	host := c.cfg.Endpoint
+	if _, ok := otlpconfig.UnixSocketPath(host); ok {
+		// Requests are sent over the socket, the host is only used for the
+		// Host header.
+		host = "localhost"
+	}
+	u := url.URL{Scheme: c.getScheme(), Host: host, Path: c.cfg.URLPath}
 	r, err := http.NewRequestWithContext(context.Background(), http.MethodPost, u.String(), http.NoBody)
 	if err != nil {
 		return request{Request: r}, err
@@ -299,7 +360,12 @@ func (c *client) newRequest(body []byte) (request, error) {
 	r.Header.Set("Content-Type", contentTypeProto)
 
 	req := request{Request: r}
-	switch Compression(c.cfg.Compression) {
+	compression := Compression(c.cfg.Compression)
+	if compression != NoCompression && len(body) < c.cfg.CompressionThreshold {
+		// Compressing small payloads costs more than it saves.
+		compression = NoCompression
+	}
+	switch compression {
 	case NoCompression:
 		r.ContentLength = int64(len(body))
 		req.bodyReader = bodyReader(body)
@@ -309,10 +375,10 @@ func (c *client) newRequest(body []byte) (request, error) {
 		r.ContentLength = -1
 		r.Header.Set("Content-Encoding", "gzip")
 
-		gz := gzPool.Get().(*gzip.Writer)
+		gz := c.gzPool.Get().(*gzip.Writer)
 		defer func() {
 			gz.Reset(io.Discard)
-			gzPool.Put(gz)
+			c.gzPool.Put(gz)
 		}()
 
 		var b bytes.Buffer
@@ -11,9 +11,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -22,6 +26,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -36,6 +42,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
@@ -310,6 +317,37 @@ func TestNoRetry(t *testing.T) {
 	assert.Empty(t, mc.GetSpans())
 }
 
+func TestPermanentExportError(t *testing.T) {
+	for _, code := range []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusBadRequest} {
+		t.Run(http.StatusText(code), func(t *testing.T) {
+			mc := runMockCollector(t, mockCollectorConfig{
+				InjectHTTPStatus: []int{code},
+			})
+			defer mc.MustStop(t)
+			driver := otlptracehttp.NewClient(
+				otlptracehttp.WithEndpoint(mc.Endpoint()),
+				otlptracehttp.WithInsecure(),
+			)
+			ctx := t.Context()
+			exporter, err := otlptrace.New(ctx, driver)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, exporter.Shutdown(ctx))
+			}()
+
+			err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+			require.Error(t, err)
+			var pErr *sdktrace.PermanentExportError
+			if code == http.StatusBadRequest {
+				assert.NotErrorAs(t, err, &pErr, "other errors are not permanent")
+				return
+			}
+			require.ErrorAs(t, err, &pErr)
+			assert.ErrorContains(t, pErr, strconv.Itoa(code))
+		})
+	}
+}
+
 func TestEmptyData(t *testing.T) {
 	mcCfg := mockCollectorConfig{}
 	mc := runMockCollector(t, mcCfg)
@@ -957,8 +995,151 @@ func TestWithEndpointURLNoPathUsesRootPath(t *testing.T) {
 	assert.Equal(t, "/", got, "a pathless endpoint URL must target the root path, not the default traces path")
 }
 
+func TestWithEndpointURLUnixSocket(t *testing.T) {
+	// Unix socket paths are limited in length, avoid the long t.TempDir path.
+	dir, err := os.MkdirTemp("", "otlp")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "collector.sock")
+
+	ln, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	pathCh := make(chan string, 1)
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pathCh <- r.URL.Path
+		w.WriteHeader(http.StatusOK)
+	}))
+	srv.Listener = ln
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpointURL("unix://"+socket),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}),
+	)
+	exporter, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exporter.Shutdown(ctx)) })
+
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+
+	got, ok := <-pathCh
+	require.True(t, ok, "request was not received")
+	assert.Equal(t, otlpconfig.DefaultTracesPath, got)
+}
+
 type roundTripperFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 	return f(r)
 }
+
+// okTransport returns a RoundTripper responding with an empty 200 response
+// and counting the requests in calls.
+func okTransport(calls *int) http.RoundTripper {
+	return roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		*calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	})
+}
+
+func TestWithHTTPTransport(t *testing.T) {
+	ctx := t.Context()
+
+	var calls int
+	exporter, err := otlptrace.New(ctx, otlptracehttp.NewClient(
+		otlptracehttp.WithHTTPTransport(okTransport(&calls)),
+		otlptracehttp.WithInsecure(),
+	))
+	require.NoError(t, err)
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	require.NoError(t, exporter.Shutdown(ctx))
+	assert.Equal(t, 1, calls)
+
+	t.Run("HTTPClientPrecedence", func(t *testing.T) {
+		var transportCalls, clientCalls int
+		exporter, err := otlptrace.New(ctx, otlptracehttp.NewClient(
+			otlptracehttp.WithHTTPTransport(okTransport(&transportCalls)),
+			otlptracehttp.WithHTTPClient(&http.Client{Transport: okTransport(&clientCalls)}),
+			otlptracehttp.WithInsecure(),
+		))
+		require.NoError(t, err)
+		require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+		require.NoError(t, exporter.Shutdown(ctx))
+		assert.Equal(t, 0, transportCalls)
+		assert.Equal(t, 1, clientCalls)
+	})
+}
+
+func TestCompressionThreshold(t *testing.T) {
+	var (
+		mu        sync.Mutex
+		encodings []string
+	)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	export := func(opts ...otlptracehttp.Option) {
+		opts = append(opts,
+			otlptracehttp.WithEndpoint(server.Listener.Addr().String()),
+			otlptracehttp.WithInsecure(),
+			otlptracehttp.WithCompression(otlptracehttp.GzipCompression),
+		)
+		ctx := t.Context()
+		exporter, err := otlptrace.New(ctx, otlptracehttp.NewClient(opts...))
+		require.NoError(t, err)
+		defer func() { _ = exporter.Shutdown(ctx) }()
+		require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	}
+
+	export(otlptracehttp.WithCompressionThreshold(1 << 20))
+	export(otlptracehttp.WithCompressionThreshold(1), otlptracehttp.WithCompressionLevel(gzip.BestSpeed))
+	export(otlptracehttp.WithCompressionLevel(100))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"", "gzip", "gzip"}, encodings)
+}
+
+func TestUploadRawTraces(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+	)
+	ctx := t.Context()
+	require.NoError(t, client.Start(ctx))
+	defer func() { assert.NoError(t, client.Stop(ctx)) }()
+
+	span := &tracepb.Span{Name: "forwarded"}
+	payload, err := proto.Marshal(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			ScopeSpans: []*tracepb.ScopeSpans{{Spans: []*tracepb.Span{span}}},
+		}},
+	})
+	require.NoError(t, err)
+
+	raw, ok := client.(otlptrace.RawClient)
+	require.True(t, ok, "client does not implement RawClient")
+	require.NoError(t, raw.UploadRawTraces(ctx, payload))
+
+	got := mc.GetSpans()
+	require.Len(t, got, 1)
+	assert.Equal(t, "forwarded", got[0].Name)
+}
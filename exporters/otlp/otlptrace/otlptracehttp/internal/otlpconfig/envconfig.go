@@ -53,6 +53,11 @@ func getOptionsFromEnv() []GenericOption {
 				opts,
 				withEndpointScheme(u),
 				newSplitOption(func(cfg Config) Config {
+					if strings.EqualFold(u.Scheme, unixScheme) {
+						cfg.Traces.Endpoint = unixEndpoint(u)
+						cfg.Traces.URLPath = DefaultTracesPath
+						return cfg
+					}
 					cfg.Traces.Endpoint = u.Host
 					// For OTLP/HTTP endpoint URLs without a per-signal
 					// configuration, the passed endpoint is used as a base URL
@@ -67,6 +72,11 @@ func getOptionsFromEnv() []GenericOption {
 				opts,
 				withEndpointScheme(u),
 				newSplitOption(func(cfg Config) Config {
+					if strings.EqualFold(u.Scheme, unixScheme) {
+						cfg.Traces.Endpoint = unixEndpoint(u)
+						cfg.Traces.URLPath = DefaultTracesPath
+						return cfg
+					}
 					cfg.Traces.Endpoint = u.Host
 					// For endpoint URLs for OTLP/HTTP per-signal variables, the
 					// URL MUST be used as-is without any modification. The only
@@ -118,6 +128,10 @@ func withEndpointScheme(u *url.URL) GenericOption {
 
 func withEndpointForGRPC(u *url.URL) func(cfg Config) Config {
 	return func(cfg Config) Config {
+		if strings.EqualFold(u.Scheme, unixScheme) {
+			cfg.Traces.Endpoint = unixEndpoint(u)
+			return cfg
+		}
 		// For OTLP/gRPC endpoints, this is the target to which the
 		// exporter is going to send telemetry.
 		cfg.Traces.Endpoint = path.Join(u.Host, u.Path)
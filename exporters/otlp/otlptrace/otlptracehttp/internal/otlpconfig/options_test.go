@@ -123,6 +123,17 @@ func TestConfigs(t *testing.T) {
 				assert.False(t, c.Traces.Insecure)
 			},
 		},
+		{
+			name: "Test With Unix Socket Endpoint URL",
+			opts: []GenericOption{
+				WithEndpointURL("unix:///var/run/otel/collector.sock"),
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.Equal(t, "unix:///var/run/otel/collector.sock", c.Traces.Endpoint)
+				assert.Equal(t, "/v1/traces", c.Traces.URLPath)
+				assert.True(t, c.Traces.Insecure)
+			},
+		},
 		{
 			name: "Test With Invalid Endpoint URL",
 			opts: []GenericOption{
@@ -186,6 +197,33 @@ func TestConfigs(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "Test Environment Unix Socket Endpoint",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT": "unix:///var/run/otel/collector.sock",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.True(t, c.Traces.Insecure)
+				assert.Equal(t, "unix:///var/run/otel/collector.sock", c.Traces.Endpoint)
+				if !grpcOption {
+					assert.Equal(t, "/v1/traces", c.Traces.URLPath)
+				}
+			},
+		},
+		{
+			name: "Test Environment Signal Specific Unix Socket Endpoint",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_ENDPOINT":        "https://overrode.by.signal.specific/env/var",
+				"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "unix:collector.sock",
+			},
+			asserts: func(t *testing.T, c *Config, grpcOption bool) { //nolint:revive // interface compliance
+				assert.True(t, c.Traces.Insecure)
+				assert.Equal(t, "unix:collector.sock", c.Traces.Endpoint)
+				if !grpcOption {
+					assert.Equal(t, "/v1/traces", c.Traces.URLPath)
+				}
+			},
+		},
 		{
 			name: "Test Environment Signal Specific Endpoint",
 			env: map[string]string{
@@ -607,3 +645,22 @@ func TestCleanPath(t *testing.T) {
 		})
 	}
 }
+
+func TestUnixSocketPath(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		ok       bool
+	}{
+		{endpoint: "unix:///var/run/otel/collector.sock", want: "/var/run/otel/collector.sock", ok: true},
+		{endpoint: "unix:collector.sock", want: "collector.sock", ok: true},
+		{endpoint: "unix://", ok: false},
+		{endpoint: "localhost:4317", ok: false},
+		{endpoint: "unixhost:4317", ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := UnixSocketPath(tt.endpoint)
+		assert.Equal(t, tt.ok, ok, tt.endpoint)
+		assert.Equal(t, tt.want, got, tt.endpoint)
+	}
+}
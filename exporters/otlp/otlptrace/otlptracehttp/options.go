@@ -11,6 +11,7 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/transport"
 )
 
 // Compression describes the compression used for payloads sent to the
@@ -91,6 +92,11 @@ func WithEndpoint(endpoint string) Option {
 // If both this option and WithEndpoint are used, the last used option will
 // take precedence.
 //
+// A Unix domain socket is targeted using the "unix" scheme, e.g.
+// "unix:///var/run/otel/collector.sock". Requests sent to a Unix domain
+// socket do not use client security and are sent to the default traces path.
+// Windows named pipes are not supported as endpoints.
+//
 // If an invalid URL is provided, the default value will be kept.
 //
 // The path of the provided URL is used as-is; with one exception: if the URL has
@@ -111,6 +117,32 @@ func WithCompression(compression Compression) Option {
 	return wrappedOption{otlpconfig.WithCompression(otlpconfig.Compression(compression))}
 }
 
+// WithCompressionLevel sets the gzip compression level the Exporter will use
+// to compress the HTTP body. The level needs to be gzip.HuffmanOnly,
+// gzip.DefaultCompression, or between gzip.NoCompression and
+// gzip.BestCompression. An invalid level is ignored.
+//
+// Lower levels use less CPU at the cost of larger payloads.
+//
+// By default, if this option is not passed, gzip.DefaultCompression is used.
+//
+// This option has no effect unless gzip compression is used.
+func WithCompressionLevel(level int) Option {
+	return wrappedOption{otlpconfig.WithCompressionLevel(level)}
+}
+
+// WithCompressionThreshold sets the minimum size, in bytes, of a serialized
+// export request for it to be compressed. Smaller requests are sent
+// uncompressed, as compressing them costs more CPU than it saves bandwidth.
+//
+// By default, if this option is not passed, or size is less than or equal to
+// zero, all requests are compressed.
+//
+// This option has no effect unless compression is used.
+func WithCompressionThreshold(size int) Option {
+	return wrappedOption{otlpconfig.WithCompressionThreshold(size)}
+}
+
 // WithURLPath allows one to override the default URL path used
 // for sending traces. If unset, default ("/v1/traces") will be used.
 func WithURLPath(urlPath string) Option {
@@ -184,3 +216,34 @@ func WithProxy(pf HTTPTransportProxyFunc) Option {
 func WithHTTPClient(c *http.Client) Option {
 	return wrappedOption{otlpconfig.WithHTTPClient(c)}
 }
+
+// WithHTTPTransport sets the transport used by the exporter to send HTTP
+// requests.
+//
+// Passing the same transport to the trace, metric, and log OTLP HTTP exporters
+// makes them share a single pool of connections. This reduces the number of
+// connections and TLS handshakes of a process exporting all signals to the
+// same collector. Use [NewHTTPTransport] to create such a transport.
+//
+// This option will take precedence over [WithProxy], [WithTLSClientConfig]
+// options as well as OTEL_EXPORTER_OTLP_CERTIFICATE and
+// OTEL_EXPORTER_OTLP_TRACES_CERTIFICATE environment variables. The timeout set
+// with [WithTimeout] still applies. [WithHTTPClient] takes precedence over
+// this option.
+//
+// The transport is used as is, an endpoint using a Unix domain socket is not
+// supported.
+func WithHTTPTransport(rt http.RoundTripper) Option {
+	return wrappedOption{otlpconfig.WithHTTPTransport(rt)}
+}
+
+// NewHTTPTransport returns an [http.Transport] that can be passed to the
+// WithHTTPTransport option of the trace, metric, and log OTLP HTTP exporters
+// so they share a single pool of connections. It connects to the collector
+// using tlsCfg, which is cloned, and the proxy returned by proxy. If proxy is
+// nil, [http.ProxyFromEnvironment] is used.
+//
+// The returned transport can be further configured before it is used.
+func NewHTTPTransport(tlsCfg *tls.Config, proxy HTTPTransportProxyFunc) *http.Transport {
+	return transport.New(tlsCfg, proxy)
+}
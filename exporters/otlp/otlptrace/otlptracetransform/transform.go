@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlptracetransform converts spans of the OpenTelemetry SDK into
+// their OTLP representation.
+//
+// It provides the conversion used by the OTLP trace exporters so custom
+// exporters, e.g. ones writing spans in a custom wire format or to an
+// in-process sink, do not need to reimplement the grouping of spans by
+// resource and instrumentation scope or the translation of attributes. The
+// returned OTLP structures can be serialized with
+// [google.golang.org/protobuf/proto.Marshal] or
+// [google.golang.org/protobuf/encoding/protojson.Marshal], or further
+// transformed.
+package otlptracetransform
+
+import (
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Spans transforms spans into OTLP ResourceSpans. Spans are grouped by their
+// resource and instrumentation scope. The order of the returned
+// ResourceSpans is unspecified, but the spans of a scope keep their order in
+// spans. Nil spans are ignored. If spans is empty, nil is returned.
+func Spans(spans []tracesdk.ReadOnlySpan) []*tracepb.ResourceSpans {
+	return tracetransform.Spans(spans)
+}
+
+// Resource transforms r into an OTLP Resource. If r is nil, nil is returned.
+func Resource(r *resource.Resource) *resourcepb.Resource {
+	return tracetransform.Resource(r)
+}
+
+// InstrumentationScope transforms s into an OTLP InstrumentationScope. If s
+// is the zero value, nil is returned.
+func InstrumentationScope(s instrumentation.Scope) *commonpb.InstrumentationScope {
+	return tracetransform.InstrumentationScope(s)
+}
+
+// KeyValues transforms attrs into OTLP KeyValues. If attrs is empty, nil is
+// returned.
+func KeyValues(attrs []attribute.KeyValue) []*commonpb.KeyValue {
+	return tracetransform.KeyValues(attrs)
+}
+
+// Value transforms v into an OTLP AnyValue.
+func Value(v attribute.Value) *commonpb.AnyValue {
+	return tracetransform.Value(v)
+}
@@ -0,0 +1,82 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracetransform_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracetransform"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSpans(t *testing.T) {
+	resA := resource.NewSchemaless(attribute.String("service.name", "a"))
+	resB := resource.NewSchemaless(attribute.String("service.name", "b"))
+	scope1 := instrumentation.Scope{Name: "scope1"}
+	scope2 := instrumentation.Scope{Name: "scope2", Version: "v1"}
+
+	spans := tracetest.SpanStubs{
+		{Name: "a1", Resource: resA, InstrumentationScope: scope1},
+		{Name: "a2", Resource: resA, InstrumentationScope: scope1},
+		{Name: "a3", Resource: resA, InstrumentationScope: scope2},
+		{Name: "b1", Resource: resB, InstrumentationScope: scope1},
+	}.Snapshots()
+
+	got := otlptracetransform.Spans(append(spans, nil))
+	require.Len(t, got, 2)
+	// The order of resources is unspecified.
+	if got[0].Resource.Attributes[0].Value.GetStringValue() != "a" {
+		got[0], got[1] = got[1], got[0]
+	}
+
+	assert.Equal(t, otlptracetransform.Resource(resA), got[0].Resource)
+	require.Len(t, got[0].ScopeSpans, 2)
+	assert.Equal(t, "scope1", got[0].ScopeSpans[0].Scope.Name)
+	require.Len(t, got[0].ScopeSpans[0].Spans, 2)
+	assert.Equal(t, "a1", got[0].ScopeSpans[0].Spans[0].Name)
+	assert.Equal(t, "a2", got[0].ScopeSpans[0].Spans[1].Name)
+	assert.Equal(t, "v1", got[0].ScopeSpans[1].Scope.Version)
+
+	assert.Equal(t, otlptracetransform.Resource(resB), got[1].Resource)
+	require.Len(t, got[1].ScopeSpans, 1)
+	assert.Equal(t, "b1", got[1].ScopeSpans[0].Spans[0].Name)
+
+	// The result can be serialized in a custom wire format.
+	_, err := proto.Marshal(got[0])
+	require.NoError(t, err)
+
+	assert.Nil(t, otlptracetransform.Spans([]tracesdk.ReadOnlySpan{}))
+}
+
+func TestKeyValues(t *testing.T) {
+	got := otlptracetransform.KeyValues([]attribute.KeyValue{
+		attribute.String("str", "v"),
+		attribute.Int("int", 1),
+	})
+	want := []*commonpb.KeyValue{
+		{Key: "str", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "v"}}},
+		{Key: "int", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 1}}},
+	}
+	assert.Equal(t, want, got)
+	assert.Nil(t, otlptracetransform.KeyValues(nil))
+
+	assert.Equal(t, want[1].Value, otlptracetransform.Value(attribute.IntValue(1)))
+}
+
+func TestResourceAndScope(t *testing.T) {
+	assert.Nil(t, otlptracetransform.Resource(nil))
+	assert.Nil(t, otlptracetransform.InstrumentationScope(instrumentation.Scope{}))
+
+	scope := otlptracetransform.InstrumentationScope(instrumentation.Scope{Name: "n", Version: "v"})
+	assert.Equal(t, &commonpb.InstrumentationScope{Name: "n", Version: "v"}, scope)
+}
@@ -125,8 +125,7 @@ func WithTranslationStrategy(strategy otlptranslator.TranslationStrategyOption)
 }
 
 // WithoutUnits disables exporter's addition of unit suffixes to metric names,
-// and will also prevent unit comments from being added in OpenMetrics once
-// unit comments are supported.
+// and will also prevent unit comments from being added in OpenMetrics.
 //
 // By default, metric names include a unit suffix to follow Prometheus naming
 // conventions. For example, the counter metric request.duration, with unit
@@ -8,4 +8,17 @@
 // The Prometheus exporter ignores metrics from the Prometheus bridge. To
 // export these metrics, simply register them directly with the Prometheus
 // Handler.
+//
+// The metrics are exposed with their unit and, for monotonic sums and
+// histograms, their start time as created timestamp. To serve them in the
+// OpenMetrics format, including the UNIT metadata and the _created series,
+// to the scrapers requesting it, enable OpenMetrics in the handler:
+//
+//	promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+//		EnableOpenMetrics:                   true,
+//		EnableOpenMetricsTextCreatedSamples: true,
+//	})
+//
+// OpenMetrics info-type metrics are not produced. The target_info metric is
+// exposed as a gauge.
 package prometheus
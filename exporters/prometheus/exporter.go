@@ -52,6 +52,8 @@ var metricsPool = sync.Pool{
 // interface for easy instantiation with a MeterProvider.
 type Exporter struct {
 	metric.Reader
+
+	collector *collector
 }
 
 // MarshalLog returns logging data about the Exporter.
@@ -75,6 +77,29 @@ func (e *Exporter) MarshalLog() any {
 
 var _ metric.Reader = &Exporter{}
 
+// Gatherer returns a [prometheus.Gatherer] gathering the metrics of g, the
+// Gatherer of the Registerer the Exporter is registered with, and setting
+// the type of the histograms marked as gauges exported by the Exporter, see
+// the IsGauge field of [metricdata.Histogram], to GaugeHistogram.
+//
+// The Prometheus client library gathers the metrics of all histograms as
+// histograms. The metrics need to be gathered with the returned Gatherer for
+// gauge histograms to be exposed as such. For example:
+//
+//	handler := promhttp.HandlerFor(exporter.Gatherer(prometheus.DefaultGatherer), promhttp.HandlerOpts{})
+//
+// Gauge histograms are exposed as histograms with the classic Prometheus
+// text format, which does not support them.
+func (e *Exporter) Gatherer(g prometheus.Gatherer) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		mfs, err := g.Gather()
+		if e.collector != nil {
+			e.collector.setGaugeHistogramTypes(mfs)
+		}
+		return mfs, err
+	})
+}
+
 // keyVals is used to store resource attribute key value pairs.
 type keyVals struct {
 	keys []string
@@ -149,7 +174,8 @@ func New(opts ...Option) (*Exporter, error) {
 	}
 
 	e := &Exporter{
-		Reader: reader,
+		Reader:    reader,
+		collector: collector,
 	}
 
 	var err error
@@ -204,7 +230,7 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 		}
 	}
 
-	global.Debug("Prometheus exporter export", "Data", metrics)
+	global.Component(global.ComponentMetricReader).Debug("Prometheus exporter export", "Data", metrics)
 
 	// Initialize (once) targetInfo and disableTargetInfo.
 	func() {
@@ -300,6 +326,7 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 			if help != "" {
 				m.Description = help
 			}
+			m.Unit = c.openMetricsUnit(name, m.Unit)
 
 			switch v := m.Data.(type) {
 			case metricdata.Histogram[int64]:
@@ -403,7 +430,7 @@ func addExponentialHistogramMetric[N int64 | float64](
 		keys = append(keys, kv.keys...)
 		values = append(values, kv.vals...)
 
-		desc := prometheus.NewDesc(name, m.Description, keys, nil)
+		desc := newDesc(name, m, keys)
 
 		// Prometheus native histograms support scales in the range [-4, 8]
 		scale := dp.Scale
@@ -505,7 +532,7 @@ func addHistogramMetric[N int64 | float64](
 		keys = append(keys, kv.keys...)
 		values = append(values, kv.vals...)
 
-		desc := prometheus.NewDesc(name, m.Description, keys, nil)
+		desc := newDesc(name, m, keys)
 		buckets := make(map[float64]uint64, len(dp.Bounds))
 
 		cumulativeCount := uint64(0)
@@ -513,7 +540,21 @@ func addHistogramMetric[N int64 | float64](
 			cumulativeCount += dp.BucketCounts[i]
 			buckets[bound] = cumulativeCount
 		}
-		m, e := prometheus.NewConstHistogram(desc, dp.Count, float64(dp.Sum), buckets, values...)
+		var m prometheus.Metric
+		// A gauge histogram has no created timestamp, its start time is the
+		// time of the last collection.
+		if dp.StartTime.IsZero() || histogram.IsGauge {
+			m, e = prometheus.NewConstHistogram(desc, dp.Count, float64(dp.Sum), buckets, values...)
+		} else {
+			m, e = prometheus.NewConstHistogramWithCreatedTimestamp(
+				desc,
+				dp.Count,
+				float64(dp.Sum),
+				buckets,
+				dp.StartTime,
+				values...,
+			)
+		}
 		if e != nil {
 			reportError(ch, desc, e)
 			err = errors.Join(err, fmt.Errorf("failed to NewConstMetric for histogram.DataPoints %d: %w", j, e))
@@ -558,8 +599,13 @@ func addSumMetric[N int64 | float64](
 		keys = append(keys, kv.keys...)
 		values = append(values, kv.vals...)
 
-		desc := prometheus.NewDesc(name, m.Description, keys, nil)
-		m, e := prometheus.NewConstMetric(desc, valueType, float64(dp.Value), values...)
+		desc := newDesc(name, m, keys)
+		var m prometheus.Metric
+		if valueType == prometheus.CounterValue && !dp.StartTime.IsZero() {
+			m, e = prometheus.NewConstMetricWithCreatedTimestamp(desc, valueType, float64(dp.Value), dp.StartTime, values...)
+		} else {
+			m, e = prometheus.NewConstMetric(desc, valueType, float64(dp.Value), values...)
+		}
 		if e != nil {
 			reportError(ch, desc, e)
 			err = errors.Join(err, fmt.Errorf("failed to NewConstMetric for sum.DataPoints %d: %w", i, e))
@@ -603,7 +649,7 @@ func addGaugeMetric[N int64 | float64](
 		keys = append(keys, kv.keys...)
 		values = append(values, kv.vals...)
 
-		desc := prometheus.NewDesc(name, m.Description, keys, nil)
+		desc := newDesc(name, m, keys)
 		m, e := prometheus.NewConstMetric(desc, prometheus.GaugeValue, float64(dp.Value), values...)
 		if e != nil {
 			reportError(ch, desc, e)
@@ -616,6 +662,17 @@ func addGaugeMetric[N int64 | float64](
 	}
 }
 
+// newDesc returns the Desc of the metric name translated from m with the
+// variable labels keys. The unit of m is used as the unit of the Desc if it is
+// not empty.
+func newDesc(name string, m metricdata.Metrics, keys []string) *prometheus.Desc {
+	var opts []prometheus.DescOpt
+	if m.Unit != "" {
+		opts = append(opts, prometheus.WithUnit(m.Unit))
+	}
+	return prometheus.V2.NewDesc(name, m.Description, prometheus.UnconstrainedLabels(keys), nil, opts...)
+}
+
 // getAttrs converts the attribute.Set to two lists of matching Prometheus-style
 // keys and values.
 func getAttrs(attrs attribute.Set, labelNamer otlptranslator.LabelNamer) ([]string, []string, error) {
@@ -729,12 +786,31 @@ func (c *collector) getName(m metricdata.Metrics) (string, error) {
 	return c.metricNamer.Build(translatorMetric)
 }
 
+// openMetricsUnit returns the OpenMetrics unit of the metric name translated
+// from a metric with the unit. OpenMetrics requires the name of a metric to
+// end with its unit, so an empty string is returned if name does not, e.g.
+// when WithoutUnits is used.
+func (c *collector) openMetricsUnit(name, unit string) string {
+	if c.withoutUnits {
+		return ""
+	}
+	u := c.unitNamer.Build(unit)
+	if u == "" || !strings.HasSuffix(strings.TrimSuffix(name, "_total"), "_"+u) {
+		return ""
+	}
+	return u
+}
+
 func (*collector) metricType(m metricdata.Metrics) *dto.MetricType {
 	switch v := m.Data.(type) {
-	case metricdata.ExponentialHistogram[int64], metricdata.ExponentialHistogram[float64]:
-		return dto.MetricType_HISTOGRAM.Enum()
-	case metricdata.Histogram[int64], metricdata.Histogram[float64]:
-		return dto.MetricType_HISTOGRAM.Enum()
+	case metricdata.ExponentialHistogram[int64]:
+		return histogramType(v.IsGauge)
+	case metricdata.ExponentialHistogram[float64]:
+		return histogramType(v.IsGauge)
+	case metricdata.Histogram[int64]:
+		return histogramType(v.IsGauge)
+	case metricdata.Histogram[float64]:
+		return histogramType(v.IsGauge)
 	case metricdata.Sum[float64]:
 		if v.IsMonotonic {
 			return dto.MetricType_COUNTER.Enum()
@@ -751,6 +827,14 @@ func (*collector) metricType(m metricdata.Metrics) *dto.MetricType {
 	return nil
 }
 
+// histogramType returns the type of a histogram, a gauge histogram if isGauge.
+func histogramType(isGauge bool) *dto.MetricType {
+	if isGauge {
+		return dto.MetricType_GAUGE_HISTOGRAM.Enum()
+	}
+	return dto.MetricType_HISTOGRAM.Enum()
+}
+
 // namingMetricType provides the metric type for naming purposes.
 func (c *collector) namingMetricType(m metricdata.Metrics) otlptranslator.MetricType {
 	switch v := m.Data.(type) {
@@ -828,6 +912,22 @@ func (c *collector) validateMetrics(name, description string, metricType *dto.Me
 	return false, ""
 }
 
+// setGaugeHistogramTypes sets the type of the families of mfs exported by c
+// as gauge histograms to GaugeHistogram.
+func (c *collector) setGaugeHistogramTypes(mfs []*dto.MetricFamily) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, mf := range mfs {
+		if mf.GetType() != dto.MetricType_HISTOGRAM {
+			continue
+		}
+		if f, ok := c.metricFamilies[mf.GetName()]; ok && f.GetType() == dto.MetricType_GAUGE_HISTOGRAM {
+			mf.Type = dto.MetricType_GAUGE_HISTOGRAM.Enum()
+		}
+	}
+}
+
 func addExemplars[N int64 | float64](
 	m prometheus.Metric,
 	exemplars []metricdata.Exemplar[N],
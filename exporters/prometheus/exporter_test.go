@@ -3052,3 +3052,147 @@ func (m *errMeter) Int64Counter(string, ...otelmetric.Int64CounterOption) (otelm
 func (m *errMeter) Float64Histogram(string, ...otelmetric.Float64HistogramOption) (otelmetric.Float64Histogram, error) {
 	return nil, m.err
 }
+
+func TestOpenMetrics(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		options []Option
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "with units",
+			want: []string{
+				"# TYPE requests_seconds counter\n",
+				"# UNIT requests_seconds seconds\n",
+				"requests_seconds_created{",
+				"# TYPE latency_milliseconds histogram\n",
+				"# UNIT latency_milliseconds milliseconds\n",
+				"latency_milliseconds_created{",
+				"# TYPE temperature_celsius gauge\n",
+				"# UNIT temperature_celsius celsius\n",
+			},
+			notWant: []string{"temperature_celsius_created"},
+		},
+		{
+			name:    "without units",
+			options: []Option{WithoutUnits()},
+			want: []string{
+				"# TYPE requests counter\n",
+				"requests_created{",
+				"# TYPE latency histogram\n",
+				"latency_created{",
+			},
+			notWant: []string{"# UNIT"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := t.Context()
+			registry := prometheus.NewRegistry()
+			exporter, err := New(append(tc.options, WithRegisterer(registry), WithoutTargetInfo())...)
+			require.NoError(t, err)
+
+			provider := metric.NewMeterProvider(metric.WithReader(exporter))
+			meter := provider.Meter("testmeter")
+
+			counter, err := meter.Float64Counter("requests", otelmetric.WithUnit("s"))
+			require.NoError(t, err)
+			counter.Add(ctx, 5)
+
+			histogram, err := meter.Float64Histogram("latency", otelmetric.WithUnit("ms"))
+			require.NoError(t, err)
+			histogram.Record(ctx, 23)
+
+			gauge, err := meter.Float64Gauge("temperature", otelmetric.WithUnit("Cel"))
+			require.NoError(t, err)
+			gauge.Record(ctx, 21)
+
+			h := promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+				EnableOpenMetrics:                   true,
+				EnableOpenMetricsTextCreatedSamples: true,
+			})
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/metrics", http.NoBody)
+			req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+			h.ServeHTTP(rr, req)
+
+			require.Equal(t, http.StatusOK, rr.Code)
+			assert.Contains(t, rr.Header().Get("Content-Type"), "application/openmetrics-text")
+			body := rr.Body.String()
+			for _, w := range tc.want {
+				assert.Contains(t, body, w)
+			}
+			for _, nw := range tc.notWant {
+				assert.NotContains(t, body, nw)
+			}
+		})
+	}
+}
+
+func TestGaugeHistogram(t *testing.T) {
+	ctx := t.Context()
+	registry := prometheus.NewRegistry()
+	exporter, err := New(WithRegisterer(registry), WithoutTargetInfo(), WithoutScopeInfo())
+	require.NoError(t, err)
+
+	provider := metric.NewMeterProvider(
+		metric.WithReader(exporter),
+		metric.WithView(metric.NewView(
+			metric.Instrument{Name: "queue.size"},
+			metric.Stream{
+				Aggregation:    metric.AggregationExplicitBucketHistogram{Boundaries: []float64{10, 100}},
+				GaugeHistogram: true,
+			},
+		)),
+	)
+	meter := provider.Meter("testmeter")
+
+	_, err = meter.Int64ObservableGauge(
+		"queue.size",
+		otelmetric.WithInt64Callback(func(_ context.Context, o otelmetric.Int64Observer) error {
+			o.Observe(5, otelmetric.WithAttributes(attribute.String("queue", "a")))
+			o.Observe(50, otelmetric.WithAttributes(attribute.String("queue", "b")))
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+	histogram, err := meter.Float64Histogram("latency")
+	require.NoError(t, err)
+	histogram.Record(ctx, 23)
+
+	gather := func(g prometheus.Gatherer) map[string]dto.MetricType {
+		mfs, err := g.Gather()
+		require.NoError(t, err)
+		types := make(map[string]dto.MetricType, len(mfs))
+		for _, mf := range mfs {
+			types[mf.GetName()] = mf.GetType()
+		}
+		return types
+	}
+
+	// The Prometheus client library gathers gauge histograms as histograms.
+	assert.Equal(t, map[string]dto.MetricType{
+		"queue_size": dto.MetricType_HISTOGRAM,
+		"latency":    dto.MetricType_HISTOGRAM,
+	}, gather(registry))
+
+	g := exporter.Gatherer(registry)
+	for range 2 {
+		assert.Equal(t, map[string]dto.MetricType{
+			"queue_size": dto.MetricType_GAUGE_HISTOGRAM,
+			"latency":    dto.MetricType_HISTOGRAM,
+		}, gather(g))
+	}
+
+	h := promhttp.HandlerFor(g, promhttp.HandlerOpts{EnableOpenMetrics: true})
+	rr := httptest.NewRecorder()
+	req := httptest.NewRequestWithContext(ctx, http.MethodGet, "/metrics", http.NoBody)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	h.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	assert.Contains(t, body, "# TYPE queue_size gaugehistogram\n")
+	assert.Contains(t, body, "# TYPE latency histogram\n")
+	// Only the observations of the last collection are aggregated.
+	assert.Contains(t, body, `queue_size_bucket{queue="b",le="+Inf"} 1`)
+}
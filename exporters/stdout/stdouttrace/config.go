@@ -19,6 +19,14 @@ type config struct {
 	// Writer is the destination.  If not set, os.Stdout is used.
 	Writer io.Writer
 
+	// WriterFactory returns the destination of each export. If set, it
+	// is used instead of Writer.
+	WriterFactory func() io.Writer
+
+	// File is the rotating file destination. If set, it is used instead of
+	// Writer.
+	File *fileConfig
+
 	// PrettyPrint will encode the output into readable JSON. Default is
 	// false.
 	PrettyPrint bool
@@ -57,6 +65,65 @@ type writerOption struct {
 
 func (o writerOption) apply(cfg config) config {
 	cfg.Writer = o.W
+	cfg.WriterFactory, cfg.File = nil, nil
+	return cfg
+}
+
+// WithWriterFactory sets the function returning the export stream destination
+// of each export. The spans of an export are all written to the io.Writer
+// returned by f for that export, which is closed once they are written if it
+// implements io.Closer. This is useful to write the spans of different
+// exports, e.g. the ones of each test run, to separate files. If f returns
+// nil, the spans of the export are dropped and an error is returned.
+//
+// This option overrides, and is overridden by, the WithWriter and
+// WithRotatingFile options according to their order. If f is nil, this
+// option has no effect.
+func WithWriterFactory(f func() io.Writer) Option {
+	return writerFactoryOption{f}
+}
+
+type writerFactoryOption struct {
+	F func() io.Writer
+}
+
+func (o writerFactoryOption) apply(cfg config) config {
+	if o.F != nil {
+		cfg.WriterFactory = o.F
+		cfg.Writer, cfg.File = nil, nil
+	}
+	return cfg
+}
+
+// fileConfig is the configuration of a rotating file destination.
+type fileConfig struct {
+	Path       string
+	MaxSize    int64
+	MaxBackups int
+}
+
+// WithRotatingFile sets the export stream destination to the file at path.
+// The file is created if it does not exist, and appended to otherwise.
+//
+// Once writing a span would make the file larger than maxSize bytes, the file
+// is rotated: it is renamed path.1, the previous path.1 is renamed path.2, and
+// so on up to path.<maxBackups>, and a new file is created at path. Older
+// files are removed. If maxSize is not positive, the file is never rotated.
+//
+// The file is closed when the Exporter is shut down.
+//
+// This option overrides, and is overridden by, the WithWriter and
+// WithWriterFactory options according to their order.
+func WithRotatingFile(path string, maxSize int64, maxBackups int) Option {
+	return fileOption{Path: path, MaxSize: maxSize, MaxBackups: maxBackups}
+}
+
+type fileOption fileConfig
+
+func (o fileOption) apply(cfg config) config {
+	c := fileConfig(o)
+	cfg.File = &c
+	cfg.Writer, cfg.WriterFactory = nil, nil
 	return cfg
 }
 
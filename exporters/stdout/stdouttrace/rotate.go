@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdouttrace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// rotatingFile is an io.WriteCloser writing to a file that is rotated once
+// it reaches a maximum size.
+//
+// When rotated, the file at path is renamed path.1, the file path.1 is
+// renamed path.2, and so on. The file path.<maxBackups> is removed.
+type rotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	f := &rotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// open opens the file at f.path for appending.
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return errors.Join(err, file.Close())
+	}
+	f.file, f.size = file, info.Size()
+	return nil
+}
+
+// Write writes p to the file, rotating it first if p would make the file
+// exceed its maximum size. A single write larger than the maximum size is
+// written to its own file.
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// rotate closes the file, shifts it and its backups, and opens a new file.
+func (f *rotatingFile) rotate() error {
+	err := f.file.Close()
+	f.file = nil
+	if err != nil {
+		return err
+	}
+
+	backup := func(i int) string { return fmt.Sprintf("%s.%d", f.path, i) }
+	if f.maxBackups <= 0 {
+		err = os.Remove(f.path)
+	} else {
+		err = os.Remove(backup(f.maxBackups))
+		if errors.Is(err, os.ErrNotExist) {
+			err = nil
+		}
+		for i := f.maxBackups - 1; i > 0 && err == nil; i-- {
+			err = os.Rename(backup(i), backup(i+1))
+			if errors.Is(err, os.ErrNotExist) {
+				err = nil
+			}
+		}
+		if err == nil {
+			err = os.Rename(f.path, backup(1))
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to rotate %s: %w", f.path, err)
+	}
+	return f.open()
+}
+
+// Close closes the file. Writes after Close return an error.
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdouttrace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out")
+	require.NoError(t, os.WriteFile(path, []byte("old\n"), 0o600))
+
+	f, err := newRotatingFile(path, 8, 2)
+	require.NoError(t, err)
+	for _, s := range []string{"ab\n", "cd\n", "ef\n", "gh\n"} {
+		_, err := f.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Close())
+	require.NoError(t, f.Close(), "Close is idempotent")
+
+	_, err = f.Write([]byte("ij\n"))
+	assert.ErrorIs(t, err, os.ErrClosed)
+
+	read := func(name string) string {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		return string(data)
+	}
+	// The existing file is appended to until the maximum size is reached.
+	assert.Equal(t, "gh\n", read(path))
+	assert.Equal(t, "cd\nef\n", read(path+".1"))
+	assert.Equal(t, "old\nab\n", read(path+".2"))
+}
+
+func TestRotatingFileNoBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out")
+	f, err := newRotatingFile(path, 4, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	for _, s := range []string{"ab\n", "cd\n"} {
+		_, err := f.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "cd\n", string(data))
+	assert.NoFileExists(t, path+".1")
+}
+
+func TestRotatingFileUnlimited(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out")
+	f, err := newRotatingFile(path, 0, 1)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	for _, s := range []string{"ab\n", "cd\n"} {
+		_, err := f.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	assert.NoFileExists(t, path+".1")
+}
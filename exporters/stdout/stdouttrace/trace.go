@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -19,20 +20,32 @@ import (
 
 var zeroTime time.Time
 
+// errNilWriter is returned by an export if the writer factory returned a nil
+// io.Writer.
+var errNilWriter = errors.New("writer factory returned a nil writer")
+
 var _ trace.SpanExporter = &Exporter{}
 
 // New creates an Exporter with the passed options.
 func New(options ...Option) (*Exporter, error) {
 	cfg := newConfig(options...)
 
-	enc := json.NewEncoder(cfg.Writer)
-	if cfg.PrettyPrint {
-		enc.SetIndent("", "\t")
+	exporter := &Exporter{
+		writerFactory: cfg.WriterFactory,
+		prettyPrint:   cfg.PrettyPrint,
+		timestamps:    cfg.Timestamps,
 	}
 
-	exporter := &Exporter{
-		encoder:    enc,
-		timestamps: cfg.Timestamps,
+	w := cfg.Writer
+	if cfg.File != nil {
+		f, err := newRotatingFile(cfg.File.Path, cfg.File.MaxSize, cfg.File.MaxBackups)
+		if err != nil {
+			return nil, err
+		}
+		w, exporter.closer = f, f
+	}
+	if w != nil {
+		exporter.encoder = exporter.newEncoder(w)
 	}
 
 	var err error
@@ -42,9 +55,14 @@ func New(options ...Option) (*Exporter, error) {
 
 // Exporter is an implementation of trace.SpanSyncer that writes spans to stdout.
 type Exporter struct {
-	encoder    *json.Encoder
-	encoderMu  sync.Mutex
-	timestamps bool
+	encoder       *json.Encoder
+	encoderMu     sync.Mutex
+	writerFactory func() io.Writer
+	prettyPrint   bool
+	timestamps    bool
+
+	// closer closes the destination owned by the exporter, if any.
+	closer io.Closer
 
 	stoppedMu sync.RWMutex
 	stopped   bool
@@ -78,6 +96,23 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan)
 
 	e.encoderMu.Lock()
 	defer e.encoderMu.Unlock()
+
+	enc := e.encoder
+	if e.writerFactory != nil {
+		w := e.writerFactory()
+		if w == nil {
+			return errNilWriter
+		}
+		if c, ok := w.(io.Closer); ok {
+			defer func() {
+				if e := c.Close(); e != nil {
+					err = errors.Join(err, fmt.Errorf("failed to close writer: %w", e))
+				}
+			}()
+		}
+		enc = e.newEncoder(w)
+	}
+
 	for i := range stubs {
 		stub := &stubs[i]
 		// Remove timestamps
@@ -91,7 +126,7 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan)
 		}
 
 		// Encode span stubs, one by one
-		if e := e.encoder.Encode(stub); e != nil {
+		if e := enc.Encode(stub); e != nil {
 			err = errors.Join(err, fmt.Errorf("failed to encode span %d: %w", i, e))
 			continue
 		}
@@ -100,13 +135,29 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []trace.ReadOnlySpan)
 	return err
 }
 
-// Shutdown is called to stop the exporter, it performs no action.
+// newEncoder returns a JSON encoder writing to w.
+func (e *Exporter) newEncoder(w io.Writer) *json.Encoder {
+	enc := json.NewEncoder(w)
+	if e.prettyPrint {
+		enc.SetIndent("", "\t")
+	}
+	return enc
+}
+
+// Shutdown is called to stop the exporter. It closes the file the exporter
+// writes to if configured with WithRotatingFile, it performs no other
+// action.
 func (e *Exporter) Shutdown(context.Context) error {
 	e.stoppedMu.Lock()
 	e.stopped = true
 	e.stoppedMu.Unlock()
 
-	return nil
+	if e.closer == nil {
+		return nil
+	}
+	e.encoderMu.Lock()
+	defer e.encoderMu.Unlock()
+	return e.closer.Close()
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this Exporter.
@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"io"
 	"math"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -239,6 +241,77 @@ func TestExporterShutdownNoError(t *testing.T) {
 	}
 }
 
+type closingBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closingBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestExporterWriterFactory(t *testing.T) {
+	var bufs []*closingBuffer
+	e, err := stdouttrace.New(
+		stdouttrace.WithWriter(io.Discard),
+		stdouttrace.WithWriterFactory(func() io.Writer {
+			b := new(closingBuffer)
+			bufs = append(bufs, b)
+			return b
+		}),
+		stdouttrace.WithoutTimestamps(),
+	)
+	require.NoError(t, err)
+
+	spans := tracetest.SpanStubs{{Name: "a"}, {Name: "b"}}.Snapshots()
+	require.NoError(t, e.ExportSpans(t.Context(), spans[:1]))
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+
+	require.Len(t, bufs, 2, "one writer per export")
+	assert.True(t, bufs[0].closed, "writer not closed")
+	assert.True(t, bufs[1].closed, "writer not closed")
+	assert.Equal(t, 1, bytes.Count(bufs[0].Bytes(), []byte("\n")))
+	assert.Equal(t, 2, bytes.Count(bufs[1].Bytes(), []byte("\n")))
+}
+
+func TestExporterWriterFactoryNil(t *testing.T) {
+	e, err := stdouttrace.New(stdouttrace.WithWriterFactory(func() io.Writer { return nil }))
+	require.NoError(t, err)
+
+	spans := tracetest.SpanStubs{{Name: "a"}}.Snapshots()
+	assert.NotPanics(t, func() {
+		assert.ErrorContains(t, e.ExportSpans(t.Context(), spans), "nil writer")
+	})
+}
+
+func TestExporterRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	e, err := stdouttrace.New(stdouttrace.WithRotatingFile(path, 1, 1))
+	require.NoError(t, err)
+
+	spans := tracetest.SpanStubs{{Name: "a"}, {Name: "b"}, {Name: "c"}}.Snapshots()
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	require.NoError(t, e.Shutdown(t.Context()))
+
+	read := func(name string) string {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		return string(data)
+	}
+	// Each span exceeds the maximum size and is written to its own file,
+	// only one backup is kept.
+	assert.Contains(t, read(path), `"Name":"c"`)
+	assert.Contains(t, read(path+".1"), `"Name":"b"`)
+	assert.NoFileExists(t, path+".2")
+}
+
+func TestExporterRotatingFileError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "traces.json")
+	_, err := stdouttrace.New(stdouttrace.WithRotatingFile(path, 0, 0))
+	assert.Error(t, err)
+}
+
 func TestObservability(t *testing.T) {
 	defaultCallExportSpans := func(t *testing.T, exporter *stdouttrace.Exporter) {
 		require.NoError(t, exporter.ExportSpans(t.Context(), tracetest.SpanStubs{
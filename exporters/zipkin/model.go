@@ -196,6 +196,7 @@ func attributeToStringPair(kv attribute.KeyValue) (string, string) {
 // extraZipkinTagsLen is a count of tags that may be added to every outgoing span.
 var extraZipkinTagsLen = len([]attribute.Key{
 	semconv.OTelStatusCodeKey,
+	semconv.OTelStatusDescriptionKey,
 	semconv.OTelScopeNameKey,
 	semconv.OTelScopeVersionKey,
 })
@@ -223,6 +224,11 @@ func toZipkinTags(data tracesdk.ReadOnlySpan) map[string]string {
 		m["error"] = data.Status().Description
 	} else {
 		delete(m, "error")
+		if desc := data.Status().Description; desc != "" {
+			// An Ok status can have a description if the TracerProvider
+			// is configured to record it.
+			m[string(semconv.OTelStatusDescriptionKey)] = desc
+		}
 	}
 
 	if is := data.InstrumentationScope(); is.Name != "" {
@@ -1108,6 +1108,23 @@ func TestTagsTransformation(t *testing.T) {
 				"otel.status_code": "OK",
 			},
 		},
+		{
+			name: "statusCode OK with description",
+			data: tracetest.SpanStub{
+				Attributes: []attribute.KeyValue{
+					attribute.String("key", keyValue),
+				},
+				Status: tracesdk.Status{
+					Code:        codes.Ok,
+					Description: statusMessage,
+				},
+			},
+			want: map[string]string{
+				"key":                     keyValue,
+				"otel.status_code":        "OK",
+				"otel.status_description": statusMessage,
+			},
+		},
 		{
 			name: "statusCode ERROR",
 			data: tracetest.SpanStub{
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// defaultRetryConfig is the RetryConfig used if none is provided. Its
+// intervals are also used in place of the invalid ones of an enabled
+// RetryConfig.
+var defaultRetryConfig = RetryConfig{
+	Enabled:         false,
+	InitialInterval: 5 * time.Second,
+	MaxInterval:     30 * time.Second,
+	MaxElapsedTime:  time.Minute,
+}
+
+// RetryConfig defines configuration for retrying batches in case of export
+// failure using an exponential backoff.
+type RetryConfig struct {
+	// Enabled indicates whether to not retry sending batches in case of
+	// export failure.
+	Enabled bool
+	// InitialInterval the time to wait after the first failure before
+	// retrying. If less than or equal to zero, 5 seconds is used.
+	InitialInterval time.Duration
+	// MaxInterval is the upper bound on backoff interval. Once this value is
+	// reached the delay between consecutive retries will always be
+	// `MaxInterval`. If less than or equal to zero, 30 seconds is used. If
+	// less than InitialInterval, InitialInterval is used.
+	MaxInterval time.Duration
+	// MaxElapsedTime is the maximum amount of time (including retries) spent
+	// trying to send a request/batch. Once this value is reached, the data
+	// is discarded.
+	MaxElapsedTime time.Duration
+}
+
+// retryableError is returned for a request that failed with a status code
+// that can be retried.
+type retryableError struct {
+	err error
+	// throttle is the delay requested by the collector before retrying. It is
+	// zero if no delay was requested.
+	throttle time.Duration
+}
+
+func (e retryableError) Error() string { return e.err.Error() }
+
+func (e retryableError) Unwrap() error { return e.err }
+
+// do calls fn until it succeeds, returns an error that is not a
+// retryableError, or the retry budget of c is exhausted.
+func (c RetryConfig) do(ctx context.Context, fn func(context.Context) error) error {
+	if !c.Enabled {
+		return fn(ctx)
+	}
+
+	if c.InitialInterval <= 0 {
+		c.InitialInterval = defaultRetryConfig.InitialInterval
+	}
+	if c.MaxInterval <= 0 {
+		c.MaxInterval = defaultRetryConfig.MaxInterval
+	}
+	c.MaxInterval = max(c.MaxInterval, c.InitialInterval)
+
+	start := time.Now()
+	interval := c.InitialInterval
+	for {
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+		var re retryableError
+		if !errors.As(err, &re) {
+			return err
+		}
+
+		// Randomize the interval by ±50% to avoid synchronized retries.
+		delay := interval/2 + rand.N(interval+1) // nolint:gosec // No need for a cryptographic RNG.
+		delay = max(delay, re.throttle)
+		if c.MaxElapsedTime > 0 && time.Since(start)+delay > c.MaxElapsedTime {
+			return fmt.Errorf("max retry time elapsed: %w", err)
+		}
+
+		t := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return fmt.Errorf("%w: %w", ctx.Err(), err)
+		case <-t.C:
+		}
+
+		interval = min(2*interval, c.MaxInterval)
+	}
+}
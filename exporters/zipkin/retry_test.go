@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zipkin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryConfigDo(t *testing.T) {
+	errRetry := retryableError{err: assert.AnError}
+
+	t.Run("Success", func(t *testing.T) {
+		var calls int
+		err := noDelayRetry.do(t.Context(), func(context.Context) error {
+			calls++
+			if calls < 3 {
+				return errRetry
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("NotRetryable", func(t *testing.T) {
+		var calls int
+		errPermanent := errors.New("permanent")
+		err := noDelayRetry.do(t.Context(), func(context.Context) error {
+			calls++
+			return errPermanent
+		})
+		assert.ErrorIs(t, err, errPermanent)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("MaxElapsedTime", func(t *testing.T) {
+		rc := RetryConfig{Enabled: true, InitialInterval: time.Hour, MaxElapsedTime: time.Minute}
+		var calls int
+		err := rc.do(t.Context(), func(context.Context) error {
+			calls++
+			return errRetry
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "max retry time elapsed")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Throttle", func(t *testing.T) {
+		rc := RetryConfig{Enabled: true, MaxElapsedTime: time.Minute}
+		err := rc.do(t.Context(), func(context.Context) error {
+			return retryableError{err: assert.AnError, throttle: 2 * time.Minute}
+		})
+		assert.ErrorContains(t, err, "max retry time elapsed", "throttle not honored")
+	})
+
+	t.Run("ContextCanceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		rc := RetryConfig{Enabled: true, InitialInterval: time.Second, MaxElapsedTime: time.Minute}
+		err := rc.do(ctx, func(context.Context) error {
+			cancel()
+			return errRetry
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("InvalidIntervals", func(t *testing.T) {
+		// Negative and zero intervals are replaced by the default ones, so
+		// the first delay exceeds the maximum elapsed time.
+		for _, rc := range []RetryConfig{
+			{Enabled: true, InitialInterval: -time.Second, MaxElapsedTime: time.Second},
+			{Enabled: true, InitialInterval: 0, MaxInterval: -time.Second, MaxElapsedTime: time.Second},
+		} {
+			var calls int
+			err := rc.do(t.Context(), func(context.Context) error {
+				calls++
+				return errRetry
+			})
+			assert.ErrorContains(t, err, "max retry time elapsed")
+			assert.Equal(t, 1, calls)
+		}
+	})
+
+	t.Run("ZeroMaxInterval", func(t *testing.T) {
+		rc := RetryConfig{Enabled: true, InitialInterval: time.Millisecond, MaxElapsedTime: time.Second}
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		var calls int
+		_ = rc.do(ctx, func(context.Context) error {
+			calls++
+			return errRetry
+		})
+		// The delay keeps growing instead of dropping to zero.
+		assert.Less(t, calls, 10)
+	})
+}
+
+func TestDefaultRetryDisabled(t *testing.T) {
+	exp, err := New("http://localhost:9411/api/v2/spans")
+	require.NoError(t, err)
+	assert.False(t, exp.retry.Enabled)
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := func(v string) *http.Response {
+		h := http.Header{}
+		if v != "" {
+			h.Set("Retry-After", v)
+		}
+		return &http.Response{Header: h}
+	}
+
+	assert.Equal(t, time.Duration(0), retryAfter(resp("")))
+	assert.Equal(t, 3*time.Second, retryAfter(resp("3")))
+	assert.Equal(t, time.Duration(0), retryAfter(resp("invalid")))
+	assert.Equal(t, time.Duration(0), retryAfter(resp(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))))
+
+	d := retryAfter(resp(time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)))
+	assert.InDelta(t, time.Hour, d, float64(time.Minute))
+}
@@ -5,18 +5,23 @@ package zipkin
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/stdr"
+	zkmodel "github.com/openzipkin/zipkin-go/model"
 
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
@@ -27,10 +32,14 @@ const (
 
 // Exporter exports spans to the zipkin collector.
 type Exporter struct {
-	url     string
-	client  *http.Client
-	logger  logr.Logger
-	headers map[string]string
+	url              string
+	client           *http.Client
+	logger           logr.Logger
+	headers          map[string]string
+	maxContentLength int
+	retry            RetryConfig
+	timeout          time.Duration
+	compression      Compression
 
 	stoppedMu sync.RWMutex
 	stopped   bool
@@ -40,11 +49,27 @@ var _ sdktrace.SpanExporter = &Exporter{}
 
 var emptyLogger = logr.Logger{}
 
+// Compression describes the compression used for the payloads sent to the
+// Zipkin collector.
+type Compression int
+
+const (
+	// NoCompression tells the exporter to send payloads without compression.
+	NoCompression Compression = iota
+	// GzipCompression tells the exporter to send payloads after compressing
+	// them with gzip.
+	GzipCompression
+)
+
 // Options contains configuration for the exporter.
 type config struct {
-	client  *http.Client
-	logger  logr.Logger
-	headers map[string]string
+	client           *http.Client
+	logger           logr.Logger
+	headers          map[string]string
+	maxContentLength int
+	retry            RetryConfig
+	timeout          time.Duration
+	compression      Compression
 }
 
 // Option defines a function that configures the exporter.
@@ -89,6 +114,59 @@ func WithClient(client *http.Client) Option {
 	})
 }
 
+// WithMaxContentLength configures the exporter to split the exported spans
+// into multiple requests so that the JSON body of each request, before any
+// compression, is at most n bytes. A single span encoded to more than n bytes
+// is sent in its own request.
+//
+// This should be set to the maximum request size accepted by the Zipkin
+// collector. If n is less than or equal to zero, which is the default, all
+// spans are sent in a single request.
+func WithMaxContentLength(n int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.maxContentLength = n
+		return cfg
+	})
+}
+
+// WithRetry configures the retry policy of the exporter. Requests that fail
+// with a 429 or 5xx status code are retried using an exponential backoff. A
+// delay requested by the Zipkin collector with the Retry-After header is
+// honored.
+//
+// If this option is not used, failed requests are not retried. Set rc.Enabled
+// to true to enable retries. The zero values of the intervals of rc are
+// replaced by an initial interval of 5 seconds and a maximum interval of 30
+// seconds.
+func WithRetry(rc RetryConfig) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.retry = rc
+		return cfg
+	})
+}
+
+// WithTimeout configures the maximum duration of a request to the Zipkin
+// collector, including its retries. If the spans exported are split into
+// multiple requests, the timeout applies to each of them.
+//
+// If timeout is less than or equal to zero, which is the default, only the
+// context passed to ExportSpans and the HTTP client bound the requests.
+func WithTimeout(timeout time.Duration) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.timeout = timeout
+		return cfg
+	})
+}
+
+// WithCompression configures the exporter to compress the payloads sent to
+// the Zipkin collector. By default, payloads are not compressed.
+func WithCompression(compression Compression) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.compression = compression
+		return cfg
+	})
+}
+
 // New creates a new Zipkin exporter.
 func New(collectorURL string, opts ...Option) (*Exporter, error) {
 	if collectorURL == "" {
@@ -103,7 +181,7 @@ func New(collectorURL string, opts ...Option) (*Exporter, error) {
 		return nil, fmt.Errorf("invalid collector URL %q: no scheme or host", collectorURL)
 	}
 
-	cfg := config{}
+	cfg := config{retry: defaultRetryConfig}
 	for _, opt := range opts {
 		cfg = opt.apply(cfg)
 	}
@@ -112,10 +190,14 @@ func New(collectorURL string, opts ...Option) (*Exporter, error) {
 		cfg.client = http.DefaultClient
 	}
 	return &Exporter{
-		url:     collectorURL,
-		client:  cfg.client,
-		logger:  cfg.logger,
-		headers: cfg.headers,
+		url:              collectorURL,
+		client:           cfg.client,
+		logger:           cfg.logger,
+		headers:          cfg.headers,
+		maxContentLength: cfg.maxContentLength,
+		retry:            cfg.retry,
+		timeout:          cfg.timeout,
+		compression:      cfg.compression,
 	}, nil
 }
 
@@ -133,17 +215,84 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpa
 		e.logf("no spans to export")
 		return nil
 	}
-	models := SpanModels(spans)
+	bodies, err := e.encode(SpanModels(spans))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, body := range bodies {
+		if err := e.send(ctx, body); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// encode returns the JSON encoding of models. If the encoding is larger than
+// the maximum content length, models are split and encoded into multiple
+// bodies.
+func (e *Exporter) encode(models []zkmodel.SpanModel) ([][]byte, error) {
 	body, err := json.Marshal(models)
 	if err != nil {
-		return e.errf("failed to serialize zipkin models to JSON: %v", err)
+		return nil, e.errf("failed to serialize zipkin models to JSON: %v", err)
+	}
+	if e.maxContentLength <= 0 || len(body) <= e.maxContentLength || len(models) == 1 {
+		return [][]byte{body}, nil
+	}
+
+	half := len(models) / 2
+	head, err := e.encode(models[:half])
+	if err != nil {
+		return nil, err
 	}
+	tail, err := e.encode(models[half:])
+	if err != nil {
+		return nil, err
+	}
+	return append(head, tail...), nil
+}
+
+// send sends body to the Zipkin collector, retrying according to the retry
+// configuration of the exporter.
+func (e *Exporter) send(ctx context.Context, body []byte) error {
+	if e.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.timeout)
+		defer cancel()
+	}
+
 	e.logf("about to send a POST request to %s with body %s", e.url, body)
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewBuffer(body))
+	encoding := ""
+	if e.compression == GzipCompression {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		if _, err := gz.Write(body); err != nil {
+			return e.errf("failed to compress request body: %v", err)
+		}
+		if err := gz.Close(); err != nil {
+			return e.errf("failed to compress request body: %v", err)
+		}
+		body, encoding = buf.Bytes(), "gzip"
+	}
+
+	return e.retry.do(ctx, func(ctx context.Context) error {
+		return e.post(ctx, body, encoding)
+	})
+}
+
+// post sends a single POST request with body to the Zipkin collector. A
+// retryableError is returned if the request failed with a status code that
+// can be retried.
+func (e *Exporter) post(ctx context.Context, body []byte, encoding string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
 	if err != nil {
 		return e.errf("failed to create request to %s: %v", e.url, err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	if encoding != "" {
+		req.Header.Set("Content-Encoding", encoding)
+	}
 
 	for k, v := range e.headers {
 		if strings.EqualFold(k, "host") {
@@ -169,12 +318,32 @@ func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpa
 	}
 
 	if resp.StatusCode != http.StatusAccepted {
-		return e.errf("failed to send spans to zipkin server with status %d", resp.StatusCode)
+		err := e.errf("failed to send spans to zipkin server with status %d", resp.StatusCode)
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			return retryableError{err: err, throttle: retryAfter(resp)}
+		}
+		return err
 	}
 
 	return nil
 }
 
+// retryAfter returns the delay requested by the Retry-After header of resp,
+// or zero if none is requested.
+func retryAfter(resp *http.Response) time.Duration {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return 0
+}
+
 // Shutdown stops the exporter flushing any pending exports.
 func (e *Exporter) Shutdown(ctx context.Context) error {
 	e.stoppedMu.Lock()
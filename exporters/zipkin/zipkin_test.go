@@ -5,6 +5,7 @@ package zipkin
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -479,3 +480,191 @@ func TestWithClient(t *testing.T) {
 		})
 	}
 }
+
+func testSpans(n int) []sdktrace.ReadOnlySpan {
+	stubs := make(tracetest.SpanStubs, n)
+	for i := range stubs {
+		stubs[i] = tracetest.SpanStub{
+			Name: fmt.Sprintf("span-%d", i),
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{0x01},
+				SpanID:  trace.SpanID{byte(i + 1)},
+			}),
+		}
+	}
+	return stubs.Snapshots()
+}
+
+// noDelayRetry is a RetryConfig retrying almost immediately.
+var noDelayRetry = RetryConfig{
+	Enabled:         true,
+	InitialInterval: time.Nanosecond,
+	MaxInterval:     time.Nanosecond,
+	MaxElapsedTime:  time.Minute,
+}
+
+func TestWithMaxContentLength(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []int
+		names    []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var models []zkmodel.SpanModel
+		assert.NoError(t, json.Unmarshal(body, &models))
+
+		mu.Lock()
+		requests = append(requests, len(body))
+		for _, m := range models {
+			names = append(names, m.Name)
+		}
+		mu.Unlock()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+
+	spans := testSpans(5)
+	single, err := json.Marshal(SpanModels(spans[:1]))
+	require.NoError(t, err)
+	maxLen := 2*len(single) + 1
+
+	exp, err := New(srv.URL, WithMaxContentLength(maxLen))
+	require.NoError(t, err)
+	require.NoError(t, exp.ExportSpans(t.Context(), spans))
+
+	assert.Len(t, requests, 3)
+	for _, n := range requests {
+		assert.LessOrEqual(t, n, maxLen)
+	}
+	assert.Equal(t, []string{"span-0", "span-1", "span-2", "span-3", "span-4"}, names)
+}
+
+func TestWithMaxContentLengthOversizedSpan(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+
+	exp, err := New(srv.URL, WithMaxContentLength(1))
+	require.NoError(t, err)
+	require.NoError(t, exp.ExportSpans(t.Context(), testSpans(3)))
+	assert.Equal(t, 3, requests, "each span sent in its own request")
+}
+
+func TestExportSpansRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []int
+		retry    RetryConfig
+		wantReqs int
+		wantErr  bool
+	}{
+		{
+			name:     "ServiceUnavailable",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusAccepted},
+			retry:    noDelayRetry,
+			wantReqs: 3,
+		},
+		{
+			name:     "TooManyRequests",
+			statuses: []int{http.StatusTooManyRequests, http.StatusAccepted},
+			retry:    noDelayRetry,
+			wantReqs: 2,
+		},
+		{
+			name:     "BadRequest",
+			statuses: []int{http.StatusBadRequest, http.StatusAccepted},
+			retry:    noDelayRetry,
+			wantReqs: 1,
+			wantErr:  true,
+		},
+		{
+			name:     "Disabled",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusAccepted},
+			retry:    RetryConfig{Enabled: false},
+			wantReqs: 1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.statuses[min(requests, len(tt.statuses)-1)])
+				requests++
+			}))
+			t.Cleanup(srv.Close)
+
+			exp, err := New(srv.URL, WithRetry(tt.retry))
+			require.NoError(t, err)
+			err = exp.ExportSpans(t.Context(), testSpans(1))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantReqs, requests)
+		})
+	}
+}
+
+func TestExportSpansSplitBatchErrors(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+
+	exp, err := New(srv.URL, WithMaxContentLength(1))
+	require.NoError(t, err)
+	err = exp.ExportSpans(t.Context(), testSpans(2))
+	assert.ErrorContains(t, err, "status 400")
+	assert.Equal(t, 2, requests, "remaining requests sent after a failure")
+}
+
+func TestWithTimeout(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(done) })
+
+	exp, err := New(srv.URL, WithTimeout(10*time.Millisecond))
+	require.NoError(t, err)
+	assert.ErrorContains(t, exp.ExportSpans(t.Context(), testSpans(1)), "context deadline exceeded")
+}
+
+func TestWithCompression(t *testing.T) {
+	var got []zkmodel.SpanModel
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Content-Encoding"))
+		gz, err := gzip.NewReader(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NoError(t, json.NewDecoder(gz).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(srv.Close)
+
+	exp, err := New(srv.URL, WithCompression(GzipCompression))
+	require.NoError(t, err)
+	require.NoError(t, exp.ExportSpans(t.Context(), testSpans(2)))
+	require.Len(t, got, 2)
+	assert.Equal(t, "span-0", got[0].Name)
+}
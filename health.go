@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// HealthStatus is a snapshot of the health of a telemetry pipeline component,
+// such as a batching processor or a periodic metric reader.
+type HealthStatus struct {
+	// Component identifies the kind of component the status describes.
+	Component string
+	// QueueSize is the number of telemetry items waiting to be exported.
+	QueueSize int
+	// QueueCapacity is the maximum number of telemetry items the component
+	// can hold. It is zero if the component does not queue telemetry.
+	QueueCapacity int
+	// LastSuccess is the time the component last exported successfully. It is
+	// the zero time if no successful export has happened yet.
+	LastSuccess time.Time
+	// LastError is the error returned by the most recent failed export. It
+	// is nil if no export has failed yet.
+	LastError error
+	// ConsecutiveFailures is the number of exports that have failed since the
+	// last successful one.
+	ConsecutiveFailures int
+	// Shutdown is true if the component has been shut down.
+	Shutdown bool
+}
+
+// Healthy reports whether the component is running and its most recent
+// export, if any, succeeded.
+func (s HealthStatus) Healthy() bool {
+	return !s.Shutdown && s.ConsecutiveFailures == 0
+}
+
+// HealthReporter reports the health of the telemetry pipeline components it
+// manages.
+//
+// The TracerProvider, MeterProvider, and LoggerProvider of the default SDK
+// implement this interface.
+type HealthReporter interface {
+	// Health returns a snapshot of the health of every component that
+	// supports health reporting.
+	//
+	// This method must be safe to call concurrently.
+	Health() []HealthStatus
+}
+
+var healthReporters = struct {
+	sync.Mutex
+	reporters []*HealthReporter
+}{}
+
+// RegisterHealthReporter registers r to be included in [HealthReport].
+//
+// The global TracerProvider and MeterProvider are included in HealthReport
+// without registration if they implement HealthReporter. Register other
+// reporters, like a LoggerProvider or a non-global provider, with this
+// function.
+//
+// The returned function unregisters r. It is safe to call more than once.
+func RegisterHealthReporter(r HealthReporter) (unregister func()) {
+	if r == nil {
+		return func() {}
+	}
+
+	// A pointer is registered so the same reporter registered more than once
+	// is unregistered one registration at a time.
+	ptr := &r
+	healthReporters.Lock()
+	healthReporters.reporters = append(healthReporters.reporters, ptr)
+	healthReporters.Unlock()
+
+	return sync.OnceFunc(func() {
+		healthReporters.Lock()
+		defer healthReporters.Unlock()
+		healthReporters.reporters = slices.DeleteFunc(healthReporters.reporters, func(p *HealthReporter) bool {
+			return p == ptr
+		})
+	})
+}
+
+// HealthReport returns the aggregated health of the global TracerProvider,
+// the global MeterProvider, and all reporters registered with
+// [RegisterHealthReporter].
+//
+// Providers that do not implement [HealthReporter] are ignored.
+func HealthReport() []HealthStatus {
+	var out []HealthStatus
+	if r, ok := GetTracerProvider().(HealthReporter); ok {
+		out = append(out, r.Health()...)
+	}
+	if r, ok := GetMeterProvider().(HealthReporter); ok {
+		out = append(out, r.Health()...)
+	}
+
+	healthReporters.Lock()
+	reporters := slices.Clone(healthReporters.reporters)
+	healthReporters.Unlock()
+	for _, r := range reporters {
+		out = append(out, (*r).Health()...)
+	}
+	return out
+}
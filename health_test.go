@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type healthTracerProvider struct {
+	testTracerProvider
+
+	status []HealthStatus
+}
+
+func (p *healthTracerProvider) Health() []HealthStatus { return p.status }
+
+type healthReporterFunc func() []HealthStatus
+
+func (f healthReporterFunc) Health() []HealthStatus { return f() }
+
+func TestHealthStatusHealthy(t *testing.T) {
+	assert.True(t, HealthStatus{}.Healthy(), "zero value")
+	assert.True(t, HealthStatus{LastSuccess: time.Now()}.Healthy(), "success")
+	assert.False(t, HealthStatus{ConsecutiveFailures: 1, LastError: errors.New("failed")}.Healthy(), "failures")
+	assert.False(t, HealthStatus{Shutdown: true}.Healthy(), "shutdown")
+}
+
+func TestHealthReport(t *testing.T) {
+	prev := GetTracerProvider()
+	t.Cleanup(func() { SetTracerProvider(prev) })
+
+	tpStatus := HealthStatus{Component: "tracer", QueueSize: 1, QueueCapacity: 2}
+	SetTracerProvider(&healthTracerProvider{status: []HealthStatus{tpStatus}})
+
+	logStatus := HealthStatus{Component: "logger", ConsecutiveFailures: 3}
+	reporter := healthReporterFunc(func() []HealthStatus {
+		return []HealthStatus{logStatus}
+	})
+	unregister := RegisterHealthReporter(reporter)
+	assert.Equal(t, []HealthStatus{tpStatus, logStatus}, HealthReport())
+
+	unregister()
+	unregister()
+	assert.Equal(t, []HealthStatus{tpStatus}, HealthReport())
+}
+
+func TestRegisterHealthReporterTwice(t *testing.T) {
+	status := HealthStatus{Component: "logger"}
+	reporter := healthReporterFunc(func() []HealthStatus {
+		return []HealthStatus{status}
+	})
+	unregister0 := RegisterHealthReporter(reporter)
+	unregister1 := RegisterHealthReporter(reporter)
+	t.Cleanup(unregister1)
+
+	assert.Equal(t, []HealthStatus{status, status}, HealthReport())
+	unregister0()
+	assert.Equal(t, []HealthStatus{status}, HealthReport())
+}
+
+func TestRegisterHealthReporterNil(t *testing.T) {
+	unregister := RegisterHealthReporter(nil)
+	assert.Empty(t, HealthReport())
+	assert.NotPanics(t, unregister)
+}
@@ -0,0 +1,17 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package httpmigration provides helpers for HTTP instrumentation migrating
+// from the HTTP semantic conventions of [go.opentelemetry.io/otel/semconv/v1.20.0]
+// to the stable HTTP semantic conventions.
+//
+// The conventions emitted are selected with the OTEL_SEMCONV_STABILITY_OPT_IN
+// environment variable, a comma-separated list of values:
+//
+//   - "http": only the stable conventions are emitted.
+//   - "http/dup": both the old and the stable conventions are emitted.
+//
+// If neither value is set, only the old conventions are emitted. This allows
+// instrumentation to support both conventions from a single call, and its
+// users to migrate gradually.
+package httpmigration
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmigration_test
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/httpmigration"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func Example() {
+	// Read the environment once, when the instrumentation is created.
+	mode := httpmigration.ModeFromEnv()
+	tracer := otel.Tracer("example/middleware")
+
+	middleware := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := tracer.Start(
+				r.Context(), r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(mode.ServerRequest("", r)...),
+			)
+			defer span.End()
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+			span.SetAttributes(mode.ServerResponse(http.StatusOK)...)
+		})
+	}
+	_ = middleware
+}
@@ -0,0 +1,269 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httpmigration
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	oldconv "go.opentelemetry.io/otel/semconv/v1.20.0"
+	"go.opentelemetry.io/otel/semconv/v1.20.0/httpconv"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+// envStabilityOptIn is the environment variable used to opt in to the stable
+// semantic conventions.
+const envStabilityOptIn = "OTEL_SEMCONV_STABILITY_OPT_IN"
+
+// Mode defines which HTTP semantic conventions are emitted.
+type Mode int
+
+const (
+	// ModeOld emits the HTTP semantic conventions of
+	// [go.opentelemetry.io/otel/semconv/v1.20.0]. This is the default.
+	ModeOld Mode = iota
+
+	// ModeStable emits the stable HTTP semantic conventions.
+	ModeStable
+
+	// ModeDuplicate emits both the old and the stable HTTP semantic
+	// conventions.
+	ModeDuplicate
+)
+
+// ModeFromEnv returns the Mode selected by the OTEL_SEMCONV_STABILITY_OPT_IN
+// environment variable. If it contains "http/dup", ModeDuplicate is
+// returned. Otherwise, if it contains "http", ModeStable is returned. If it
+// contains neither, ModeOld is returned.
+func ModeFromEnv() Mode {
+	mode := ModeOld
+	for v := range strings.SplitSeq(os.Getenv(envStabilityOptIn), ",") {
+		switch strings.TrimSpace(v) {
+		case "http/dup":
+			return ModeDuplicate
+		case "http":
+			mode = ModeStable
+		}
+	}
+	return mode
+}
+
+func (m Mode) old() bool    { return m != ModeStable }
+func (m Mode) stable() bool { return m == ModeStable || m == ModeDuplicate }
+
+// ClientRequest returns trace attributes for an HTTP request made by a
+// client.
+//
+// The stable conventions always include "http.request.method", "url.full",
+// "server.address", "server.port", and "network.protocol.version".
+// "user_agent.original" is included if it is set in req. See
+// [httpconv.ClientRequest] for the old conventions.
+func (m Mode) ClientRequest(req *http.Request) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if m.old() {
+		attrs = httpconv.ClientRequest(req)
+	}
+	if !m.stable() {
+		return attrs
+	}
+
+	attrs = appendMethod(attrs, req.Method)
+	attrs = append(attrs, semconv.URLFull(redactedURL(req.URL)))
+
+	host, port := req.URL.Hostname(), req.URL.Port()
+	if host == "" {
+		host, port = splitHostPort(req.Host)
+	}
+	if host != "" {
+		attrs = append(attrs, semconv.ServerAddress(host))
+	}
+	if p := portOrDefault(port, req.URL.Scheme == "https"); p > 0 {
+		attrs = append(attrs, semconv.ServerPort(p))
+	}
+	attrs = append(attrs, semconv.NetworkProtocolVersion(protocolVersion(req)))
+	if ua := req.UserAgent(); ua != "" {
+		attrs = append(attrs, semconv.UserAgentOriginal(ua))
+	}
+	return attrs
+}
+
+// ClientResponse returns trace attributes for an HTTP response received by a
+// client.
+//
+// The stable conventions include "http.response.status_code". See
+// [httpconv.ClientResponse] for the old conventions.
+func (m Mode) ClientResponse(resp *http.Response) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if m.old() {
+		attrs = httpconv.ClientResponse(resp)
+	}
+	if m.stable() && resp.StatusCode > 0 {
+		attrs = append(attrs, semconv.HTTPResponseStatusCode(resp.StatusCode))
+	}
+	return attrs
+}
+
+// ServerRequest returns trace attributes for an HTTP request received by a
+// server.
+//
+// The server is the primary server name, see [httpconv.ServerRequest] for
+// more information. If it is empty, the Host of req is used instead.
+//
+// The stable conventions always include "http.request.method", "url.scheme",
+// "url.path", and "network.protocol.version". "url.query", "server.address",
+// "server.port", "client.address", "network.peer.address",
+// "network.peer.port", and "user_agent.original" are included if they are
+// defined in req. See [httpconv.ServerRequest] for the old conventions.
+func (m Mode) ServerRequest(server string, req *http.Request) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if m.old() {
+		attrs = httpconv.ServerRequest(server, req)
+	}
+	if !m.stable() {
+		return attrs
+	}
+
+	attrs = appendMethod(attrs, req.Method)
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	attrs = append(attrs, semconv.URLScheme(scheme), semconv.URLPath(req.URL.Path))
+	if req.URL.RawQuery != "" {
+		attrs = append(attrs, semconv.URLQuery(req.URL.RawQuery))
+	}
+
+	if server == "" {
+		server = req.Host
+	}
+	host, port := splitHostPort(server)
+	if host != "" {
+		attrs = append(attrs, semconv.ServerAddress(host))
+	}
+	if p, err := strconv.Atoi(port); err == nil && p > 0 {
+		attrs = append(attrs, semconv.ServerPort(p))
+	}
+	attrs = append(attrs, semconv.NetworkProtocolVersion(protocolVersion(req)))
+
+	peer, peerPort := splitHostPort(req.RemoteAddr)
+	client := peer
+	if xff := req.Header.Get("X-Forwarded-For"); xff != "" {
+		client, _, _ = strings.Cut(xff, ",")
+		client = strings.TrimSpace(client)
+	}
+	if client != "" {
+		attrs = append(attrs, semconv.ClientAddress(client))
+	}
+	if peer != "" {
+		attrs = append(attrs, semconv.NetworkPeerAddress(peer))
+	}
+	if p, err := strconv.Atoi(peerPort); err == nil && p > 0 {
+		attrs = append(attrs, semconv.NetworkPeerPort(p))
+	}
+	if ua := req.UserAgent(); ua != "" {
+		attrs = append(attrs, semconv.UserAgentOriginal(ua))
+	}
+	return attrs
+}
+
+// ServerResponse returns trace attributes for the statusCode of an HTTP
+// response sent by a server.
+//
+// The old conventions include "http.status_code", and the stable conventions
+// include "http.response.status_code".
+func (m Mode) ServerResponse(statusCode int) []attribute.KeyValue {
+	if statusCode <= 0 {
+		return nil
+	}
+	var attrs []attribute.KeyValue
+	if m.old() {
+		attrs = append(attrs, oldconv.HTTPStatusCode(statusCode))
+	}
+	if m.stable() {
+		attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+	}
+	return attrs
+}
+
+// knownMethods are the HTTP methods known by the semantic conventions.
+var knownMethods = map[string]bool{
+	http.MethodConnect: true,
+	http.MethodDelete:  true,
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPatch:   true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodTrace:   true,
+}
+
+// appendMethod appends the "http.request.method" attribute for method to
+// attrs, and "http.request.method_original" if method is not known.
+func appendMethod(attrs []attribute.KeyValue, method string) []attribute.KeyValue {
+	if method == "" {
+		// The Go HTTP client uses GET for an empty method.
+		method = http.MethodGet
+	}
+	if knownMethods[method] {
+		return append(attrs, semconv.HTTPRequestMethodKey.String(method))
+	}
+	if upper := strings.ToUpper(method); knownMethods[upper] {
+		return append(attrs,
+			semconv.HTTPRequestMethodKey.String(upper),
+			semconv.HTTPRequestMethodOriginal(method),
+		)
+	}
+	return append(attrs, semconv.HTTPRequestMethodOther, semconv.HTTPRequestMethodOriginal(method))
+}
+
+// redactedURL returns u as a string with its credentials redacted.
+func redactedURL(u *url.URL) string {
+	if u.User == nil {
+		return u.String()
+	}
+	c := *u
+	c.User = url.UserPassword("REDACTED", "REDACTED")
+	return c.String()
+}
+
+// splitHostPort splits hostport into its host and port. If hostport has no
+// port, hostport is returned as the host.
+func splitHostPort(hostport string) (host, port string) {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return hostport, ""
+	}
+	return host, port
+}
+
+// portOrDefault returns the port parsed from port, or the default port of the
+// scheme if port is empty. If port is invalid, -1 is returned.
+func portOrDefault(port string, https bool) int {
+	if port == "" {
+		if https {
+			return 443
+		}
+		return 80
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		return -1
+	}
+	return p
+}
+
+// protocolVersion returns the HTTP protocol version of req, e.g. "1.1" or
+// "2".
+func protocolVersion(req *http.Request) string {
+	if req.ProtoMajor >= 2 && req.ProtoMinor == 0 {
+		return strconv.Itoa(req.ProtoMajor)
+	}
+	return strconv.Itoa(req.ProtoMajor) + "." + strconv.Itoa(req.ProtoMinor)
+}
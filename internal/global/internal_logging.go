@@ -5,7 +5,9 @@ package global
 
 import (
 	"log"
+	"maps"
 	"os"
+	"sync"
 	"sync/atomic"
 
 	"github.com/go-logr/logr"
@@ -60,3 +62,101 @@ func Debug(msg string, keysAndValues ...any) {
 func Warn(msg string, keysAndValues ...any) {
 	GetLogger().V(1).Info(msg, keysAndValues...)
 }
+
+// Names of the components of the SDK logging with a ComponentLogger.
+const (
+	ComponentBatchSpanProcessor = "batch_span_processor"
+	ComponentOTLPClient         = "otlp_client"
+	ComponentMetricReader       = "metric_reader"
+)
+
+var (
+	// componentLevelsMu serializes the updates of componentLevels.
+	componentLevelsMu sync.Mutex
+	// componentLevels holds the verbosity set for each component.
+	componentLevels atomic.Pointer[map[string]int]
+)
+
+// SetComponentLogLevel sets the verbosity of the messages logged by the
+// ComponentLogger of component. If verbosity is negative, the verbosity of the
+// component is unset.
+//
+// A message of the component is logged if its verbosity is less than or equal
+// to the verbosity of the component, regardless of the verbosity of the global
+// logger. The message is logged at the verbosity 0 of the global logger, named
+// after the component.
+//
+// The messages of a component without verbosity are logged as the messages of
+// the package level functions.
+func SetComponentLogLevel(component string, verbosity int) {
+	componentLevelsMu.Lock()
+	defer componentLevelsMu.Unlock()
+
+	levels := make(map[string]int)
+	if p := componentLevels.Load(); p != nil {
+		maps.Copy(levels, *p)
+	}
+	if verbosity < 0 {
+		delete(levels, component)
+	} else {
+		levels[component] = verbosity
+	}
+	componentLevels.Store(&levels)
+}
+
+// componentLevel returns the verbosity set for component, if any.
+func componentLevel(component string) (int, bool) {
+	p := componentLevels.Load()
+	if p == nil {
+		return 0, false
+	}
+	v, ok := (*p)[component]
+	return v, ok
+}
+
+// ComponentLogger logs the messages of a component of the SDK. Its verbosity
+// can be set independently of the other components using
+// SetComponentLogLevel.
+type ComponentLogger struct {
+	name string
+}
+
+// Component returns the ComponentLogger of the component name.
+func Component(name string) ComponentLogger {
+	return ComponentLogger{name: name}
+}
+
+// Info prints messages about the general state of the component.
+func (c ComponentLogger) Info(msg string, keysAndValues ...any) {
+	c.log(4, msg, keysAndValues)
+}
+
+// Error prints messages about exceptional states of the component.
+func (c ComponentLogger) Error(err error, msg string, keysAndValues ...any) {
+	l := GetLogger()
+	if _, ok := componentLevel(c.name); ok {
+		l = l.WithName(c.name)
+	}
+	l.Error(err, msg, keysAndValues...)
+}
+
+// Debug prints messages about all internal changes of the component.
+func (c ComponentLogger) Debug(msg string, keysAndValues ...any) {
+	c.log(8, msg, keysAndValues)
+}
+
+// Warn prints messages about warnings of the component.
+func (c ComponentLogger) Warn(msg string, keysAndValues ...any) {
+	c.log(1, msg, keysAndValues)
+}
+
+func (c ComponentLogger) log(verbosity int, msg string, keysAndValues []any) {
+	level, ok := componentLevel(c.name)
+	if !ok {
+		GetLogger().V(verbosity).Info(msg, keysAndValues...)
+		return
+	}
+	if verbosity <= level {
+		GetLogger().WithName(c.name).Info(msg, keysAndValues...)
+	}
+}
@@ -90,3 +90,45 @@ func newBuffLogger(buf *bytes.Buffer, verbosity int) logr.Logger {
 		Verbosity: verbosity,
 	})
 }
+
+func TestComponentLogLevel(t *testing.T) {
+	var buf bytes.Buffer
+	SetLogger(funcr.New(func(prefix, args string) {
+		_, _ = buf.WriteString(prefix + ": " + args)
+	}, funcr.Options{}))
+	t.Cleanup(func() {
+		SetComponentLogLevel(ComponentBatchSpanProcessor, -1)
+		SetComponentLogLevel(ComponentOTLPClient, -1)
+	})
+
+	bsp := Component(ComponentBatchSpanProcessor)
+	otlp := Component(ComponentOTLPClient)
+
+	// Without verbosity, the verbosity of the logger is used.
+	bsp.Debug("foo")
+	assert.Empty(t, buf.String())
+
+	SetComponentLogLevel(ComponentBatchSpanProcessor, 8)
+	bsp.Debug("foo")
+	assert.Equal(t, `batch_span_processor: "level"=0 "msg"="foo"`, buf.String())
+	buf.Reset()
+
+	// Other components are not affected.
+	otlp.Debug("bar")
+	assert.Empty(t, buf.String())
+
+	SetComponentLogLevel(ComponentOTLPClient, 1)
+	otlp.Info("bar")
+	assert.Empty(t, buf.String())
+	otlp.Warn("bar")
+	assert.Equal(t, `otlp_client: "level"=0 "msg"="bar"`, buf.String())
+	buf.Reset()
+
+	otlp.Error(errors.New("baz"), "baz")
+	assert.Equal(t, `otlp_client: "msg"="baz" "error"="baz"`, buf.String())
+	buf.Reset()
+
+	SetComponentLogLevel(ComponentBatchSpanProcessor, -1)
+	bsp.Debug("foo")
+	assert.Empty(t, buf.String())
+}
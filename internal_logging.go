@@ -13,3 +13,30 @@ import (
 func SetLogger(logger logr.Logger) {
 	global.SetLogger(logger)
 }
+
+// Components of the SDK whose internal logging verbosity can be set with
+// SetComponentLogLevel.
+const (
+	// LogComponentBatchSpanProcessor is the BatchSpanProcessor of the trace
+	// SDK.
+	LogComponentBatchSpanProcessor = global.ComponentBatchSpanProcessor
+	// LogComponentOTLPClient is the client of the OTLP exporters.
+	LogComponentOTLPClient = global.ComponentOTLPClient
+	// LogComponentMetricReader is the readers of the metric SDK.
+	LogComponentMetricReader = global.ComponentMetricReader
+)
+
+// SetComponentLogLevel sets the verbosity of the messages logged internally by
+// component, e.g. LogComponentBatchSpanProcessor. This allows the debugging of
+// a single component without enabling verbose logging for all of them.
+//
+// The verbosity uses the levels of the logger configured with SetLogger: 1 for
+// warnings, 4 for informational messages, and 8 for debug messages. Errors are
+// always logged. A message of component is logged if its level is less than
+// or equal to verbosity, regardless of the verbosity of the logger, at the
+// verbosity 0 of the logger, named after component. If verbosity is negative,
+// the messages of component are logged according to the verbosity of the
+// logger, as the messages of other components.
+func SetComponentLogLevel(component string, verbosity int) {
+	global.SetComponentLogLevel(component, verbosity)
+}
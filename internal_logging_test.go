@@ -16,3 +16,10 @@ func ExampleSetLogger() {
 	logger := stdr.New(log.New(os.Stdout, "", log.LstdFlags|log.Lshortfile))
 	otel.SetLogger(logger)
 }
+
+func ExampleSetComponentLogLevel() {
+	logger := stdr.New(log.New(os.Stdout, "", log.LstdFlags|log.Lshortfile))
+	otel.SetLogger(logger)
+	// Log the debug messages of the BatchSpanProcessor only.
+	otel.SetComponentLogLevel(otel.LogComponentBatchSpanProcessor, 8)
+}
@@ -94,6 +94,8 @@ type Record struct {
 
 // Recorder stores all received log records in-memory.
 // Recorder implements [log.LoggerProvider].
+//
+// All methods of a Recorder and of its loggers are safe to call concurrently.
 type Recorder struct {
 	// Ensure forward compatibility by explicitly making this not comparable.
 	_ [0]func()
@@ -102,6 +104,9 @@ type Recorder struct {
 
 	mu      sync.Mutex
 	loggers map[Scope]*logger
+	// emitted is closed when a log record is emitted. It is nil if no one
+	// waits for a log record.
+	emitted chan struct{}
 
 	// enabledFn decides whether the recorder should enable logging of a record or not
 	enabledFn enabledFn
@@ -143,6 +148,7 @@ func (r *Recorder) Logger(name string, opts ...log.LoggerOption) log.Logger {
 	}
 	l = &logger{
 		enabledFn: r.enabledFn,
+		recorder:  r,
 	}
 	r.loggers[scope] = l
 	return l
@@ -182,9 +188,103 @@ func (r *Recorder) Result() Recording {
 	return res
 }
 
+// RecordsForScope returns a deep copy of the current in-memory recorded log
+// records of the loggers with the instrumentation scope name. The records of
+// a logger are in the order they were emitted. The order of the records of
+// loggers with the same name but different scope versions, schema URLs, or
+// attributes is unspecified.
+func (r *Recorder) RecordsForScope(name string) []Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var recs []Record
+	for s, l := range r.loggers {
+		if s.Name != name {
+			continue
+		}
+		func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			for _, rec := range l.records {
+				recs = append(recs, rec.Clone())
+			}
+		}()
+	}
+	return recs
+}
+
+// WaitForRecords waits until at least n log records are recorded, or ctx is
+// done. It returns the result, see [Recorder.Result], once they are recorded,
+// or the error of ctx.
+func (r *Recorder) WaitForRecords(ctx context.Context, n int) (Recording, error) {
+	err := r.wait(ctx, n, func(Scope) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+	return r.Result(), nil
+}
+
+// WaitForScopeRecords waits until at least n log records are recorded by the
+// loggers with the instrumentation scope name, or ctx is done. It returns
+// the records, see [Recorder.RecordsForScope], once they are recorded, or the
+// error of ctx.
+func (r *Recorder) WaitForScopeRecords(ctx context.Context, name string, n int) ([]Record, error) {
+	err := r.wait(ctx, n, func(s Scope) bool { return s.Name == name })
+	if err != nil {
+		return nil, err
+	}
+	return r.RecordsForScope(name), nil
+}
+
+// wait waits until at least n log records are recorded by the loggers with a
+// scope matching match, or ctx is done.
+func (r *Recorder) wait(ctx context.Context, n int, match func(Scope) bool) error {
+	for {
+		r.mu.Lock()
+		var count int
+		for s, l := range r.loggers {
+			if match(s) {
+				l.mu.Lock()
+				count += len(l.records)
+				l.mu.Unlock()
+			}
+		}
+		if count >= n {
+			r.mu.Unlock()
+			return nil
+		}
+		if r.emitted == nil {
+			r.emitted = make(chan struct{})
+		}
+		emitted := r.emitted
+		r.mu.Unlock()
+
+		select {
+		case <-emitted:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// notify wakes up the callers waiting for log records.
+func (r *Recorder) notify() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.emitted != nil {
+		close(r.emitted)
+		r.emitted = nil
+	}
+}
+
 type logger struct {
 	embedded.Logger
 
+	// recorder is the Recorder that created the logger. It is nil if the
+	// logger was not created by a Recorder.
+	recorder *Recorder
+
 	mu      sync.Mutex
 	records []*Record
 
@@ -203,6 +303,15 @@ func (l *logger) Enabled(ctx context.Context, param log.EnabledParameters) bool
 
 // Emit stores the log record.
 func (l *logger) Emit(ctx context.Context, record log.Record) {
+	l.emit(ctx, record)
+	if l.recorder != nil {
+		// Notify once l.mu is released as the Recorder locks it while
+		// holding its own lock.
+		l.recorder.notify()
+	}
+}
+
+func (l *logger) emit(ctx context.Context, record log.Record) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/log"
@@ -176,3 +177,96 @@ func TestRecorderConcurrentSafe(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestRecorderRecordsForScope(t *testing.T) {
+	rec := NewRecorder()
+	ctx := t.Context()
+
+	emit := func(l log.Logger, body string) {
+		var r log.Record
+		r.SetBody(attribute.StringValue(body))
+		l.Emit(ctx, r)
+	}
+	emit(rec.Logger("a"), "a1")
+	emit(rec.Logger("b"), "b1")
+	emit(rec.Logger("a"), "a2")
+	emit(rec.Logger("a", log.WithInstrumentationVersion("v1")), "a3")
+
+	var bodies []string
+	for _, r := range rec.RecordsForScope("a") {
+		bodies = append(bodies, r.Body.AsString())
+	}
+	assert.ElementsMatch(t, []string{"a1", "a2", "a3"}, bodies)
+
+	b := rec.RecordsForScope("b")
+	require.Len(t, b, 1)
+	assert.Equal(t, "b1", b[0].Body.AsString())
+
+	assert.Empty(t, rec.RecordsForScope("c"))
+
+	rec.Reset()
+	assert.Empty(t, rec.RecordsForScope("a"))
+}
+
+func TestRecorderWaitForRecords(t *testing.T) {
+	const goRoutineN = 10
+
+	rec := NewRecorder()
+	ctx := t.Context()
+
+	var wg sync.WaitGroup
+	for i := range goRoutineN {
+		wg.Go(func() {
+			name := "even"
+			if i%2 == 1 {
+				name = "odd"
+			}
+			rec.Logger(name).Emit(ctx, log.Record{})
+		})
+	}
+
+	got, err := rec.WaitForRecords(ctx, goRoutineN)
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	odd, err := rec.WaitForScopeRecords(ctx, "odd", goRoutineN/2)
+	require.NoError(t, err)
+	assert.Len(t, odd, goRoutineN/2)
+	wg.Wait()
+}
+
+func TestRecorderWaitForRecordsBlocks(t *testing.T) {
+	rec := NewRecorder()
+
+	done := make(chan []Record)
+	go func() {
+		recs, err := rec.WaitForScopeRecords(t.Context(), "test", 2)
+		assert.NoError(t, err)
+		done <- recs
+	}()
+
+	l := rec.Logger("test")
+	l.Emit(t.Context(), log.Record{})
+	rec.Logger("other").Emit(t.Context(), log.Record{})
+	select {
+	case <-done:
+		t.Fatal("returned before the records were emitted")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	l.Emit(t.Context(), log.Record{})
+	assert.Len(t, <-done, 2)
+}
+
+func TestRecorderWaitForRecordsContextDone(t *testing.T) {
+	rec := NewRecorder()
+	rec.Logger("test").Emit(t.Context(), log.Record{})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	_, err := rec.WaitForRecords(ctx, 2)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = rec.WaitForScopeRecords(ctx, "test", 2)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
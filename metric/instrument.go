@@ -215,6 +215,25 @@ func (o bucketOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramCon
 	return c
 }
 
+// WithNoMinMax sets the instrument to not record the min and max of its
+// measurements. Use it for histograms whose min and max are not needed to
+// reduce the memory used to aggregate them.
+//
+// This option is considered "advisory", and may be ignored by API implementations.
+func WithNoMinMax() HistogramOption { return noMinMaxOpt{} }
+
+type noMinMaxOpt struct{}
+
+func (noMinMaxOpt) applyFloat64Histogram(c Float64HistogramConfig) Float64HistogramConfig {
+	c.noMinMax = true
+	return c
+}
+
+func (noMinMaxOpt) applyInt64Histogram(c Int64HistogramConfig) Int64HistogramConfig {
+	c.noMinMax = true
+	return c
+}
+
 // AddOption applies options to an addition measurement. See
 // [MeasurementOption] for other options that can be used as an AddOption.
 type AddOption interface {
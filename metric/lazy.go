@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+// lazyInstrument creates an instrument of type T, configured with options of
+// type O, on first use.
+type lazyInstrument[T, O any] struct {
+	provider func() MeterProvider
+	scope    string
+	name     string
+	create   func(Meter, string, ...O) (T, error)
+	options  []O
+
+	once sync.Once
+	inst T
+	ok   bool
+}
+
+func newLazyInstrument[T, O any](
+	provider func() MeterProvider,
+	scope, name string,
+	create func(Meter, string, ...O) (T, error),
+	options []O,
+) lazyInstrument[T, O] {
+	return lazyInstrument[T, O]{
+		provider: provider,
+		scope:    scope,
+		name:     name,
+		create:   create,
+		options:  options,
+	}
+}
+
+// get returns the instrument, creating it if it does not exist. It returns
+// false if the instrument could not be created.
+func (l *lazyInstrument[T, O]) get() (T, bool) {
+	l.once.Do(func() {
+		if l.provider == nil {
+			return
+		}
+		mp := l.provider()
+		if mp == nil {
+			return
+		}
+		// Implementations may return a usable instrument along with an
+		// error, e.g. for an invalid name. Use it if provided.
+		l.inst, _ = l.create(mp.Meter(l.scope), l.name, l.options...)
+		l.ok = any(l.inst) != nil
+	})
+	return l.inst, l.ok
+}
+
+// LazyInt64Counter returns an Int64Counter that defers its creation until it
+// is first used. The counter is then created by the Meter named scope from the
+// MeterProvider returned by provider, using name and options.
+//
+// This allows declaring a package-level instrument before the MeterProvider is
+// configured, e.g. with provider set to otel.GetMeterProvider:
+//
+//	var requests = metric.LazyInt64Counter(otel.GetMeterProvider, "example.com/pkg", "requests")
+//
+// The provider is only called once. If the instrument cannot be created, the
+// measurements recorded with it are dropped. The other Lazy functions behave
+// the same for their instrument.
+func LazyInt64Counter(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Int64CounterOption,
+) Int64Counter {
+	return &lazyInt64Counter{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Int64Counter, options),
+	}
+}
+
+type lazyInt64Counter struct {
+	embedded.Int64Counter
+	lazyInstrument[Int64Counter, Int64CounterOption]
+}
+
+var _ Int64Counter = (*lazyInt64Counter)(nil)
+
+func (i *lazyInt64Counter) Add(ctx context.Context, incr int64, options ...AddOption) {
+	if inst, ok := i.get(); ok {
+		inst.Add(ctx, incr, options...)
+	}
+}
+
+func (i *lazyInt64Counter) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
+
+// LazyInt64UpDownCounter returns an Int64UpDownCounter that defers its creation
+// until it is first used, like LazyInt64Counter.
+func LazyInt64UpDownCounter(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Int64UpDownCounterOption,
+) Int64UpDownCounter {
+	return &lazyInt64UpDownCounter{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Int64UpDownCounter, options),
+	}
+}
+
+type lazyInt64UpDownCounter struct {
+	embedded.Int64UpDownCounter
+	lazyInstrument[Int64UpDownCounter, Int64UpDownCounterOption]
+}
+
+var _ Int64UpDownCounter = (*lazyInt64UpDownCounter)(nil)
+
+func (i *lazyInt64UpDownCounter) Add(ctx context.Context, incr int64, options ...AddOption) {
+	if inst, ok := i.get(); ok {
+		inst.Add(ctx, incr, options...)
+	}
+}
+
+func (i *lazyInt64UpDownCounter) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
+
+// LazyInt64Histogram returns an Int64Histogram that defers its creation until
+// it is first used, like LazyInt64Counter.
+func LazyInt64Histogram(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Int64HistogramOption,
+) Int64Histogram {
+	return &lazyInt64Histogram{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Int64Histogram, options),
+	}
+}
+
+type lazyInt64Histogram struct {
+	embedded.Int64Histogram
+	lazyInstrument[Int64Histogram, Int64HistogramOption]
+}
+
+var _ Int64Histogram = (*lazyInt64Histogram)(nil)
+
+func (i *lazyInt64Histogram) Record(ctx context.Context, incr int64, options ...RecordOption) {
+	if inst, ok := i.get(); ok {
+		inst.Record(ctx, incr, options...)
+	}
+}
+
+func (i *lazyInt64Histogram) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
+
+// LazyInt64Gauge returns an Int64Gauge that defers its creation until it is
+// first used, like LazyInt64Counter.
+func LazyInt64Gauge(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Int64GaugeOption,
+) Int64Gauge {
+	return &lazyInt64Gauge{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Int64Gauge, options),
+	}
+}
+
+type lazyInt64Gauge struct {
+	embedded.Int64Gauge
+	lazyInstrument[Int64Gauge, Int64GaugeOption]
+}
+
+var _ Int64Gauge = (*lazyInt64Gauge)(nil)
+
+func (i *lazyInt64Gauge) Record(ctx context.Context, value int64, options ...RecordOption) {
+	if inst, ok := i.get(); ok {
+		inst.Record(ctx, value, options...)
+	}
+}
+
+func (i *lazyInt64Gauge) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
+
+// LazyFloat64Counter returns a Float64Counter that defers its creation until it
+// is first used, like LazyInt64Counter.
+func LazyFloat64Counter(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Float64CounterOption,
+) Float64Counter {
+	return &lazyFloat64Counter{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Float64Counter, options),
+	}
+}
+
+type lazyFloat64Counter struct {
+	embedded.Float64Counter
+	lazyInstrument[Float64Counter, Float64CounterOption]
+}
+
+var _ Float64Counter = (*lazyFloat64Counter)(nil)
+
+func (i *lazyFloat64Counter) Add(ctx context.Context, incr float64, options ...AddOption) {
+	if inst, ok := i.get(); ok {
+		inst.Add(ctx, incr, options...)
+	}
+}
+
+func (i *lazyFloat64Counter) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
+
+// LazyFloat64UpDownCounter returns a Float64UpDownCounter that defers its
+// creation until it is first used, like LazyInt64Counter.
+func LazyFloat64UpDownCounter(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Float64UpDownCounterOption,
+) Float64UpDownCounter {
+	return &lazyFloat64UpDownCounter{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Float64UpDownCounter, options),
+	}
+}
+
+type lazyFloat64UpDownCounter struct {
+	embedded.Float64UpDownCounter
+	lazyInstrument[Float64UpDownCounter, Float64UpDownCounterOption]
+}
+
+var _ Float64UpDownCounter = (*lazyFloat64UpDownCounter)(nil)
+
+func (i *lazyFloat64UpDownCounter) Add(ctx context.Context, incr float64, options ...AddOption) {
+	if inst, ok := i.get(); ok {
+		inst.Add(ctx, incr, options...)
+	}
+}
+
+func (i *lazyFloat64UpDownCounter) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
+
+// LazyFloat64Histogram returns a Float64Histogram that defers its creation
+// until it is first used, like LazyInt64Counter.
+func LazyFloat64Histogram(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Float64HistogramOption,
+) Float64Histogram {
+	return &lazyFloat64Histogram{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Float64Histogram, options),
+	}
+}
+
+type lazyFloat64Histogram struct {
+	embedded.Float64Histogram
+	lazyInstrument[Float64Histogram, Float64HistogramOption]
+}
+
+var _ Float64Histogram = (*lazyFloat64Histogram)(nil)
+
+func (i *lazyFloat64Histogram) Record(ctx context.Context, incr float64, options ...RecordOption) {
+	if inst, ok := i.get(); ok {
+		inst.Record(ctx, incr, options...)
+	}
+}
+
+func (i *lazyFloat64Histogram) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
+
+// LazyFloat64Gauge returns a Float64Gauge that defers its creation until it is
+// first used, like LazyInt64Counter.
+func LazyFloat64Gauge(
+	provider func() MeterProvider,
+	scope, name string,
+	options ...Float64GaugeOption,
+) Float64Gauge {
+	return &lazyFloat64Gauge{
+		lazyInstrument: newLazyInstrument(provider, scope, name, Meter.Float64Gauge, options),
+	}
+}
+
+type lazyFloat64Gauge struct {
+	embedded.Float64Gauge
+	lazyInstrument[Float64Gauge, Float64GaugeOption]
+}
+
+var _ Float64Gauge = (*lazyFloat64Gauge)(nil)
+
+func (i *lazyFloat64Gauge) Record(ctx context.Context, value float64, options ...RecordOption) {
+	if inst, ok := i.get(); ok {
+		inst.Record(ctx, value, options...)
+	}
+}
+
+func (i *lazyFloat64Gauge) Enabled(ctx context.Context) bool {
+	if inst, ok := i.get(); ok {
+		return inst.Enabled(ctx)
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type recordingCounter struct {
+	noop.Int64Counter
+
+	mu    sync.Mutex
+	total int64
+}
+
+func (c *recordingCounter) Add(_ context.Context, incr int64, _ ...metric.AddOption) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.total += incr
+}
+
+func (*recordingCounter) Enabled(context.Context) bool { return true }
+
+type recordingMeterProvider struct {
+	noop.MeterProvider
+
+	scope   string
+	name    string
+	counter *recordingCounter
+}
+
+func (p *recordingMeterProvider) Meter(scope string, _ ...metric.MeterOption) metric.Meter {
+	p.scope = scope
+	return recordingMeter{p: p}
+}
+
+type recordingMeter struct {
+	noop.Meter
+
+	p *recordingMeterProvider
+}
+
+func (m recordingMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	m.p.name = name
+	return m.p.counter, nil
+}
+
+func TestLazyInt64Counter(t *testing.T) {
+	var (
+		calls int
+		mp    *recordingMeterProvider
+	)
+	c := metric.LazyInt64Counter(func() metric.MeterProvider {
+		calls++
+		return mp
+	}, "scope", "requests")
+
+	// The provider is set after the counter is declared.
+	mp = &recordingMeterProvider{counter: &recordingCounter{}}
+	assert.Equal(t, 0, calls, "provider called before first use")
+
+	ctx := t.Context()
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() { c.Add(ctx, 1) })
+	}
+	wg.Wait()
+
+	assert.True(t, c.Enabled(ctx))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "scope", mp.scope)
+	assert.Equal(t, "requests", mp.name)
+	assert.Equal(t, int64(10), mp.counter.total)
+}
+
+func TestLazyInstrumentNilProvider(t *testing.T) {
+	c := metric.LazyInt64Counter(nil, "scope", "requests")
+	assert.NotPanics(t, func() { c.Add(t.Context(), 1) })
+	assert.False(t, c.Enabled(t.Context()))
+
+	c = metric.LazyInt64Counter(func() metric.MeterProvider { return nil }, "scope", "requests")
+	assert.NotPanics(t, func() { c.Add(t.Context(), 1) })
+	assert.False(t, c.Enabled(t.Context()))
+}
+
+type creatingMeter struct {
+	noop.Meter
+
+	created *[]string
+}
+
+func (m creatingMeter) Int64UpDownCounter(
+	name string,
+	_ ...metric.Int64UpDownCounterOption,
+) (metric.Int64UpDownCounter, error) {
+	*m.created = append(*m.created, "Int64UpDownCounter:"+name)
+	return noop.Int64UpDownCounter{}, nil
+}
+
+func (m creatingMeter) Int64Histogram(name string, _ ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
+	*m.created = append(*m.created, "Int64Histogram:"+name)
+	return noop.Int64Histogram{}, nil
+}
+
+func (m creatingMeter) Int64Gauge(name string, _ ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	*m.created = append(*m.created, "Int64Gauge:"+name)
+	return noop.Int64Gauge{}, nil
+}
+
+func (m creatingMeter) Float64Counter(name string, _ ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	*m.created = append(*m.created, "Float64Counter:"+name)
+	return noop.Float64Counter{}, nil
+}
+
+func (m creatingMeter) Float64UpDownCounter(
+	name string,
+	_ ...metric.Float64UpDownCounterOption,
+) (metric.Float64UpDownCounter, error) {
+	*m.created = append(*m.created, "Float64UpDownCounter:"+name)
+	return noop.Float64UpDownCounter{}, nil
+}
+
+func (m creatingMeter) Float64Histogram(
+	name string,
+	_ ...metric.Float64HistogramOption,
+) (metric.Float64Histogram, error) {
+	*m.created = append(*m.created, "Float64Histogram:"+name)
+	return noop.Float64Histogram{}, nil
+}
+
+func (m creatingMeter) Float64Gauge(name string, _ ...metric.Float64GaugeOption) (metric.Float64Gauge, error) {
+	*m.created = append(*m.created, "Float64Gauge:"+name)
+	return noop.Float64Gauge{}, nil
+}
+
+type creatingMeterProvider struct {
+	noop.MeterProvider
+
+	created []string
+}
+
+func (p *creatingMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return creatingMeter{created: &p.created}
+}
+
+func TestLazyInstruments(t *testing.T) {
+	mp := &creatingMeterProvider{}
+	provider := func() metric.MeterProvider { return mp }
+	ctx := t.Context()
+
+	metric.LazyInt64UpDownCounter(provider, "scope", "a").Add(ctx, 1)
+	metric.LazyInt64Histogram(provider, "scope", "b").Record(ctx, 1)
+	metric.LazyInt64Gauge(provider, "scope", "c").Record(ctx, 1)
+	metric.LazyFloat64Counter(provider, "scope", "d").Add(ctx, 1)
+	metric.LazyFloat64UpDownCounter(provider, "scope", "e").Add(ctx, 1)
+	metric.LazyFloat64Histogram(provider, "scope", "f").Record(ctx, 1)
+	g := metric.LazyFloat64Gauge(provider, "scope", "g")
+	assert.False(t, g.Enabled(ctx))
+	g.Record(ctx, 1)
+
+	assert.Equal(t, []string{
+		"Int64UpDownCounter:a",
+		"Int64Histogram:b",
+		"Int64Gauge:c",
+		"Float64Counter:d",
+		"Float64UpDownCounter:e",
+		"Float64Histogram:f",
+		"Float64Gauge:g",
+	}, mp.created)
+}
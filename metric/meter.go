@@ -298,6 +298,10 @@ type Callback func(context.Context, Observer) error
 
 // Observer records measurements for multiple instruments in a Callback.
 //
+// An Observer is only valid for the duration of the Callback it is passed to.
+// Implementations are expected to drop observations made after the Callback
+// returns, so all observations of a Callback are part of the same collection.
+//
 // Warning: Methods may be added to this interface in minor releases. See
 // package documentation on API implementation for information on how to set
 // default behavior for unimplemented methods.
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+// MustMeter wraps a Meter and creates instruments that panic instead of
+// returning an error. It is intended for package-level instruments whose
+// names and options are static, where any creation error is a programming
+// error.
+type MustMeter struct {
+	meter Meter
+}
+
+// Must returns a MustMeter wrapping meter.
+//
+// The returned MustMeter panics if meter returns an error when creating an
+// instrument. This includes errors an implementation returns alongside a
+// usable instrument, e.g. for an invalid instrument name.
+func Must(meter Meter) MustMeter {
+	return MustMeter{meter: meter}
+}
+
+// Meter returns the Meter wrapped by m.
+func (m MustMeter) Meter() Meter {
+	return m.meter
+}
+
+// Int64Counter returns a new Int64Counter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Int64Counter(name string, options ...Int64CounterOption) Int64Counter {
+	i, err := m.meter.Int64Counter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Int64UpDownCounter returns a new Int64UpDownCounter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Int64UpDownCounter(name string, options ...Int64UpDownCounterOption) Int64UpDownCounter {
+	i, err := m.meter.Int64UpDownCounter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Int64Histogram returns a new Int64Histogram instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Int64Histogram(name string, options ...Int64HistogramOption) Int64Histogram {
+	i, err := m.meter.Int64Histogram(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Int64Gauge returns a new Int64Gauge instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Int64Gauge(name string, options ...Int64GaugeOption) Int64Gauge {
+	i, err := m.meter.Int64Gauge(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Int64ObservableCounter returns a new Int64ObservableCounter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Int64ObservableCounter(name string, options ...Int64ObservableCounterOption) Int64ObservableCounter {
+	i, err := m.meter.Int64ObservableCounter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Int64ObservableUpDownCounter returns a new Int64ObservableUpDownCounter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Int64ObservableUpDownCounter(name string, options ...Int64ObservableUpDownCounterOption) Int64ObservableUpDownCounter {
+	i, err := m.meter.Int64ObservableUpDownCounter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Int64ObservableGauge returns a new Int64ObservableGauge instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Int64ObservableGauge(name string, options ...Int64ObservableGaugeOption) Int64ObservableGauge {
+	i, err := m.meter.Int64ObservableGauge(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Float64Counter returns a new Float64Counter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Float64Counter(name string, options ...Float64CounterOption) Float64Counter {
+	i, err := m.meter.Float64Counter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Float64UpDownCounter returns a new Float64UpDownCounter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Float64UpDownCounter(name string, options ...Float64UpDownCounterOption) Float64UpDownCounter {
+	i, err := m.meter.Float64UpDownCounter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Float64Histogram returns a new Float64Histogram instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Float64Histogram(name string, options ...Float64HistogramOption) Float64Histogram {
+	i, err := m.meter.Float64Histogram(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Float64Gauge returns a new Float64Gauge instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Float64Gauge(name string, options ...Float64GaugeOption) Float64Gauge {
+	i, err := m.meter.Float64Gauge(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Float64ObservableCounter returns a new Float64ObservableCounter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Float64ObservableCounter(name string, options ...Float64ObservableCounterOption) Float64ObservableCounter {
+	i, err := m.meter.Float64ObservableCounter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Float64ObservableUpDownCounter returns a new Float64ObservableUpDownCounter instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Float64ObservableUpDownCounter(name string, options ...Float64ObservableUpDownCounterOption) Float64ObservableUpDownCounter {
+	i, err := m.meter.Float64ObservableUpDownCounter(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Float64ObservableGauge returns a new Float64ObservableGauge instrument identified by name and
+// configured with options. It panics if the instrument cannot be created.
+func (m MustMeter) Float64ObservableGauge(name string, options ...Float64ObservableGaugeOption) Float64ObservableGauge {
+	i, err := m.meter.Float64ObservableGauge(name, options...)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
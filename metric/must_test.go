@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+var errInstrument = errors.New("instrument error")
+
+// errMeter returns a usable instrument along with errInstrument.
+type errMeter struct {
+	noop.Meter
+}
+
+func (errMeter) Int64Counter(string, ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return noop.Int64Counter{}, errInstrument
+}
+
+func (errMeter) Float64ObservableGauge(
+	string,
+	...metric.Float64ObservableGaugeOption,
+) (metric.Float64ObservableGauge, error) {
+	return noop.Float64ObservableGauge{}, errInstrument
+}
+
+func TestMust(t *testing.T) {
+	m := metric.Must(noop.Meter{})
+	assert.Equal(t, noop.Meter{}, m.Meter())
+
+	assert.NotPanics(t, func() {
+		assert.NotNil(t, m.Int64Counter("c"))
+		assert.NotNil(t, m.Int64UpDownCounter("c"))
+		assert.NotNil(t, m.Int64Histogram("c"))
+		assert.NotNil(t, m.Int64Gauge("c"))
+		assert.NotNil(t, m.Int64ObservableCounter("c"))
+		assert.NotNil(t, m.Int64ObservableUpDownCounter("c"))
+		assert.NotNil(t, m.Int64ObservableGauge("c"))
+		assert.NotNil(t, m.Float64Counter("c"))
+		assert.NotNil(t, m.Float64UpDownCounter("c"))
+		assert.NotNil(t, m.Float64Histogram("c"))
+		assert.NotNil(t, m.Float64Gauge("c"))
+		assert.NotNil(t, m.Float64ObservableCounter("c"))
+		assert.NotNil(t, m.Float64ObservableUpDownCounter("c"))
+		assert.NotNil(t, m.Float64ObservableGauge("c"))
+	})
+}
+
+func TestMustPanics(t *testing.T) {
+	m := metric.Must(errMeter{})
+	assert.PanicsWithError(t, errInstrument.Error(), func() { m.Int64Counter("c") })
+	assert.PanicsWithError(t, errInstrument.Error(), func() { m.Float64ObservableGauge("c") })
+}
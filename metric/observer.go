@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+// ObserverWithAttributeSet returns an Observer that records all observations
+// with o, associating them with the attributes of set.
+//
+// This is useful for Callbacks observing many instruments, where all
+// observations share the same attributes. Attributes passed with an
+// observation are merged with set, the ones passed with the observation take
+// precedence for duplicate keys.
+//
+//	_, err := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+//		stats := readStats() // Expensive, shared by all instruments.
+//		o = metric.ObserverWithAttributeSet(o, stats.Attributes)
+//		o.ObserveInt64(threads, stats.Threads)
+//		o.ObserveInt64(openFiles, stats.OpenFiles)
+//		return nil
+//	}, threads, openFiles)
+func ObserverWithAttributeSet(o Observer, set attribute.Set) Observer {
+	if set.Len() == 0 {
+		return o
+	}
+	return attrObserver{o: o, opt: WithAttributeSet(set)}
+}
+
+// attrObserver is an Observer adding a shared attribute set to observations.
+type attrObserver struct {
+	embedded.Observer
+
+	o   Observer
+	opt MeasurementOption
+}
+
+func (o attrObserver) options(opts []ObserveOption) []ObserveOption {
+	// The shared option is passed first so the attributes passed with the
+	// observation take precedence.
+	return append([]ObserveOption{o.opt}, opts...)
+}
+
+func (o attrObserver) ObserveFloat64(obsrv Float64Observable, value float64, opts ...ObserveOption) {
+	o.o.ObserveFloat64(obsrv, value, o.options(opts)...)
+}
+
+func (o attrObserver) ObserveInt64(obsrv Int64Observable, value int64, opts ...ObserveOption) {
+	o.o.ObserveInt64(obsrv, value, o.options(opts)...)
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/embedded"
+)
+
+type recordingObserver struct {
+	embedded.Observer
+
+	attrs []attribute.Set
+}
+
+func (o *recordingObserver) ObserveFloat64(_ Float64Observable, _ float64, opts ...ObserveOption) {
+	o.attrs = append(o.attrs, NewObserveConfig(opts).Attributes())
+}
+
+func (o *recordingObserver) ObserveInt64(_ Int64Observable, _ int64, opts ...ObserveOption) {
+	o.attrs = append(o.attrs, NewObserveConfig(opts).Attributes())
+}
+
+func TestObserverWithAttributeSet(t *testing.T) {
+	rec := new(recordingObserver)
+	assert.Same(t, rec, ObserverWithAttributeSet(rec, *attribute.EmptySet()))
+
+	shared := attribute.NewSet(attribute.String("a", "shared"), attribute.String("b", "shared"))
+	o := ObserverWithAttributeSet(rec, shared)
+	o.ObserveInt64(nil, 1)
+	o.ObserveFloat64(nil, 1, WithAttributes(attribute.String("b", "obs"), attribute.String("c", "obs")))
+
+	want := []attribute.Set{
+		shared,
+		attribute.NewSet(
+			attribute.String("a", "shared"),
+			attribute.String("b", "obs"),
+			attribute.String("c", "obs"),
+		),
+	}
+	assert.Equal(t, want, rec.attrs)
+}
@@ -183,6 +183,7 @@ type Float64HistogramConfig struct {
 	description              string
 	unit                     string
 	explicitBucketBoundaries []float64
+	noMinMax                 bool
 }
 
 // NewFloat64HistogramConfig returns a new [Float64HistogramConfig] with all
@@ -213,6 +214,11 @@ func (c Float64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
 }
 
+// NoMinMax returns whether the min and max are configured to not be recorded.
+func (c Float64HistogramConfig) NoMinMax() bool {
+	return c.noMinMax
+}
+
 // Float64HistogramOption applies options to a [Float64HistogramConfig]. See
 // [InstrumentOption] for other options that can be used as a
 // Float64HistogramOption.
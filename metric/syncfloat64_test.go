@@ -50,3 +50,9 @@ func TestFloat64ExplicitBucketHistogramConfiguration(t *testing.T) {
 	got := NewFloat64HistogramConfig(WithExplicitBucketBoundaries(bounds...))
 	assert.Equal(t, bounds, got.ExplicitBucketBoundaries(), "boundaries")
 }
+
+func TestFloat64HistogramNoMinMaxConfiguration(t *testing.T) {
+	assert.False(t, NewFloat64HistogramConfig().NoMinMax(), "default")
+	got := NewFloat64HistogramConfig(WithNoMinMax())
+	assert.True(t, got.NoMinMax(), "no min max")
+}
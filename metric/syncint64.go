@@ -183,6 +183,7 @@ type Int64HistogramConfig struct {
 	description              string
 	unit                     string
 	explicitBucketBoundaries []float64
+	noMinMax                 bool
 }
 
 // NewInt64HistogramConfig returns a new [Int64HistogramConfig] with all opts
@@ -213,6 +214,11 @@ func (c Int64HistogramConfig) ExplicitBucketBoundaries() []float64 {
 	return c.explicitBucketBoundaries
 }
 
+// NoMinMax returns whether the min and max are configured to not be recorded.
+func (c Int64HistogramConfig) NoMinMax() bool {
+	return c.noMinMax
+}
+
 // Int64HistogramOption applies options to a [Int64HistogramConfig]. See
 // [InstrumentOption] for other options that can be used as an
 // Int64HistogramOption.
@@ -50,3 +50,9 @@ func TestInt64ExplicitBucketHistogramConfiguration(t *testing.T) {
 	got := NewInt64HistogramConfig(WithExplicitBucketBoundaries(bounds...))
 	assert.Equal(t, bounds, got.ExplicitBucketBoundaries(), "boundaries")
 }
+
+func TestInt64HistogramNoMinMaxConfiguration(t *testing.T) {
+	assert.False(t, NewInt64HistogramConfig().NoMinMax(), "default")
+	got := NewInt64HistogramConfig(WithNoMinMax())
+	assert.True(t, got.NoMinMax(), "no min max")
+}
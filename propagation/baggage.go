@@ -32,11 +32,50 @@ var handleExtractErrOnce sync.Once
 //
 // This propagates user-defined baggage associated with a trace. The complete
 // specification is defined at https://www.w3.org/TR/baggage/.
-type Baggage struct{}
+//
+// The zero value extracts baggage with [baggage.ParseDefault]. Use
+// [NewBaggage] to extract it with another [baggage.ParseMode].
+type Baggage struct {
+	mode baggage.ParseMode
+}
 
 var _ TextMapPropagator = Baggage{}
 
-// Inject sets baggage key-values from ctx into the carrier.
+// BaggageOption configures a Baggage propagator returned by [NewBaggage].
+type BaggageOption interface {
+	apply(Baggage) Baggage
+}
+
+type baggageOptionFunc func(Baggage) Baggage
+
+func (fn baggageOptionFunc) apply(b Baggage) Baggage {
+	return fn(b)
+}
+
+// WithBaggageParseMode sets the [baggage.ParseMode] the baggage extracted by
+// the propagator is parsed with. By default, [baggage.ParseDefault] is used.
+//
+// The mode only applies to the extracted baggage. Values injected by the
+// propagator are always percent-encoded as required by the W3C Baggage
+// specification, so that any compliant system can parse them.
+func WithBaggageParseMode(mode baggage.ParseMode) BaggageOption {
+	return baggageOptionFunc(func(b Baggage) Baggage {
+		b.mode = mode
+		return b
+	})
+}
+
+// NewBaggage returns a Baggage propagator configured with opts.
+func NewBaggage(opts ...BaggageOption) Baggage {
+	var b Baggage
+	for _, opt := range opts {
+		b = opt.apply(b)
+	}
+	return b
+}
+
+// Inject sets baggage key-values from ctx into the carrier. The values are
+// percent-encoded as required by the W3C Baggage specification.
 func (Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
 	bStr := baggage.FromContext(ctx).String()
 	if bStr != "" {
@@ -47,11 +86,11 @@ func (Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
 // Extract returns a copy of parent with the baggage from the carrier added.
 // If carrier implements [ValuesGetter] (e.g. [HeaderCarrier]), Values is invoked
 // for multiple values extraction. Otherwise, Get is called.
-func (Baggage) Extract(parent context.Context, carrier TextMapCarrier) context.Context {
+func (b Baggage) Extract(parent context.Context, carrier TextMapCarrier) context.Context {
 	if multiCarrier, ok := carrier.(ValuesGetter); ok {
-		return extractMultiBaggage(parent, multiCarrier)
+		return extractMultiBaggage(parent, multiCarrier, b.mode)
 	}
-	return extractSingleBaggage(parent, carrier)
+	return extractSingleBaggage(parent, carrier, b.mode)
 }
 
 // Fields returns the keys who's values are set with Inject.
@@ -59,13 +98,13 @@ func (Baggage) Fields() []string {
 	return []string{baggageHeader}
 }
 
-func extractSingleBaggage(parent context.Context, carrier TextMapCarrier) context.Context {
+func extractSingleBaggage(parent context.Context, carrier TextMapCarrier, mode baggage.ParseMode) context.Context {
 	bStr := carrier.Get(baggageHeader)
 	if bStr == "" {
 		return parent
 	}
 
-	bag, err := baggage.Parse(bStr)
+	bag, err := baggage.ParseWithMode(bStr, mode)
 	if err != nil {
 		handleExtractErrOnce.Do(func() {
 			errorhandler.GetErrorHandler().Handle(err)
@@ -77,7 +116,7 @@ func extractSingleBaggage(parent context.Context, carrier TextMapCarrier) contex
 	return baggage.ContextWithBaggage(parent, bag)
 }
 
-func extractMultiBaggage(parent context.Context, carrier ValuesGetter) context.Context {
+func extractMultiBaggage(parent context.Context, carrier ValuesGetter, mode baggage.ParseMode) context.Context {
 	bVals := carrier.Values(baggageHeader)
 	if len(bVals) == 0 {
 		return parent
@@ -110,7 +149,7 @@ func extractMultiBaggage(parent context.Context, carrier ValuesGetter) context.C
 
 		// If members exceed the limit, stop parsing baggage.
 		if len(members) <= maxMembers {
-			currBag, err := baggage.Parse(bStr)
+			currBag, err := baggage.ParseWithMode(bStr, mode)
 			if err != nil {
 				parseErrors++
 				if parseErrors <= maxParseErrors {
@@ -714,3 +714,40 @@ func TestExtractInvalidBaggageReportsErrorOnce(t *testing.T) {
 		})
 	}
 }
+
+func TestBaggageParseMode(t *testing.T) {
+	const header = "k=café,ok=v"
+	tests := []struct {
+		mode baggage.ParseMode
+		want map[string]string
+	}{
+		{mode: baggage.ParseDefault, want: map[string]string{"ok": "v"}},
+		{mode: baggage.ParseStrict, want: map[string]string{}},
+		{mode: baggage.ParsePermissive, want: map[string]string{"k": "café", "ok": "v"}},
+	}
+	for _, tt := range tests {
+		prop := propagation.NewBaggage(propagation.WithBaggageParseMode(tt.mode))
+
+		req, _ := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://example.com", http.NoBody)
+		req.Header.Set("baggage", header)
+		carriers := []propagation.TextMapCarrier{
+			propagation.MapCarrier{"baggage": header},
+			propagation.HeaderCarrier(req.Header),
+		}
+		for _, carrier := range carriers {
+			got := map[string]string{}
+			for _, m := range baggage.FromContext(prop.Extract(t.Context(), carrier)).Members() {
+				got[m.Key()] = m.Value()
+			}
+			assert.Equalf(t, tt.want, got, "mode %d, carrier %T", tt.mode, carrier)
+		}
+	}
+
+	// Injected values are always percent-encoded.
+	prop := propagation.NewBaggage(propagation.WithBaggageParseMode(baggage.ParsePermissive))
+	ctx := prop.Extract(t.Context(), propagation.MapCarrier{"baggage": "k=café"})
+	carrier := propagation.MapCarrier{}
+	prop.Inject(ctx, carrier)
+	assert.Equal(t, "k=caf%C3%A9", carrier.Get("baggage"))
+	assert.Equal(t, propagation.Baggage{}, propagation.NewBaggage(), "default propagator")
+}
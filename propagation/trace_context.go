@@ -5,19 +5,13 @@ package propagation
 
 import (
 	"context"
-	"encoding/hex"
-	"fmt"
-	"strings"
 
 	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	supportedVersion  = 0
-	maxVersion        = 254
 	traceparentHeader = "traceparent"
 	tracestateHeader  = "tracestate"
-	delimiter         = "-"
 )
 
 // TraceContext is a propagator that supports the W3C Trace Context format
@@ -30,10 +24,7 @@ const (
 // their proprietary information.
 type TraceContext struct{}
 
-var (
-	_           TextMapPropagator = TraceContext{}
-	versionPart                   = fmt.Sprintf("%.2X", supportedVersion)
-)
+var _ TextMapPropagator = TraceContext{}
 
 // Inject injects the trace context from ctx into carrier.
 func (TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
@@ -46,22 +37,7 @@ func (TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 		carrier.Set(tracestateHeader, ts)
 	}
 
-	// Preserve only the spec-defined flags: sampled (0x01) and random (0x02).
-	flags := sc.TraceFlags() & (trace.FlagsSampled | trace.FlagsRandom)
-
-	var sb strings.Builder
-	sb.Grow(2 + 32 + 16 + 2 + 3)
-	_, _ = sb.WriteString(versionPart)
-	traceID := sc.TraceID()
-	spanID := sc.SpanID()
-	flagByte := [1]byte{byte(flags)}
-	var buf [32]byte
-	for _, src := range [][]byte{traceID[:], spanID[:], flagByte[:]} {
-		_ = sb.WriteByte(delimiter[0])
-		n := hex.Encode(buf[:], src)
-		_, _ = sb.Write(buf[:n])
-	}
-	carrier.Set(traceparentHeader, sb.String())
+	carrier.Set(traceparentHeader, sc.TraceParent())
 }
 
 // Extract reads tracecontext from the carrier into a returned Context.
@@ -83,70 +59,16 @@ func (TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 		return trace.SpanContext{}
 	}
 
-	var ver [1]byte
-	if !extractPart(ver[:], &h, 2) {
-		return trace.SpanContext{}
-	}
-	version := int(ver[0])
-	if version > maxVersion {
-		return trace.SpanContext{}
-	}
-
-	var scc trace.SpanContextConfig
-	if !extractPart(scc.TraceID[:], &h, 32) {
-		return trace.SpanContext{}
-	}
-	if !extractPart(scc.SpanID[:], &h, 16) {
-		return trace.SpanContext{}
-	}
-
-	var opts [1]byte
-	if !extractPart(opts[:], &h, 2) {
-		return trace.SpanContext{}
-	}
-	if version == 0 && (h != "" || opts[0] > 3) {
-		// version 0 does not allow extra fields or reserved flag bits.
+	sc, err := trace.ParseTraceParent(h)
+	if err != nil {
 		return trace.SpanContext{}
 	}
 
-	scc.TraceFlags = trace.TraceFlags(opts[0]) & //nolint:gosec // slice size already checked.
-		(trace.FlagsSampled | trace.FlagsRandom)
-
 	// Ignore the error returned here. Failure to parse tracestate MUST NOT
 	// affect the parsing of traceparent according to the W3C tracecontext
 	// specification.
-	scc.TraceState, _ = trace.ParseTraceState(carrier.Get(tracestateHeader))
-	scc.Remote = true
-
-	sc := trace.NewSpanContext(scc)
-	if !sc.IsValid() {
-		return trace.SpanContext{}
-	}
-
-	return sc
-}
-
-// upperHex detect hex is upper case Unicode characters.
-func upperHex(v string) bool {
-	for _, c := range v {
-		if c >= 'A' && c <= 'F' {
-			return true
-		}
-	}
-	return false
-}
-
-func extractPart(dst []byte, h *string, n int) bool {
-	part, left, _ := strings.Cut(*h, delimiter)
-	*h = left
-	// hex.Decode decodes unsupported upper-case characters, so exclude explicitly.
-	if len(part) != n || upperHex(part) {
-		return false
-	}
-	if p, err := hex.Decode(dst, []byte(part)); err != nil || p != n/2 {
-		return false
-	}
-	return true
+	ts, _ := trace.ParseTraceState(carrier.Get(tracestateHeader))
+	return sc.WithTraceState(ts)
 }
 
 // Fields returns the keys who's values are set with Inject.
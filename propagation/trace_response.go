@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const traceresponseHeader = "traceresponse"
+
+// traceResponseKeyType is the context key type of the extracted traceresponse
+// span context.
+type traceResponseKeyType int
+
+const traceResponseKey traceResponseKeyType = 0
+
+// TraceResponse is a propagator that supports the W3C Trace Context
+// traceresponse header (https://w3c.github.io/trace-context/#traceresponse-header).
+//
+// The traceresponse header is set by servers on their responses to return
+// the identity of the span handling the request to the caller. It has the same
+// format as the traceparent header. This lets clients correlate their requests
+// with the traces of the server, e.g. in browser real user monitoring, even
+// if the server started a new trace instead of continuing the trace of the
+// client.
+//
+// The specification of the traceresponse header is a draft and may change.
+//
+// This propagator is meant to be used with response carriers. It is not meant
+// to be combined with the request propagators in a composite propagator.
+type TraceResponse struct{}
+
+var _ TextMapPropagator = TraceResponse{}
+
+// Inject sets the traceresponse header of carrier to the SpanContext of ctx,
+// usually the one of the span of a server handling a request.
+func (TraceResponse) Inject(ctx context.Context, carrier TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+	carrier.Set(traceresponseHeader, sc.TraceParent())
+}
+
+// Extract reads the traceresponse header of carrier into a returned Context.
+// Use TraceResponseFromContext to retrieve it.
+//
+// The returned Context will be a copy of ctx holding the extracted
+// traceresponse. The SpanContext of ctx is not changed. If the extracted
+// traceresponse is invalid, the passed ctx will be returned directly instead.
+func (TraceResponse) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	h := carrier.Get(traceresponseHeader)
+	if h == "" {
+		return ctx
+	}
+	sc, err := trace.ParseTraceParent(h)
+	if err != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, traceResponseKey, sc)
+}
+
+// Fields returns the keys who's values are set with Inject.
+func (TraceResponse) Fields() []string {
+	return []string{traceresponseHeader}
+}
+
+// TraceResponseFromContext returns the remote SpanContext of the server
+// extracted from a traceresponse header by the TraceResponse propagator. An
+// invalid SpanContext is returned if ctx does not hold any.
+func TraceResponseFromContext(ctx context.Context) trace.SpanContext {
+	sc, _ := ctx.Value(traceResponseKey).(trace.SpanContext)
+	return sc
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var traceresponse = http.CanonicalHeaderKey("traceresponse")
+
+func TestTraceResponseInject(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(t.Context(), sc)
+
+	h := http.Header{}
+	propagation.TraceResponse{}.Inject(ctx, propagation.HeaderCarrier(h))
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", h.Get(traceresponse))
+
+	h = http.Header{}
+	propagation.TraceResponse{}.Inject(t.Context(), propagation.HeaderCarrier(h))
+	assert.Empty(t, h, "invalid span context")
+}
+
+func TestTraceResponseExtract(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   trace.SpanContext
+	}{
+		{
+			name:   "sampled",
+			header: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			want: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: trace.FlagsSampled,
+				Remote:     true,
+			}),
+		},
+		{name: "missing"},
+		{name: "invalid", header: "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The span context of the client is not changed.
+			client := trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{0x01},
+				SpanID:  trace.SpanID{0x01},
+			})
+			ctx := trace.ContextWithSpanContext(t.Context(), client)
+
+			h := http.Header{}
+			if tt.header != "" {
+				h.Set(traceresponse, tt.header)
+			}
+			ctx = propagation.TraceResponse{}.Extract(ctx, propagation.HeaderCarrier(h))
+			assert.Equal(t, tt.want, propagation.TraceResponseFromContext(ctx))
+			assert.Equal(t, client, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestTraceResponseFields(t *testing.T) {
+	assert.Equal(t, []string{"traceresponse"}, propagation.TraceResponse{}.Fields())
+}
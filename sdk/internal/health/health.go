@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package health provides export outcome tracking used by SDK components to
+// report their health.
+package health
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// Tracker records the outcome of exports. The zero value is ready to use.
+type Tracker struct {
+	mu          sync.Mutex
+	lastSuccess time.Time
+	lastErr     error
+	failures    int
+}
+
+// Record records the outcome of an export that returned err.
+func (t *Tracker) Record(err error) {
+	now := time.Now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		t.lastErr = err
+		t.failures++
+		return
+	}
+	t.lastSuccess = now
+	t.failures = 0
+}
+
+// Status returns a [otel.HealthStatus] for component populated with the
+// export outcomes recorded by t.
+func (t *Tracker) Status(component string) otel.HealthStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return otel.HealthStatus{
+		Component:           component,
+		LastSuccess:         t.lastSuccess,
+		LastError:           t.lastErr,
+		ConsecutiveFailures: t.failures,
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	var tracker Tracker
+	s := tracker.Status("component")
+	assert.Equal(t, "component", s.Component)
+	assert.True(t, s.Healthy())
+	assert.True(t, s.LastSuccess.IsZero())
+
+	errFailed := errors.New("failed")
+	tracker.Record(errFailed)
+	tracker.Record(errFailed)
+	s = tracker.Status("component")
+	assert.False(t, s.Healthy())
+	assert.Equal(t, 2, s.ConsecutiveFailures)
+	assert.Equal(t, errFailed, s.LastError)
+	assert.True(t, s.LastSuccess.IsZero())
+
+	tracker.Record(nil)
+	s = tracker.Status("component")
+	assert.True(t, s.Healthy())
+	assert.Zero(t, s.ConsecutiveFailures)
+	assert.Equal(t, errFailed, s.LastError, "last error retained")
+	assert.False(t, s.LastSuccess.IsZero())
+}
@@ -0,0 +1,266 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/log/internal/observ"
+)
+
+const dfltAsyncQSize = dfltMaxQSize
+
+// Compile-time check AsyncProcessor implements Processor.
+var _ Processor = (*AsyncProcessor)(nil)
+
+// AsyncProcessor is a processor that calls another processor from a pool of
+// worker goroutines.
+//
+// Use [NewAsyncProcessor] to create an AsyncProcessor. An empty AsyncProcessor
+// is shut down by default, no records will be processed.
+type AsyncProcessor struct {
+	inner   Processor
+	workers int
+
+	queue   chan asyncItem
+	dropped atomic.Uint64
+
+	// flushing serializes ForceFlush calls. Each worker blocks on the
+	// asyncFlush it receives, so the asyncFlush of concurrent calls would
+	// otherwise be split across the workers and none would complete.
+	flushing chan struct{}
+
+	// inst is the instrumentation for observability (nil when disabled).
+	inst *observ.ALP
+
+	// mu guards sending to queue against closing it on shutdown.
+	mu      sync.RWMutex
+	stopped bool
+	wg      sync.WaitGroup
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+// asyncItem is a queued log record or a flush request.
+type asyncItem struct {
+	ctx    context.Context
+	record Record
+	flush  *asyncFlush
+}
+
+// asyncFlush is queued by ForceFlush to synchronize with all workers of an
+// AsyncProcessor.
+type asyncFlush struct {
+	// arrived is done by each worker that receives the asyncFlush.
+	arrived sync.WaitGroup
+	// release is closed when the workers may continue processing records.
+	release chan struct{}
+}
+
+// NewAsyncProcessor returns an [AsyncProcessor] that calls the OnEmit method
+// of inner from a pool of workers goroutines instead of the goroutine emitting
+// the log record. This removes the latency of expensive processors, for
+// example ones enriching records, from the application.
+//
+// Emitted records are cloned and buffered in a queue holding at most
+// queueSize records. If the queue is full, the record is dropped and is not
+// passed to inner. The number of dropped records is reported by the
+// experimental SDK observability metrics.
+//
+// Changes made by inner to the records it receives are not visible to the
+// processors registered after the AsyncProcessor. Errors returned by the
+// OnEmit method of inner are passed to the global error handler.
+//
+// The Enabled method of inner is still called synchronously.
+//
+// If workers is less than one, one worker is used. If queueSize is less than
+// one, a queue size of 2048 is used.
+func NewAsyncProcessor(inner Processor, workers, queueSize int) *AsyncProcessor {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 1 {
+		queueSize = dfltAsyncQSize
+	}
+
+	p := &AsyncProcessor{
+		inner:    inner,
+		workers:  workers,
+		queue:    make(chan asyncItem, queueSize),
+		flushing: make(chan struct{}, 1),
+	}
+
+	var err error
+	p.inst, err = observ.NewALP(
+		observ.NextAsyncProcessorID(),
+		func() int64 { return int64(len(p.queue)) },
+		int64(queueSize),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	for range workers {
+		p.wg.Go(p.work)
+	}
+	return p
+}
+
+// work passes queued records to the inner processor until the queue is
+// closed.
+func (p *AsyncProcessor) work() {
+	for item := range p.queue {
+		if item.flush != nil {
+			item.flush.arrived.Done()
+			<-item.flush.release
+			continue
+		}
+		if err := p.inner.OnEmit(item.ctx, &item.record); err != nil {
+			otel.Handle(err)
+		}
+		if p.inst != nil {
+			p.inst.Processed(item.ctx, 1)
+		}
+	}
+}
+
+// Enabled returns the result of the Enabled method of the wrapped processor.
+func (p *AsyncProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.inner == nil {
+		return false
+	}
+	return p.inner.Enabled(ctx, param)
+}
+
+// OnEmit queues a copy of r to be passed to the wrapped processor. If the
+// queue is full, r is dropped.
+//
+// The record is passed to the wrapped processor with a context that holds the
+// values of ctx but is never canceled.
+func (p *AsyncProcessor) OnEmit(ctx context.Context, r *Record) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.stopped || p.queue == nil {
+		return nil
+	}
+
+	// The record is cloned so that changes done by subsequent processors
+	// are not going to lead to a data race.
+	select {
+	case p.queue <- asyncItem{ctx: context.WithoutCancel(ctx), record: r.Clone()}:
+	default:
+		if p.dropped.Add(1) == 1 {
+			global.Warn("async processor queue is full, dropping log records", "capacity", cap(p.queue))
+		}
+		if p.inst != nil {
+			p.inst.ProcessedQueueFull(ctx, 1)
+		}
+	}
+	return nil
+}
+
+// ForceFlush waits for all queued records to be passed to the wrapped
+// processor and then flushes it.
+func (p *AsyncProcessor) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if p.queue == nil {
+		return nil
+	}
+
+	select {
+	case p.flushing <- struct{}{}:
+		defer func() { <-p.flushing }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	p.mu.RLock()
+	if p.stopped {
+		p.mu.RUnlock()
+		return nil
+	}
+
+	// Block every worker once all records queued before them are processed.
+	f := &asyncFlush{release: make(chan struct{})}
+	defer close(f.release)
+	for range p.workers {
+		f.arrived.Add(1)
+		select {
+		case p.queue <- asyncItem{flush: f}:
+		case <-ctx.Done():
+			p.mu.RUnlock()
+			return ctx.Err()
+		}
+	}
+	p.mu.RUnlock()
+
+	done := make(chan struct{})
+	go func() {
+		f.arrived.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return p.inner.ForceFlush(ctx)
+}
+
+// Shutdown stops accepting records, waits for all queued records to be passed
+// to the wrapped processor, and then shuts it down. If ctx is done before all
+// queued records are processed, the wrapped processor is shut down without
+// waiting for them.
+func (p *AsyncProcessor) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	if p.stopped || p.queue == nil {
+		p.mu.Unlock()
+		return nil
+	}
+	p.stopped = true
+	close(p.queue)
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+		err = p.inner.Shutdown(ctx)
+	case <-ctx.Done():
+		err = errors.Join(ctx.Err(), p.inner.Shutdown(ctx))
+	}
+
+	if p.inst != nil {
+		err = errors.Join(err, p.inst.Shutdown())
+	}
+	return err
+}
+
+// Health returns a snapshot of the health of the AsyncProcessor.
+func (p *AsyncProcessor) Health() otel.HealthStatus {
+	p.mu.RLock()
+	stopped := p.stopped || p.queue == nil
+	p.mu.RUnlock()
+
+	return otel.HealthStatus{
+		Component:     "AsyncProcessor",
+		QueueSize:     len(p.queue),
+		QueueCapacity: cap(p.queue),
+		Shutdown:      stopped,
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// blockingProcessor is a processor that waits for block to be closed, if not
+// nil, before passing records to its embedded processor.
+type blockingProcessor struct {
+	*processor
+
+	block chan struct{}
+	// entered receives each record passed to OnEmit, if not nil.
+	entered chan struct{}
+}
+
+func (p *blockingProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if p.entered != nil {
+		p.entered <- struct{}{}
+	}
+	if p.block != nil {
+		<-p.block
+	}
+	return p.processor.OnEmit(ctx, r)
+}
+
+func bodies(records []Record) []string {
+	out := make([]string, len(records))
+	for i, r := range records {
+		out[i] = r.Body().AsString()
+	}
+	return out
+}
+
+func bodyRecord(body string) *Record {
+	r := new(Record)
+	r.SetBody(attribute.StringValue(body))
+	return r
+}
+
+func TestAsyncProcessor(t *testing.T) {
+	inner := newProcessor("inner")
+	p := NewAsyncProcessor(inner, 1, 10)
+	defer func() { assert.NoError(t, p.Shutdown(t.Context())) }()
+	ctx := t.Context()
+
+	assert.True(t, p.Enabled(ctx, EnabledParameters{}))
+
+	r := bodyRecord("a")
+	require.NoError(t, p.OnEmit(ctx, r))
+	// The queued record is a copy.
+	r.SetBody(attribute.StringValue("changed"))
+	require.NoError(t, p.OnEmit(ctx, bodyRecord("b")))
+
+	require.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, []string{"a", "b"}, bodies(inner.records))
+	assert.Equal(t, 1, inner.forceFlushCalls)
+}
+
+func TestAsyncProcessorDoesNotBlockOnEmit(t *testing.T) {
+	inner := &blockingProcessor{processor: newProcessor("inner"), block: make(chan struct{})}
+	p := NewAsyncProcessor(inner, 1, 10)
+	defer func() { assert.NoError(t, p.Shutdown(t.Context())) }()
+	ctx := t.Context()
+
+	emitted := make(chan error)
+	go func() { emitted <- p.OnEmit(ctx, bodyRecord("a")) }()
+	select {
+	case err := <-emitted:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnEmit blocked on the inner processor")
+	}
+
+	close(inner.block)
+	require.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, []string{"a"}, bodies(inner.records))
+}
+
+func TestAsyncProcessorDropsWhenFull(t *testing.T) {
+	inner := &blockingProcessor{
+		processor: newProcessor("inner"),
+		block:     make(chan struct{}),
+		entered:   make(chan struct{}, 1),
+	}
+	p := NewAsyncProcessor(inner, 1, 1)
+	defer func() { assert.NoError(t, p.Shutdown(t.Context())) }()
+	ctx := t.Context()
+
+	require.NoError(t, p.OnEmit(ctx, bodyRecord("processing")))
+	// Wait for the worker to be blocked processing the first record.
+	<-inner.entered
+	inner.entered = nil
+
+	require.NoError(t, p.OnEmit(ctx, bodyRecord("queued")))
+	require.NoError(t, p.OnEmit(ctx, bodyRecord("dropped")))
+	assert.Equal(t, uint64(1), p.dropped.Load())
+
+	h := p.Health()
+	assert.Equal(t, 1, h.QueueSize)
+	assert.Equal(t, 1, h.QueueCapacity)
+
+	close(inner.block)
+	require.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, []string{"processing", "queued"}, bodies(inner.records))
+}
+
+func TestAsyncProcessorOnEmitError(t *testing.T) {
+	var got atomic.Value
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { got.Store(err) }))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	inner := newProcessor("inner")
+	inner.Err = assert.AnError
+	p := NewAsyncProcessor(inner, 1, 10)
+	defer func() { assert.ErrorIs(t, p.Shutdown(t.Context()), assert.AnError) }()
+	ctx := t.Context()
+
+	require.NoError(t, p.OnEmit(ctx, bodyRecord("a")))
+	assert.ErrorIs(t, p.ForceFlush(ctx), assert.AnError)
+	assert.Equal(t, assert.AnError, got.Load())
+}
+
+func TestAsyncProcessorForceFlushCanceled(t *testing.T) {
+	inner := &blockingProcessor{processor: newProcessor("inner"), block: make(chan struct{})}
+	p := NewAsyncProcessor(inner, 1, 10)
+	defer func() { assert.NoError(t, p.Shutdown(t.Context())) }()
+
+	require.NoError(t, p.OnEmit(t.Context(), bodyRecord("a")))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, p.ForceFlush(ctx), context.DeadlineExceeded)
+
+	close(inner.block)
+	require.NoError(t, p.ForceFlush(t.Context()))
+	assert.Equal(t, []string{"a"}, bodies(inner.records))
+	assert.Equal(t, 1, inner.forceFlushCalls)
+}
+
+func TestAsyncProcessorConcurrentForceFlush(t *testing.T) {
+	inner := new(concurrentProcessor)
+	p := NewAsyncProcessor(inner, 4, 16)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Go(func() {
+				assert.NoError(t, p.ForceFlush(context.Background()))
+			})
+		}
+		wg.Wait()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("concurrent ForceFlush calls did not return")
+	}
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
+
+func TestAsyncProcessorShutdownCanceled(t *testing.T) {
+	inner := &blockingProcessor{processor: newProcessor("inner"), block: make(chan struct{})}
+	p := NewAsyncProcessor(inner, 1, 10)
+	defer close(inner.block)
+
+	require.NoError(t, p.OnEmit(t.Context(), bodyRecord("a")))
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, p.Shutdown(ctx), context.DeadlineExceeded)
+	assert.Equal(t, 1, inner.shutdownCalls, "inner not shut down")
+}
+
+func TestAsyncProcessorShutdown(t *testing.T) {
+	inner := newProcessor("inner")
+	p := NewAsyncProcessor(inner, 2, 10)
+	ctx := t.Context()
+
+	require.NoError(t, p.OnEmit(ctx, bodyRecord("before")))
+	require.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, []string{"before"}, bodies(inner.records), "queued records processed")
+	assert.Equal(t, 1, inner.shutdownCalls)
+	assert.True(t, p.Health().Shutdown)
+
+	require.NoError(t, p.OnEmit(ctx, bodyRecord("after")))
+	require.NoError(t, p.ForceFlush(ctx))
+	require.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, []string{"before"}, bodies(inner.records), "record emitted after shutdown")
+	assert.Equal(t, 1, inner.shutdownCalls, "inner shutdown more than once")
+}
+
+func TestAsyncProcessorEmpty(t *testing.T) {
+	var p AsyncProcessor
+	ctx := t.Context()
+	assert.False(t, p.Enabled(ctx, EnabledParameters{}))
+	assert.NoError(t, p.OnEmit(ctx, new(Record)))
+	assert.NoError(t, p.ForceFlush(ctx))
+	assert.NoError(t, p.Shutdown(ctx))
+	assert.True(t, p.Health().Shutdown)
+}
+
+// concurrentProcessor is a concurrent safe processor that counts records.
+type concurrentProcessor struct {
+	processor
+
+	n atomic.Int64
+}
+
+func (p *concurrentProcessor) OnEmit(context.Context, *Record) error {
+	p.n.Add(1)
+	return nil
+}
+
+func TestAsyncProcessorConcurrentSafe(t *testing.T) {
+	inner := new(concurrentProcessor)
+	p := NewAsyncProcessor(inner, 4, 16)
+	ctx := t.Context()
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 100 {
+				_ = p.Enabled(ctx, EnabledParameters{})
+				_ = p.OnEmit(ctx, new(Record))
+			}
+		})
+	}
+	wg.Go(func() {
+		_ = p.ForceFlush(ctx)
+	})
+	wg.Wait()
+
+	require.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, int64(800), inner.n.Load()+int64(p.dropped.Load()))
+}
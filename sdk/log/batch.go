@@ -13,6 +13,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/health"
 	"go.opentelemetry.io/otel/sdk/log/internal/counter"
 	"go.opentelemetry.io/otel/sdk/log/internal/observ"
 )
@@ -47,6 +48,9 @@ type BatchProcessor struct {
 	q *queue
 	// batchSize is the maximum number of records in a scheduled export.
 	batchSize int
+	// batchBytes is the maximum estimated encoded size of a scheduled export.
+	// If zero, exports are not limited by their estimated size.
+	batchBytes int
 
 	// exportTrigger is a coalesced signal that records are ready to export.
 	exportTrigger chan struct{}
@@ -64,12 +68,20 @@ type BatchProcessor struct {
 	// inst is the instrumentation for observability (nil when disabled).
 	inst *observ.BLP
 
+	// health tracks the outcome of exports.
+	health health.Tracker
+
 	noCmp [0]func() //nolint: unused  // This is indeed used.
 }
 
 type batchProcessorRequest struct {
 	ctx  context.Context
 	resp chan<- error
+
+	// scope is the name of the instrumentation scope of the records to flush
+	// if scoped is true. Otherwise, all the queued records are flushed.
+	scope  string
+	scoped bool
 }
 
 func (r batchProcessorRequest) respond(err error) {
@@ -91,11 +103,13 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 	b := &BatchProcessor{
 		q:             newQueue(cfg.maxQSize.Value),
 		batchSize:     cfg.expMaxBatchSize.Value,
+		batchBytes:    cfg.expMaxBatchBytes.Value,
 		exportTrigger: make(chan struct{}, 1),
 		flush:         make(chan batchProcessorRequest),
 		shutdown:      make(chan batchProcessorRequest, 1),
 		done:          make(chan struct{}),
 	}
+	b.q.maxBytes = b.batchBytes
 
 	var err error
 	b.inst, err = observ.NewBLP(
@@ -120,7 +134,7 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 	exporter = newTimeoutExporter(exporter, cfg.expTimeout.Value)
 	// Use a chunkExporter to ensure ForceFlush and Shutdown calls are batched
 	// appropriately on export.
-	exporter = newChunkExporter(exporter, cfg.expMaxBatchSize.Value)
+	exporter = newChunkExporter(exporter, cfg.expMaxBatchSize.Value, cfg.expMaxBatchBytes.Value)
 
 	b.exporter = exporter
 	b.process(cfg.expInterval.Value)
@@ -159,7 +173,7 @@ func (b *BatchProcessor) process(interval time.Duration) {
 				req.respond(err)
 				return
 			case req := <-b.flush:
-				err := b.flushExporter(req.ctx)
+				err := b.flushExporter(req)
 				req.respond(err)
 				continue
 			default:
@@ -174,7 +188,7 @@ func (b *BatchProcessor) process(interval time.Duration) {
 				req.respond(err)
 				return
 			case req := <-b.flush:
-				err := b.flushExporter(req.ctx)
+				err := b.flushExporter(req)
 				req.respond(err)
 			case <-timer.C:
 				resetTimer(timer, interval)
@@ -206,22 +220,30 @@ func (b *BatchProcessor) exportBatch(buf []Record) {
 	}
 
 	err := b.exporter.Export(context.Background(), buf[:n])
+	b.health.Record(err)
 	clear(buf[:n])
 	if err != nil {
 		otel.Handle(err)
 	}
-	if remaining >= b.batchSize {
+	if remaining >= b.batchSize || b.q.BytesReady() {
 		b.triggerExport()
 	}
 }
 
-func (b *BatchProcessor) flushExporter(ctx context.Context) error {
+func (b *BatchProcessor) flushExporter(req batchProcessorRequest) error {
+	ctx := req.ctx
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	b.logDroppedRecords()
-	records := b.q.Flush()
+	var records []Record
+	if req.scoped {
+		records = b.q.FlushScope(req.scope)
+	} else {
+		records = b.q.Flush()
+	}
 	err := b.exporter.Export(ctx, records)
+	b.recordHealth(len(records), err)
 	clear(records)
 	if ctxErr := ctx.Err(); ctxErr != nil {
 		return errors.Join(err, ctxErr)
@@ -233,6 +255,7 @@ func (b *BatchProcessor) shutdownExporter(ctx context.Context) error {
 	b.logDroppedRecords()
 	records := b.q.Flush()
 	err := b.exporter.Export(ctx, records)
+	b.recordHealth(len(records), err)
 	clear(records)
 	if ctxErr := ctx.Err(); ctxErr != nil {
 		err = errors.Join(err, ctxErr)
@@ -246,6 +269,14 @@ func (b *BatchProcessor) shutdownExporter(ctx context.Context) error {
 	return err
 }
 
+// recordHealth records the outcome of an export of n records. Exports of no
+// records are not recorded as they do not reflect the exporter's health.
+func (b *BatchProcessor) recordHealth(n int, err error) {
+	if n > 0 {
+		b.health.Record(err)
+	}
+}
+
 func (b *BatchProcessor) logDroppedRecords() {
 	if d := b.q.Dropped(); d > 0 {
 		if b.inst != nil {
@@ -274,12 +305,23 @@ func (b *BatchProcessor) OnEmit(_ context.Context, r *Record) error {
 	}
 	// The record is cloned so that changes done by subsequent processors
 	// are not going to lead to a data race.
-	if n, accepted := b.q.Enqueue(r.Clone()); accepted && n >= b.batchSize {
+	if n, accepted := b.q.Enqueue(r.Clone()); accepted && (n >= b.batchSize || b.q.BytesReady()) {
 		b.triggerExport()
 	}
 	return nil
 }
 
+// Health returns a snapshot of the health of the BatchProcessor.
+func (b *BatchProcessor) Health() otel.HealthStatus {
+	s := b.health.Status("BatchProcessor")
+	if b.q != nil {
+		s.QueueSize = b.q.Len()
+		s.QueueCapacity = b.q.cap
+	}
+	s.Shutdown = b.stopped.Load() || b.q == nil
+	return s
+}
+
 // Shutdown flushes queued log records and the decorated exporter before
 // shutting it down.
 func (b *BatchProcessor) Shutdown(ctx context.Context) error {
@@ -303,15 +345,27 @@ func (b *BatchProcessor) Shutdown(ctx context.Context) error {
 
 // ForceFlush flushes queued log records and flushes the decorated exporter.
 func (b *BatchProcessor) ForceFlush(ctx context.Context) error {
+	return b.forceFlush(batchProcessorRequest{ctx: ctx})
+}
+
+// ForceFlushScope flushes the queued log records emitted by the loggers with
+// the instrumentation scope name, and flushes the decorated exporter. The
+// other queued log records are kept in the queue and exported as scheduled.
+func (b *BatchProcessor) ForceFlushScope(ctx context.Context, name string) error {
+	return b.forceFlush(batchProcessorRequest{ctx: ctx, scope: name, scoped: true})
+}
+
+func (b *BatchProcessor) forceFlush(req batchProcessorRequest) error {
 	if b.stopped.Load() || b.q == nil {
 		return nil
 	}
+	ctx := req.ctx
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 
 	resp := make(chan error, 1)
-	req := batchProcessorRequest{ctx: ctx, resp: resp}
+	req.resp = resp
 	select {
 	case b.flush <- req:
 	case <-b.done:
@@ -339,6 +393,12 @@ type queue struct {
 	cap, len    int
 	read, write *ring
 	closed      bool
+
+	// maxBytes is the estimated encoded size limit of a dequeued batch. If
+	// zero, the estimated size of records is not tracked.
+	maxBytes int
+	// bytes is the estimated encoded size of all queued records.
+	bytes int
 }
 
 func newQueue(size int) *queue {
@@ -363,6 +423,20 @@ func (q *queue) Dropped() uint64 {
 	return q.dropped.Swap(0)
 }
 
+// BytesReady reports whether the estimated encoded size of the queued records
+// has reached the batch size limit of the queue. It always returns false if
+// the queue does not limit the size of batches.
+func (q *queue) BytesReady() bool {
+	if q.maxBytes <= 0 {
+		return false
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	return q.bytes >= q.maxBytes
+}
+
 // Enqueue adds r to the queue. The queue size, including the addition of r, is
 // returned.
 //
@@ -376,6 +450,13 @@ func (q *queue) Enqueue(r Record) (int, bool) {
 		return q.len, false
 	}
 
+	if q.maxBytes > 0 {
+		if q.len == q.cap {
+			// The oldest record is about to be overwritten by r.
+			q.bytes -= q.write.Value.estimatedSize()
+		}
+		q.bytes += r.estimatedSize()
+	}
 	q.write.Value = r
 	q.write = q.write.Next()
 
@@ -391,17 +472,31 @@ func (q *queue) Enqueue(r Record) (int, bool) {
 
 // Dequeue removes up to len(buf) records from the queue and copies them into
 // buf. The number copied and the number remaining are returned.
+//
+// If the queue limits the estimated encoded size of batches, records are
+// dequeued only while that limit is not exceeded. At least one record is
+// always dequeued if the queue is not empty.
 func (q *queue) Dequeue(buf []Record) (int, int) {
 	q.Lock()
 	defer q.Unlock()
 
 	n := min(len(buf), q.len)
+	var bytes int
 	for i := range n {
+		if q.maxBytes > 0 {
+			size := q.read.Value.estimatedSize()
+			if i > 0 && bytes+size > q.maxBytes {
+				n = i
+				break
+			}
+			bytes += size
+		}
 		buf[i] = q.read.Value // nolint:gosec // n is bounded by len(buf)
 		q.read.Value = Record{}
 		q.read = q.read.Next()
 	}
 	q.len -= n
+	q.bytes -= bytes
 	return n, q.len
 }
 
@@ -414,6 +509,42 @@ func (q *queue) Flush() []Record {
 	return q.flush()
 }
 
+// FlushScope returns the Records held in the queue with the instrumentation
+// scope name and removes them from the queue. The order of the other Records
+// is preserved.
+func (q *queue) FlushScope(name string) []Record {
+	q.Lock()
+	defer q.Unlock()
+
+	var out []Record
+	n, kept := q.len, 0
+	keep := q.read
+	for r := q.read; n > 0; n-- {
+		rec := r.Value
+		if rec.InstrumentationScope().Name == name {
+			out = append(out, rec)
+			if q.maxBytes > 0 {
+				q.bytes -= rec.estimatedSize()
+			}
+		} else {
+			keep.Value = rec
+			keep = keep.Next()
+			kept++
+		}
+		r = r.Next()
+	}
+	// Erase the slots freed by the removed Records to let the GC collect
+	// them.
+	q.write = keep
+	for range q.len - kept {
+		keep.Value = Record{}
+		keep = keep.Next()
+	}
+	q.len = kept
+
+	return out
+}
+
 // Close stops the queue from accepting records.
 func (q *queue) Close() {
 	q.Lock()
@@ -430,6 +561,7 @@ func (q *queue) flush() []Record {
 		q.read = q.read.Next()
 	}
 	q.len = 0
+	q.bytes = 0
 
 	return out
 }
@@ -439,6 +571,8 @@ type batchConfig struct {
 	expInterval     setting[time.Duration]
 	expTimeout      setting[time.Duration]
 	expMaxBatchSize setting[int]
+
+	expMaxBatchBytes setting[int]
 }
 
 func newBatchConfig(options []BatchProcessorOption) batchConfig {
@@ -472,6 +606,9 @@ func newBatchConfig(options []BatchProcessorOption) batchConfig {
 		fallback[int](dfltExpMaxBatchSize),
 		clampMax[int](c.maxQSize.Value),
 	)
+	c.expMaxBatchBytes = c.expMaxBatchBytes.Resolve(
+		clearLessThanOne[int](),
+	)
 	return c
 }
 
@@ -549,6 +686,28 @@ func WithExportMaxBatchSize(size int) BatchProcessorOption {
 	})
 }
 
+// WithExportMaxBatchBytes sets the maximum estimated encoded size, in bytes,
+// of every export. An export is triggered once the estimated size of the
+// queued log records reaches this size, and a batch will be split into
+// multiple exports to not exceed it. A single log record larger than size is
+// exported by itself.
+//
+// The size of a log record is estimated from its content. It approximates the
+// size of the record in an OTLP payload, but it is not exact. Choose a size
+// with sufficient headroom below the limit of the receiver.
+//
+// This limit is applied in addition to the one set by
+// [WithExportMaxBatchSize]; whichever is reached first bounds the export.
+//
+// By default, or if the provided value is less than one, exports are not
+// limited by their estimated size.
+func WithExportMaxBatchBytes(size int) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.expMaxBatchBytes = newSetting(size)
+		return cfg
+	})
+}
+
 // WithExportBufferSize is retained for source compatibility and has no effect.
 // The processor no longer maintains a separately configurable export-request
 // buffer. [WithMaxQueueSize] bounds the pending-record queue.
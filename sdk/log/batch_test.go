@@ -84,13 +84,15 @@ func TestNewBatchConfig(t *testing.T) {
 				WithExportInterval(time.Microsecond),
 				WithExportTimeout(time.Hour),
 				WithExportMaxBatchSize(2),
+				WithExportMaxBatchBytes(1024),
 				WithExportBufferSize(3),
 			},
 			want: batchConfig{
-				maxQSize:        newSetting(10),
-				expInterval:     newSetting(time.Microsecond),
-				expTimeout:      newSetting(time.Hour),
-				expMaxBatchSize: newSetting(2),
+				maxQSize:         newSetting(10),
+				expInterval:      newSetting(time.Microsecond),
+				expTimeout:       newSetting(time.Hour),
+				expMaxBatchSize:  newSetting(2),
+				expMaxBatchBytes: newSetting(1024),
 			},
 		},
 		{
@@ -115,6 +117,7 @@ func TestNewBatchConfig(t *testing.T) {
 				WithExportInterval(-1 * time.Microsecond),
 				WithExportTimeout(-1 * time.Hour),
 				WithExportMaxBatchSize(-2),
+				WithExportMaxBatchBytes(-2),
 				WithExportBufferSize(-2),
 			},
 			want: batchConfig{
@@ -277,6 +280,40 @@ func TestBatchProcessor(t *testing.T) {
 		assert.GreaterOrEqual(t, e.ExportN(), 10)
 	})
 
+	t.Run("OnEmitBatchBytes", func(t *testing.T) {
+		e := &testExporter{}
+		var r Record
+		r.SetBody(attribute.StringValue(strings.Repeat("a", 1024)))
+		size := r.estimatedSize()
+
+		b := NewBatchProcessor(
+			e,
+			WithMaxQueueSize(100),
+			WithExportMaxBatchSize(100),
+			WithExportMaxBatchBytes(2*size),
+			WithExportInterval(time.Hour),
+			WithExportTimeout(time.Hour),
+		)
+		defer func() { assert.NoError(t, b.Shutdown(t.Context())) }()
+
+		assert.NoError(t, b.OnEmit(ctx, &r))
+		assert.NoError(t, b.OnEmit(ctx, &r))
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, 1, e.ExportN())
+		}, 2*time.Second, time.Microsecond, "size triggered export")
+		records := e.Records()
+		require.Len(t, records, 1)
+		assert.Len(t, records[0], 2)
+
+		for range 5 {
+			assert.NoError(t, b.OnEmit(ctx, &r))
+		}
+		assert.NoError(t, b.Shutdown(ctx))
+		for i, batch := range e.Records() {
+			assert.LessOrEqualf(t, len(batch), 2, "batch %d", i)
+		}
+	})
+
 	t.Run("ScheduledExportError", func(t *testing.T) {
 		original := otel.GetErrorHandler()
 		handled := make(chan error, 1)
@@ -614,6 +651,27 @@ func TestBatchProcessorForceFlushExportError(t *testing.T) {
 	assert.Equal(t, 1, e.ForceFlushN())
 }
 
+func TestBatchProcessorForceFlushScope(t *testing.T) {
+	e := &testExporter{}
+	b := NewBatchProcessor(e, WithExportInterval(time.Hour))
+	defer func() { assert.NoError(t, b.Shutdown(t.Context())) }()
+
+	records := make([]*Record, 3)
+	for i, name := range []string{"a", "b", "a"} {
+		records[i] = new(Record)
+		records[i].SetBody(attribute.IntValue(i))
+		records[i].scope = &instrumentation.Scope{Name: name}
+		require.NoError(t, b.OnEmit(t.Context(), records[i]))
+	}
+
+	require.NoError(t, b.ForceFlushScope(t.Context(), "a"))
+	assert.Equal(t, [][]Record{{*records[0], *records[2]}}, e.Records(), "scope records")
+	assert.Equal(t, 1, e.ForceFlushN(), "exporter ForceFlush calls")
+
+	require.NoError(t, b.ForceFlush(t.Context()))
+	assert.Equal(t, [][]Record{{*records[1]}}, e.Records(), "other records")
+}
+
 func TestBatchProcessorCanceledFlushRetainsQueue(t *testing.T) {
 	e := &testExporter{}
 	b := NewBatchProcessor(
@@ -858,6 +916,34 @@ func TestQueue(t *testing.T) {
 		assert.Equal(t, []Record{r}, q.Flush(), "flushed")
 	})
 
+	t.Run("FlushScope", func(t *testing.T) {
+		scoped := func(name string, body int) Record {
+			var rec Record
+			rec.SetBody(attribute.IntValue(body))
+			rec.scope = &instrumentation.Scope{Name: name}
+			return rec
+		}
+		a0, b0, a1, b1 := scoped("a", 0), scoped("b", 0), scoped("a", 1), scoped("b", 1)
+
+		const size = 4
+		q := newQueue(size)
+		q.maxBytes = 1
+		// Overflow the queue so its records wrap around the ring.
+		for _, rec := range []Record{r, a0, b0, a1, b1} {
+			_, _ = q.Enqueue(rec)
+		}
+		require.Equal(t, uint64(1), q.Dropped())
+
+		assert.Equal(t, []Record{a0, a1}, q.FlushScope("a"), "flushed")
+		assert.Equal(t, 2, q.Len(), "length")
+		assert.Equal(t, b0.estimatedSize()+b1.estimatedSize(), q.bytes, "bytes")
+		assert.Empty(t, q.FlushScope("a"), "flushed twice")
+
+		n, _ := q.Enqueue(r)
+		assert.Equal(t, 3, n, "length after enqueue")
+		assert.Equal(t, []Record{b0, b1, r}, q.Flush(), "remaining records")
+	})
+
 	t.Run("Dequeue", func(t *testing.T) {
 		const size = 3
 		q := newQueue(size)
@@ -881,6 +967,36 @@ func TestQueue(t *testing.T) {
 		assert.Equal(t, []Record{r}, buf[:n], "records")
 	})
 
+	t.Run("DequeueBytes", func(t *testing.T) {
+		const size = 4
+		q := newQueue(size)
+		q.maxBytes = 2 * r.estimatedSize()
+
+		for range size {
+			_, _ = q.Enqueue(r)
+		}
+		assert.True(t, q.BytesReady(), "bytes ready")
+
+		buf := make([]Record, size)
+		n, remaining := q.Dequeue(buf)
+		assert.Equal(t, 2, n, "dequeued")
+		assert.Equal(t, 2, remaining, "remaining")
+
+		n, remaining = q.Dequeue(buf[:1])
+		assert.Equal(t, 1, n, "dequeued")
+		assert.Equal(t, 1, remaining, "remaining")
+		assert.False(t, q.BytesReady(), "bytes ready")
+
+		// Overflowing records are no longer accounted for.
+		for range size {
+			_, _ = q.Enqueue(r)
+		}
+		assert.Equal(t, size*r.estimatedSize(), q.bytes, "overflow bytes")
+
+		q.Flush()
+		assert.Zero(t, q.bytes, "flushed bytes")
+	})
+
 	t.Run("Close", func(t *testing.T) {
 		q := newQueue(1)
 		_, accepted := q.Enqueue(r)
@@ -1235,3 +1351,44 @@ func assertBLPMetrics(
 		metricdatatest.IgnoreExemplars(),
 	)
 }
+
+func TestBatchProcessorHealth(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	e := &testExporter{
+		ExportFunc: func(context.Context, []Record) error {
+			if fail.Load() {
+				return assert.AnError
+			}
+			return nil
+		},
+	}
+	b := NewBatchProcessor(e, WithMaxQueueSize(10), WithExportInterval(time.Hour))
+	p := NewLoggerProvider(WithProcessor(b))
+
+	health := p.Health()
+	require.Len(t, health, 1)
+	assert.Equal(t, "BatchProcessor", health[0].Component)
+	assert.Equal(t, 10, health[0].QueueCapacity)
+	assert.True(t, health[0].Healthy())
+
+	ctx := t.Context()
+	require.NoError(t, b.OnEmit(ctx, new(Record)))
+	assert.Equal(t, 1, b.Health().QueueSize)
+	assert.ErrorIs(t, b.ForceFlush(ctx), assert.AnError)
+	health = p.Health()
+	require.Len(t, health, 1)
+	assert.False(t, health[0].Healthy())
+	assert.Equal(t, 1, health[0].ConsecutiveFailures)
+	assert.Zero(t, health[0].QueueSize)
+
+	fail.Store(false)
+	require.NoError(t, b.OnEmit(ctx, new(Record)))
+	require.NoError(t, b.ForceFlush(ctx))
+	assert.True(t, b.Health().Healthy())
+	assert.False(t, b.Health().LastSuccess.IsZero())
+
+	require.NoError(t, p.Shutdown(ctx))
+	assert.True(t, b.Health().Shutdown)
+	assert.True(t, new(BatchProcessor).Health().Shutdown)
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exceptionlog provides a span processor mirroring the exceptions
+// recorded on spans into the log signal.
+package exceptionlog
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanProcessor is a [sdktrace.SpanProcessor] emitting a log record for each
+// span event named "exception", e.g. recorded with RecordError, when the span
+// ends.
+//
+// The log records are emitted with a Logger of the LoggerProvider using the
+// instrumentation scope of the span. Each record has the timestamp, the
+// attributes (e.g. "exception.type", "exception.message", and
+// "exception.stacktrace"), and the name of the span event. Its body is the
+// exception message and its severity is ERROR. The record is emitted in the
+// context of the span, so it is correlated with the span.
+//
+// The span events are not modified, they are still exported with the span.
+type SpanProcessor struct {
+	provider log.LoggerProvider
+}
+
+var _ sdktrace.SpanProcessor = (*SpanProcessor)(nil)
+
+// NewSpanProcessor returns a new SpanProcessor emitting log records with
+// provider.
+//
+// The provider is not flushed nor shut down by the returned SpanProcessor.
+// It needs to be shut down after the TracerProvider the SpanProcessor is
+// registered with, so the log records of spans ended during the shutdown of
+// the TracerProvider are exported.
+func NewSpanProcessor(provider log.LoggerProvider) *SpanProcessor {
+	return &SpanProcessor{provider: provider}
+}
+
+// OnStart does nothing.
+func (*SpanProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd emits a log record for each exception event of s.
+func (p *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	var logger log.Logger
+	ctx := trace.ContextWithSpanContext(context.Background(), s.SpanContext())
+	for _, e := range s.Events() {
+		if e.Name != semconv.ExceptionEventName {
+			continue
+		}
+		if logger == nil {
+			logger = p.logger(s)
+		}
+
+		param := log.EnabledParameters{
+			Severity:  log.SeverityError,
+			EventName: e.Name,
+		}
+		if !logger.Enabled(ctx, param) {
+			return
+		}
+		logger.Emit(ctx, record(e))
+	}
+}
+
+// logger returns a Logger using the instrumentation scope of s.
+func (p *SpanProcessor) logger(s sdktrace.ReadOnlySpan) log.Logger {
+	scope := s.InstrumentationScope()
+	return p.provider.Logger(
+		scope.Name,
+		log.WithInstrumentationVersion(scope.Version),
+		log.WithSchemaURL(scope.SchemaURL),
+		log.WithInstrumentationAttributeSet(scope.Attributes),
+	)
+}
+
+// record returns the log record representing the exception event e.
+func record(e sdktrace.Event) log.Record {
+	var r log.Record
+	r.SetEventName(e.Name)
+	r.SetTimestamp(e.Time)
+	r.SetSeverity(log.SeverityError)
+	r.SetSeverityText("ERROR")
+	for _, kv := range e.Attributes {
+		if kv.Key == semconv.ExceptionMessageKey {
+			r.SetBody(kv.Value)
+			break
+		}
+	}
+	r.AddAttributes(e.Attributes...)
+	return r
+}
+
+// Shutdown does nothing. The LoggerProvider needs to be shut down separately.
+func (*SpanProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing. The LoggerProvider needs to be flushed separately.
+func (*SpanProcessor) ForceFlush(context.Context) error { return nil }
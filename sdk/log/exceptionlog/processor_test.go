@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exceptionlog
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type exporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (e *exporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range records {
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+func (*exporter) Shutdown(context.Context) error   { return nil }
+func (*exporter) ForceFlush(context.Context) error { return nil }
+
+func TestSpanProcessor(t *testing.T) {
+	exp := new(exporter)
+	lp := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(exp)))
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(NewSpanProcessor(lp)))
+	t.Cleanup(func() {
+		assert.NoError(t, tp.Shutdown(context.Background()))
+		assert.NoError(t, lp.Shutdown(context.Background()))
+	})
+
+	tracer := tp.Tracer("scope", trace.WithInstrumentationVersion("v1"))
+	_, span := tracer.Start(t.Context(), "span")
+	span.AddEvent("not an exception")
+	span.RecordError(errors.New("failure"), trace.WithAttributes(attribute.String("key", "value")))
+	span.End()
+
+	_, other := tracer.Start(t.Context(), "no exception")
+	other.End()
+
+	require.Len(t, exp.records, 1)
+	r := exp.records[0]
+	assert.Equal(t, semconv.ExceptionEventName, r.EventName())
+	assert.Equal(t, log.SeverityError, r.Severity())
+	assert.Equal(t, "ERROR", r.SeverityText())
+	assert.Equal(t, attribute.StringValue("failure"), r.Body())
+	assert.False(t, r.Timestamp().IsZero())
+	assert.Equal(t, span.SpanContext().TraceID(), r.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), r.SpanID())
+	assert.Equal(t, "scope", r.InstrumentationScope().Name)
+	assert.Equal(t, "v1", r.InstrumentationScope().Version)
+
+	attrs := map[attribute.Key]attribute.Value{}
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		attrs[kv.Key] = kv.Value
+		return true
+	})
+	assert.Equal(t, map[attribute.Key]attribute.Value{
+		semconv.ExceptionTypeKey:    attribute.StringValue("*errors.errorString"),
+		semconv.ExceptionMessageKey: attribute.StringValue("failure"),
+		"key":                       attribute.StringValue("value"),
+	}, attrs)
+}
@@ -77,29 +77,34 @@ type chunkExporter struct {
 
 	// size is the maximum batch size exported.
 	size int
+	// maxBytes is the maximum estimated encoded size of a batch exported. If
+	// zero, batches are not limited by their estimated size.
+	maxBytes int
 }
 
 // newChunkExporter wraps exporter. Calls to the Export will have their records
-// payload chunked so they do not exceed size. If size is less than or equal
-// to 0, exporter is returned directly.
-func newChunkExporter(exporter Exporter, size int) Exporter {
-	if size <= 0 {
+// payload chunked so they do not exceed size records or maxBytes estimated
+// bytes. If both size and maxBytes are less than or equal to 0, exporter is
+// returned directly.
+func newChunkExporter(exporter Exporter, size, maxBytes int) Exporter {
+	if size <= 0 && maxBytes <= 0 {
 		return exporter
 	}
-	return &chunkExporter{Exporter: exporter, size: size}
+	return &chunkExporter{Exporter: exporter, size: size, maxBytes: max(maxBytes, 0)}
 }
 
-// Export exports records in chunks no larger than c.size.
+// Export exports records in chunks no larger than c.size and c.maxBytes.
 func (c chunkExporter) Export(ctx context.Context, records []Record) error {
-	n := len(records)
 	var errs []error
-	for i, j := 0, min(c.size, n); i < n; i, j = i+c.size, min(j+c.size, n) {
+	for len(records) > 0 {
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return errors.Join(append(errs, ctxErr)...)
 		}
-		if err := c.Exporter.Export(ctx, records[i:j]); err != nil {
+		n := c.next(records)
+		if err := c.Exporter.Export(ctx, records[:n]); err != nil {
 			errs = append(errs, err)
 		}
+		records = records[n:]
 		if ctxErr := ctx.Err(); ctxErr != nil {
 			return errors.Join(append(errs, ctxErr)...)
 		}
@@ -107,6 +112,26 @@ func (c chunkExporter) Export(ctx context.Context, records []Record) error {
 	return errors.Join(errs...)
 }
 
+// next returns the number of records from the front of records to include in
+// the next chunk. At least one record is always included.
+func (c chunkExporter) next(records []Record) int {
+	n := len(records)
+	if c.size > 0 {
+		n = min(c.size, n)
+	}
+	if c.maxBytes <= 0 {
+		return n
+	}
+	var bytes int
+	for i := range n {
+		bytes += records[i].estimatedSize()
+		if i > 0 && bytes > c.maxBytes {
+			return i
+		}
+	}
+	return n
+}
+
 // timeoutExporter wraps an Exporter and ensures any call to Export will have a
 // timeout for the context.
 type timeoutExporter struct {
@@ -6,6 +6,7 @@ package log
 import (
 	"context"
 	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type testExporter struct {
@@ -117,7 +120,7 @@ func (e *testExporter) Calls() []string {
 func TestChunker(t *testing.T) {
 	t.Run("ZeroSize", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		const size = 100
 		_ = c.Export(t.Context(), make([]Record, size))
 
@@ -129,21 +132,21 @@ func TestChunker(t *testing.T) {
 
 	t.Run("ForceFlush", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		_ = c.ForceFlush(t.Context())
 		assert.Equal(t, 1, exp.ForceFlushN(), "ForceFlush not passed through")
 	})
 
 	t.Run("Shutdown", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		_ = c.Shutdown(t.Context())
 		assert.Equal(t, 1, exp.ShutdownN(), "Shutdown not passed through")
 	})
 
 	t.Run("Chunk", func(t *testing.T) {
 		exp := &testExporter{}
-		c := newChunkExporter(exp, 10)
+		c := newChunkExporter(exp, 10, 0)
 		assert.NoError(t, c.Export(t.Context(), make([]Record, 5)))
 		assert.NoError(t, c.Export(t.Context(), make([]Record, 25)))
 
@@ -155,15 +158,33 @@ func TestChunker(t *testing.T) {
 		}
 	})
 
+	t.Run("ChunkBytes", func(t *testing.T) {
+		var small, large Record
+		small.SetBody(attribute.StringValue("a"))
+		large.SetBody(attribute.StringValue(strings.Repeat("a", 1024)))
+
+		exp := &testExporter{}
+		c := newChunkExporter(exp, 10, large.estimatedSize())
+		records := []Record{small, small, large, large, small}
+		assert.NoError(t, c.Export(t.Context(), records))
+
+		wantLens := []int{2, 1, 1, 1}
+		got := exp.Records()
+		require.Len(t, got, len(wantLens), "chunks")
+		for i, n := range wantLens {
+			assert.Lenf(t, got[i], n, "chunk %d", i)
+		}
+	})
+
 	t.Run("ExportError", func(t *testing.T) {
 		exp := &testExporter{Err: assert.AnError}
-		c := newChunkExporter(exp, 0)
+		c := newChunkExporter(exp, 0, 0)
 		ctx := t.Context()
 		records := make([]Record, 25)
 		err := c.Export(ctx, records)
 		assert.ErrorIs(t, err, assert.AnError, "no chunking")
 
-		c = newChunkExporter(exp, 10)
+		c = newChunkExporter(exp, 10, 0)
 		err = c.Export(ctx, records)
 		assert.ErrorIs(t, err, assert.AnError, "with chunking")
 		assert.Equal(t, 4, exp.ExportN(), "all chunks attempted")
@@ -174,7 +195,7 @@ func TestChunker(t *testing.T) {
 		ctx, cancel := context.WithCancel(t.Context())
 		cancel()
 
-		err := newChunkExporter(exp, 10).Export(ctx, make([]Record, 25))
+		err := newChunkExporter(exp, 10, 0).Export(ctx, make([]Record, 25))
 		assert.ErrorIs(t, err, context.Canceled)
 		assert.Zero(t, exp.ExportN(), "Export calls")
 	})
@@ -188,7 +209,7 @@ func TestChunker(t *testing.T) {
 			return assert.AnError
 		}
 
-		c := newChunkExporter(exp, 10)
+		c := newChunkExporter(exp, 10, 0)
 		err := c.Export(ctx, make([]Record, 25))
 		assert.ErrorIs(t, err, assert.AnError)
 		assert.ErrorIs(t, err, context.Canceled)
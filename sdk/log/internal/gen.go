@@ -10,5 +10,9 @@ package internal
 //go:generate gotmpl --body=../../../internal/shared/attrnorm/dedup_test.go.tmpl "--data={}" --out=attrnorm/dedup_test.go
 //go:generate gotmpl --body=../../../internal/shared/attrnorm/truncate.go.tmpl "--data={}" --out=attrnorm/truncate.go
 //go:generate gotmpl --body=../../../internal/shared/attrnorm/truncate_test.go.tmpl "--data={}" --out=attrnorm/truncate_test.go
+//go:generate gotmpl --body=../../../internal/shared/attrsize/size.go.tmpl "--data={}" --out=attrsize/size.go
+//go:generate gotmpl --body=../../../internal/shared/attrsize/size_test.go.tmpl "--data={}" --out=attrsize/size_test.go
 //go:generate gotmpl --body=../../../internal/shared/counter/counter.go.tmpl "--data={ \"pkg\": \"go.opentelemetry.io/otel/sdk/log\" }" --out=counter/counter.go
 //go:generate gotmpl --body=../../../internal/shared/counter/counter_test.go.tmpl "--data={}" --out=counter/counter_test.go
+//go:generate gotmpl --body=../../../internal/shared/stack/stack.go.tmpl "--data={}" --out=stack/stack.go
+//go:generate gotmpl --body=../../../internal/shared/stack/stack_test.go.tmpl "--data={}" --out=stack/stack_test.go
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observ
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk"
+	"go.opentelemetry.io/otel/sdk/log/internal/x"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
+)
+
+// ComponentTypeAsyncLogProcessor is the component type of an AsyncProcessor.
+const ComponentTypeAsyncLogProcessor otelconv.ComponentTypeAttr = "async_log_processor"
+
+// asyncProcessorN is a global 0-based count of the number of async processor
+// created.
+var asyncProcessorN atomic.Int64
+
+// NextAsyncProcessorID returns the next unique ID for an AsyncProcessor.
+func NextAsyncProcessorID() int64 {
+	const inc = 1
+	return asyncProcessorN.Add(inc) - inc
+}
+
+// ALPComponentName returns the component name attribute for an
+// AsyncProcessor with the given ID.
+func ALPComponentName(id int64) attribute.KeyValue {
+	name := fmt.Sprintf("%s/%d", ComponentTypeAsyncLogProcessor, id)
+	return semconv.OTelComponentName(name)
+}
+
+// ALP is the instrumentation for an OTel SDK AsyncProcessor.
+type ALP struct {
+	reg metric.Registration
+
+	processed              metric.Int64Counter
+	processedOpts          []metric.AddOption
+	processedQueueFullOpts []metric.AddOption
+}
+
+// NewALP creates a new AsyncProcessor instrumentation.
+// Returns nil if observability is not enabled.
+func NewALP(id int64, qLen func() int64, qMax int64) (*ALP, error) {
+	if !x.Observability.Enabled() {
+		return nil, nil
+	}
+	if qLen == nil {
+		return nil, errors.New("ALP qLen must not be nil")
+	}
+
+	meter := otel.GetMeterProvider().Meter(
+		ScopeName,
+		metric.WithInstrumentationVersion(sdk.Version()),
+		metric.WithSchemaURL(SchemaURL),
+	)
+
+	qCap, err := otelconv.NewSDKProcessorLogQueueCapacity(meter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ALP queue capacity metric: %w", err)
+	}
+	qCapInst := qCap.Inst()
+
+	qSize, err := otelconv.NewSDKProcessorLogQueueSize(meter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ALP queue size metric: %w", err)
+	}
+	qSizeInst := qSize.Inst()
+
+	cmpntT := semconv.OTelComponentTypeKey.String(string(ComponentTypeAsyncLogProcessor))
+	cmpnt := ALPComponentName(id)
+	set := attribute.NewSet(cmpnt, cmpntT)
+
+	obsOpts := []metric.ObserveOption{metric.WithAttributeSet(set)}
+	reg, err := meter.RegisterCallback(
+		func(_ context.Context, o metric.Observer) error {
+			o.ObserveInt64(qSizeInst, qLen(), obsOpts...)
+			o.ObserveInt64(qCapInst, qMax, obsOpts...)
+			return nil
+		},
+		qSizeInst,
+		qCapInst,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register ALP queue size/capacity callback: %w", err)
+	}
+
+	processed, err := otelconv.NewSDKProcessorLogProcessed(meter)
+	if err != nil {
+		_ = reg.Unregister()
+		return nil, fmt.Errorf("failed to create ALP processed logs metric: %w", err)
+	}
+
+	processedOpts := []metric.AddOption{metric.WithAttributeSet(set)}
+	setWithError := attribute.NewSet(cmpnt, cmpntT, ErrQueueFull)
+	processedQueueFullOpts := []metric.AddOption{metric.WithAttributeSet(setWithError)}
+
+	return &ALP{
+		reg:                    reg,
+		processed:              processed.Inst(),
+		processedOpts:          processedOpts,
+		processedQueueFullOpts: processedQueueFullOpts,
+	}, nil
+}
+
+// Shutdown unregisters the queue size and capacity callback.
+func (a *ALP) Shutdown() error {
+	if a == nil || a.reg == nil {
+		return nil
+	}
+	return a.reg.Unregister()
+}
+
+// Processed records that n log records have been passed to the wrapped
+// processor.
+func (a *ALP) Processed(ctx context.Context, n int64) {
+	if a.processed.Enabled(ctx) {
+		a.processed.Add(ctx, n, a.processedOpts...)
+	}
+}
+
+// ProcessedQueueFull records that n log records have been dropped because the
+// queue was full.
+func (a *ALP) ProcessedQueueFull(ctx context.Context, n int64) {
+	if a.processed.Enabled(ctx) {
+		a.processed.Add(ctx, n, a.processedQueueFullOpts...)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observ_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/log/internal/observ"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
+)
+
+func TestALPComponentName(t *testing.T) {
+	got := observ.ALPComponentName(42)
+	want := semconv.OTelComponentName("async_log_processor/42")
+	assert.Equal(t, want, got)
+}
+
+func TestNextAsyncProcessorID(t *testing.T) {
+	first := observ.NextAsyncProcessorID()
+	assert.Equal(t, first+1, observ.NextAsyncProcessorID())
+}
+
+func TestNewALPDisabled(t *testing.T) {
+	alp, err := observ.NewALP(id, nil, 0)
+	assert.NoError(t, err)
+	assert.Nil(t, alp)
+}
+
+func TestNewALPNilQLen(t *testing.T) {
+	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+	alp, err := observ.NewALP(id, nil, 0)
+	assert.Nil(t, alp)
+	assert.ErrorContains(t, err, "qLen must not be nil")
+}
+
+func TestNewALPErrors(t *testing.T) {
+	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+
+	orig := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(orig) })
+
+	check := func(t *testing.T, wantMsg string) {
+		t.Helper()
+		_, err := observ.NewALP(id, func() int64 { return 0 }, 0)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, wantMsg)
+	}
+
+	t.Run("qCap", func(t *testing.T) {
+		otel.SetMeterProvider(meterProvider{m: &errOnNthObsCounterMeter{n: 1, err: assert.AnError}})
+		check(t, "create ALP queue capacity metric")
+	})
+	t.Run("qSize", func(t *testing.T) {
+		otel.SetMeterProvider(meterProvider{m: &errOnNthObsCounterMeter{n: 2, err: assert.AnError}})
+		check(t, "create ALP queue size metric")
+	})
+	t.Run("callback", func(t *testing.T) {
+		otel.SetMeterProvider(meterProvider{m: &errCallbackMeter{err: assert.AnError}})
+		check(t, "register ALP queue size/capacity callback")
+	})
+	t.Run("processed", func(t *testing.T) {
+		otel.SetMeterProvider(meterProvider{m: &errCounterMeter{err: assert.AnError}})
+		check(t, "create ALP processed logs metric")
+	})
+}
+
+func alpSet(attrs ...attribute.KeyValue) attribute.Set {
+	return attribute.NewSet(append([]attribute.KeyValue{
+		semconv.OTelComponentTypeKey.String("async_log_processor"),
+		observ.ALPComponentName(id),
+	}, attrs...)...)
+}
+
+func TestALPCallback(t *testing.T) {
+	collect := setup(t)
+
+	var n int64 = 3
+	alp, err := observ.NewALP(id, func() int64 { return n }, 5)
+	require.NoError(t, err)
+	require.NotNil(t, alp)
+
+	queue := func(name, desc, unit string, v int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name:        name,
+			Description: desc,
+			Unit:        unit,
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				DataPoints:  []metricdata.DataPoint[int64]{dPt(alpSet(), v)},
+			},
+		}
+	}
+	size, capacity := otelconv.SDKProcessorLogQueueSize{}, otelconv.SDKProcessorLogQueueCapacity{}
+	check(t, collect(),
+		queue(size.Name(), size.Description(), size.Unit(), n),
+		queue(capacity.Name(), capacity.Description(), capacity.Unit(), 5),
+	)
+
+	require.NoError(t, alp.Shutdown())
+	got := collect()
+	assert.Empty(t, got.Metrics, "no metrics after shutdown")
+}
+
+func TestALPProcessed(t *testing.T) {
+	collect := setup(t)
+
+	alp, err := observ.NewALP(id, func() int64 { return 0 }, 0)
+	require.NoError(t, err)
+	require.NotNil(t, alp)
+	require.NoError(t, alp.Shutdown()) // Unregister callback.
+
+	ctx := t.Context()
+	alp.Processed(ctx, 10)
+	alp.ProcessedQueueFull(ctx, 2)
+	check(t, collect(), processed(
+		dPt(alpSet(), 10),
+		dPt(alpSet(observ.ErrQueueFull), 2),
+	))
+}
@@ -0,0 +1,246 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	dfltJSONBodyMaxDepth = 8
+	dfltJSONBodyMaxSize  = 64 * 1024
+)
+
+// errJSONBodyTooDeep is returned when a JSON body is nested deeper than the
+// maximum depth.
+var errJSONBodyTooDeep = errors.New("JSON body too deep")
+
+// Compile-time check JSONBodyProcessor implements Processor.
+var _ Processor = (*JSONBodyProcessor)(nil)
+
+// JSONBodyProcessor is a processor that parses string bodies holding a JSON
+// object into structured map values before passing the records to another
+// processor.
+//
+// Use [NewJSONBodyProcessor] to create a JSONBodyProcessor.
+type JSONBodyProcessor struct {
+	next Processor
+	cfg  jsonBodyConfig
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+// NewJSONBodyProcessor returns a [JSONBodyProcessor] that replaces the string
+// body of a log record holding a JSON object with the equivalent map value,
+// and then passes the record to next. This makes the fields of log records
+// forwarded by bridges of loggers serializing them as JSON queryable without
+// changing the application.
+//
+// JSON objects are converted to map values, whose members are sorted by key
+// as for any map value, arrays to slice values, strings to string values,
+// booleans to bool values, integers fitting in an int64 to int64 values,
+// other numbers to float64 values, and null to empty values.
+//
+// The body is left unchanged if it is not a string, if it is not a single
+// valid JSON object, if it is larger than the maximum size (64 KiB by
+// default, see [WithJSONBodyMaxSize]), or if it is nested deeper than the
+// maximum depth (8 by default, see [WithJSONBodyMaxDepth]).
+func NewJSONBodyProcessor(next Processor, opts ...JSONBodyProcessorOption) *JSONBodyProcessor {
+	return &JSONBodyProcessor{next: next, cfg: newJSONBodyConfig(opts)}
+}
+
+// Enabled returns the result of the Enabled method of the next processor.
+func (p *JSONBodyProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	return p.next.Enabled(ctx, param)
+}
+
+// OnEmit replaces the body of r with the map value it holds as a JSON object,
+// if any, and passes r to the next processor.
+func (p *JSONBodyProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if body := r.Body(); body.Type() == attribute.STRING {
+		if v, ok := p.parse(body.AsString()); ok {
+			r.SetBody(v)
+		}
+	}
+	return p.next.OnEmit(ctx, r)
+}
+
+// parse returns the map value of the JSON object s.
+func (p *JSONBodyProcessor) parse(s string) (attribute.Value, bool) {
+	if len(s) > p.cfg.maxSize {
+		return attribute.Value{}, false
+	}
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") {
+		return attribute.Value{}, false
+	}
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	v, err := p.decodeValue(dec, 0)
+	if err != nil {
+		return attribute.Value{}, false
+	}
+	// The body needs to hold a single JSON value.
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return attribute.Value{}, false
+	}
+	return v, true
+}
+
+// decodeValue decodes the next JSON value of dec nested at depth.
+func (p *JSONBodyProcessor) decodeValue(dec *json.Decoder, depth int) (attribute.Value, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return attribute.Value{}, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		if depth >= p.cfg.maxDepth {
+			return attribute.Value{}, errJSONBodyTooDeep
+		}
+		if t == '{' {
+			return p.decodeObject(dec, depth+1)
+		}
+		return p.decodeArray(dec, depth+1)
+	case string:
+		return attribute.StringValue(t), nil
+	case bool:
+		return attribute.BoolValue(t), nil
+	case json.Number:
+		if i, err := strconv.ParseInt(t.String(), 10, 64); err == nil {
+			return attribute.Int64Value(i), nil
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return attribute.Value{}, err
+		}
+		return attribute.Float64Value(f), nil
+	default: // nil
+		return attribute.Value{}, nil
+	}
+}
+
+// decodeObject decodes the members of a JSON object whose opening delimiter
+// was read from dec.
+func (p *JSONBodyProcessor) decodeObject(dec *json.Decoder, depth int) (attribute.Value, error) {
+	var kvs []attribute.KeyValue
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return attribute.Value{}, err
+		}
+		// Object keys are always strings.
+		key, _ := tok.(string)
+		v, err := p.decodeValue(dec, depth)
+		if err != nil {
+			return attribute.Value{}, err
+		}
+		kvs = append(kvs, attribute.KeyValue{Key: attribute.Key(key), Value: v})
+	}
+	// Closing delimiter.
+	if _, err := dec.Token(); err != nil {
+		return attribute.Value{}, err
+	}
+	return attribute.MapValue(kvs...), nil
+}
+
+// decodeArray decodes the elements of a JSON array whose opening delimiter
+// was read from dec.
+func (p *JSONBodyProcessor) decodeArray(dec *json.Decoder, depth int) (attribute.Value, error) {
+	var vals []attribute.Value
+	for dec.More() {
+		v, err := p.decodeValue(dec, depth)
+		if err != nil {
+			return attribute.Value{}, err
+		}
+		vals = append(vals, v)
+	}
+	// Closing delimiter.
+	if _, err := dec.Token(); err != nil {
+		return attribute.Value{}, err
+	}
+	return attribute.SliceValue(vals...), nil
+}
+
+// ForceFlush flushes the next processor.
+func (p *JSONBodyProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// ForceFlushScope flushes the log records of the instrumentation scope name
+// held by the next processor. The next processor is entirely flushed if it
+// cannot flush the log records of a single scope.
+func (p *JSONBodyProcessor) ForceFlushScope(ctx context.Context, name string) error {
+	return forceFlushScope(ctx, p.next, name)
+}
+
+// Shutdown shuts down the next processor.
+func (p *JSONBodyProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+type jsonBodyConfig struct {
+	maxDepth int
+	maxSize  int
+}
+
+func newJSONBodyConfig(options []JSONBodyProcessorOption) jsonBodyConfig {
+	c := jsonBodyConfig{
+		maxDepth: dfltJSONBodyMaxDepth,
+		maxSize:  dfltJSONBodyMaxSize,
+	}
+	for _, o := range options {
+		c = o.apply(c)
+	}
+	return c
+}
+
+// JSONBodyProcessorOption applies a configuration to a [JSONBodyProcessor].
+type JSONBodyProcessorOption interface {
+	apply(jsonBodyConfig) jsonBodyConfig
+}
+
+type jsonBodyOptionFunc func(jsonBodyConfig) jsonBodyConfig
+
+func (fn jsonBodyOptionFunc) apply(c jsonBodyConfig) jsonBodyConfig {
+	return fn(c)
+}
+
+// WithJSONBodyMaxDepth sets the maximum nesting depth of the JSON objects and
+// arrays of a body. The top-level object has a depth of one. Bodies nested
+// deeper are left unchanged.
+//
+// By default, 8 is used. The default value is also used when the provided
+// value is less than one.
+func WithJSONBodyMaxDepth(n int) JSONBodyProcessorOption {
+	return jsonBodyOptionFunc(func(c jsonBodyConfig) jsonBodyConfig {
+		if n > 0 {
+			c.maxDepth = n
+		}
+		return c
+	})
+}
+
+// WithJSONBodyMaxSize sets the maximum size, in bytes, of a body parsed as
+// JSON. Larger bodies are left unchanged.
+//
+// By default, 64 KiB is used. The default value is also used when the
+// provided value is less than one.
+func WithJSONBodyMaxSize(n int) JSONBodyProcessorOption {
+	return jsonBodyOptionFunc(func(c jsonBodyConfig) jsonBodyConfig {
+		if n > 0 {
+			c.maxSize = n
+		}
+		return c
+	})
+}
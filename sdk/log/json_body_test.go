@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestJSONBodyProcessor(t *testing.T) {
+	body := `{"msg":"hello","n":3,"f":1.5,"ok":true,"nil":null,"tags":["a",1],"user":{"id":"42"}}`
+	want := attribute.MapValue(
+		attribute.String("msg", "hello"),
+		attribute.Int64("n", 3),
+		attribute.Float64("f", 1.5),
+		attribute.Bool("ok", true),
+		attribute.KeyValue{Key: "nil"},
+		attribute.KeyValue{Key: "tags", Value: attribute.SliceValue(
+			attribute.StringValue("a"),
+			attribute.Int64Value(1),
+		)},
+		attribute.KeyValue{Key: "user", Value: attribute.MapValue(attribute.String("id", "42"))},
+	)
+
+	next := newProcessor("next")
+	p := NewJSONBodyProcessor(next)
+	r := new(Record)
+	r.SetBody(attribute.StringValue(" " + body + "\n"))
+	require.NoError(t, p.OnEmit(t.Context(), r))
+
+	require.Len(t, next.records, 1)
+	assert.Equal(t, want, next.records[0].Body())
+}
+
+func TestJSONBodyProcessorUnchanged(t *testing.T) {
+	deep := strings.Repeat(`{"a":`, 3) + "1" + strings.Repeat("}", 3)
+	tests := []struct {
+		name string
+		body attribute.Value
+		opts []JSONBodyProcessorOption
+	}{
+		{name: "Empty", body: attribute.Value{}},
+		{name: "NotString", body: attribute.Int64Value(1)},
+		{name: "NotJSON", body: attribute.StringValue("hello {world}")},
+		{name: "Array", body: attribute.StringValue(`["a"]`)},
+		{name: "Invalid", body: attribute.StringValue(`{"a":}`)},
+		{name: "Truncated", body: attribute.StringValue(`{"a":1`)},
+		{name: "TrailingData", body: attribute.StringValue(`{"a":1} {"b":2}`)},
+		{
+			name: "TooLarge",
+			body: attribute.StringValue(`{"a":1}`),
+			opts: []JSONBodyProcessorOption{WithJSONBodyMaxSize(6)},
+		},
+		{
+			name: "TooDeep",
+			body: attribute.StringValue(deep),
+			opts: []JSONBodyProcessorOption{WithJSONBodyMaxDepth(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := newProcessor("next")
+			p := NewJSONBodyProcessor(next, tt.opts...)
+			r := new(Record)
+			r.SetBody(tt.body)
+			require.NoError(t, p.OnEmit(t.Context(), r))
+
+			require.Len(t, next.records, 1)
+			assert.Equal(t, tt.body, next.records[0].Body(), "body changed")
+		})
+	}
+
+	next := newProcessor("next")
+	p := NewJSONBodyProcessor(next, WithJSONBodyMaxDepth(3))
+	r := new(Record)
+	r.SetBody(attribute.StringValue(deep))
+	require.NoError(t, p.OnEmit(t.Context(), r))
+	assert.Equal(t, attribute.MAP, next.records[0].Body().Type(), "max depth not inclusive")
+}
+
+func TestJSONBodyProcessorDelegates(t *testing.T) {
+	next := newProcessor("next")
+	p := NewJSONBodyProcessor(next)
+	ctx := t.Context()
+
+	assert.True(t, p.Enabled(ctx, EnabledParameters{}))
+	require.NoError(t, p.ForceFlush(ctx))
+	require.NoError(t, p.ForceFlushScope(ctx, "scope"))
+	require.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 2, next.forceFlushCalls)
+	assert.Equal(t, 1, next.shutdownCalls)
+}
@@ -55,6 +55,9 @@ func newLogger(p *LoggerProvider, scope instrumentation.Scope) *logger {
 }
 
 func (l *logger) Emit(ctx context.Context, r log.Record) {
+	if m := l.provider.misuse; m != nil && l.provider.stopped.Load() {
+		m.report("Logger.Emit", 0)
+	}
 	newRecord := l.newRecord(ctx, r)
 	for _, p := range l.provider.processors {
 		if err := p.OnEmit(ctx, &newRecord); err != nil {
@@ -105,6 +108,7 @@ func (l *logger) newRecord(ctx context.Context, r log.Record) Record {
 		attributeValueLengthLimit: l.provider.attributeValueLengthLimit,
 		attributeCountLimit:       l.provider.attributeCountLimit,
 		allowDupKeys:              l.provider.allowDupKeys,
+		firstKeyWins:              l.provider.firstKeyWins,
 	}
 	if l.recCntIncr != nil {
 		l.recCntIncr(ctx)
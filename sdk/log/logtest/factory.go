@@ -37,7 +37,8 @@ type RecordFactory struct {
 	Resource             *resource.Resource
 	InstrumentationScope *instrumentation.Scope
 
-	DroppedAttributes int
+	DroppedAttributes   int
+	DuplicateAttributes int
 }
 
 // NewRecord returns a [sdklog.Record] configured from the values of f.
@@ -64,6 +65,7 @@ func (f RecordFactory) NewRecord() sdklog.Record {
 	set(r, "resource", f.Resource)
 	set(r, "scope", f.InstrumentationScope)
 	set(r, "dropped", f.DroppedAttributes)
+	set(r, "duplicates", f.DuplicateAttributes)
 
 	return *r
 }
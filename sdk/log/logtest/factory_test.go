@@ -51,6 +51,7 @@ func TestRecordFactory(t *testing.T) {
 	spanID := trace.SpanID([8]byte{2})
 	traceFlags := trace.FlagsSampled
 	dropped := 3
+	duplicates := 2
 	scope := instrumentation.Scope{
 		Name: t.Name(),
 	}
@@ -68,6 +69,7 @@ func TestRecordFactory(t *testing.T) {
 		SpanID:               spanID,
 		TraceFlags:           traceFlags,
 		DroppedAttributes:    dropped,
+		DuplicateAttributes:  duplicates,
 		InstrumentationScope: &scope,
 		Resource:             r,
 	}.NewRecord()
@@ -80,6 +82,7 @@ func TestRecordFactory(t *testing.T) {
 	assertBody(t, body, got)
 	assertAttributes(t, attrs, got)
 	assert.Equal(t, dropped, got.DroppedAttributes())
+	assert.Equal(t, duplicates, got.DuplicateAttributes())
 	assert.Equal(t, traceID, got.TraceID())
 	assert.Equal(t, spanID, got.SpanID())
 	assert.Equal(t, traceFlags, got.TraceFlags())
@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/log/internal/stack"
+)
+
+// maxMisuseStackDepth is the maximum number of frames recorded for the stack
+// trace of a misuse.
+const maxMisuseStackDepth = 32
+
+// MisuseError is the error reported to the global ErrorHandler when misuse
+// detection is enabled and a LoggerProvider, or one of its Loggers, is used
+// after the LoggerProvider was shut down.
+type MisuseError struct {
+	// Op is the misused operation, e.g. "Logger.Emit".
+	Op string
+
+	pcs []uintptr
+}
+
+// Error returns a description of the misuse and the stack trace of where it
+// happened.
+func (e *MisuseError) Error() string {
+	return fmt.Sprintf("%s called after LoggerProvider.Shutdown, called at:%s", e.Op, e.Stack())
+}
+
+// Stack returns the stack trace of where the misuse happened.
+func (e *MisuseError) Stack() string {
+	return stack.Format(e.pcs)
+}
+
+// misuseDetector reports misuses of a LoggerProvider once per call site.
+type misuseDetector struct {
+	// reported holds the program counters of the call sites already
+	// reported.
+	reported sync.Map
+}
+
+// report reports the misuse of op by the caller of the function calling
+// report, skipping skip additional frames, if that call site has not already
+// been reported.
+func (d *misuseDetector) report(op string, skip int) {
+	// Skip report and the misused operation.
+	pcs := stack.Callers(skip+2, maxMisuseStackDepth)
+	if len(pcs) == 0 {
+		return
+	}
+	if _, loaded := d.reported.LoadOrStore(pcs[0], struct{}{}); loaded {
+		return
+	}
+	otel.Handle(&MisuseError{Op: op, pcs: pcs})
+}
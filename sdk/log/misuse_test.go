@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestMisuseDetection(t *testing.T) {
+	var errs []error
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			errs = append(errs, err)
+		}))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	p := NewLoggerProvider(WithMisuseDetection())
+	l := p.Logger("TestMisuseDetection")
+	l.Emit(t.Context(), log.Record{})
+	assert.Empty(t, errs, "misuse reported before Shutdown")
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	for range 2 {
+		// Each call site is only reported once.
+		l.Emit(t.Context(), log.Record{})
+		_ = p.Logger("TestMisuseDetection")
+	}
+
+	require.Len(t, errs, 2)
+	var emitErr, loggerErr *MisuseError
+	require.ErrorAs(t, errs[0], &emitErr)
+	assert.Equal(t, "Logger.Emit", emitErr.Op)
+	assert.Contains(t, emitErr.Stack(), "TestMisuseDetection")
+	assert.NotContains(t, emitErr.Stack(), "(*logger).Emit")
+
+	require.ErrorAs(t, errs[1], &loggerErr)
+	assert.Equal(t, "LoggerProvider.Logger", loggerErr.Op)
+	assert.Contains(t, loggerErr.Error(), "called after LoggerProvider.Shutdown")
+}
+
+func TestMisuseDetectionDisabled(t *testing.T) {
+	var errs []error
+	t.Cleanup(func(orig otel.ErrorHandler) func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			errs = append(errs, err)
+		}))
+		return func() { otel.SetErrorHandler(orig) }
+	}(otel.GetErrorHandler()))
+
+	p := NewLoggerProvider()
+	l := p.Logger("TestMisuseDetectionDisabled")
+	require.NoError(t, p.Shutdown(t.Context()))
+	l.Emit(t.Context(), log.Record{})
+	_ = p.Logger("TestMisuseDetectionDisabled")
+	assert.Empty(t, errs)
+}
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import "sync"
+
+// maxPooledAttributes is the maximum number of attributes, beyond those
+// stored inline, a Record returned to a RecordPool can hold storage for.
+// Larger Records are not reused to limit the memory retained by the pool.
+const maxPooledAttributes = 128
+
+// RecordPool is a pool of Records, to be used by the processors retaining the
+// records passed to OnEmit, to avoid allocating a copy for each of them.
+//
+// The records taken from the pool with Get are owned by the caller until
+// they are returned to the pool with Put. They must not be used after being
+// returned: the pool may hand them over to any other caller. This means a
+// pooled record can only be returned to the pool once no exporter or other
+// processor holds it anymore, e.g. once the batch holding it is exported.
+//
+// The zero value is ready to use. A RecordPool is safe for concurrent use.
+type RecordPool struct {
+	pool sync.Pool
+}
+
+// Get returns a record of the pool holding a copy of r with no shared state,
+// see [Record.CloneInto].
+func (p *RecordPool) Get(r *Record) *Record {
+	dst, _ := p.pool.Get().(*Record)
+	if dst == nil {
+		dst = new(Record)
+	}
+	r.CloneInto(dst)
+	return dst
+}
+
+// Put returns r to the pool. The record must not be used afterwards.
+func (p *RecordPool) Put(r *Record) {
+	if r == nil || cap(r.back) > maxPooledAttributes {
+		return
+	}
+	back := r.back[:cap(r.back)]
+	// Do not retain the attributes of r.
+	clear(back)
+	*r = Record{back: back[:0]}
+	p.pool.Put(r)
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestRecordPool(t *testing.T) {
+	var pool RecordPool
+
+	r := new(Record)
+	r.attributeCountLimit = -1
+	r.SetEventName("event")
+	r.SetBody(attribute.StringValue("body"))
+	for i := range attributesInlineCount + 2 {
+		r.AddAttributes(attribute.Int(string(rune('a'+i)), i))
+	}
+
+	got := pool.Get(r)
+	assert.Equal(t, r.Clone(), *got)
+	got.SetAttributes(attribute.Bool("modified", true))
+	assert.Equal(t, attributesInlineCount+2, r.AttributesLen(), "original record modified")
+
+	pool.Put(got)
+	assert.Zero(t, got.AttributesLen(), "pooled record not reset")
+	assert.Empty(t, got.EventName(), "pooled record not reset")
+
+	// Records with large attribute storage are not pooled.
+	large := &Record{back: make([]attribute.KeyValue, 0, maxPooledAttributes+1)}
+	pool.Put(large)
+	assert.Equal(t, maxPooledAttributes+1, cap(large.back))
+
+	// Nil records are ignored.
+	assert.NotPanics(t, func() { pool.Put(nil) })
+}
+
+func TestRecordPoolConcurrentSafe(t *testing.T) {
+	var pool RecordPool
+
+	r := new(Record)
+	r.SetBody(attribute.StringValue("body"))
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 100 {
+				got := pool.Get(r)
+				assert.Equal(t, r.Body(), got.Body())
+				pool.Put(got)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func BenchmarkRecordPool(b *testing.B) {
+	r := new(Record)
+	r.attributeCountLimit = -1
+	for i := range 2 * attributesInlineCount {
+		r.AddAttributes(attribute.Int(string(rune('a'+i)), i))
+	}
+
+	b.Run("Clone", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			got := r.Clone()
+			_ = got
+		}
+	})
+
+	b.Run("Pool", func(b *testing.B) {
+		var pool RecordPool
+		b.ReportAllocs()
+		for b.Loop() {
+			pool.Put(pool.Get(r))
+		}
+	})
+}
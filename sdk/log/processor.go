@@ -67,6 +67,13 @@ type Processor interface {
 	// Note that Record is not concurrent safe. Therefore, asynchronous
 	// processing may cause race conditions. Use Record.Clone
 	// to create a copy that shares no state with the original.
+	//
+	// The record must not be retained after OnEmit returns: it may be
+	// modified by the next registered processors. Implementations retaining
+	// records, e.g. to batch them, need to retain a copy, see Record.Clone.
+	// To avoid allocating a copy for each record, copy them to records taken
+	// from a RecordPool with Record.CloneInto, and return these records to
+	// the pool once they are processed.
 	OnEmit(ctx context.Context, record *Record) error
 
 	// Shutdown is called when the SDK shuts down. Any cleanup or release of
@@ -32,7 +32,8 @@ type providerConfig struct {
 	processors    []Processor
 	attrCntLim    setting[int]
 	attrValLenLim setting[int]
-	allowDupKeys  setting[bool]
+	dupKeyPolicy  setting[DuplicateKeyPolicy]
+	detectMisuse  bool
 }
 
 type experimentalOption interface {
@@ -75,18 +76,26 @@ type LoggerProvider struct {
 	attributeCountLimit       int
 	attributeValueLengthLimit int
 	allowDupKeys              bool
+	firstKeyWins              bool
 
 	loggersMu sync.Mutex
 	loggers   map[instrumentation.Scope]*logger
 
 	stopped atomic.Bool
 
+	// misuse reports the use of the provider after it is shut down. It is
+	// nil if misuse detection is disabled.
+	misuse *misuseDetector
+
 	noCmp [0]func() //nolint: unused  // This is indeed used.
 }
 
 // Compile-time check LoggerProvider implements log.LoggerProvider.
 var _ log.LoggerProvider = (*LoggerProvider)(nil)
 
+// Compile-time check LoggerProvider implements otel.HealthReporter.
+var _ otel.HealthReporter = (*LoggerProvider)(nil)
+
 // NewLoggerProvider returns a new and configured LoggerProvider.
 //
 // By default, the returned LoggerProvider is configured with the default
@@ -95,13 +104,18 @@ var _ log.LoggerProvider = (*LoggerProvider)(nil)
 // Processors, will perform no operations.
 func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
 	cfg := newProviderConfig(opts)
-	return &LoggerProvider{
+	p := &LoggerProvider{
 		resource:                  cfg.resource,
 		processors:                cfg.processors,
 		attributeCountLimit:       cfg.attrCntLim.Value,
 		attributeValueLengthLimit: cfg.attrValLenLim.Value,
-		allowDupKeys:              cfg.allowDupKeys.Value,
+		allowDupKeys:              cfg.dupKeyPolicy.Value == DuplicateKeysKeep,
+		firstKeyWins:              cfg.dupKeyPolicy.Value == DuplicateKeysFirstWins,
+	}
+	if cfg.detectMisuse {
+		p.misuse = &misuseDetector{}
 	}
+	return p
 }
 
 // Logger returns a new [log.Logger] with the provided name and configuration.
@@ -115,6 +129,9 @@ func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logge
 	}
 
 	if p.stopped.Load() {
+		if p.misuse != nil {
+			p.misuse.report("LoggerProvider.Logger", 0)
+		}
 		return noop.NewLoggerProvider().Logger(name, opts...)
 	}
 
@@ -166,17 +183,83 @@ func (p *LoggerProvider) Shutdown(ctx context.Context) error {
 
 // ForceFlush flushes all processors.
 //
+// The processors are flushed concurrently. Each one is given the whole
+// deadline of ctx, instead of what remains of it after the previous ones are
+// flushed, so a slow processor does not prevent the others from being
+// flushed.
+//
 // This method can be called concurrently.
 func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
 	if p.stopped.Load() {
 		return nil
 	}
+	return p.flush(func(proc Processor) error { return proc.ForceFlush(ctx) })
+}
 
-	var err error
-	for _, p := range p.processors {
-		err = errors.Join(err, p.ForceFlush(ctx))
+// ForceFlushScope flushes the log records emitted by the loggers with the
+// instrumentation scope name, e.g. the log records of a request handled by a
+// function as a service (FaaS) before the function is frozen, without
+// flushing the log records of the other scopes.
+//
+// The processors with a ForceFlushScope(context.Context, string) error
+// method, like [BatchProcessor], only flush the log records of the scope.
+// The other processors are entirely flushed with ForceFlush. As with
+// ForceFlush, the processors are flushed concurrently.
+//
+// This method can be called concurrently.
+func (p *LoggerProvider) ForceFlushScope(ctx context.Context, name string) error {
+	if p.stopped.Load() {
+		return nil
 	}
-	return err
+	return p.flush(func(proc Processor) error { return forceFlushScope(ctx, proc, name) })
+}
+
+// scopeFlusher is a Processor able to flush the log records of a single
+// instrumentation scope.
+type scopeFlusher interface {
+	ForceFlushScope(ctx context.Context, name string) error
+}
+
+// forceFlushScope flushes the log records of the instrumentation scope name
+// if proc is a scopeFlusher, and all the log records of proc otherwise.
+func forceFlushScope(ctx context.Context, proc Processor, name string) error {
+	if s, ok := proc.(scopeFlusher); ok {
+		return s.ForceFlushScope(ctx, name)
+	}
+	return proc.ForceFlush(ctx)
+}
+
+// flush calls fn concurrently for all processors and returns the joined
+// errors.
+func (p *LoggerProvider) flush(fn func(Processor) error) error {
+	switch len(p.processors) {
+	case 0:
+		return nil
+	case 1:
+		return fn(p.processors[0])
+	}
+
+	errs := make([]error, len(p.processors))
+	var wg sync.WaitGroup
+	for i, proc := range p.processors {
+		wg.Go(func() { errs[i] = fn(proc) })
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Health returns a snapshot of the health of all registered processors that
+// support health reporting, like [BatchProcessor].
+//
+// This method can be called concurrently.
+func (p *LoggerProvider) Health() []otel.HealthStatus {
+	var out []otel.HealthStatus
+	for _, proc := range p.processors {
+		if r, ok := proc.(interface{ Health() otel.HealthStatus }); ok {
+			out = append(out, r.Health())
+		}
+	}
+	return out
 }
 
 // LoggerProviderOption applies a configuration option value to a LoggerProvider.
@@ -279,9 +362,59 @@ func WithAttributeValueLengthLimit(limit int) LoggerProviderOption {
 // deduplication, you are responsible for ensuring that duplicate keys within a
 // single collection are not emitted, or that the telemetry receiver can handle
 // such duplicates.
+//
+// This option is equivalent to WithDuplicateKeyPolicy(DuplicateKeysKeep).
 func WithAllowKeyDuplication() LoggerProviderOption {
+	return WithDuplicateKeyPolicy(DuplicateKeysKeep)
+}
+
+// DuplicateKeyPolicy defines which key-value pair is retained when multiple
+// attributes of a log record have the same key.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeysLastWins retains the last key-value pair added for a key.
+	// This is the default policy.
+	DuplicateKeysLastWins DuplicateKeyPolicy = iota
+	// DuplicateKeysFirstWins retains the first key-value pair added for a
+	// key. Subsequent pairs with the same key are discarded.
+	DuplicateKeysFirstWins
+	// DuplicateKeysKeep retains all key-value pairs, key-value collections
+	// are not deduplicated. See WithAllowKeyDuplication for the implications
+	// of this policy.
+	DuplicateKeysKeep
+)
+
+// WithDuplicateKeyPolicy sets the policy used to deduplicate the attributes
+// of log records added with [Record.AddAttributes] and
+// [Record.SetAttributes].
+//
+// Key-value pairs discarded by the policy are counted by
+// [Record.DuplicateAttributes]. Key-value pairs nested in map values,
+// including the log record body, and instrumentation scope attributes are
+// always deduplicated with the last value retained, unless DuplicateKeysKeep
+// is used.
+//
+// By default, DuplicateKeysLastWins is used.
+func WithDuplicateKeyPolicy(policy DuplicateKeyPolicy) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.dupKeyPolicy = newSetting(policy)
+		return cfg
+	})
+}
+
+// WithMisuseDetection enables the detection of the use of a LoggerProvider
+// after it is shut down. A LoggerProvider that is shut down returns no-op
+// Loggers, and its processors drop the log records emitted by its Loggers.
+// With this option, each call site doing so is reported once to the global
+// ErrorHandler with a *MisuseError containing its stack trace.
+//
+// This is meant to debug bridges and applications that log during or after
+// their shutdown. It adds a check to every emitted log record, and should
+// not be used in production.
+func WithMisuseDetection() LoggerProviderOption {
 	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
-		cfg.allowDupKeys = newSetting(true)
+		cfg.detectMisuse = true
 		return cfg
 	})
 }
@@ -5,10 +5,12 @@ package log
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -127,6 +129,20 @@ func TestNewLoggerProviderConfiguration(t *testing.T) {
 				allowDupKeys:              true,
 			},
 		},
+		{
+			name: "DuplicateKeyPolicy",
+			options: []LoggerProviderOption{
+				WithAllowKeyDuplication(),
+				// The last option overrides previous ones.
+				WithDuplicateKeyPolicy(DuplicateKeysFirstWins),
+			},
+			want: &LoggerProvider{
+				resource:                  resource.Default(),
+				attributeCountLimit:       defaultAttrCntLim,
+				attributeValueLengthLimit: defaultAttrValLenLim,
+				firstKeyWins:              true,
+			},
+		},
 		{
 			name: "Environment",
 			envars: map[string]string{
@@ -445,6 +461,87 @@ func TestLoggerProviderForceFlush(t *testing.T) {
 		ctx := t.Context()
 		assert.ErrorIs(t, p.ForceFlush(ctx), assert.AnError, "processor error not returned")
 	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		// Each processor blocks until all of them are flushing.
+		var started sync.WaitGroup
+		started.Add(2)
+		flush := func(context.Context) error {
+			started.Done()
+			all := make(chan struct{})
+			go func() {
+				started.Wait()
+				close(all)
+			}()
+			select {
+			case <-all:
+				return nil
+			case <-time.After(5 * time.Second):
+				return errors.New("processors not flushed concurrently")
+			}
+		}
+		p := NewLoggerProvider(
+			WithProcessor(&flushProcessor{processor: newProcessor("0"), flush: flush}),
+			WithProcessor(&flushProcessor{processor: newProcessor("1"), flush: flush}),
+		)
+
+		assert.NoError(t, p.ForceFlush(t.Context()))
+	})
+
+	t.Run("ErrorsJoined", func(t *testing.T) {
+		errA, errB := errors.New("a"), errors.New("b")
+		procA, procB := newProcessor("a"), newProcessor("b")
+		procA.Err, procB.Err = errA, errB
+		p := NewLoggerProvider(WithProcessor(procA), WithProcessor(procB))
+
+		err := p.ForceFlush(t.Context())
+		assert.ErrorIs(t, err, errA)
+		assert.ErrorIs(t, err, errB)
+	})
+}
+
+// flushProcessor is a processor flushing with flush, and flushing the records
+// of a scope with flushScope.
+type flushProcessor struct {
+	*processor
+
+	flush      func(context.Context) error
+	flushScope func(context.Context, string) error
+}
+
+func (p *flushProcessor) ForceFlush(ctx context.Context) error {
+	return p.flush(ctx)
+}
+
+func (p *flushProcessor) ForceFlushScope(ctx context.Context, name string) error {
+	return p.flushScope(ctx, name)
+}
+
+func TestLoggerProviderForceFlushScope(t *testing.T) {
+	var scopes []string
+	scoped := &flushProcessor{
+		processor: newProcessor("scoped"),
+		flush: func(context.Context) error {
+			t.Error("ForceFlush called instead of ForceFlushScope")
+			return nil
+		},
+		flushScope: func(_ context.Context, name string) error {
+			scopes = append(scopes, name)
+			return nil
+		},
+	}
+	proc := newProcessor("")
+	p := NewLoggerProvider(WithProcessor(scoped), WithProcessor(proc))
+
+	ctx := t.Context()
+	require.NoError(t, p.ForceFlushScope(ctx, "handler"))
+	assert.Equal(t, []string{"handler"}, scopes, "ForceFlushScope calls")
+	assert.Equal(t, 1, proc.forceFlushCalls, "ForceFlush of processor without ForceFlushScope")
+
+	require.NoError(t, p.Shutdown(ctx))
+	require.NoError(t, p.ForceFlushScope(ctx, "handler"))
+	assert.Len(t, scopes, 1, "ForceFlushScope called after Shutdown")
+	assert.Equal(t, 1, proc.forceFlushCalls, "ForceFlush called after Shutdown")
 }
 
 func BenchmarkLoggerProviderLogger(b *testing.B) {
@@ -105,6 +105,10 @@ type Record struct {
 	// were reached.
 	dropped int
 
+	// duplicates is the count of attributes that have been discarded because
+	// of key duplication.
+	duplicates int
+
 	traceID    trace.TraceID
 	spanID     trace.SpanID
 	traceFlags trace.TraceFlags
@@ -120,10 +124,20 @@ type Record struct {
 
 	// specifies whether we should deduplicate any key value collections or not
 	allowDupKeys bool
+	// specifies whether the first attribute added for a key is retained when
+	// deduplicating attributes, instead of the last one.
+	firstKeyWins bool
 
 	noCmp [0]func() //nolint: unused  // This is indeed used.
 }
 
+func (r *Record) addDuplicates(n int) {
+	r.duplicates += n
+	if n > 0 {
+		logKeyValuePairDropped()
+	}
+}
+
 func (r *Record) addDropped(n int) {
 	r.dropped += n
 	if n > 0 {
@@ -215,17 +229,17 @@ func (r *Record) WalkAttributes(f func(attribute.KeyValue) bool) {
 }
 
 // AddAttributes adds attributes to the log record.
-// Attributes in attrs will overwrite any attribute already added to r with the same key.
+// Attributes in attrs will overwrite any attribute already added to r with the
+// same key, unless the LoggerProvider is configured with a different
+// [DuplicateKeyPolicy].
 func (r *Record) AddAttributes(attrs ...attribute.KeyValue) {
 	n := r.AttributesLen()
 	if n == 0 {
 		// Avoid the more complex duplicate map lookups below.
 		var drop int
 		if !r.allowDupKeys {
-			attrs, drop = dedup(attrs)
-			if drop > 0 {
-				logKeyValuePairDropped()
-			}
+			attrs, drop = dedup(attrs, r.firstKeyWins)
+			r.addDuplicates(drop)
 		}
 
 		attrs, drop := r.head(attrs)
@@ -254,20 +268,25 @@ func (r *Record) AddAttributes(attrs ...attribute.KeyValue) {
 
 		// Deduplicate attrs within the scope of all existing attributes.
 		for _, a := range attrs {
-			// Last-value-wins for any duplicates in attrs.
+			// Last-value-wins, unless first-value-wins is configured, for
+			// any duplicates in attrs.
 			idx, found := uIndex[a.Key]
 			if found {
 				dropped++
-				logKeyValuePairDropped()
-				(*unique)[idx] = a
+				if !r.firstKeyWins {
+					(*unique)[idx] = a
+				}
 				continue
 			}
 
 			idx, found = rIndex[a.Key]
 			if found {
-				// New attrs overwrite any existing with the same key.
+				// New attrs overwrite any existing with the same key, unless
+				// first-value-wins is configured.
 				dropped++
-				logKeyValuePairDropped()
+				if r.firstKeyWins {
+					continue
+				}
 				if idx < 0 {
 					r.front[-(idx + 1)] = a
 				} else {
@@ -281,6 +300,7 @@ func (r *Record) AddAttributes(attrs ...attribute.KeyValue) {
 		}
 
 		if dropped > 0 {
+			r.addDuplicates(dropped)
 			attrs = make([]attribute.KeyValue, len(*unique))
 			copy(attrs, *unique)
 		}
@@ -343,11 +363,10 @@ func (r *Record) addAttrs(attrs []attribute.KeyValue) {
 func (r *Record) SetAttributes(attrs ...attribute.KeyValue) {
 	var drop int
 	r.dropped = 0
+	r.duplicates = 0
 	if !r.allowDupKeys {
-		attrs, drop = dedup(attrs)
-		if drop > 0 {
-			logKeyValuePairDropped()
-		}
+		attrs, drop = dedup(attrs, r.firstKeyWins)
+		r.addDuplicates(drop)
 	}
 
 	attrs, drop = r.head(attrs)
@@ -382,8 +401,9 @@ func (r *Record) hasAttributeCountLimit() bool {
 	return r.attributeCountLimit >= 0
 }
 
-// dedup deduplicates kvs front-to-back with the last value saved.
-func dedup(kvs []attribute.KeyValue) (unique []attribute.KeyValue, dropped int) {
+// dedup deduplicates kvs front-to-back with the last value saved, or the
+// first one if firstWins is true.
+func dedup(kvs []attribute.KeyValue, firstWins bool) (unique []attribute.KeyValue, dropped int) {
 	if len(kvs) <= 1 {
 		return kvs, 0 // No deduplication needed.
 	}
@@ -396,7 +416,9 @@ func dedup(kvs []attribute.KeyValue) (unique []attribute.KeyValue, dropped int)
 		idx, found := index[a.Key]
 		if found {
 			dropped++
-			(*u)[idx] = a
+			if !firstWins {
+				(*u)[idx] = a
+			}
 		} else {
 			*u = append(*u, a)
 			index[a.Key] = len(*u) - 1
@@ -423,6 +445,13 @@ func (r *Record) DroppedAttributes() int {
 	return r.dropped
 }
 
+// DuplicateAttributes returns the number of attributes discarded because
+// another attribute of the log record has the same key. Discarded attributes
+// are not counted by [Record.DroppedAttributes].
+func (r *Record) DuplicateAttributes() int {
+	return r.duplicates
+}
+
 // TraceID returns the trace ID or empty array.
 func (r *Record) TraceID() trace.TraceID {
 	return r.traceID
@@ -468,12 +497,32 @@ func (r *Record) InstrumentationScope() instrumentation.Scope {
 
 // Clone returns a copy of the record with no shared state. The original record
 // and the clone can both be modified without interfering with each other.
+//
+// Only the storage of the attributes is copied. The attribute values, the
+// resource, and the instrumentation scope are immutable and are shared with
+// the clone.
 func (r *Record) Clone() Record {
 	res := *r
 	res.back = slices.Clone(r.back)
 	return res
 }
 
+// CloneInto sets dst to a copy of the record with no shared state, like
+// Clone. The attribute storage of dst is reused, if large enough, instead of
+// being allocated. The previous content of dst is discarded.
+//
+// This can be used with a [RecordPool] to retain records without allocating.
+func (r *Record) CloneInto(dst *Record) {
+	if r == dst {
+		return
+	}
+	back := dst.back[:0]
+	*dst = *r
+	dst.back = append(back, r.back...)
+	// Do not retain the previous attributes of dst past its length.
+	clear(dst.back[len(dst.back):cap(dst.back)])
+}
+
 func (r *Record) applyAttrLimitsAndDedup(attr attribute.KeyValue) attribute.KeyValue {
 	if !r.allowDupKeys {
 		var changed bool
@@ -577,6 +577,38 @@ func TestRecordClone(t *testing.T) {
 	})
 }
 
+func TestRecordCloneInto(t *testing.T) {
+	r := new(Record)
+	r.attributeCountLimit = -1
+	r.SetSeverityText("text")
+	for i := range attributesInlineCount + 2 {
+		r.AddAttributes(attribute.Int(string(rune('a'+i)), i))
+	}
+
+	dst := &Record{back: make([]attribute.KeyValue, 0, 8)}
+	storage := dst.back[:1]
+	r.CloneInto(dst)
+	assert.Equal(t, r.Clone(), *dst)
+	assert.Same(t, &storage[0], &dst.back[0], "attribute storage not reused")
+
+	dst.SetAttributes(attribute.Bool("modified", true))
+	assert.Equal(t, attributesInlineCount+2, r.AttributesLen(), "original record modified")
+
+	// The content of dst is discarded, its attribute storage kept.
+	r.CloneInto(dst)
+	storage = dst.back
+	small := new(Record)
+	small.SetBody(attribute.IntValue(1))
+	small.CloneInto(dst)
+	assert.Equal(t, small.Body(), dst.Body())
+	assert.Equal(t, 0, dst.AttributesLen())
+	assert.Empty(t, dst.back)
+	assert.Same(t, &storage[0], &dst.back[:1][0], "attribute storage dropped")
+	assert.Equal(t, attribute.KeyValue{}, dst.back[:1][0], "previous attribute retained")
+
+	r.CloneInto(r)
+	assert.Equal(t, attributesInlineCount+2, r.AttributesLen())
+}
 func TestRecordDroppedAttributes(t *testing.T) {
 	orig := logAttrDropped
 	t.Cleanup(func() { logAttrDropped = orig })
@@ -957,6 +989,100 @@ func TestRecordAttrDeduplication(t *testing.T) {
 	}
 }
 
+func TestRecordDuplicateKeyPolicy(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		attribute.String("a", "a1"),
+		attribute.String("b", "b1"),
+		attribute.String("a", "a2"),
+	}
+	more := []attribute.KeyValue{
+		attribute.String("b", "b2"),
+		attribute.String("c", "c1"),
+		attribute.String("c", "c2"),
+	}
+
+	testcases := []struct {
+		name           string
+		policy         DuplicateKeyPolicy
+		wantSet        []attribute.KeyValue
+		wantAdd        []attribute.KeyValue
+		wantDuplicates int
+	}{
+		{
+			name:   "LastWins",
+			policy: DuplicateKeysLastWins,
+			wantSet: []attribute.KeyValue{
+				attribute.String("a", "a2"),
+				attribute.String("b", "b1"),
+			},
+			wantAdd: []attribute.KeyValue{
+				attribute.String("a", "a2"),
+				attribute.String("b", "b2"),
+				attribute.String("c", "c2"),
+			},
+			wantDuplicates: 3,
+		},
+		{
+			name:   "FirstWins",
+			policy: DuplicateKeysFirstWins,
+			wantSet: []attribute.KeyValue{
+				attribute.String("a", "a1"),
+				attribute.String("b", "b1"),
+			},
+			wantAdd: []attribute.KeyValue{
+				attribute.String("a", "a1"),
+				attribute.String("b", "b1"),
+				attribute.String("c", "c1"),
+			},
+			wantDuplicates: 3,
+		},
+		{
+			name:    "Keep",
+			policy:  DuplicateKeysKeep,
+			wantSet: attrs,
+			wantAdd: append(slices.Clone(attrs), more...),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewLoggerProvider(WithDuplicateKeyPolicy(tc.policy))
+			newRecord := func() *Record {
+				return &Record{
+					attributeValueLengthLimit: -1,
+					attributeCountLimit:       -1,
+					allowDupKeys:              p.allowDupKeys,
+					firstKeyWins:              p.firstKeyWins,
+				}
+			}
+
+			r := newRecord()
+			r.SetAttributes(attrs...)
+			assert.Equal(t, tc.wantSet, recordAttrs(r))
+			assert.Equal(t, min(tc.wantDuplicates, 1), r.DuplicateAttributes())
+			assert.Equal(t, 0, r.DroppedAttributes())
+
+			r = newRecord()
+			r.AddAttributes(attrs...)
+			r.AddAttributes(more...)
+			assert.Equal(t, tc.wantAdd, recordAttrs(r))
+			assert.Equal(t, tc.wantDuplicates, r.DuplicateAttributes())
+
+			r.SetAttributes()
+			assert.Equal(t, 0, r.DuplicateAttributes(), "not reset")
+		})
+	}
+}
+
+func recordAttrs(r *Record) []attribute.KeyValue {
+	var out []attribute.KeyValue
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		out = append(out, kv)
+		return true
+	})
+	return out
+}
+
 func TestApplyAttrLimitsDeduplication(t *testing.T) {
 	testcases := []struct {
 		name             string
@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/log/internal/attrsize"
+)
+
+// recordOverhead is the estimated encoded size of the fixed-size fields of a
+// Record (timestamps, severity, flags, trace and span IDs) and the framing
+// that surrounds it in an export payload.
+const recordOverhead = 64
+
+// estimatedSize returns an estimate of the number of bytes r will occupy in an
+// encoded export payload.
+//
+// The estimate approximates the OTLP protobuf encoding. It is not exact, but
+// it scales with the size of the record's variable-length content which is
+// what dominates payload sizes.
+func (r *Record) estimatedSize() int {
+	n := recordOverhead + len(r.eventName) + len(r.severityText) + attrsize.Value(r.body)
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		n += attrsize.KeyValue(kv)
+		return true
+	})
+	return n
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/log/internal/attrsize"
+)
+
+func TestRecordEstimatedSize(t *testing.T) {
+	r := Record{attributeCountLimit: -1, attributeValueLengthLimit: -1}
+	base := r.estimatedSize()
+	assert.Equal(t, recordOverhead, base)
+
+	body := strings.Repeat("a", 100)
+	r.SetBody(attribute.StringValue(body))
+	r.AddAttributes(attribute.String("key", "value"))
+	want := base + attrsize.Value(r.Body()) + attrsize.KeyValue(attribute.String("key", "value"))
+	assert.Equal(t, want, r.estimatedSize())
+}
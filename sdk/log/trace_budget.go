@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	dfltTraceBudgetWindow    = time.Minute
+	dfltTraceBudgetMaxTraces = 4096
+
+	// suppressedCountKey is the attribute key of the number of log records
+	// suppressed in a summary record emitted by a TraceBudgetProcessor.
+	suppressedCountKey = attribute.Key("io.opentelemetry.go.sdk.log.suppressed_count")
+	// summaryBody is the body of a summary record emitted by a
+	// TraceBudgetProcessor.
+	summaryBody = "log records suppressed: trace log record budget exceeded"
+)
+
+// Compile-time check TraceBudgetProcessor implements Processor.
+var _ Processor = (*TraceBudgetProcessor)(nil)
+
+// TraceBudgetProcessor is a processor that limits the number of log records
+// associated with the same trace that are passed to another processor.
+//
+// Use [NewTraceBudgetProcessor] to create a TraceBudgetProcessor.
+type TraceBudgetProcessor struct {
+	next   Processor
+	budget int
+	cfg    traceBudgetConfig
+
+	mu sync.Mutex
+	// traces indexes the elements of order by trace ID.
+	traces map[trace.TraceID]*list.Element
+	// order holds *traceLogBudget ordered by the time their window started,
+	// oldest first.
+	order *list.List
+	now   func() time.Time
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+// traceLogBudget tracks the log records of a single trace within a window.
+type traceLogBudget struct {
+	traceID trace.TraceID
+	start   time.Time
+	emitted int
+	// suppressed is the number of records not passed to the next processor.
+	suppressed int
+	// first is a copy of the first suppressed record. It is used as the
+	// template of the summary record.
+	first Record
+}
+
+// NewTraceBudgetProcessor returns a [TraceBudgetProcessor] that passes at
+// most budget log records associated with the same trace ID to next within a
+// window (1 minute by default, see [WithTraceBudgetWindow]). This prevents a
+// single misbehaving request from producing a storm of correlated log
+// records.
+//
+// Once the window of a trace in which records were suppressed ends, a single
+// summary record is passed to next. It uses the trace and span IDs, resource,
+// and instrumentation scope of the first suppressed record. Its body describes
+// the suppression and the "io.opentelemetry.go.sdk.log.suppressed_count"
+// attribute holds the number of suppressed records. Windows end when a record
+// of the same trace is emitted after the window elapsed, when the trace is
+// evicted to respect the maximum number of tracked traces (see
+// [WithTraceBudgetMaxTraces]), or when the processor is flushed or shut down.
+//
+// Log records not associated with a trace are always passed to next.
+//
+// If budget is less than one, all records are passed to next.
+func NewTraceBudgetProcessor(next Processor, budget int, opts ...TraceBudgetProcessorOption) *TraceBudgetProcessor {
+	cfg := newTraceBudgetConfig(opts)
+	return &TraceBudgetProcessor{
+		next:   next,
+		budget: budget,
+		cfg:    cfg,
+		traces: make(map[trace.TraceID]*list.Element),
+		order:  list.New(),
+		now:    time.Now,
+	}
+}
+
+// Enabled returns the result of the Enabled method of the next processor.
+func (p *TraceBudgetProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	return p.next.Enabled(ctx, param)
+}
+
+// OnEmit passes r to the next processor if the budget of the trace r is
+// associated with is not exceeded.
+func (p *TraceBudgetProcessor) OnEmit(ctx context.Context, r *Record) error {
+	traceID := r.TraceID()
+	if p.budget < 1 || !traceID.IsValid() {
+		return p.next.OnEmit(ctx, r)
+	}
+
+	p.mu.Lock()
+	now := p.now()
+	// Summaries are emitted after releasing the lock.
+	summaries := p.expire(now)
+
+	var tb *traceLogBudget
+	if e, ok := p.traces[traceID]; ok {
+		tb = e.Value.(*traceLogBudget)
+	} else {
+		if p.order.Len() >= p.cfg.maxTraces {
+			summaries = p.evict(p.order.Front(), summaries)
+		}
+		tb = &traceLogBudget{traceID: traceID, start: now}
+		p.traces[traceID] = p.order.PushBack(tb)
+	}
+
+	suppress := tb.emitted >= p.budget
+	if suppress {
+		if tb.suppressed == 0 {
+			tb.first = r.Clone()
+		}
+		tb.suppressed++
+	} else {
+		tb.emitted++
+	}
+	p.mu.Unlock()
+
+	err := p.emitSummaries(ctx, summaries)
+	if suppress {
+		return err
+	}
+	return errors.Join(err, p.next.OnEmit(ctx, r))
+}
+
+// expire removes all tracked traces whose window ended before now and returns
+// the summary records of the ones with suppressed records. It must be called
+// while holding p.mu.
+func (p *TraceBudgetProcessor) expire(now time.Time) []Record {
+	var summaries []Record
+	for e := p.order.Front(); e != nil; e = p.order.Front() {
+		tb := e.Value.(*traceLogBudget)
+		if now.Sub(tb.start) < p.cfg.window {
+			break
+		}
+		summaries = p.evict(e, summaries)
+	}
+	return summaries
+}
+
+// evict removes the tracked trace held by e and appends its summary record to
+// summaries if any record was suppressed. It must be called while holding
+// p.mu.
+func (p *TraceBudgetProcessor) evict(e *list.Element, summaries []Record) []Record {
+	tb := p.order.Remove(e).(*traceLogBudget)
+	delete(p.traces, tb.traceID)
+	if tb.suppressed == 0 {
+		return summaries
+	}
+	return append(summaries, tb.summary())
+}
+
+// summary returns the summary record of the suppressed records of tb.
+func (tb *traceLogBudget) summary() Record {
+	s := tb.first
+	s.SetEventName("")
+	s.SetBody(attribute.StringValue(summaryBody))
+	s.SetSeverity(log.SeverityWarn)
+	s.SetSeverityText("WARN")
+	s.SetAttributes(suppressedCountKey.Int(tb.suppressed))
+	return s
+}
+
+func (p *TraceBudgetProcessor) emitSummaries(ctx context.Context, summaries []Record) error {
+	var err error
+	for i := range summaries {
+		err = errors.Join(err, p.next.OnEmit(ctx, &summaries[i]))
+	}
+	return err
+}
+
+// flushSummaries ends the window of all tracked traces and passes their
+// summary records to the next processor.
+func (p *TraceBudgetProcessor) flushSummaries(ctx context.Context) error {
+	p.mu.Lock()
+	var summaries []Record
+	for e := p.order.Front(); e != nil; e = p.order.Front() {
+		summaries = p.evict(e, summaries)
+	}
+	p.mu.Unlock()
+
+	return p.emitSummaries(ctx, summaries)
+}
+
+// ForceFlush passes the summary records of all tracked traces to the next
+// processor and then flushes it.
+func (p *TraceBudgetProcessor) ForceFlush(ctx context.Context) error {
+	err := p.flushSummaries(ctx)
+	return errors.Join(err, p.next.ForceFlush(ctx))
+}
+
+// Shutdown passes the summary records of all tracked traces to the next
+// processor and then shuts it down.
+func (p *TraceBudgetProcessor) Shutdown(ctx context.Context) error {
+	err := p.flushSummaries(ctx)
+	return errors.Join(err, p.next.Shutdown(ctx))
+}
+
+type traceBudgetConfig struct {
+	window    time.Duration
+	maxTraces int
+}
+
+func newTraceBudgetConfig(options []TraceBudgetProcessorOption) traceBudgetConfig {
+	c := traceBudgetConfig{
+		window:    dfltTraceBudgetWindow,
+		maxTraces: dfltTraceBudgetMaxTraces,
+	}
+	for _, o := range options {
+		c = o.apply(c)
+	}
+	return c
+}
+
+// TraceBudgetProcessorOption applies a configuration to a
+// [TraceBudgetProcessor].
+type TraceBudgetProcessorOption interface {
+	apply(traceBudgetConfig) traceBudgetConfig
+}
+
+type traceBudgetOptionFunc func(traceBudgetConfig) traceBudgetConfig
+
+func (fn traceBudgetOptionFunc) apply(c traceBudgetConfig) traceBudgetConfig {
+	return fn(c)
+}
+
+// WithTraceBudgetWindow sets the duration of the window in which the budget of
+// a trace applies. The window starts when the first record of a trace is
+// emitted.
+//
+// By default, 1 minute is used. The default value is also used when the
+// provided value is less than one.
+func WithTraceBudgetWindow(d time.Duration) TraceBudgetProcessorOption {
+	return traceBudgetOptionFunc(func(c traceBudgetConfig) traceBudgetConfig {
+		if d > 0 {
+			c.window = d
+		}
+		return c
+	})
+}
+
+// WithTraceBudgetMaxTraces sets the maximum number of traces tracked at the
+// same time. When the limit is reached, the window of the trace tracked for
+// the longest time ends early.
+//
+// By default, 4096 is used. The default value is also used when the provided
+// value is less than one.
+func WithTraceBudgetMaxTraces(n int) TraceBudgetProcessorOption {
+	return traceBudgetOptionFunc(func(c traceBudgetConfig) traceBudgetConfig {
+		if n > 0 {
+			c.maxTraces = n
+		}
+		return c
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func traceRecord(id byte, body string) *Record {
+	r := &Record{attributeCountLimit: -1, attributeValueLengthLimit: -1}
+	r.SetTraceID(trace.TraceID{id})
+	r.SetSpanID(trace.SpanID{id})
+	r.SetBody(attribute.StringValue(body))
+	return r
+}
+
+func attrValue(r Record, key attribute.Key) (attribute.Value, bool) {
+	var v attribute.Value
+	var found bool
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		if kv.Key == key {
+			v, found = kv.Value, true
+			return false
+		}
+		return true
+	})
+	return v, found
+}
+
+func TestTraceBudgetProcessor(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceBudgetProcessor(next, 2)
+	ctx := t.Context()
+
+	for range 5 {
+		require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	}
+	require.NoError(t, p.OnEmit(ctx, traceRecord(2, "b")))
+	require.NoError(t, p.OnEmit(ctx, new(Record)), "no trace")
+	require.NoError(t, p.OnEmit(ctx, new(Record)), "no trace")
+	assert.Len(t, next.records, 5, "records before flush")
+
+	require.NoError(t, p.ForceFlush(ctx))
+	assert.Equal(t, 1, next.forceFlushCalls)
+	require.Len(t, next.records, 6, "summary record")
+
+	summary := next.records[5]
+	assert.Equal(t, trace.TraceID{1}, summary.TraceID())
+	assert.Equal(t, summaryBody, summary.Body().AsString())
+	assert.Equal(t, log.SeverityWarn, summary.Severity())
+	v, ok := attrValue(summary, "io.opentelemetry.go.sdk.log.suppressed_count")
+	require.True(t, ok, "suppressed count attribute")
+	assert.Equal(t, int64(3), v.AsInt64())
+
+	// The budget is reset after the flush.
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	assert.Len(t, next.records, 7)
+}
+
+func TestTraceBudgetProcessorWindow(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceBudgetProcessor(next, 1, WithTraceBudgetWindow(time.Second))
+	now := time.Unix(0, 0)
+	p.now = func() time.Time { return now }
+	ctx := t.Context()
+
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	assert.Len(t, next.records, 1)
+
+	now = now.Add(time.Second)
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	require.Len(t, next.records, 3, "summary and new window")
+	assert.Equal(t, summaryBody, next.records[1].Body().AsString())
+	assert.Equal(t, "a", next.records[2].Body().AsString())
+}
+
+func TestTraceBudgetProcessorMaxTraces(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceBudgetProcessor(next, 1, WithTraceBudgetMaxTraces(1))
+	ctx := t.Context()
+
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	require.NoError(t, p.OnEmit(ctx, traceRecord(2, "b")))
+
+	require.Len(t, next.records, 3)
+	assert.Equal(t, summaryBody, next.records[1].Body().AsString(), "evicted trace summary")
+	assert.Equal(t, "b", next.records[2].Body().AsString())
+	assert.Equal(t, 1, p.order.Len())
+}
+
+func TestTraceBudgetProcessorDisabled(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceBudgetProcessor(next, 0)
+	ctx := t.Context()
+	for range 3 {
+		require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	}
+	assert.Len(t, next.records, 3)
+	assert.True(t, p.Enabled(ctx, EnabledParameters{}))
+}
+
+func TestTraceBudgetProcessorShutdown(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceBudgetProcessor(next, 1)
+	ctx := t.Context()
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+	require.NoError(t, p.OnEmit(ctx, traceRecord(1, "a")))
+
+	require.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 1, next.shutdownCalls)
+	assert.Len(t, next.records, 2, "summary emitted on shutdown")
+}
@@ -0,0 +1,127 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// dfltTraceSampledMinSeverity is the default minimum severity of the records
+// passed by a TraceSampledProcessor regardless of the sampling of their trace.
+const dfltTraceSampledMinSeverity = log.SeverityInfo1
+
+// Compile-time check TraceSampledProcessor implements Processor.
+var _ Processor = (*TraceSampledProcessor)(nil)
+
+// TraceSampledProcessor is a processor that passes verbose log records to
+// another processor only when they are associated with a sampled trace.
+//
+// Use [NewTraceSampledProcessor] to create a TraceSampledProcessor.
+type TraceSampledProcessor struct {
+	next Processor
+	cfg  traceSampledConfig
+
+	noCmp [0]func() //nolint: unused  // This is indeed used.
+}
+
+// NewTraceSampledProcessor returns a [TraceSampledProcessor] that passes the
+// log records with a severity lower than a minimum (INFO by default, see
+// [WithTraceSampledMinSeverity]) to next only if the trace they are
+// associated with is sampled, as reported by the sampled flag of their trace
+// flags. This keeps verbose records, e.g. DEBUG ones, only for the requests
+// whose traces are recorded, cutting the volume of records while keeping the
+// records and traces correlated.
+//
+// Verbose records not associated with a trace are dropped. Records with an
+// undefined severity and records with a severity greater than or equal to the
+// minimum are always passed to next.
+func NewTraceSampledProcessor(next Processor, opts ...TraceSampledProcessorOption) *TraceSampledProcessor {
+	return &TraceSampledProcessor{next: next, cfg: newTraceSampledConfig(opts)}
+}
+
+// Enabled returns false if a record with the severity of param emitted with
+// ctx would be dropped because the span of ctx is not sampled. Otherwise, it
+// returns the result of the Enabled method of the next processor.
+func (p *TraceSampledProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.verbose(param.Severity) && !trace.SpanContextFromContext(ctx).IsSampled() {
+		return false
+	}
+	return p.next.Enabled(ctx, param)
+}
+
+// OnEmit passes r to the next processor unless it is verbose and it is not
+// associated with a sampled trace.
+func (p *TraceSampledProcessor) OnEmit(ctx context.Context, r *Record) error {
+	if p.verbose(r.Severity()) && (!r.TraceID().IsValid() || !r.TraceFlags().IsSampled()) {
+		return nil
+	}
+	return p.next.OnEmit(ctx, r)
+}
+
+// verbose returns whether records of severity are only passed for sampled
+// traces.
+func (p *TraceSampledProcessor) verbose(severity log.Severity) bool {
+	return severity != log.SeverityUndefined && severity < p.cfg.minSeverity
+}
+
+// ForceFlush flushes the next processor.
+func (p *TraceSampledProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// ForceFlushScope flushes the log records of the instrumentation scope name
+// held by the next processor. The next processor is entirely flushed if it
+// cannot flush the log records of a single scope.
+func (p *TraceSampledProcessor) ForceFlushScope(ctx context.Context, name string) error {
+	return forceFlushScope(ctx, p.next, name)
+}
+
+// Shutdown shuts down the next processor.
+func (p *TraceSampledProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+type traceSampledConfig struct {
+	minSeverity log.Severity
+}
+
+func newTraceSampledConfig(options []TraceSampledProcessorOption) traceSampledConfig {
+	c := traceSampledConfig{minSeverity: dfltTraceSampledMinSeverity}
+	for _, o := range options {
+		c = o.apply(c)
+	}
+	return c
+}
+
+// TraceSampledProcessorOption applies a configuration to a
+// [TraceSampledProcessor].
+type TraceSampledProcessorOption interface {
+	apply(traceSampledConfig) traceSampledConfig
+}
+
+type traceSampledOptionFunc func(traceSampledConfig) traceSampledConfig
+
+func (fn traceSampledOptionFunc) apply(c traceSampledConfig) traceSampledConfig {
+	return fn(c)
+}
+
+// WithTraceSampledMinSeverity sets the minimum severity of the log records
+// passed regardless of the sampling of their trace. Records with a lower
+// severity are only passed if their trace is sampled. For example, use
+// [log.SeverityWarn1] to also keep the INFO records only for sampled traces.
+//
+// By default, [log.SeverityInfo1] is used, so DEBUG and TRACE records are only
+// passed for sampled traces. The default value is also used when the provided
+// value is undefined.
+func WithTraceSampledMinSeverity(severity log.Severity) TraceSampledProcessorOption {
+	return traceSampledOptionFunc(func(c traceSampledConfig) traceSampledConfig {
+		if severity != log.SeverityUndefined {
+			c.minSeverity = severity
+		}
+		return c
+	})
+}
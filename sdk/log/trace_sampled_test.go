@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func sampledRecord(severity log.Severity, flags trace.TraceFlags, traced bool) *Record {
+	r := new(Record)
+	r.SetSeverity(severity)
+	if traced {
+		r.SetTraceID(trace.TraceID{1})
+		r.SetSpanID(trace.SpanID{1})
+		r.SetTraceFlags(flags)
+	}
+	return r
+}
+
+func TestTraceSampledProcessor(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []TraceSampledProcessorOption
+		severity log.Severity
+		flags    trace.TraceFlags
+		traced   bool
+		want     bool
+	}{
+		{name: "DebugSampled", severity: log.SeverityDebug, flags: trace.FlagsSampled, traced: true, want: true},
+		{name: "DebugNotSampled", severity: log.SeverityDebug, traced: true},
+		{name: "DebugNoTrace", severity: log.SeverityDebug},
+		{name: "TraceNotSampled", severity: log.SeverityTrace, traced: true},
+		{name: "InfoNotSampled", severity: log.SeverityInfo, traced: true, want: true},
+		{name: "ErrorNoTrace", severity: log.SeverityError, want: true},
+		{name: "Undefined", severity: log.SeverityUndefined, want: true},
+		{
+			name:     "MinSeverityNotSampled",
+			opts:     []TraceSampledProcessorOption{WithTraceSampledMinSeverity(log.SeverityWarn)},
+			severity: log.SeverityInfo,
+			traced:   true,
+		},
+		{
+			name:     "MinSeveritySampled",
+			opts:     []TraceSampledProcessorOption{WithTraceSampledMinSeverity(log.SeverityWarn)},
+			severity: log.SeverityInfo,
+			flags:    trace.FlagsSampled,
+			traced:   true,
+			want:     true,
+		},
+		{
+			name:     "MinSeverityUndefined",
+			opts:     []TraceSampledProcessorOption{WithTraceSampledMinSeverity(log.SeverityUndefined)},
+			severity: log.SeverityInfo,
+			traced:   true,
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := newProcessor("next")
+			p := NewTraceSampledProcessor(next, tt.opts...)
+			require.NoError(t, p.OnEmit(t.Context(), sampledRecord(tt.severity, tt.flags, tt.traced)))
+			if tt.want {
+				assert.Len(t, next.records, 1)
+			} else {
+				assert.Empty(t, next.records)
+			}
+		})
+	}
+}
+
+func TestTraceSampledProcessorEnabled(t *testing.T) {
+	p := NewTraceSampledProcessor(newProcessor("next"))
+	sampled := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	notSampled := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	}))
+
+	debug := EnabledParameters{Severity: log.SeverityDebug}
+	assert.True(t, p.Enabled(sampled, debug), "sampled")
+	assert.False(t, p.Enabled(notSampled, debug), "not sampled")
+	assert.False(t, p.Enabled(t.Context(), debug), "no span")
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityInfo}), "info")
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{}), "undefined")
+}
+
+func TestTraceSampledProcessorDelegates(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceSampledProcessor(next)
+	ctx := t.Context()
+
+	require.NoError(t, p.ForceFlush(ctx))
+	require.NoError(t, p.ForceFlushScope(ctx, "scope"))
+	require.NoError(t, p.Shutdown(ctx))
+	assert.Equal(t, 2, next.forceFlushCalls)
+	assert.Equal(t, 1, next.shutdownCalls)
+}
@@ -137,6 +137,20 @@ func (h AggregationExplicitBucketHistogram) copy() Aggregation {
 	}
 }
 
+// withNoMinMax returns a copy of agg not recording the min and max if agg is a
+// histogram aggregation. Otherwise, agg is returned.
+func withNoMinMax(agg Aggregation) Aggregation {
+	switch a := agg.(type) {
+	case AggregationExplicitBucketHistogram:
+		a.NoMinMax = true
+		return a
+	case AggregationBase2ExponentialHistogram:
+		a.NoMinMax = true
+		return a
+	}
+	return agg
+}
+
 // AggregationBase2ExponentialHistogram is an Aggregation that summarizes a set of
 // measurements as an histogram with bucket widths that grow exponentially.
 type AggregationBase2ExponentialHistogram struct {
@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import "go.opentelemetry.io/otel/attribute"
+
+// AttributeValueFilter maps the values of the attributes recorded for a
+// measurement of a Stream. It is called with each attribute and returns true
+// if the attribute is recorded as-is. Otherwise, the returned value is
+// recorded in place of the attribute value, or the attribute is dropped if
+// the returned value is the zero-value.
+//
+// An AttributeValueFilter needs to be safe to call concurrently.
+type AttributeValueFilter func(attribute.KeyValue) (attribute.Value, bool)
+
+// NewAllowAttributeValuesFilter returns an AttributeValueFilter that records
+// the attribute with key as-is only if its value is one of values. Other
+// values are replaced with other, e.g. attribute.StringValue("other"). If
+// other is the zero-value, the attribute is dropped instead. Attributes with
+// other keys are recorded as-is.
+//
+// This limits the cardinality of an attribute whose values are not bounded,
+// but which has a known set of relevant values.
+func NewAllowAttributeValuesFilter(key attribute.Key, other attribute.Value, values ...attribute.Value) AttributeValueFilter {
+	allowed := make(map[attribute.Value]struct{}, len(values))
+	for _, v := range values {
+		allowed[v] = struct{}{}
+	}
+	return func(kv attribute.KeyValue) (attribute.Value, bool) {
+		if kv.Key != key {
+			return attribute.Value{}, true
+		}
+		if _, ok := allowed[kv.Value]; ok {
+			return attribute.Value{}, true
+		}
+		return other, false
+	}
+}
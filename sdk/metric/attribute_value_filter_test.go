@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestNewAllowAttributeValuesFilter(t *testing.T) {
+	other := attribute.StringValue("other")
+	f := NewAllowAttributeValuesFilter("route", other, attribute.StringValue("/a"))
+
+	_, keep := f(attribute.String("route", "/a"))
+	assert.True(t, keep, "allowed value")
+
+	v, keep := f(attribute.String("route", "/b"))
+	assert.False(t, keep, "other value")
+	assert.Equal(t, other, v)
+
+	_, keep = f(attribute.String("method", "GET"))
+	assert.True(t, keep, "other key")
+
+	f = NewAllowAttributeValuesFilter("route", attribute.Value{})
+	v, keep = f(attribute.String("route", "/a"))
+	assert.False(t, keep, "drop")
+	assert.Equal(t, attribute.INVALID, v.Type())
+}
+
+func TestAttributeValueFilter(t *testing.T) {
+	get := attribute.String("method", "GET")
+	route := func(r string) metric.MeasurementOption {
+		return metric.WithAttributes(get, attribute.String("route", r))
+	}
+
+	testcases := []struct {
+		name   string
+		other  attribute.Value
+		record func(*testing.T, metric.Meter)
+		want   metricdata.Aggregation
+	}{
+		{
+			name:  "Int64Counter",
+			other: attribute.StringValue("other"),
+			record: func(t *testing.T, mtr metric.Meter) {
+				ctr, err := mtr.Int64Counter("counter")
+				require.NoError(t, err)
+				ctr.Add(t.Context(), 1, route("/a"))
+				ctr.Add(t.Context(), 2, route("/b"))
+				ctr.Add(t.Context(), 3, route("/c"))
+			},
+			want: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Attributes: attribute.NewSet(get, attribute.String("route", "/a")),
+						Value:      1,
+					},
+					{
+						Attributes: attribute.NewSet(get, attribute.String("route", "other")),
+						Value:      5,
+					},
+				},
+			},
+		},
+		{
+			name: "Int64ObservableCounterDrop",
+			record: func(t *testing.T, mtr metric.Meter) {
+				_, err := mtr.Int64ObservableCounter("counter", metric.WithInt64Callback(
+					func(_ context.Context, o metric.Int64Observer) error {
+						o.Observe(1, route("/a"))
+						o.Observe(2, route("/b"))
+						o.Observe(3, route("/c"))
+						return nil
+					},
+				))
+				require.NoError(t, err)
+			},
+			want: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Attributes: attribute.NewSet(get, attribute.String("route", "/a")),
+						Value:      1,
+					},
+					{Attributes: attribute.NewSet(get), Value: 5},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			view := NewView(Instrument{Name: "counter"}, Stream{
+				AttributeValueFilter: NewAllowAttributeValuesFilter(
+					"route", tc.other, attribute.StringValue("/a"),
+				),
+			})
+			rdr := NewManualReader()
+			mtr := NewMeterProvider(WithReader(rdr), WithView(view)).Meter("test")
+			tc.record(t, mtr)
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, rdr.Collect(t.Context(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+			metricdatatest.AssertAggregationsEqual(
+				t, tc.want, rm.ScopeMetrics[0].Metrics[0].Data,
+				metricdatatest.IgnoreTimestamp(),
+			)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Compile-time check BackfillProducer implements Producer.
+var _ Producer = (*BackfillProducer)(nil)
+
+// errBackfillConflict is reported when data points are recorded for a metric
+// name with a type different from the one first recorded for the name.
+var errBackfillConflict = errors.New("conflicting backfill metric type")
+
+// BackfillProducer is a [Producer] of measurements recorded with explicit
+// timestamps.
+//
+// It is intended to import metric data that was not measured by the SDK, like
+// measurements collected while offline or read from an external system. The
+// timestamps of recorded data points are exported as-is, instead of being
+// replaced with the collection time.
+//
+// Every recorded data point is produced exactly once: the next call to
+// Produce returns all data points recorded since the previous call. Register
+// the BackfillProducer with a single [Reader] using [WithProducer].
+//
+// A metric name is bound to the type of the data points first recorded for
+// it, e.g. an int64 gauge or a monotonic float64 sum. Data points recorded for
+// the name with another type are dropped and an error is logged, so a
+// single metric is never produced with conflicting types.
+//
+// Use [NewBackfillProducer] to create a BackfillProducer.
+type BackfillProducer struct {
+	scope instrumentation.Scope
+
+	mu      sync.Mutex
+	metrics []metricdata.Metrics
+	// kinds holds the type of the data points of each recorded metric name.
+	kinds map[string]string
+}
+
+// NewBackfillProducer returns a [BackfillProducer] that produces metric data
+// for the instrumentation scope.
+func NewBackfillProducer(scope instrumentation.Scope) *BackfillProducer {
+	return &BackfillProducer{scope: scope}
+}
+
+// RecordInt64Gauge records points for the int64 gauge with name, description,
+// and unit.
+//
+// A data point with a zero Time is stamped with the time it is recorded.
+func (p *BackfillProducer) RecordInt64Gauge(name, description, unit string, points ...metricdata.DataPoint[int64]) {
+	recordGauge(p, name, description, unit, points)
+}
+
+// RecordFloat64Gauge records points for the float64 gauge with name,
+// description, and unit.
+//
+// A data point with a zero Time is stamped with the time it is recorded.
+func (p *BackfillProducer) RecordFloat64Gauge(name, description, unit string, points ...metricdata.DataPoint[float64]) {
+	recordGauge(p, name, description, unit, points)
+}
+
+// RecordInt64Sum records points for the cumulative int64 sum with name,
+// description, unit, and monotonicity.
+//
+// A data point with a zero Time is stamped with the time it is recorded. A
+// data point with a zero StartTime uses its Time as StartTime.
+func (p *BackfillProducer) RecordInt64Sum(
+	name, description, unit string,
+	monotonic bool,
+	points ...metricdata.DataPoint[int64],
+) {
+	recordSum(p, name, description, unit, monotonic, points)
+}
+
+// RecordFloat64Sum records points for the cumulative float64 sum with name,
+// description, unit, and monotonicity.
+//
+// A data point with a zero Time is stamped with the time it is recorded. A
+// data point with a zero StartTime uses its Time as StartTime.
+func (p *BackfillProducer) RecordFloat64Sum(
+	name, description, unit string,
+	monotonic bool,
+	points ...metricdata.DataPoint[float64],
+) {
+	recordSum(p, name, description, unit, monotonic, points)
+}
+
+// Produce returns all data points recorded since the last call to Produce.
+//
+// This method is safe to call concurrently.
+func (p *BackfillProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	p.mu.Lock()
+	metrics := p.metrics
+	p.metrics = nil
+	p.mu.Unlock()
+
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+	return []metricdata.ScopeMetrics{{Scope: p.scope, Metrics: metrics}}, nil
+}
+
+func recordGauge[N int64 | float64](p *BackfillProducer, name, desc, unit string, points []metricdata.DataPoint[N]) {
+	if len(points) == 0 {
+		return
+	}
+	points = stampPoints(points, false)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.bind(name, fmt.Sprintf("%T", metricdata.Gauge[N]{})) {
+		return
+	}
+	for i, m := range p.metrics {
+		if m.Name != name {
+			continue
+		}
+		if g, ok := m.Data.(metricdata.Gauge[N]); ok {
+			g.DataPoints = append(g.DataPoints, points...)
+			p.metrics[i].Data = g
+			return
+		}
+	}
+	p.metrics = append(p.metrics, metricdata.Metrics{
+		Name:        name,
+		Description: desc,
+		Unit:        unit,
+		Data:        metricdata.Gauge[N]{DataPoints: points},
+	})
+}
+
+func recordSum[N int64 | float64](
+	p *BackfillProducer,
+	name, desc, unit string,
+	monotonic bool,
+	points []metricdata.DataPoint[N],
+) {
+	if len(points) == 0 {
+		return
+	}
+	points = stampPoints(points, true)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.bind(name, fmt.Sprintf("%T{IsMonotonic: %t}", metricdata.Sum[N]{}, monotonic)) {
+		return
+	}
+	for i, m := range p.metrics {
+		if m.Name != name {
+			continue
+		}
+		if s, ok := m.Data.(metricdata.Sum[N]); ok && s.IsMonotonic == monotonic {
+			s.DataPoints = append(s.DataPoints, points...)
+			p.metrics[i].Data = s
+			return
+		}
+	}
+	p.metrics = append(p.metrics, metricdata.Metrics{
+		Name:        name,
+		Description: desc,
+		Unit:        unit,
+		Data: metricdata.Sum[N]{
+			DataPoints:  points,
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: monotonic,
+		},
+	})
+}
+
+// bind binds the metric name to kind if it is not yet bound, and reports
+// whether name is bound to kind. A conflict is logged.
+//
+// The mu lock of p needs to be held.
+func (p *BackfillProducer) bind(name, kind string) bool {
+	prev, ok := p.kinds[name]
+	if !ok {
+		if p.kinds == nil {
+			p.kinds = make(map[string]string)
+		}
+		p.kinds[name] = kind
+		return true
+	}
+	if prev != kind {
+		global.Error(errBackfillConflict, "dropped data points", "name", name, "type", kind, "registered", prev)
+		return false
+	}
+	return true
+}
+
+// stampPoints returns a copy of points with zero timestamps set. If start is
+// true, a zero StartTime is set to the Time of the point.
+func stampPoints[N int64 | float64](points []metricdata.DataPoint[N], start bool) []metricdata.DataPoint[N] {
+	points = slices.Clone(points)
+	now := time.Now()
+	for i := range points {
+		if points[i].Time.IsZero() {
+			points[i].Time = now
+		}
+		if start && points[i].StartTime.IsZero() {
+			points[i].StartTime = points[i].Time
+		}
+	}
+	return points
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestBackfillProducer(t *testing.T) {
+	scope := instrumentation.Scope{Name: "backfill"}
+	p := NewBackfillProducer(scope)
+
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+	attrs := attribute.NewSet(attribute.String("host", "a"))
+
+	p.RecordFloat64Gauge("temp", "temperature", "Cel",
+		metricdata.DataPoint[float64]{Attributes: attrs, Time: t0, Value: 20},
+	)
+	p.RecordFloat64Gauge("temp", "temperature", "Cel",
+		metricdata.DataPoint[float64]{Attributes: attrs, Time: t1, Value: 21},
+	)
+	p.RecordInt64Sum("requests", "", "{request}", true,
+		metricdata.DataPoint[int64]{Attributes: attrs, StartTime: t0, Time: t1, Value: 10},
+		metricdata.DataPoint[int64]{Attributes: attrs, Time: t1, Value: 3},
+	)
+	p.RecordInt64Gauge("empty", "", "")
+
+	got, err := p.Produce(t.Context())
+	require.NoError(t, err)
+	want := []metricdata.ScopeMetrics{{
+		Scope: scope,
+		Metrics: []metricdata.Metrics{
+			{
+				Name:        "temp",
+				Description: "temperature",
+				Unit:        "Cel",
+				Data: metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{
+					{Attributes: attrs, Time: t0, Value: 20},
+					{Attributes: attrs, Time: t1, Value: 21},
+				}},
+			},
+			{
+				Name: "requests",
+				Unit: "{request}",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attrs, StartTime: t0, Time: t1, Value: 10},
+						{Attributes: attrs, StartTime: t1, Time: t1, Value: 3},
+					},
+				},
+			},
+		},
+	}}
+	require.Len(t, got, 1)
+	metricdatatest.AssertEqual(t, want[0], got[0])
+
+	got, err = p.Produce(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, got, "data points are produced once")
+}
+
+func TestBackfillProducerZeroTime(t *testing.T) {
+	p := NewBackfillProducer(instrumentation.Scope{Name: "backfill"})
+	before := time.Now()
+	p.RecordFloat64Sum("sum", "", "", false, metricdata.DataPoint[float64]{Value: 1})
+
+	got, err := p.Produce(t.Context())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Len(t, got[0].Metrics, 1)
+	sum, ok := got[0].Metrics[0].Data.(metricdata.Sum[float64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	dp := sum.DataPoints[0]
+	assert.False(t, dp.Time.Before(before), "time stamped")
+	assert.Equal(t, dp.Time, dp.StartTime, "start time")
+	assert.False(t, sum.IsMonotonic)
+}
+
+func TestBackfillProducerWithReader(t *testing.T) {
+	p := NewBackfillProducer(instrumentation.Scope{Name: "backfill"})
+	r := NewManualReader(WithProducer(p))
+	mp := NewMeterProvider(WithReader(r))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(t.Context())) })
+
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p.RecordInt64Gauge("gauge", "", "", metricdata.DataPoint[int64]{Time: ts, Value: 1})
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	g, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[int64])
+	require.True(t, ok)
+	require.Len(t, g.DataPoints, 1)
+	assert.Equal(t, ts, g.DataPoints[0].Time, "explicit timestamp preserved")
+}
+
+func TestBackfillProducerConflict(t *testing.T) {
+	l := newLogSink(t)
+	orig := global.GetLogger()
+	t.Cleanup(func() { global.SetLogger(orig) })
+	global.SetLogger(logr.New(l))
+
+	p := NewBackfillProducer(instrumentation.Scope{Name: "backfill"})
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p.RecordInt64Gauge("metric", "", "", metricdata.DataPoint[int64]{Time: ts, Value: 1})
+	p.RecordFloat64Gauge("metric", "", "", metricdata.DataPoint[float64]{Time: ts, Value: 2})
+	p.RecordInt64Sum("metric", "", "", true, metricdata.DataPoint[int64]{Time: ts, Value: 3})
+
+	got, err := p.Produce(t.Context())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Len(t, got[0].Metrics, 1, "duplicate metric produced")
+	_, ok := got[0].Metrics[0].Data.(metricdata.Gauge[int64])
+	assert.True(t, ok, "first recorded type not kept")
+	assert.Len(t, l.messages, 2)
+
+	// The type of a name is kept across collections.
+	p.RecordInt64Sum("metric", "", "", false, metricdata.DataPoint[int64]{Time: ts, Value: 4})
+	got, err = p.Produce(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	assert.Len(t, l.messages, 3)
+
+	p.RecordInt64Sum("sum", "", "", true, metricdata.DataPoint[int64]{Time: ts, Value: 1})
+	p.RecordInt64Sum("sum", "", "", false, metricdata.DataPoint[int64]{Time: ts, Value: 1})
+	assert.Len(t, l.messages, 4, "monotonicity conflict not reported")
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// CollectOption applies an option to a collection of a [ManualReader], see
+// [ManualReader.CollectScoped].
+type CollectOption interface {
+	applyCollect(collectConfig) collectConfig
+}
+
+type collectOptionFunc func(collectConfig) collectConfig
+
+func (fn collectOptionFunc) applyCollect(cfg collectConfig) collectConfig {
+	return fn(cfg)
+}
+
+// collectConfig selects the streams of a collection. The zero value selects
+// all the streams.
+type collectConfig struct {
+	// scopes are the names of the selected instrumentation scopes. All the
+	// scopes are selected if it is nil.
+	scopes map[string]struct{}
+	// names are the names of the selected streams. All the streams are
+	// selected if it is nil.
+	names map[string]struct{}
+}
+
+// WithScope selects the streams of the meters with an instrumentation scope
+// named one of names. If this option is used multiple times, the streams of
+// all the named scopes are selected.
+func WithScope(names ...string) CollectOption {
+	return collectOptionFunc(func(cfg collectConfig) collectConfig {
+		cfg.scopes = addNames(cfg.scopes, names)
+		return cfg
+	})
+}
+
+// WithInstrument selects the streams named one of names, e.g. the instruments
+// with these names not renamed by a View. If this option is used multiple
+// times, the streams with all the names are selected.
+//
+// The streams of asynchronous instruments are always selected, when their
+// scope is, as the callbacks observing them can observe multiple instruments
+// and their observations need to be computed to not be reported twice.
+func WithInstrument(names ...string) CollectOption {
+	return collectOptionFunc(func(cfg collectConfig) collectConfig {
+		cfg.names = addNames(cfg.names, names)
+		return cfg
+	})
+}
+
+// addNames returns a copy of set with names added.
+func addNames(set map[string]struct{}, names []string) map[string]struct{} {
+	out := make(map[string]struct{}, len(set)+len(names))
+	for name := range set {
+		out[name] = struct{}{}
+	}
+	for _, name := range names {
+		out[name] = struct{}{}
+	}
+	return out
+}
+
+// scope reports whether the streams of s may be selected.
+func (c collectConfig) scope(s instrumentation.Scope) bool {
+	if c.scopes == nil {
+		return true
+	}
+	_, ok := c.scopes[s.Name]
+	return ok
+}
+
+// stream reports whether inst is selected, provided its scope is.
+func (c collectConfig) stream(inst instrumentSync) bool {
+	if c.names == nil || inst.async {
+		return true
+	}
+	_, ok := c.names[inst.name]
+	return ok
+}
+
+// appendScopeMetrics appends the metrics of src selected by c to dst.
+func (c collectConfig) appendScopeMetrics(dst, src []metricdata.ScopeMetrics) []metricdata.ScopeMetrics {
+	if c.scopes == nil && c.names == nil {
+		return append(dst, src...)
+	}
+	for _, sm := range src {
+		if !c.scope(sm.Scope) {
+			continue
+		}
+		if c.names != nil {
+			sm.Metrics = slices.DeleteFunc(slices.Clone(sm.Metrics), func(m metricdata.Metrics) bool {
+				_, ok := c.names[m.Name]
+				return !ok
+			})
+			if len(sm.Metrics) == 0 {
+				continue
+			}
+		}
+		dst = append(dst, sm)
+	}
+	return dst
+}
@@ -6,6 +6,7 @@ package metric
 import (
 	"context"
 	"errors"
+	"maps"
 	"os"
 	"strconv"
 	"strings"
@@ -22,7 +23,13 @@ type config struct {
 	readers          []Reader
 	views            []View
 	exemplarFilter   exemplar.Filter
+	spanExemplars    map[InstrumentKind]bool
 	cardinalityLimit int
+	unitValidation   UnitValidation
+	nameSanitizer    func(string) string
+
+	collectConcurrency int
+	lastUpdateTime     bool
 }
 
 const defaultCardinalityLimit = 2000
@@ -162,6 +169,11 @@ func WithView(views ...View) Option {
 // By default, the [exemplar.TraceBasedFilter]
 // is used. Exemplars can be entirely disabled by providing the
 // [exemplar.AlwaysOffFilter].
+//
+// The OTEL_METRICS_EXEMPLAR_FILTER environment variable can also be used to
+// set the filter. The supported values are "trace_based", "always_on", and
+// "always_off". Unrecognized values are ignored. This option takes precedence
+// over the environment variable.
 func WithExemplarFilter(filter exemplar.Filter) Option {
 	return optionFunc(func(cfg config) config {
 		cfg.exemplarFilter = filter
@@ -169,6 +181,41 @@ func WithExemplarFilter(filter exemplar.Filter) Option {
 	})
 }
 
+// WithSpanExemplars records exemplars referencing the span of the
+// measurements of the instruments of kinds made within sampled spans,
+// regardless of the exemplar filter set with [WithExemplarFilter]. This links
+// the metrics of these instruments to the traces of the operations they
+// measure, e.g. for the metrics recorded by span processors deriving metrics
+// from spans. The other measurements are still offered to the exemplar
+// reservoirs according to the exemplar filter.
+//
+// If no kinds are passed, all the synchronous instrument kinds are used.
+// Asynchronous instruments are not passed the context of their measurements,
+// and never record exemplars referencing spans.
+//
+// Exemplar reservoirs make the final decision of which of the offered
+// measurements are stored as exemplars, see
+// [Stream.ExemplarReservoirProviderSelector].
+func WithSpanExemplars(kinds ...InstrumentKind) Option {
+	if len(kinds) == 0 {
+		kinds = []InstrumentKind{
+			InstrumentKindCounter,
+			InstrumentKindUpDownCounter,
+			InstrumentKindHistogram,
+			InstrumentKindGauge,
+		}
+	}
+	return optionFunc(func(cfg config) config {
+		spanExemplars := make(map[InstrumentKind]bool, len(cfg.spanExemplars)+len(kinds))
+		maps.Copy(spanExemplars, cfg.spanExemplars)
+		for _, k := range kinds {
+			spanExemplars[k] = true
+		}
+		cfg.spanExemplars = spanExemplars
+		return cfg
+	})
+}
+
 // WithCardinalityLimit sets the global cardinality limit for the MeterProvider.
 //
 // The cardinality limit is the hard limit on the number of metric datapoints
@@ -189,6 +236,38 @@ func WithCardinalityLimit(limit int) Option {
 	})
 }
 
+// WithCollectConcurrency sets the maximum number of goroutines computing the
+// aggregations of the instruments during a collection of a Reader.
+//
+// By default, if this option is not used, aggregations are computed
+// sequentially. Computing them concurrently reduces the duration of
+// collections of many streams, at the cost of using more CPU at once.
+//
+// Setting this to a value less than 2 computes the aggregations sequentially.
+// Callbacks of asynchronous instruments are always called sequentially.
+func WithCollectConcurrency(n int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.collectConcurrency = n
+		return cfg
+	})
+}
+
+// WithLastUpdateTime records the time of the last measurement made for each
+// attribute set of the instruments, and sets it as the LastUpdateTime of the
+// collected data points. This can be used to detect streams that are no
+// longer updated, e.g. to emit staleness markers or detect silent
+// instrumentation.
+//
+// By default, if this option is not used, the time is not recorded and the
+// LastUpdateTime of the data points is the zero time. Recording it reads the
+// clock once per measurement.
+func WithLastUpdateTime() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.lastUpdateTime = true
+		return cfg
+	})
+}
+
 func meterProviderOptionsFromEnv() []Option {
 	var opts []Option
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/d4b241f451674e8f611bb589477680341006ad2b/specification/configuration/sdk-environment-variables.md#exemplar
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -224,6 +225,54 @@ func TestWithView(t *testing.T) {
 	assert.Len(t, c.views, 2)
 }
 
+func TestWithCollectConcurrency(t *testing.T) {
+	assert.Equal(t, 0, newConfig(nil).collectConcurrency)
+	c := newConfig([]Option{WithCollectConcurrency(4)})
+	assert.Equal(t, 4, c.collectConcurrency)
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r), WithCollectConcurrency(4))
+	t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+	require.Len(t, mp.pipes, 1)
+	assert.Equal(t, 4, mp.pipes[0].collectConcurrency)
+}
+
+func TestWithLastUpdateTime(t *testing.T) {
+	assert.False(t, newConfig(nil).lastUpdateTime)
+	assert.True(t, newConfig([]Option{WithLastUpdateTime()}).lastUpdateTime)
+
+	for _, enabled := range []bool{false, true} {
+		opts := []Option{}
+		if enabled {
+			opts = append(opts, WithLastUpdateTime())
+		}
+		r := NewManualReader()
+		mp := NewMeterProvider(append(opts, WithReader(r))...)
+		t.Cleanup(func() { assert.NoError(t, mp.Shutdown(context.Background())) })
+
+		ctr, err := mp.Meter("TestWithLastUpdateTime").Int64Counter("counter")
+		require.NoError(t, err)
+		before := time.Now()
+		ctr.Add(t.Context(), 1)
+
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, r.Collect(t.Context(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, sum.DataPoints, 1)
+
+		got := sum.DataPoints[0].LastUpdateTime
+		if !enabled {
+			assert.True(t, got.IsZero(), "LastUpdateTime set when disabled")
+			continue
+		}
+		assert.False(t, got.Before(before), "LastUpdateTime before the measurement")
+		assert.False(t, got.After(sum.DataPoints[0].Time), "LastUpdateTime after the collection")
+	}
+}
+
 func TestWithExemplarFilterOff(t *testing.T) {
 	for _, tc := range []struct {
 		desc                   string
@@ -368,6 +417,70 @@ func TestWithCardinalityLimit(t *testing.T) {
 	}
 }
 
+func TestWithSpanExemplars(t *testing.T) {
+	assert.Nil(t, newConfig(nil).spanExemplars)
+
+	c := newConfig([]Option{WithSpanExemplars()})
+	assert.Equal(t, map[InstrumentKind]bool{
+		InstrumentKindCounter:       true,
+		InstrumentKindUpDownCounter: true,
+		InstrumentKindHistogram:     true,
+		InstrumentKindGauge:         true,
+	}, c.spanExemplars)
+
+	c = newConfig([]Option{
+		WithSpanExemplars(InstrumentKindCounter),
+		WithSpanExemplars(InstrumentKindGauge),
+	})
+	assert.Equal(t, map[InstrumentKind]bool{
+		InstrumentKindCounter: true,
+		InstrumentKindGauge:   true,
+	}, c.spanExemplars)
+}
+
+func TestSpanExemplarFilter(t *testing.T) {
+	ctx := t.Context()
+	sampled := sample(ctx)
+	marked := context.WithValue(ctx, testExemplarKey{}, true)
+	custom := func(ctx context.Context) bool { return ctx.Value(testExemplarKey{}) != nil }
+
+	for _, tc := range []struct {
+		desc   string
+		filter exemplar.Filter
+		want   map[context.Context]bool
+	}{
+		{
+			desc:   "always off",
+			filter: exemplar.AlwaysOffFilter,
+			want:   map[context.Context]bool{ctx: false, sampled: true, marked: false},
+		},
+		{
+			desc:   "trace based",
+			filter: exemplar.TraceBasedFilter,
+			want:   map[context.Context]bool{ctx: false, sampled: true, marked: false},
+		},
+		{
+			desc:   "always on",
+			filter: exemplar.AlwaysOnFilter,
+			want:   map[context.Context]bool{ctx: true, sampled: true, marked: true},
+		},
+		{
+			desc:   "custom",
+			filter: custom,
+			want:   map[context.Context]bool{ctx: false, sampled: true, marked: true},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := spanExemplarFilter(tc.filter)
+			for c, want := range tc.want {
+				assert.Equal(t, want, f(c))
+			}
+		})
+	}
+}
+
+type testExemplarKey struct{}
+
 func sample(parent context.Context) context.Context {
 	sc := trace.NewSpanContext(trace.SpanContextConfig{
 		TraceID:    trace.TraceID{0x01},
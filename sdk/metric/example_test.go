@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 )
@@ -285,3 +287,38 @@ func ExampleWithExemplarFilter_custom() {
 		metric.WithExemplarFilter(customFilter),
 	)
 }
+
+func ExampleBackfillProducer() {
+	// Measurements collected while offline can be exported with their
+	// original timestamps once the application is able to export again.
+	producer := metric.NewBackfillProducer(instrumentation.Scope{
+		Name: "go.opentelemetry.io/otel/sdk/metric#BackfillProducer",
+	})
+
+	reader := metric.NewManualReader(metric.WithProducer(producer))
+	meterProvider := metric.NewMeterProvider(metric.WithReader(reader))
+	defer func() {
+		err := meterProvider.Shutdown(context.Background())
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}()
+
+	collectedAt := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	producer.RecordFloat64Gauge(
+		"device.temperature", "Temperature of the device.", "Cel",
+		metricdata.DataPoint[float64]{
+			Attributes: attribute.NewSet(attribute.String("device.id", "sensor-1")),
+			Time:       collectedAt,
+			Value:      21.5,
+		},
+	)
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		log.Fatalln(err)
+	}
+	gauge := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[float64])
+	fmt.Println(gauge.DataPoints[0].Time.Format(time.RFC3339), gauge.DataPoints[0].Value)
+	// Output: 2026-01-01T12:00:00Z 21.5
+}
@@ -4,6 +4,7 @@
 package metric
 
 import (
+	"context"
 	"reflect"
 	"runtime"
 
@@ -38,6 +39,29 @@ func reservoirFunc[N int64 | float64](
 	}
 }
 
+// exemplarFilterFor returns the exemplar filter of the instruments of kind.
+func (p *pipeline) exemplarFilterFor(kind InstrumentKind) exemplar.Filter {
+	if !p.spanExemplars[kind] {
+		return p.exemplarFilter
+	}
+	return spanExemplarFilter(p.exemplarFilter)
+}
+
+// spanExemplarFilter returns filter also offering the measurements made
+// within sampled spans.
+func spanExemplarFilter(filter exemplar.Filter) exemplar.Filter {
+	switch reflect.ValueOf(filter).Pointer() {
+	case reflect.ValueOf(exemplar.AlwaysOffFilter).Pointer(),
+		reflect.ValueOf(exemplar.TraceBasedFilter).Pointer():
+		return exemplar.TraceBasedFilter
+	case reflect.ValueOf(exemplar.AlwaysOnFilter).Pointer():
+		return filter
+	}
+	return func(ctx context.Context) bool {
+		return exemplar.TraceBasedFilter(ctx) || filter(ctx)
+	}
+}
+
 // DefaultExemplarReservoirProviderSelector returns the default
 // [exemplar.ReservoirProvider] for the
 // provided [Aggregation].
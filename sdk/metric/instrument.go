@@ -146,15 +146,62 @@ type Stream struct {
 	// because Exemplars are recorded with the dropped measurement attributes
 	// when View attribute filtering is applied.
 	//
+	// The observations of asynchronous counters are spatially re-aggregated
+	// when their attributes are filtered: the increase of each unfiltered
+	// attribute set is summed into its filtered attribute set. The filtered
+	// sum does not decrease when an unfiltered attribute set is no longer
+	// observed, for either temporality.
+	//
 	// Use NewAllowKeysFilter from "go.opentelemetry.io/otel/attribute" to
 	// provide an allow-list of attribute keys here.
 	AttributeFilter attribute.Filter
+	// AttributeValueFilter maps the values of the attributes recorded for an
+	// instrument's measurement, e.g. to limit the values of an attribute to a
+	// known set. It is applied before the AttributeFilter.
+	//
+	// Like for the AttributeFilter, the observations of asynchronous counters
+	// mapped to the same attribute set are spatially re-aggregated.
+	//
+	// Use NewAllowAttributeValuesFilter to provide an allow-list of attribute
+	// values here.
+	AttributeValueFilter AttributeValueFilter
 	// ExemplarReservoirProvider selects the
 	// [go.opentelemetry.io/otel/sdk/metric/exemplar.ReservoirProvider] based
 	// on the [Aggregation].
 	//
 	// If unspecified, [DefaultExemplarReservoirProviderSelector] is used.
 	ExemplarReservoirProviderSelector ExemplarReservoirProviderSelector
+	// NoMinMax indicates whether to not record the min and max of the
+	// histogram aggregation of the stream, whether the aggregation is set by
+	// the View or selected by the Reader. It is ignored for other
+	// aggregations.
+	//
+	// Not recording the min and max reduces the memory used by each
+	// histogram data point.
+	NoMinMax bool
+	// GaugeHistogram indicates whether the histogram aggregation of the
+	// stream describes the current distribution of a gauge, e.g. the sizes
+	// of queues observed by an asynchronous gauge, instead of accumulating
+	// measurements. It is ignored for other aggregations.
+	//
+	// The histogram of a gauge histogram stream is computed with the
+	// measurements made since the last collection, i.e. with delta
+	// temporality whatever the temporality of the Reader is, and is marked
+	// with IsGauge. Exporters supporting it, e.g. Prometheus, export it as a
+	// gauge histogram.
+	//
+	// It cannot be combined with Summary: NewView drops a view setting both,
+	// and GaugeHistogram is ignored for a stream setting both.
+	GaugeHistogram bool
+	// Summary, if not nil, converts the explicit bucket histogram
+	// aggregation of the stream into summaries of the quantiles it
+	// configures when the stream is collected. It is ignored for other
+	// aggregations.
+	//
+	// The histogram of the stream is computed with cumulative temporality
+	// whatever the temporality of the Reader is. Only the summaries are
+	// exported, not the buckets of the histogram.
+	Summary *HistogramSummary
 }
 
 // instID are the identifying properties of a instrument.
@@ -33,6 +33,11 @@ type Builder[N int64 | float64] struct {
 	// Filter is the attribute filter the aggregate function will use on the
 	// input of measurements.
 	Filter attribute.Filter
+	// ValueFilter maps the attribute values of the input of measurements. It
+	// is applied before Filter. It returns true if the attribute is kept
+	// as-is. Otherwise, the returned value replaces the attribute value, or
+	// the attribute is dropped if the returned value is invalid.
+	ValueFilter func(attribute.KeyValue) (attribute.Value, bool)
 	// ReservoirFunc is the factory function used by aggregate functions to
 	// create new exemplar reservoirs for a new seen attribute set.
 	//
@@ -47,6 +52,10 @@ type Builder[N int64 | float64] struct {
 	// If AggregationLimit is less than or equal to zero there will not be an
 	// aggregation limit imposed (i.e. unlimited attribute sets).
 	AggregationLimit int
+	// LastUpdateTime is whether the time of the last measurement of each
+	// attribute set is recorded and output as the LastUpdateTime of its data
+	// point.
+	LastUpdateTime bool
 }
 
 func (b Builder[N]) resFunc() func(attribute.Set) FilteredExemplarReservoir[N] {
@@ -60,10 +69,9 @@ func (b Builder[N]) resFunc() func(attribute.Set) FilteredExemplarReservoir[N] {
 type fltrMeasure[N int64 | float64] func(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue)
 
 func (b Builder[N]) filter(f fltrMeasure[N]) Measure[N] {
-	if b.Filter != nil {
-		fltr := b.Filter // Copy to make it immutable after assignment.
+	if fltr := b.attrFilter(); fltr != nil {
 		return func(ctx context.Context, n N, a attribute.Set) {
-			fAttr, dropped := a.Filter(fltr)
+			fAttr, dropped := fltr(a)
 			f(ctx, n, fAttr, dropped)
 		}
 	}
@@ -72,14 +80,80 @@ func (b Builder[N]) filter(f fltrMeasure[N]) Measure[N] {
 	}
 }
 
+// attrFilter returns the function applying the ValueFilter and Filter of b
+// to an attribute set. It returns the filtered set and the attributes
+// dropped. If b has no filters, nil is returned.
+func (b Builder[N]) attrFilter() func(attribute.Set) (attribute.Set, []attribute.KeyValue) {
+	// Copy to make them immutable after assignment.
+	vf, fltr := b.ValueFilter, b.Filter
+	switch {
+	case vf == nil && fltr == nil:
+		return nil
+	case vf == nil:
+		return func(a attribute.Set) (attribute.Set, []attribute.KeyValue) {
+			return a.Filter(fltr)
+		}
+	case fltr == nil:
+		return func(a attribute.Set) (attribute.Set, []attribute.KeyValue) {
+			return filterValues(vf, a)
+		}
+	default:
+		return func(a attribute.Set) (attribute.Set, []attribute.KeyValue) {
+			a, dropped := filterValues(vf, a)
+			a, d := a.Filter(fltr)
+			return a, append(dropped, d...)
+		}
+	}
+}
+
+// filterValues returns the attribute set with the values of a mapped by vf,
+// and the attributes dropped. If no value is mapped, a is returned.
+func filterValues(
+	vf func(attribute.KeyValue) (attribute.Value, bool),
+	a attribute.Set,
+) (attribute.Set, []attribute.KeyValue) {
+	n := a.Len()
+	var (
+		kvs     []attribute.KeyValue
+		dropped []attribute.KeyValue
+	)
+	for i := range n {
+		kv, _ := a.Get(i)
+		v, keep := vf(kv)
+		if keep {
+			if kvs != nil {
+				kvs = append(kvs, kv)
+			}
+			continue
+		}
+
+		if kvs == nil {
+			// Copy now that the set is known to change.
+			kvs = make([]attribute.KeyValue, i, n)
+			for j := range i {
+				kvs[j], _ = a.Get(j)
+			}
+		}
+		if v.Type() == attribute.INVALID {
+			dropped = append(dropped, kv)
+			continue
+		}
+		kvs = append(kvs, attribute.KeyValue{Key: kv.Key, Value: v})
+	}
+	if kvs == nil {
+		return a, nil
+	}
+	return attribute.NewSet(kvs...), dropped
+}
+
 // LastValue returns a last-value aggregate function input and output.
 func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		lv := newDeltaLastValue[N](b.AggregationLimit, b.resFunc())
+		lv := newDeltaLastValue[N](b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 		return b.filter(lv.measure), lv.collect
 	default:
-		lv := newCumulativeLastValue[N](b.AggregationLimit, b.resFunc())
+		lv := newCumulativeLastValue[N](b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 		return b.filter(lv.measure), lv.collect
 	}
 }
@@ -88,7 +162,7 @@ func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
 // output. The aggregation returned from the returned ComputeAggregation
 // function will always only return values from the previous collection cycle.
 func (b Builder[N]) PrecomputedLastValue() (Measure[N], ComputeAggregation) {
-	lv := newPrecomputedLastValue[N](b.AggregationLimit, b.resFunc())
+	lv := newPrecomputedLastValue[N](b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		return b.filter(lv.measure), lv.delta
@@ -99,8 +173,23 @@ func (b Builder[N]) PrecomputedLastValue() (Measure[N], ComputeAggregation) {
 
 // PrecomputedSum returns a sum aggregate function input and output. The
 // arguments passed to the input are expected to be the precomputed sum values.
+//
+// If monotonic is true and b has a Filter, the observations are spatially
+// re-aggregated: the increase of each unfiltered attribute set is summed into
+// its filtered attribute set. This ensures the returned sums do not decrease
+// when an unfiltered attribute set is no longer observed or is reset.
 func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregation) {
-	s := newPrecomputedSum[N](monotonic, b.AggregationLimit, b.resFunc())
+	if fltr := b.attrFilter(); monotonic && fltr != nil {
+		s := newFilteredPrecomputedSum[N](fltr, b.LastUpdateTime, b.AggregationLimit, b.resFunc())
+		switch b.Temporality {
+		case metricdata.DeltaTemporality:
+			return s.measure, s.delta
+		default:
+			return s.measure, s.cumulative
+		}
+	}
+
+	s := newPrecomputedSum[N](monotonic, b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		return b.filter(s.measure), s.delta
@@ -113,10 +202,10 @@ func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregati
 func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		s := newDeltaSum[N](monotonic, b.AggregationLimit, b.resFunc())
+		s := newDeltaSum[N](monotonic, b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 		return b.filter(s.measure), s.collect
 	default:
-		s := newCumulativeSum[N](monotonic, b.AggregationLimit, b.resFunc())
+		s := newCumulativeSum[N](monotonic, b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 		return b.filter(s.measure), s.collect
 	}
 }
@@ -129,10 +218,10 @@ func (b Builder[N]) ExplicitBucketHistogram(
 ) (Measure[N], ComputeAggregation) {
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		h := newDeltaHistogram[N](boundaries, noMinMax, noSum, b.AggregationLimit, b.resFunc())
+		h := newDeltaHistogram[N](boundaries, noMinMax, noSum, b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 		return b.filter(h.measure), h.collect
 	default:
-		h := newCumulativeHistogram[N](boundaries, noMinMax, noSum, b.AggregationLimit, b.resFunc())
+		h := newCumulativeHistogram[N](boundaries, noMinMax, noSum, b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 		return b.filter(h.measure), h.collect
 	}
 }
@@ -143,7 +232,7 @@ func (b Builder[N]) ExponentialBucketHistogram(
 	maxSize, maxScale int32,
 	noMinMax, noSum bool,
 ) (Measure[N], ComputeAggregation) {
-	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum, b.AggregationLimit, b.resFunc())
+	h := newExponentialHistogram[N](maxSize, maxScale, noMinMax, noSum, b.LastUpdateTime, b.AggregationLimit, b.resFunc())
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
 		return b.filter(h.measure), h.delta
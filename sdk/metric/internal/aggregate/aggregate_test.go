@@ -102,7 +102,133 @@ func testBuilderFilter[N int64 | float64]() func(t *testing.T) {
 
 		t.Run("NoFilter", run(Builder[N]{}, attr, nil))
 		t.Run("Filter", run(Builder[N]{Filter: attrFltr}, fltrAlice, []attribute.KeyValue{adminTrue}))
+
+		userOther := attribute.String(keyUser, "other")
+		mapUser := func(kv attribute.KeyValue) (attribute.Value, bool) {
+			if kv.Key == attribute.Key(keyUser) {
+				return userOther.Value, false
+			}
+			return attribute.Value{}, true
+		}
+		t.Run("ValueFilter", run(
+			Builder[N]{ValueFilter: mapUser},
+			attribute.NewSet(userOther, adminTrue),
+			nil,
+		))
+		dropAdmin := func(kv attribute.KeyValue) (attribute.Value, bool) {
+			return attribute.Value{}, kv.Key != adminTrue.Key
+		}
+		t.Run("ValueFilterDrop", run(Builder[N]{ValueFilter: dropAdmin}, fltrAlice, []attribute.KeyValue{adminTrue}))
+		t.Run("ValueFilterAndFilter", run(
+			Builder[N]{Filter: attrFltr, ValueFilter: mapUser},
+			attribute.NewSet(userOther),
+			[]attribute.KeyValue{adminTrue},
+		))
+	}
+}
+
+func TestBuilderLastUpdateTime(t *testing.T) {
+	t.Run("Int64", testBuilderLastUpdateTime[int64]())
+	t.Run("Float64", testBuilderLastUpdateTime[float64]())
+}
+
+func testBuilderLastUpdateTime[N int64 | float64]() func(t *testing.T) {
+	return func(t *testing.T) {
+		aggs := map[string]func(Builder[N]) (Measure[N], ComputeAggregation){
+			"Sum":            func(b Builder[N]) (Measure[N], ComputeAggregation) { return b.Sum(true) },
+			"PrecomputedSum": func(b Builder[N]) (Measure[N], ComputeAggregation) { return b.PrecomputedSum(false) },
+			"FilteredPrecomputedSum": func(b Builder[N]) (Measure[N], ComputeAggregation) {
+				b.Filter = attrFltr
+				return b.PrecomputedSum(true)
+			},
+			"LastValue":            func(b Builder[N]) (Measure[N], ComputeAggregation) { return b.LastValue() },
+			"PrecomputedLastValue": func(b Builder[N]) (Measure[N], ComputeAggregation) { return b.PrecomputedLastValue() },
+			"ExplicitBucketHistogram": func(b Builder[N]) (Measure[N], ComputeAggregation) {
+				return b.ExplicitBucketHistogram([]float64{0, 5, 10}, false, false)
+			},
+			"ExponentialBucketHistogram": func(b Builder[N]) (Measure[N], ComputeAggregation) {
+				return b.ExponentialBucketHistogram(4, 20, false, false)
+			},
+		}
+		temporalities := []metricdata.Temporality{metricdata.DeltaTemporality, metricdata.CumulativeTemporality}
+
+		var current atomic.Int64
+		orig := now
+		now = func() time.Time { return y2kPlus(current.Load()) }
+		t.Cleanup(func() { now = orig })
+
+		for name, newAgg := range aggs {
+			for _, temp := range temporalities {
+				t.Run(name+"/"+temp.String(), func(t *testing.T) {
+					for _, enabled := range []bool{false, true} {
+						meas, comp := newAgg(Builder[N]{Temporality: temp, LastUpdateTime: enabled})
+
+						ctx := t.Context()
+						current.Store(1)
+						meas(ctx, 1, alice)
+						current.Store(2)
+						meas(ctx, 1, bob)
+						current.Store(3)
+						meas(ctx, 2, alice)
+						current.Store(4)
+
+						got := new(metricdata.Aggregation)
+						comp(got)
+						want := map[string]time.Time{"Alice": {}, "Bob": {}}
+						if enabled {
+							want = map[string]time.Time{"Alice": y2kPlus(3), "Bob": y2kPlus(2)}
+						}
+						assert.Equal(t, want, lastUpdateTimes(*got), "LastUpdateTime enabled: %t", enabled)
+					}
+				})
+			}
+		}
+	}
+}
+
+// lastUpdateTimes returns the LastUpdateTime of the data points of agg keyed
+// by the user attribute value.
+func lastUpdateTimes(agg metricdata.Aggregation) map[string]time.Time {
+	out := make(map[string]time.Time)
+	add := func(attrs attribute.Set, t time.Time) {
+		v, _ := attrs.Value(attribute.Key(keyUser))
+		out[v.AsString()] = t
+	}
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
+	case metricdata.Sum[float64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
+	case metricdata.Gauge[int64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
+	case metricdata.Gauge[float64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
+	case metricdata.Histogram[int64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
+	case metricdata.Histogram[float64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
+	case metricdata.ExponentialHistogram[int64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
+	case metricdata.ExponentialHistogram[float64]:
+		for _, dp := range a.DataPoints {
+			add(dp.Attributes, dp.LastUpdateTime)
+		}
 	}
+	return out
 }
 
 type arg[N int64 | float64] struct {
@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 )
@@ -57,6 +58,24 @@ func (n *atomicCounter[N]) reset() {
 	n.nInt.Store(0)
 }
 
+// atomicTime is a time stored atomically with a nanosecond precision.
+type atomicTime struct {
+	unixNano atomic.Int64
+}
+
+// storeNow stores the current time.
+func (t *atomicTime) storeNow() {
+	t.unixNano.Store(now().UnixNano())
+}
+
+// load returns the stored time, or the zero time if none was stored.
+func (t *atomicTime) load() time.Time {
+	if ns := t.unixNano.Load(); ns != 0 {
+		return time.Unix(0, ns)
+	}
+	return time.Time{}
+}
+
 // atomicN is a generic atomic number value.
 type atomicN[N int64 | float64] struct {
 	val atomic.Uint64
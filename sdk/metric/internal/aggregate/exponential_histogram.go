@@ -43,6 +43,7 @@ type expoHistogramDataPoint[N int64 | float64] struct {
 	negBuckets expoBuckets
 	zeroCount  atomic.Uint64
 	startTime  time.Time
+	lastUpdate atomicTime
 }
 
 func newExpoHistogramDataPoint[N int64 | float64](
@@ -294,15 +295,16 @@ func (b *expoBuckets) count() uint64 {
 // and the aggregation cycle the measurements were made in.
 func newExponentialHistogram[N int64 | float64](
 	maxSize, maxScale int32,
-	noMinMax, noSum bool,
+	noMinMax, noSum, lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *expoHistogram[N] {
 	return &expoHistogram[N]{
-		noSum:    noSum,
-		noMinMax: noMinMax,
-		maxSize:  int(maxSize),
-		maxScale: maxScale,
+		noSum:      noSum,
+		noMinMax:   noMinMax,
+		lastUpdate: lastUpdate,
+		maxSize:    int(maxSize),
+		maxScale:   maxScale,
 
 		newRes: r,
 		limit:  newLimiter[expoHistogramDataPoint[N]](limit),
@@ -315,10 +317,11 @@ func newExponentialHistogram[N int64 | float64](
 // expoHistogram summarizes a set of measurements as an histogram with exponentially
 // defined buckets.
 type expoHistogram[N int64 | float64] struct {
-	noSum    bool
-	noMinMax bool
-	maxSize  int
-	maxScale int32
+	noSum      bool
+	noMinMax   bool
+	lastUpdate bool
+	maxSize    int
+	maxScale   int32
 
 	newRes   func(attribute.Set) FilteredExemplarReservoir[N]
 	limit    limiter[expoHistogramDataPoint[N]]
@@ -359,6 +362,9 @@ func (e *expoHistogram[N]) measure(
 		}
 	}
 	v.record(value)
+	if e.lastUpdate {
+		v.lastUpdate.storeNow()
+	}
 	if !v.dropExemplars {
 		v.res.Offer(ctx, value, droppedAttr)
 	}
@@ -385,6 +391,7 @@ func (e *expoHistogram[N]) delta(
 		hDPts[i].Attributes = val.attrs
 		hDPts[i].StartTime = e.start
 		hDPts[i].Time = t
+		hDPts[i].LastUpdateTime = val.lastUpdate.load()
 		hDPts[i].Count = val.count()
 		hDPts[i].Scale = val.scale.Load()
 		hDPts[i].ZeroCount = val.zeroCount.Load()
@@ -464,6 +471,7 @@ func (e *expoHistogram[N]) cumulative(
 		}
 		hDPts[i].StartTime = startTime
 		hDPts[i].Time = t
+		hDPts[i].LastUpdateTime = val.lastUpdate.load()
 		hDPts[i].Count = val.count()
 		hDPts[i].Scale = val.scale.Load()
 		hDPts[i].ZeroCount = val.zeroCount.Load()
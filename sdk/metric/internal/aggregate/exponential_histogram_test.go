@@ -155,7 +155,7 @@ func testExpoHistogramMinMaxSumInt64(t *testing.T) {
 			restore := withHandler(t)
 			defer restore()
 
-			h := newExponentialHistogram[int64](4, 20, false, false, 0, dropExemplars[int64])
+			h := newExponentialHistogram[int64](4, 20, false, false, false, 0, dropExemplars[int64])
 			for _, v := range tt.values {
 				h.measure(t.Context(), v, alice, nil)
 			}
@@ -197,7 +197,7 @@ func testExpoHistogramMinMaxSumFloat64(t *testing.T) {
 			restore := withHandler(t)
 			defer restore()
 
-			h := newExponentialHistogram[float64](4, 20, false, false, 0, dropExemplars[float64])
+			h := newExponentialHistogram[float64](4, 20, false, false, false, 0, dropExemplars[float64])
 			for _, v := range tt.values {
 				h.measure(t.Context(), v, alice, nil)
 			}
@@ -1279,7 +1279,7 @@ func TestExpoHistogramRecordUnderflow(t *testing.T) {
 }
 
 func TestDeltaExpoHistogramMeasureNaNAndInf(t *testing.T) {
-	h := newExponentialHistogram[float64](4, 20, false, false, 0, dropExemplars[float64])
+	h := newExponentialHistogram[float64](4, 20, false, false, false, 0, dropExemplars[float64])
 	ctx := t.Context()
 
 	h.measure(ctx, math.NaN(), attribute.NewSet(), nil)
@@ -1392,7 +1392,7 @@ func TestExponentialHistogramMinMaxUnset(t *testing.T) {
 	alice := attribute.NewSet(attribute.String("user", "alice"))
 
 	// Test Delta
-	hDelta := newExponentialHistogram[int64](4, 20, false, false, 0, dropExemplars[int64])
+	hDelta := newExponentialHistogram[int64](4, 20, false, false, false, 0, dropExemplars[int64])
 	dpDelta := newExpoHistogramDataPoint[int64](alice, 4, 20, false, false)
 	dpDelta.res = dropExemplars[int64](alice)
 	// dpDelta.minMax.set is false by default
@@ -1410,7 +1410,7 @@ func TestExponentialHistogramMinMaxUnset(t *testing.T) {
 	assert.False(t, defined, "Max should be invalid when not set")
 
 	// Test Cumulative
-	hCumul := newExponentialHistogram[int64](4, 20, false, false, 0, dropExemplars[int64])
+	hCumul := newExponentialHistogram[int64](4, 20, false, false, false, 0, dropExemplars[int64])
 	dpCumul := newExpoHistogramDataPoint[int64](alice, 4, 20, false, false)
 	dpCumul.res = dropExemplars[int64](alice)
 	// dpCumul.minMax.set is false by default
@@ -19,6 +19,7 @@ import (
 type histogramPoint[N int64 | float64] struct {
 	attrs         attribute.Set
 	res           FilteredExemplarReservoir[N]
+	lastUpdate    atomicTime
 	dropExemplars bool
 	histogramPointCounters[N]
 }
@@ -32,6 +33,7 @@ type hotColdHistogramPoint[N int64 | float64] struct {
 	attrs         attribute.Set
 	res           FilteredExemplarReservoir[N]
 	startTime     time.Time
+	lastUpdate    atomicTime
 	dropExemplars bool
 }
 
@@ -97,11 +99,12 @@ type deltaHistogram[N int64 | float64] struct {
 	hcwg          hotColdWaitGroup
 	hotColdValMap [2]limitedSyncMap[*histogramPoint[N]]
 
-	start    time.Time
-	noMinMax bool
-	noSum    bool
-	bounds   []float64
-	newRes   func(attribute.Set) FilteredExemplarReservoir[N]
+	start      time.Time
+	noMinMax   bool
+	noSum      bool
+	lastUpdate bool
+	bounds     []float64
+	newRes     func(attribute.Set) FilteredExemplarReservoir[N]
 }
 
 func (s *deltaHistogram[N]) measure(
@@ -144,6 +147,9 @@ func (s *deltaHistogram[N]) measure(
 	if !s.noSum {
 		h.total.add(value)
 	}
+	if s.lastUpdate {
+		h.lastUpdate.storeNow()
+	}
 	if !h.dropExemplars {
 		h.res.Offer(ctx, value, droppedAttr)
 	}
@@ -153,7 +159,7 @@ func (s *deltaHistogram[N]) measure(
 // collected.
 func newDeltaHistogram[N int64 | float64](
 	boundaries []float64,
-	noMinMax, noSum bool,
+	noMinMax, noSum, lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *deltaHistogram[N] {
@@ -164,11 +170,12 @@ func newDeltaHistogram[N int64 | float64](
 	b := slices.Clone(boundaries)
 	slices.Sort(b)
 	return &deltaHistogram[N]{
-		start:    now(),
-		noMinMax: noMinMax,
-		noSum:    noSum,
-		bounds:   b,
-		newRes:   r,
+		start:      now(),
+		noMinMax:   noMinMax,
+		noSum:      noSum,
+		lastUpdate: lastUpdate,
+		bounds:     b,
+		newRes:     r,
 		hotColdValMap: [2]limitedSyncMap[*histogramPoint[N]]{
 			{aggLimit: limit},
 			{aggLimit: limit},
@@ -205,6 +212,7 @@ func (s *deltaHistogram[N]) collect(
 		hDPts[i].Attributes = val.attrs
 		hDPts[i].StartTime = s.start
 		hDPts[i].Time = t
+		hDPts[i].LastUpdateTime = val.lastUpdate.load()
 		hDPts[i].Count = count
 		hDPts[i].Bounds = bounds
 
@@ -252,18 +260,19 @@ func (s *deltaHistogram[N]) collect(
 type cumulativeHistogram[N int64 | float64] struct {
 	values limitedSyncMap[*hotColdHistogramPoint[N]]
 
-	start    time.Time
-	noMinMax bool
-	noSum    bool
-	bounds   []float64
-	newRes   func(attribute.Set) FilteredExemplarReservoir[N]
+	start      time.Time
+	noMinMax   bool
+	noSum      bool
+	lastUpdate bool
+	bounds     []float64
+	newRes     func(attribute.Set) FilteredExemplarReservoir[N]
 }
 
 // newCumulativeHistogram returns a histogram that accumulates measurements
 // into a histogram data structure. It is never reset.
 func newCumulativeHistogram[N int64 | float64](
 	boundaries []float64,
-	noMinMax, noSum bool,
+	noMinMax, noSum, lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *cumulativeHistogram[N] {
@@ -274,12 +283,13 @@ func newCumulativeHistogram[N int64 | float64](
 	b := slices.Clone(boundaries)
 	slices.Sort(b)
 	return &cumulativeHistogram[N]{
-		start:    now(),
-		noMinMax: noMinMax,
-		noSum:    noSum,
-		bounds:   b,
-		newRes:   r,
-		values:   limitedSyncMap[*hotColdHistogramPoint[N]]{aggLimit: limit},
+		start:      now(),
+		noMinMax:   noMinMax,
+		noSum:      noSum,
+		lastUpdate: lastUpdate,
+		bounds:     b,
+		newRes:     r,
+		values:     limitedSyncMap[*hotColdHistogramPoint[N]]{aggLimit: limit},
 	}
 }
 
@@ -333,6 +343,9 @@ func (s *cumulativeHistogram[N]) measure(
 	if !s.noSum {
 		h.hotColdPoint[hotIdx].total.add(value)
 	}
+	if s.lastUpdate {
+		h.lastUpdate.storeNow()
+	}
 	if !h.dropExemplars {
 		h.res.Offer(ctx, value, droppedAttr)
 	}
@@ -386,6 +399,7 @@ func (s *cumulativeHistogram[N]) collect(
 		dp.Attributes = val.attrs
 		dp.StartTime = startTime
 		dp.Time = t
+		dp.LastUpdateTime = val.lastUpdate.load()
 		dp.Count = count
 		dp.Bounds = bounds
 		if !s.noSum {
@@ -18,6 +18,7 @@ type lastValuePoint[N int64 | float64] struct {
 	value         atomicN[N]
 	res           FilteredExemplarReservoir[N]
 	startTime     time.Time
+	lastUpdate    atomicTime
 	dropExemplars bool
 }
 
@@ -25,6 +26,9 @@ type lastValuePoint[N int64 | float64] struct {
 type lastValueMap[N int64 | float64] struct {
 	newRes func(attribute.Set) FilteredExemplarReservoir[N]
 	values limitedSyncMap[*lastValuePoint[N]]
+	// recordLastUpdate is whether the time of the last measurement of each
+	// value is recorded.
+	recordLastUpdate bool
 }
 
 func (s *lastValueMap[N]) measure(
@@ -47,12 +51,16 @@ func (s *lastValueMap[N]) measure(
 	})
 
 	lv.value.Store(value)
+	if s.recordLastUpdate {
+		lv.lastUpdate.storeNow()
+	}
 	if !lv.dropExemplars {
 		lv.res.Offer(ctx, value, droppedAttr)
 	}
 }
 
 func newDeltaLastValue[N int64 | float64](
+	lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *deltaLastValue[N] {
@@ -61,12 +69,14 @@ func newDeltaLastValue[N int64 | float64](
 		start:  now(),
 		hotColdValMap: [2]lastValueMap[N]{
 			{
-				newRes: r,
-				values: limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+				newRes:           r,
+				values:           limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+				recordLastUpdate: lastUpdate,
 			},
 			{
-				newRes: r,
-				values: limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+				newRes:           r,
+				values:           limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+				recordLastUpdate: lastUpdate,
 			},
 		},
 	}
@@ -124,6 +134,7 @@ func (s *deltaLastValue[N]) copyAndClearDpts(
 		dPts[i].Attributes = v.attrs
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
+		dPts[i].LastUpdateTime = v.lastUpdate.load()
 		dPts[i].Value = v.value.Load()
 		collectExemplars[N](&dPts[i].Exemplars, v.res.Collect)
 		i++
@@ -143,13 +154,15 @@ type cumulativeLastValue[N int64 | float64] struct {
 }
 
 func newCumulativeLastValue[N int64 | float64](
+	lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *cumulativeLastValue[N] {
 	return &cumulativeLastValue[N]{
 		lastValueMap: lastValueMap[N]{
-			newRes: r,
-			values: limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+			newRes:           r,
+			values:           limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+			recordLastUpdate: lastUpdate,
 		},
 		start: now(),
 	}
@@ -178,10 +191,11 @@ func (s *cumulativeLastValue[N]) collect(
 			startTime = v.startTime
 		}
 		newPt := metricdata.DataPoint[N]{
-			Attributes: v.attrs,
-			StartTime:  startTime,
-			Time:       t,
-			Value:      v.value.Load(),
+			Attributes:     v.attrs,
+			StartTime:      startTime,
+			Time:           t,
+			LastUpdateTime: v.lastUpdate.load(),
+			Value:          v.value.Load(),
 		}
 		collectExemplars[N](&newPt.Exemplars, v.res.Collect)
 		dPts = append(dPts, newPt)
@@ -201,10 +215,11 @@ func (s *cumulativeLastValue[N]) collect(
 // newPrecomputedLastValue returns an aggregator that summarizes a set of
 // observations as the last one made.
 func newPrecomputedLastValue[N int64 | float64](
+	lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *precomputedLastValue[N] {
-	return &precomputedLastValue[N]{deltaLastValue: newDeltaLastValue[N](limit, r)}
+	return &precomputedLastValue[N]{deltaLastValue: newDeltaLastValue[N](lastUpdate, limit, r)}
 }
 
 // precomputedLastValue summarizes a set of observations as the last one made.
@@ -17,12 +17,16 @@ type sumValue[N int64 | float64] struct {
 	res           FilteredExemplarReservoir[N]
 	attrs         attribute.Set
 	startTime     time.Time
+	lastUpdate    atomicTime
 	dropExemplars bool
 }
 
 type sumValueMap[N int64 | float64] struct {
 	newRes func(attribute.Set) FilteredExemplarReservoir[N]
 	values limitedSyncMap[*sumValue[N]]
+	// recordLastUpdate is whether the time of the last measurement of each
+	// value is recorded.
+	recordLastUpdate bool
 }
 
 func (s *sumValueMap[N]) measure(
@@ -31,7 +35,20 @@ func (s *sumValueMap[N]) measure(
 	fltrAttr attribute.Set,
 	droppedAttr []attribute.KeyValue,
 ) {
-	sv := s.values.LoadOrStoreAttr(fltrAttr, func(attr attribute.Set) *sumValue[N] {
+	sv := s.load(fltrAttr)
+	sv.n.add(value)
+	if s.recordLastUpdate {
+		sv.lastUpdate.storeNow()
+	}
+	// It is possible for collection to race with measurement and observe the
+	// exemplar in the batch of metrics after the add() for cumulative sums.
+	// This is an accepted tradeoff to avoid locking during measurement.
+	s.offer(ctx, sv, value, droppedAttr)
+}
+
+// load returns the sumValue for fltrAttr, creating it if it does not exist.
+func (s *sumValueMap[N]) load(fltrAttr attribute.Set) *sumValue[N] {
+	return s.values.LoadOrStoreAttr(fltrAttr, func(attr attribute.Set) *sumValue[N] {
 		r := s.newRes(attr)
 		_, isDrop := r.(*dropRes[N])
 		return &sumValue[N]{
@@ -41,10 +58,10 @@ func (s *sumValueMap[N]) measure(
 			dropExemplars: isDrop,
 		}
 	})
-	sv.n.add(value)
-	// It is possible for collection to race with measurement and observe the
-	// exemplar in the batch of metrics after the add() for cumulative sums.
-	// This is an accepted tradeoff to avoid locking during measurement.
+}
+
+// offer offers value to the exemplar reservoir of sv.
+func (*sumValueMap[N]) offer(ctx context.Context, sv *sumValue[N], value N, droppedAttr []attribute.KeyValue) {
 	if !sv.dropExemplars {
 		sv.res.Offer(ctx, value, droppedAttr)
 	}
@@ -54,7 +71,7 @@ func (s *sumValueMap[N]) measure(
 // their arithmetic sum. Each sum is scoped by attributes and the aggregation
 // cycle the measurements were made in.
 func newDeltaSum[N int64 | float64](
-	monotonic bool,
+	monotonic, lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *deltaSum[N] {
@@ -63,12 +80,14 @@ func newDeltaSum[N int64 | float64](
 		start:     now(),
 		hotColdValMap: [2]sumValueMap[N]{
 			{
-				newRes: r,
-				values: limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+				newRes:           r,
+				values:           limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+				recordLastUpdate: lastUpdate,
 			},
 			{
-				newRes: r,
-				values: limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+				newRes:           r,
+				values:           limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+				recordLastUpdate: lastUpdate,
 			},
 		},
 	}
@@ -114,6 +133,7 @@ func (s *deltaSum[N]) collect(
 		dPts[i].Attributes = val.attrs
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
+		dPts[i].LastUpdateTime = val.lastUpdate.load()
 		dPts[i].Value = val.n.load()
 		i++
 		return true
@@ -132,7 +152,7 @@ func (s *deltaSum[N]) collect(
 // as their arithmetic sum. Each sum is scoped by attributes and the
 // aggregation cycle the measurements were made in.
 func newCumulativeSum[N int64 | float64](
-	monotonic bool,
+	monotonic, lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *cumulativeSum[N] {
@@ -140,8 +160,9 @@ func newCumulativeSum[N int64 | float64](
 		monotonic: monotonic,
 		start:     now(),
 		sumValueMap: sumValueMap[N]{
-			newRes: r,
-			values: limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+			newRes:           r,
+			values:           limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+			recordLastUpdate: lastUpdate,
 		},
 	}
 }
@@ -180,10 +201,11 @@ func (s *cumulativeSum[N]) collect(
 			startTime = val.startTime
 		}
 		newPt := metricdata.DataPoint[N]{
-			Attributes: val.attrs,
-			StartTime:  startTime,
-			Time:       t,
-			Value:      val.n.load(),
+			Attributes:     val.attrs,
+			StartTime:      startTime,
+			Time:           t,
+			LastUpdateTime: val.lastUpdate.load(),
+			Value:          val.n.load(),
 		}
 		collectExemplars(&newPt.Exemplars, val.res.Collect)
 		dPts = append(dPts, newPt)
@@ -205,12 +227,12 @@ func (s *cumulativeSum[N]) collect(
 // observations as their arithmetic sum. Each sum is scoped by attributes and
 // the aggregation cycle the measurements were made in.
 func newPrecomputedSum[N int64 | float64](
-	monotonic bool,
+	monotonic, lastUpdate bool,
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *precomputedSum[N] {
 	return &precomputedSum[N]{
-		deltaSum: newDeltaSum(monotonic, limit, r),
+		deltaSum: newDeltaSum(monotonic, lastUpdate, limit, r),
 	}
 }
 
@@ -250,6 +272,7 @@ func (s *precomputedSum[N]) delta(
 		dPts[i].Attributes = val.attrs
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
+		dPts[i].LastUpdateTime = val.lastUpdate.load()
 		dPts[i].Value = delta
 		newReported[key] = n
 		i++
@@ -291,11 +314,200 @@ func (s *precomputedSum[N]) cumulative(
 		dPts[i].Attributes = val.attrs
 		dPts[i].StartTime = s.start
 		dPts[i].Time = t
+		dPts[i].LastUpdateTime = val.lastUpdate.load()
+		dPts[i].Value = val.n.load()
+		i++
+		return true
+	})
+	s.hotColdValMap[readIdx].values.Clear()
+
+	sData.DataPoints = dPts
+	*dest = sData
+
+	return i
+}
+
+// newFilteredPrecomputedSum returns an aggregator that summarizes a set of
+// monotonic observations as their arithmetic sum. Unlike precomputedSum, the
+// observations are tracked for their complete attribute set and then
+// spatially re-aggregated into the attribute set produced by fltr.
+func newFilteredPrecomputedSum[N int64 | float64](
+	fltr func(attribute.Set) (attribute.Set, []attribute.KeyValue),
+	lastUpdate bool,
+	limit int,
+	r func(attribute.Set) FilteredExemplarReservoir[N],
+) *filteredPrecomputedSum[N] {
+	return &filteredPrecomputedSum[N]{
+		deltaSum: newDeltaSum(true, lastUpdate, limit, r),
+		filter:   fltr,
+		limit:    limit,
+		reported: make(map[any]N),
+		totals:   make(map[any]N),
+	}
+}
+
+// filteredPrecomputedSum summarizes a set of monotonic observations, whose
+// attributes are filtered, as their arithmetic sum.
+//
+// The observations of a monotonic instrument are the cumulative values of
+// each complete attribute set. Summing them after their attributes are
+// filtered is incorrect if any of the complete attribute sets stop being
+// observed, or are reset, as the filtered sum would decrease. Instead, the
+// increase of each complete attribute set since the last collection is
+// computed and these increases are summed into their filtered attribute set.
+type filteredPrecomputedSum[N int64 | float64] struct {
+	*deltaSum[N]
+
+	filter func(attribute.Set) (attribute.Set, []attribute.KeyValue)
+	// raw holds the observations of each complete attribute set.
+	raw [2]limitedSyncMap[*rawSum[N]]
+
+	// limit is the aggregation limit bounding reported and totals.
+	limit int
+	// reported holds the last observation of each complete attribute set.
+	// It is kept across collection cycles so a set skipping a cycle is not
+	// counted again when it is observed anew.
+	reported map[any]N
+	// totals holds the cumulative sum of each filtered attribute set. It is
+	// kept across collection cycles so the sum of a set skipping a cycle does
+	// not decrease when it is observed anew.
+	totals map[any]N
+}
+
+// rawSum is the sum of observations for a complete attribute set.
+type rawSum[N int64 | float64] struct {
+	n atomicCounter[N]
+	// sv is the value of the filtered attribute set n is aggregated into.
+	sv *sumValue[N]
+}
+
+func (s *filteredPrecomputedSum[N]) measure(ctx context.Context, value N, attr attribute.Set) {
+	fltrAttr, dropped := s.filter(attr)
+
+	hotIdx := s.hcwg.start()
+	defer s.hcwg.done(hotIdx)
+
+	vm := &s.hotColdValMap[hotIdx]
+	sv := vm.load(fltrAttr)
+	rs := s.raw[hotIdx].LoadOrStoreAttr(attr, func(attribute.Set) *rawSum[N] {
+		return &rawSum[N]{sv: sv}
+	})
+	rs.n.add(value)
+	if vm.recordLastUpdate {
+		rs.sv.lastUpdate.storeNow()
+	}
+	vm.offer(ctx, rs.sv, value, dropped)
+}
+
+// reaggregate swaps the hot and cold values and sums the increase of each
+// observed complete attribute set into its filtered attribute set. The index
+// of the cold values is returned.
+func (s *filteredPrecomputedSum[N]) reaggregate() uint64 {
+	readIdx := s.hcwg.swapHotAndWait()
+
+	observed := make(map[any]struct{})
+	s.raw[readIdx].Range(func(key, value any) bool {
+		rs := value.(*rawSum[N])
+		n := rs.n.load()
+		inc := n - s.reported[key]
+		if inc < 0 {
+			// The observed value was reset.
+			inc = n
+		}
+		rs.sv.n.add(inc)
+		s.reported[key] = n
+		observed[key] = struct{}{}
+		return true
+	})
+	s.raw[readIdx].Clear()
+	forgetUnobserved(s.reported, observed, s.limit)
+
+	return readIdx
+}
+
+// forgetUnobserved removes the entries of m not in observed if m holds more
+// than limit entries. If limit is less than or equal to zero, m is not bounded.
+func forgetUnobserved[N int64 | float64](m map[any]N, observed map[any]struct{}, limit int) {
+	if limit <= 0 || len(m) <= limit {
+		return
+	}
+	for key := range m {
+		if _, ok := observed[key]; !ok {
+			delete(m, key)
+		}
+	}
+}
+
+func (s *filteredPrecomputedSum[N]) delta(
+	dest *metricdata.Aggregation, //nolint:gocritic // The pointer is needed for the ComputeAggregation interface
+) int {
+	t := now()
+
+	// If *dest is not a metricdata.Sum, memory reuse is missed. In that case,
+	// use the zero-value sData and hope for better alignment next cycle.
+	sData, _ := (*dest).(metricdata.Sum[N])
+	sData.Temporality = metricdata.DeltaTemporality
+	sData.IsMonotonic = s.monotonic
+
+	readIdx := s.reaggregate()
+	n := s.hotColdValMap[readIdx].values.Len()
+	dPts := reset(sData.DataPoints, n, n)
+
+	var i int
+	s.hotColdValMap[readIdx].values.Range(func(_, value any) bool {
+		val := value.(*sumValue[N])
+		collectExemplars(&dPts[i].Exemplars, val.res.Collect)
+		dPts[i].Attributes = val.attrs
+		dPts[i].StartTime = s.start
+		dPts[i].Time = t
+		dPts[i].LastUpdateTime = val.lastUpdate.load()
 		dPts[i].Value = val.n.load()
 		i++
 		return true
 	})
 	s.hotColdValMap[readIdx].values.Clear()
+	// The delta collection cycle resets.
+	s.start = t
+
+	sData.DataPoints = dPts
+	*dest = sData
+
+	return i
+}
+
+func (s *filteredPrecomputedSum[N]) cumulative(
+	dest *metricdata.Aggregation, //nolint:gocritic // The pointer is needed for the ComputeAggregation interface
+) int {
+	t := now()
+	observed := make(map[any]struct{})
+
+	// If *dest is not a metricdata.Sum, memory reuse is missed. In that case,
+	// use the zero-value sData and hope for better alignment next cycle.
+	sData, _ := (*dest).(metricdata.Sum[N])
+	sData.Temporality = metricdata.CumulativeTemporality
+	sData.IsMonotonic = s.monotonic
+
+	readIdx := s.reaggregate()
+	n := s.hotColdValMap[readIdx].values.Len()
+	dPts := reset(sData.DataPoints, n, n)
+
+	var i int
+	s.hotColdValMap[readIdx].values.Range(func(key, value any) bool {
+		val := value.(*sumValue[N])
+		total := s.totals[key] + val.n.load()
+		collectExemplars(&dPts[i].Exemplars, val.res.Collect)
+		dPts[i].Attributes = val.attrs
+		dPts[i].StartTime = s.start
+		dPts[i].Time = t
+		dPts[i].LastUpdateTime = val.lastUpdate.load()
+		dPts[i].Value = total
+		s.totals[key] = total
+		observed[key] = struct{}{}
+		i++
+		return true
+	})
+	s.hotColdValMap[readIdx].values.Clear()
+	forgetUnobserved(s.totals, observed, s.limit)
 
 	sData.DataPoints = dPts
 	*dest = sData
@@ -63,6 +63,173 @@ func TestSum(t *testing.T) {
 	c.Reset()
 
 	t.Run("Float64/CumulativePrecomputedSum", testCumulativePrecomputedSum[float64]())
+	c.Reset()
+
+	t.Run("Int64/DeltaFilteredPrecomputedSum", testDeltaFilteredPrecomputedSum[int64]())
+	c.Reset()
+
+	t.Run("Float64/DeltaFilteredPrecomputedSum", testDeltaFilteredPrecomputedSum[float64]())
+	c.Reset()
+
+	t.Run("Int64/CumulativeFilteredPrecomputedSum", testCumulativeFilteredPrecomputedSum[int64]())
+	c.Reset()
+
+	t.Run("Float64/CumulativeFilteredPrecomputedSum", testCumulativeFilteredPrecomputedSum[float64]())
+	c.Reset()
+
+	t.Run("Int64/DeltaFilteredPrecomputedSumSkip", testDeltaFilteredPrecomputedSumSkip[int64]())
+	c.Reset()
+
+	t.Run("Float64/DeltaFilteredPrecomputedSumSkip", testDeltaFilteredPrecomputedSumSkip[float64]())
+	c.Reset()
+
+	t.Run("Int64/CumulativeFilteredPrecomputedSumSkip", testCumulativeFilteredPrecomputedSumSkip[int64]())
+	c.Reset()
+
+	t.Run("Float64/CumulativeFilteredPrecomputedSumSkip", testCumulativeFilteredPrecomputedSumSkip[float64]())
+}
+
+// filteredPrecomputedSumInputs returns the inputs of each collection cycle
+// used to test monotonic precomputed sums with filtered attributes.
+func filteredPrecomputedSumInputs[N int64 | float64]() [][]arg[N] {
+	ctx := context.Background()
+	// aliceUser is filtered to the same attribute set as alice.
+	aliceUser := attribute.NewSet(userAlice, adminFalse)
+	return [][]arg[N]{
+		{{ctx, 10, alice}, {ctx, 5, aliceUser}, {ctx, 1, bob}},
+		// aliceUser is no longer observed.
+		{{ctx, 12, alice}, {ctx, 4, bob}},
+		// alice is reset and aliceUser is observed again.
+		{{ctx, 3, alice}, {ctx, 1, aliceUser}, {ctx, 4, bob}},
+		// Exceed the cardinality limit.
+		{{ctx, 3, alice}, {ctx, 1, carol}, {ctx, 1, dave}},
+	}
+}
+
+func testDeltaFilteredPrecomputedSum[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality:      metricdata.DeltaTemporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+	}.PrecomputedSum(true)
+	inputs := filteredPrecomputedSumInputs[N]()
+	sum := func(pts ...metricdata.DataPoint[N]) output {
+		return output{n: len(pts), agg: metricdata.Sum[N]{
+			IsMonotonic: true,
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  pts,
+		}}
+	}
+	pt := func(attr attribute.Set, start, t int64, v N) metricdata.DataPoint[N] {
+		return metricdata.DataPoint[N]{Attributes: attr, StartTime: y2kPlus(start), Time: y2kPlus(t), Value: v}
+	}
+	return test[N](in, out, []teststep[N]{
+		{input: []arg[N]{}, expect: sum()},
+		{input: inputs[0], expect: sum(pt(fltrAlice, 1, 4, 15), pt(fltrBob, 1, 4, 1))},
+		// The sum of aliceUser is not removed from the filtered sum.
+		{input: inputs[1], expect: sum(pt(fltrAlice, 4, 7, 2), pt(fltrBob, 4, 7, 3))},
+		{input: inputs[2], expect: sum(pt(fltrAlice, 7, 10, 4), pt(fltrBob, 7, 10, 0))},
+		{input: inputs[3], expect: sum(
+			pt(fltrAlice, 10, 14, 0),
+			pt(attribute.NewSet(userCarol), 10, 14, 1),
+			pt(overflowSet, 10, 14, 1),
+		)},
+	})
+}
+
+func testCumulativeFilteredPrecomputedSum[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality:      metricdata.CumulativeTemporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+	}.PrecomputedSum(true)
+	inputs := filteredPrecomputedSumInputs[N]()
+	sum := func(pts ...metricdata.DataPoint[N]) output {
+		return output{n: len(pts), agg: metricdata.Sum[N]{
+			IsMonotonic: true,
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  pts,
+		}}
+	}
+	pt := func(attr attribute.Set, t int64, v N) metricdata.DataPoint[N] {
+		return metricdata.DataPoint[N]{Attributes: attr, StartTime: y2kPlus(0), Time: y2kPlus(t), Value: v}
+	}
+	return test[N](in, out, []teststep[N]{
+		{input: []arg[N]{}, expect: sum()},
+		{input: inputs[0], expect: sum(pt(fltrAlice, 4, 15), pt(fltrBob, 4, 1))},
+		// The sum of aliceUser is not removed from the filtered sum.
+		{input: inputs[1], expect: sum(pt(fltrAlice, 7, 17), pt(fltrBob, 7, 4))},
+		{input: inputs[2], expect: sum(pt(fltrAlice, 10, 21), pt(fltrBob, 10, 4))},
+		{input: inputs[3], expect: sum(
+			pt(fltrAlice, 14, 21),
+			pt(attribute.NewSet(userCarol), 14, 1),
+			pt(overflowSet, 14, 1),
+		)},
+	})
+}
+
+// filteredPrecomputedSumSkipInputs returns the inputs of each collection
+// cycle used to test monotonic precomputed sums with filtered attributes
+// whose attribute sets skip collection cycles.
+func filteredPrecomputedSumSkipInputs[N int64 | float64]() [][]arg[N] {
+	ctx := context.Background()
+	// aliceUser is filtered to the same attribute set as alice.
+	aliceUser := attribute.NewSet(userAlice, adminFalse)
+	return [][]arg[N]{
+		{{ctx, 10, alice}, {ctx, 5, aliceUser}, {ctx, 1, bob}},
+		// alice and aliceUser are not observed.
+		{{ctx, 2, bob}},
+		// alice is observed again, aliceUser is still not observed.
+		{{ctx, 12, alice}, {ctx, 2, bob}},
+	}
+}
+
+func testDeltaFilteredPrecomputedSumSkip[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality: metricdata.DeltaTemporality,
+		Filter:      attrFltr,
+	}.PrecomputedSum(true)
+	inputs := filteredPrecomputedSumSkipInputs[N]()
+	sum := func(pts ...metricdata.DataPoint[N]) output {
+		return output{n: len(pts), agg: metricdata.Sum[N]{
+			IsMonotonic: true,
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  pts,
+		}}
+	}
+	pt := func(attr attribute.Set, start, t int64, v N) metricdata.DataPoint[N] {
+		return metricdata.DataPoint[N]{Attributes: attr, StartTime: y2kPlus(start), Time: y2kPlus(t), Value: v}
+	}
+	return test[N](in, out, []teststep[N]{
+		{input: inputs[0], expect: sum(pt(fltrAlice, 0, 3, 15), pt(fltrBob, 0, 3, 1))},
+		{input: inputs[1], expect: sum(pt(fltrBob, 3, 5, 1))},
+		// Only the increase of alice since it was last observed is counted.
+		{input: inputs[2], expect: sum(pt(fltrAlice, 5, 8, 2), pt(fltrBob, 5, 8, 0))},
+	})
+}
+
+func testCumulativeFilteredPrecomputedSumSkip[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality: metricdata.CumulativeTemporality,
+		Filter:      attrFltr,
+	}.PrecomputedSum(true)
+	inputs := filteredPrecomputedSumSkipInputs[N]()
+	sum := func(pts ...metricdata.DataPoint[N]) output {
+		return output{n: len(pts), agg: metricdata.Sum[N]{
+			IsMonotonic: true,
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints:  pts,
+		}}
+	}
+	pt := func(attr attribute.Set, t int64, v N) metricdata.DataPoint[N] {
+		return metricdata.DataPoint[N]{Attributes: attr, StartTime: y2kPlus(0), Time: y2kPlus(t), Value: v}
+	}
+	return test[N](in, out, []teststep[N]{
+		{input: inputs[0], expect: sum(pt(fltrAlice, 3, 15), pt(fltrBob, 3, 1))},
+		{input: inputs[1], expect: sum(pt(fltrBob, 5, 2))},
+		// The sum of alice does not decrease.
+		{input: inputs[2], expect: sum(pt(fltrAlice, 8, 17), pt(fltrBob, 8, 2))},
+	})
 }
 
 func testDeltaSum[N int64 | float64]() func(t *testing.T) {
@@ -585,6 +752,8 @@ func TestSumConcurrentSafe(t *testing.T) {
 	t.Run("Float64/DeltaPrecomputedSum", testDeltaPrecomputedSumConcurrentSafe[float64]())
 	t.Run("Int64/CumulativePrecomputedSum", testCumulativePrecomputedSumConcurrentSafe[int64]())
 	t.Run("Float64/CumulativePrecomputedSum", testCumulativePrecomputedSumConcurrentSafe[float64]())
+	t.Run("Int64/FilteredPrecomputedSum", testFilteredPrecomputedSumConcurrentSafe[int64]())
+	t.Run("Float64/FilteredPrecomputedSum", testFilteredPrecomputedSumConcurrentSafe[float64]())
 }
 
 //nolint:revive // isPrecomputed is used for configuring validation
@@ -657,6 +826,15 @@ func testCumulativePrecomputedSumConcurrentSafe[N int64 | float64]() func(t *tes
 	return testAggregationConcurrentSafe[N](in, out, validateSum[N](true))
 }
 
+func testFilteredPrecomputedSumConcurrentSafe[N int64 | float64]() func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality:      metricdata.DeltaTemporality,
+		Filter:           attrFltr,
+		AggregationLimit: 3,
+	}.PrecomputedSum(true)
+	return testAggregationConcurrentSafe[N](in, out, validateSum[N](true))
+}
+
 func BenchmarkSum(b *testing.B) {
 	// The monotonic argument is only used to annotate the Sum returned from
 	// the Aggregation method. It should not have an effect on operational
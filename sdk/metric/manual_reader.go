@@ -73,7 +73,13 @@ func nextManualReaderID() int64 {
 // to read metrics from the SDK on demand.
 func (mr *ManualReader) register(p sdkProducer) {
 	// Only register once. If producer is already set, do nothing.
-	if !mr.sdkProducer.CompareAndSwap(nil, produceHolder{produce: p.produce}) {
+	ph := produceHolder{produce: p.produce}
+	if sp, ok := p.(interface {
+		produceSelected(context.Context, *metricdata.ResourceMetrics, collectConfig) error
+	}); ok {
+		ph.produceSelected = sp.produceSelected
+	}
+	if !mr.sdkProducer.CompareAndSwap(nil, ph) {
 		msg := "did not register manual reader"
 		global.Error(errDuplicateRegister, msg)
 	}
@@ -125,6 +131,38 @@ func (mr *ManualReader) Shutdown(context.Context) error {
 //
 // This method is safe to call concurrently.
 func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return mr.collect(ctx, rm, collectConfig{})
+}
+
+// CollectScoped gathers the metric data of the streams selected by opts from
+// the SDK and other Producers and stores the result in rm. Unlike Collect,
+// the streams not selected are not computed, and the callbacks of the meters
+// whose scope is not selected are not run, which reduces the cost of
+// targeted collections, e.g. for debug endpoints.
+//
+// The streams not selected are not affected by the collection: their
+// measurements are reported by the next collection selecting them.
+//
+// If no option is passed, all the streams are collected, like Collect.
+//
+// CollectScoped will return an error if called after shutdown.
+// CollectScoped will return an error if rm is a nil ResourceMetrics.
+// CollectScoped will return an error if the context's Done channel is closed.
+//
+// This method is safe to call concurrently.
+func (mr *ManualReader) CollectScoped(
+	ctx context.Context,
+	rm *metricdata.ResourceMetrics,
+	opts ...CollectOption,
+) error {
+	var cfg collectConfig
+	for _, opt := range opts {
+		cfg = opt.applyCollect(cfg)
+	}
+	return mr.collect(ctx, rm, cfg)
+}
+
+func (mr *ManualReader) collect(ctx context.Context, rm *metricdata.ResourceMetrics, sel collectConfig) error {
 	var err error
 	if mr.inst != nil {
 		cp := mr.inst.CollectMetrics(ctx)
@@ -151,7 +189,11 @@ func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetr
 		return err
 	}
 
-	err = ph.produce(ctx, rm)
+	if ph.produceSelected != nil {
+		err = ph.produceSelected(ctx, rm, sel)
+	} else {
+		err = ph.produce(ctx, rm)
+	}
 	if err != nil {
 		return err
 	}
@@ -160,10 +202,10 @@ func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetr
 		if e != nil {
 			err = errors.Join(err, e)
 		}
-		rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
+		rm.ScopeMetrics = sel.appendScopeMetrics(rm.ScopeMetrics, externalMetrics)
 	}
 
-	global.Debug("ManualReader collection", "Data", rm)
+	global.Component(global.ComponentMetricReader).Debug("ManualReader collection", "Data", rm)
 
 	return err
 }
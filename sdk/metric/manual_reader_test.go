@@ -401,3 +401,99 @@ func BenchmarkManualReaderInstrumentation(b *testing.B) {
 		run(b, true)
 	})
 }
+
+func TestManualReaderCollectScoped(t *testing.T) {
+	for _, concurrency := range []int{0, 2} {
+		t.Run(fmt.Sprintf("Concurrency/%d", concurrency), func(t *testing.T) {
+			testManualReaderCollectScoped(t, concurrency)
+		})
+	}
+}
+
+func testManualReaderCollectScoped(t *testing.T, concurrency int) {
+	reader := NewManualReader()
+	mp := NewMeterProvider(WithReader(reader), WithCollectConcurrency(concurrency))
+
+	ctx := t.Context()
+	var callbacks [2]int
+	for i, name := range []string{"a", "b"} {
+		meter := mp.Meter(name)
+		counter, err := meter.Int64Counter("counter")
+		require.NoError(t, err)
+		counter.Add(ctx, 1)
+		other, err := meter.Int64Counter("other")
+		require.NoError(t, err)
+		other.Add(ctx, 1)
+		_, err = meter.Int64ObservableCounter("observable", metric.WithInt64Callback(
+			func(_ context.Context, o metric.Int64Observer) error {
+				callbacks[i]++
+				o.Observe(int64(callbacks[i]))
+				return nil
+			},
+		))
+		require.NoError(t, err)
+	}
+
+	names := func(rm metricdata.ResourceMetrics) map[string][]string {
+		out := make(map[string][]string)
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				out[sm.Scope.Name] = append(out[sm.Scope.Name], m.Name)
+			}
+		}
+		return out
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.CollectScoped(ctx, &rm, WithScope("a")))
+	assert.Equal(t, map[string][]string{"a": {"counter", "other", "observable"}}, names(rm))
+	assert.Equal(t, [2]int{1, 0}, callbacks, "callbacks of other scopes run")
+
+	require.NoError(t, reader.CollectScoped(ctx, &rm, WithScope("b"), WithInstrument("counter")))
+	assert.Equal(t, map[string][]string{"b": {"counter", "observable"}}, names(rm))
+	assert.Equal(t, [2]int{1, 1}, callbacks)
+
+	require.NoError(t, reader.Collect(ctx, &rm))
+	assert.Equal(t, map[string][]string{
+		"a": {"counter", "other", "observable"},
+		"b": {"counter", "other", "observable"},
+	}, names(rm))
+	assert.Equal(t, [2]int{2, 2}, callbacks)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok, "%s/%s", sm.Scope.Name, m.Name)
+			require.Len(t, sum.DataPoints, 1)
+			want := int64(1)
+			if m.Name == "observable" {
+				want = 2
+			}
+			assert.Equal(t, want, sum.DataPoints[0].Value, "%s/%s", sm.Scope.Name, m.Name)
+		}
+	}
+}
+
+func TestManualReaderCollectScopedExternal(t *testing.T) {
+	producer := testExternalProducer{produceFunc: func(context.Context) ([]metricdata.ScopeMetrics, error) {
+		return []metricdata.ScopeMetrics{
+			{Scope: instrumentation.Scope{Name: "a"}, Metrics: []metricdata.Metrics{{Name: "x"}, {Name: "y"}}},
+			{Scope: instrumentation.Scope{Name: "b"}, Metrics: []metricdata.Metrics{{Name: "x"}}},
+		}, nil
+	}}
+	reader := NewManualReader(WithProducer(producer))
+	_ = NewMeterProvider(WithReader(reader))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.CollectScoped(t.Context(), &rm, WithScope("a"), WithInstrument("y")))
+	assert.Equal(t, []metricdata.ScopeMetrics{
+		{Scope: instrumentation.Scope{Name: "a"}, Metrics: []metricdata.Metrics{{Name: "y"}}},
+	}, rm.ScopeMetrics)
+}
+
+func TestManualReaderCollectScopedShutdown(t *testing.T) {
+	reader := NewManualReader()
+	_ = NewMeterProvider(WithReader(reader))
+	require.NoError(t, reader.Shutdown(t.Context()))
+	var rm metricdata.ResourceMetrics
+	assert.ErrorIs(t, reader.CollectScoped(t.Context(), &rm, WithScope("a")), ErrReaderShutdown)
+}
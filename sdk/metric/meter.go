@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
@@ -37,9 +38,17 @@ type meter struct {
 
 	int64Resolver   resolver[int64]
 	float64Resolver resolver[float64]
+
+	unitValidation *unitValidator
+	nameSanitizer  *nameSanitizer
 }
 
-func newMeter(s instrumentation.Scope, p pipelines) *meter {
+func newMeter(
+	s instrumentation.Scope,
+	p pipelines,
+	unitValidation *unitValidator,
+	nameSanitizer *nameSanitizer,
+) *meter {
 	// viewCache ensures instrument conflicts, including number conflicts, this
 	// meter is asked to create are logged to the user.
 	var viewCache cache[string, instID]
@@ -58,6 +67,8 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 		float64ObservableInsts: &float64ObservableInsts,
 		int64Resolver:          newResolver[int64](p, &viewCache),
 		float64Resolver:        newResolver[float64](p, &viewCache),
+		unitValidation:         unitValidation,
+		nameSanitizer:          nameSanitizer,
 	}
 }
 
@@ -71,12 +82,14 @@ func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption)
 	cfg := metric.NewInt64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // Int64UpDownCounter returns a new instrument identified by name and
@@ -89,12 +102,14 @@ func (m *meter) Int64UpDownCounter(
 	cfg := metric.NewInt64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // Int64Histogram returns a new instrument identified by name and configured
@@ -103,12 +118,14 @@ func (m *meter) Int64UpDownCounter(
 func (m *meter) Int64Histogram(name string, options ...metric.Int64HistogramOption) (metric.Int64Histogram, error) {
 	cfg := metric.NewInt64HistogramConfig(options...)
 	p := int64InstProvider{m}
-	i, err := p.lookupHistogram(name, cfg, defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookupHistogram(name, unit, cfg, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // Int64Gauge returns a new instrument identified by name and configured
@@ -118,12 +135,14 @@ func (m *meter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (met
 	cfg := metric.NewInt64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // int64ObservableInstrument returns a new observable identified by the Instrument.
@@ -133,6 +152,9 @@ func (m *meter) int64ObservableInstrument(
 	allowedKeys []attribute.Key,
 	callbacks []metric.Int64Callback,
 ) (int64Observable, error) {
+	var nameErr, unitErr error
+	id.Name, nameErr = m.nameSanitizer.instrumentName(id.Name)
+	id.Unit, unitErr = m.unitValidation.instrumentUnit(id.Unit)
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -142,7 +164,7 @@ func (m *meter) int64ObservableInstrument(
 	if m.int64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
 		warnRepeatedObservableCallbacks(id)
 	}
-	return m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
+	inst, err := m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
 		inst := newInt64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
 		for _, insert := range m.int64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
@@ -165,11 +187,12 @@ func (m *meter) int64ObservableInstrument(
 			for _, cback := range callbacks {
 				inst := int64Observer{measures: in}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(m.scope, func(ctx context.Context) error { return fn(ctx, inst) })
 			}
 		}
-		return inst, validateInstrumentName(id.Name)
+		return inst, nameErr
 	})
+	return inst, withUnitErr(err, unitErr)
 }
 
 // Int64ObservableCounter returns a new instrument identified by name and
@@ -251,12 +274,14 @@ func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOpti
 	cfg := metric.NewFloat64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // Float64UpDownCounter returns a new instrument identified by name and
@@ -269,12 +294,14 @@ func (m *meter) Float64UpDownCounter(
 	cfg := metric.NewFloat64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // Float64Histogram returns a new instrument identified by name and configured
@@ -286,12 +313,14 @@ func (m *meter) Float64Histogram(
 ) (metric.Float64Histogram, error) {
 	cfg := metric.NewFloat64HistogramConfig(options...)
 	p := float64InstProvider{m}
-	i, err := p.lookupHistogram(name, cfg, defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookupHistogram(name, unit, cfg, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // Float64Gauge returns a new instrument identified by name and configured
@@ -301,12 +330,14 @@ func (m *meter) Float64Gauge(name string, options ...metric.Float64GaugeOption)
 	cfg := metric.NewFloat64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	name, nameErr := m.nameSanitizer.instrumentName(name)
+	unit, unitErr := m.unitValidation.instrumentUnit(cfg.Unit())
+	i, err := p.lookup(kind, name, cfg.Description(), unit, defaultAttributes(options))
 	if err != nil {
 		return i, err
 	}
 
-	return i, validateInstrumentName(name)
+	return i, withUnitErr(nameErr, unitErr)
 }
 
 // float64ObservableInstrument returns a new observable identified by the Instrument.
@@ -316,6 +347,9 @@ func (m *meter) float64ObservableInstrument(
 	allowedKeys []attribute.Key,
 	callbacks []metric.Float64Callback,
 ) (float64Observable, error) {
+	var nameErr, unitErr error
+	id.Name, nameErr = m.nameSanitizer.instrumentName(id.Name)
+	id.Unit, unitErr = m.unitValidation.instrumentUnit(id.Unit)
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -325,7 +359,7 @@ func (m *meter) float64ObservableInstrument(
 	if m.float64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
 		warnRepeatedObservableCallbacks(id)
 	}
-	return m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
+	inst, err := m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
 		inst := newFloat64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
 		for _, insert := range m.float64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
@@ -348,11 +382,12 @@ func (m *meter) float64ObservableInstrument(
 			for _, cback := range callbacks {
 				inst := float64Observer{measures: in}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(m.scope, func(ctx context.Context) error { return fn(ctx, inst) })
 			}
 		}
-		return inst, validateInstrumentName(id.Name)
+		return inst, nameErr
 	})
+	return inst, withUnitErr(err, unitErr)
 }
 
 // Float64ObservableCounter returns a new instrument identified by name and
@@ -481,6 +516,12 @@ func warnRepeatedObservableCallbacks(id Instrument) {
 // instruments, asynchronous callbacks can "forget" attribute sets that are no
 // longer relevant by omitting the observation during the callback.
 //
+// All observations f makes for insts are part of the same collection, and f
+// is not called concurrently for the same reader. This allows f to share an
+// expensive computation between many instruments and export a consistent
+// snapshot of them. Observations made after f returns, e.g. by goroutines f
+// started, are dropped and an error is logged.
+//
 // The returned Registration can be used to unregister f.
 func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable) (metric.Registration, error) {
 	if len(insts) == 0 {
@@ -534,8 +575,14 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 		}
 
 		// Some or all instruments were valid.
-		cBack := func(ctx context.Context) error { return f(ctx, reg) }
-		unregs[ix] = pipe.addMultiCallback(cBack)
+		cBack := func(ctx context.Context) error {
+			// Only accept observations while f runs so they are all part of
+			// this collection.
+			reg.active.Store(true)
+			defer reg.active.Store(false)
+			return f(ctx, reg)
+		}
+		unregs[ix] = pipe.addMultiCallback(m.scope, cBack)
 	}
 
 	return unregisterFuncs{f: unregs}, err
@@ -547,6 +594,8 @@ type observer struct {
 	pipe    *pipeline
 	float64 map[observableID[float64]]struct{}
 	int64   map[observableID[int64]]struct{}
+	// active is true while the callback of the observer is running.
+	active *atomic.Bool
 }
 
 func newObserver(p *pipeline) observer {
@@ -554,6 +603,7 @@ func newObserver(p *pipeline) observer {
 		pipe:    p,
 		float64: make(map[observableID[float64]]struct{}),
 		int64:   make(map[observableID[int64]]struct{}),
+		active:  new(atomic.Bool),
 	}
 }
 
@@ -566,8 +616,9 @@ func (r observer) registerInt64(id observableID[int64]) {
 }
 
 var (
-	errUnknownObserver = errors.New("unknown observable instrument")
-	errUnregObserver   = errors.New("observable instrument not registered for callback")
+	errUnknownObserver  = errors.New("unknown observable instrument")
+	errUnregObserver    = errors.New("observable instrument not registered for callback")
+	errInactiveObserver = errors.New("observation made after callback returned")
 )
 
 func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...metric.ObserveOption) {
@@ -580,6 +631,10 @@ func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...
 		return
 	}
 
+	if !r.active.Load() {
+		global.Error(errInactiveObserver, "failed to record", "name", oImpl.name)
+		return
+	}
 	if _, registered := r.float64[oImpl.observableID]; !registered {
 		if !oImpl.dropAggregation {
 			global.Error(
@@ -613,6 +668,10 @@ func (r observer) ObserveInt64(o metric.Int64Observable, v int64, opts ...metric
 		return
 	}
 
+	if !r.active.Load() {
+		global.Error(errInactiveObserver, "failed to record", "name", oImpl.name)
+		return
+	}
 	if _, registered := r.int64[oImpl.observableID]; !registered {
 		if !oImpl.dropAggregation {
 			global.Error(
@@ -661,7 +720,7 @@ func (p int64InstProvider) aggs(
 }
 
 func (p int64InstProvider) histogramAggs(
-	name string,
+	name, unit string,
 	cfg metric.Int64HistogramConfig,
 	allowedKeys []attribute.Key,
 ) ([]aggregate.Measure[int64], error) {
@@ -674,11 +733,11 @@ func (p int64InstProvider) histogramAggs(
 	inst := Instrument{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        unit,
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
-	measures, err := p.int64Resolver.HistogramAggregators(inst, allowedKeys, boundaries)
+	measures, err := p.int64Resolver.HistogramAggregators(inst, allowedKeys, boundaries, cfg.NoMinMax())
 	return measures, errors.Join(aggError, err)
 }
 
@@ -701,17 +760,17 @@ func (p int64InstProvider) lookup(
 
 // lookupHistogram returns the resolved instrumentImpl.
 func (p int64InstProvider) lookupHistogram(
-	name string,
+	name, unit string,
 	cfg metric.Int64HistogramConfig,
 	allowedKeys []attribute.Key,
 ) (*int64Inst, error) {
 	return p.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        unit,
 		Kind:        InstrumentKindHistogram,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg, allowedKeys)
+		aggs, err := p.histogramAggs(name, unit, cfg, allowedKeys)
 		return &int64Inst{measures: aggs}, err
 	})
 }
@@ -735,7 +794,7 @@ func (p float64InstProvider) aggs(
 }
 
 func (p float64InstProvider) histogramAggs(
-	name string,
+	name, unit string,
 	cfg metric.Float64HistogramConfig,
 	allowedKeys []attribute.Key,
 ) ([]aggregate.Measure[float64], error) {
@@ -748,11 +807,11 @@ func (p float64InstProvider) histogramAggs(
 	inst := Instrument{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        unit,
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
-	measures, err := p.float64Resolver.HistogramAggregators(inst, allowedKeys, boundaries)
+	measures, err := p.float64Resolver.HistogramAggregators(inst, allowedKeys, boundaries, cfg.NoMinMax())
 	return measures, errors.Join(aggError, err)
 }
 
@@ -775,17 +834,17 @@ func (p float64InstProvider) lookup(
 
 // lookupHistogram returns the resolved instrumentImpl.
 func (p float64InstProvider) lookupHistogram(
-	name string,
+	name, unit string,
 	cfg metric.Float64HistogramConfig,
 	allowedKeys []attribute.Key,
 ) (*float64Inst, error) {
 	return p.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        unit,
 		Kind:        InstrumentKindHistogram,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg, allowedKeys)
+		aggs, err := p.histogramAggs(name, unit, cfg, allowedKeys)
 		return &float64Inst{measures: aggs}, err
 	})
 }
@@ -28,6 +28,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // A meter should be able to make instruments concurrently.
@@ -1375,6 +1376,79 @@ func TestRegisterCallbackDropAggregations(t *testing.T) {
 	assert.Empty(t, data.ScopeMetrics, "metrics exported for drop instruments")
 }
 
+func TestRegisterCallbackBatch(t *testing.T) {
+	l := newLogSink(t)
+	otel.SetLogger(logr.New(l))
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r))
+	m := mp.Meter(t.Name())
+
+	threads, err := m.Int64ObservableGauge("threads")
+	require.NoError(t, err)
+	load, err := m.Float64ObservableGauge("load")
+	require.NoError(t, err)
+
+	var (
+		calls int
+		saved metric.Observer
+	)
+	shared := attribute.NewSet(attribute.String("pid", "1"))
+	_, err = m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		calls++ // Shared computation.
+		saved = o
+		o = metric.ObserverWithAttributeSet(o, shared)
+		o.ObserveInt64(threads, int64(calls))
+		o.ObserveFloat64(load, float64(calls), metric.WithAttributes(attribute.String("cpu", "0")))
+		return nil
+	}, threads, load)
+	require.NoError(t, err)
+
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: t.Name()},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "threads",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: shared, Value: 1}},
+				},
+			},
+			{
+				Name: "load",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{
+						Attributes: attribute.NewSet(attribute.String("pid", "1"), attribute.String("cpu", "0")),
+						Value:      1,
+					}},
+				},
+			},
+		},
+	}
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+	assert.Equal(t, 1, calls)
+
+	// Observations made after the callback returned are dropped.
+	saved.ObserveInt64(threads, 100)
+	require.Len(t, l.messages, 1)
+	assert.Contains(t, l.messages[0], errInactiveObserver.Error())
+
+	want.Metrics[0].Data = metricdata.Gauge[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{{Attributes: shared, Value: 2}},
+	}
+	want.Metrics[1].Data = metricdata.Gauge[float64]{
+		DataPoints: []metricdata.DataPoint[float64]{{
+			Attributes: attribute.NewSet(attribute.String("pid", "1"), attribute.String("cpu", "0")),
+			Value:      2,
+		}},
+	}
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
 func TestAttributeFilter(t *testing.T) {
 	t.Run("Delta", testAttributeFilter(metricdata.DeltaTemporality))
 	t.Run("Cumulative", testAttributeFilter(metricdata.CumulativeTemporality))
@@ -1901,7 +1975,9 @@ func TestObservableExample(t *testing.T) {
 		observations[thread2.Equivalent()] = observation{attrs: thread2, value: 53}
 		observations[thread3.Equivalent()] = observation{attrs: thread3, value: 5}
 
-		*wantFiltered = 58
+		// Thread 1 is no longer observed, but its page faults are not removed
+		// from the spatially re-aggregated sum (107 + 6 + 5).
+		*wantFiltered = 118
 		want.Metrics[1].Data = metricdata.Sum[int64]{
 			Temporality: temporality,
 			IsMonotonic: true,
@@ -1975,7 +2051,9 @@ func TestObservableExample(t *testing.T) {
 		observations[thread2.Equivalent()] = observation{attrs: thread2, value: 53}
 		observations[thread3.Equivalent()] = observation{attrs: thread3, value: 5}
 
-		*wantFiltered = -49
+		// Thread 1 is no longer observed, only the increase of the observed
+		// threads is spatially re-aggregated (6 + 5).
+		*wantFiltered = 11
 		want.Metrics[1].Data = metricdata.Sum[int64]{
 			Temporality: temporality,
 			IsMonotonic: true,
@@ -2253,6 +2331,187 @@ func TestHistogramBucketPrecedenceOrdering(t *testing.T) {
 	}
 }
 
+func TestHistogramNoMinMax(t *testing.T) {
+	expoSelector := func(InstrumentKind) Aggregation {
+		return AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20}
+	}
+	for _, tt := range []struct {
+		desc          string
+		reader        Reader
+		views         []View
+		histogramOpts []metric.Float64HistogramOption
+		wantMinMax    bool
+	}{
+		{
+			desc:       "default",
+			reader:     NewManualReader(),
+			wantMinMax: true,
+		},
+		{
+			desc:          "histogram option",
+			reader:        NewManualReader(),
+			histogramOpts: []metric.Float64HistogramOption{metric.WithNoMinMax()},
+		},
+		{
+			desc:          "histogram option with reader aggregation",
+			reader:        NewManualReader(WithAggregationSelector(expoSelector)),
+			histogramOpts: []metric.Float64HistogramOption{metric.WithNoMinMax()},
+		},
+		{
+			desc:          "histogram option overridden by view aggregation",
+			reader:        NewManualReader(),
+			histogramOpts: []metric.Float64HistogramOption{metric.WithNoMinMax()},
+			views: []View{NewView(Instrument{Name: "*"}, Stream{
+				Aggregation: AggregationExplicitBucketHistogram{Boundaries: []float64{0, 5}},
+			})},
+			wantMinMax: true,
+		},
+		{
+			desc:   "view",
+			reader: NewManualReader(),
+			views:  []View{NewView(Instrument{Name: "*"}, Stream{NoMinMax: true})},
+		},
+		{
+			desc:   "view with aggregation",
+			reader: NewManualReader(),
+			views: []View{NewView(Instrument{Name: "*"}, Stream{
+				Aggregation: AggregationExplicitBucketHistogram{Boundaries: []float64{0, 5}},
+				NoMinMax:    true,
+			})},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			meter := NewMeterProvider(
+				WithView(tt.views...),
+				WithReader(tt.reader),
+			).Meter("TestHistogramNoMinMax")
+			histogram, err := meter.Float64Histogram("histogram", tt.histogramOpts...)
+			require.NoError(t, err)
+			histogram.Record(t.Context(), 1)
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, tt.reader.Collect(t.Context(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+			var minMax [2]metricdata.Extrema[float64]
+			switch data := rm.ScopeMetrics[0].Metrics[0].Data.(type) {
+			case metricdata.Histogram[float64]:
+				require.Len(t, data.DataPoints, 1)
+				minMax = [2]metricdata.Extrema[float64]{data.DataPoints[0].Min, data.DataPoints[0].Max}
+			case metricdata.ExponentialHistogram[float64]:
+				require.Len(t, data.DataPoints, 1)
+				minMax = [2]metricdata.Extrema[float64]{data.DataPoints[0].Min, data.DataPoints[0].Max}
+			default:
+				t.Fatalf("unexpected data type %T", data)
+			}
+			_, minOK := minMax[0].Value()
+			_, maxOK := minMax[1].Value()
+			assert.Equal(t, tt.wantMinMax, minOK, "min")
+			assert.Equal(t, tt.wantMinMax, maxOK, "max")
+		})
+	}
+}
+
+func TestGaugeHistogram(t *testing.T) {
+	expoView := NewView(Instrument{Name: "*"}, Stream{
+		Aggregation:    AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20},
+		GaugeHistogram: true,
+	})
+	for _, tt := range []struct {
+		desc      string
+		views     []View
+		wantGauge bool
+	}{
+		{desc: "default"},
+		{
+			desc:      "view",
+			views:     []View{NewView(Instrument{Name: "*"}, Stream{GaugeHistogram: true})},
+			wantGauge: true,
+		},
+		{
+			desc:      "view with exponential aggregation",
+			views:     []View{expoView},
+			wantGauge: true,
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			reader := NewManualReader()
+			meter := NewMeterProvider(
+				WithView(tt.views...),
+				WithReader(reader),
+			).Meter("TestGaugeHistogram")
+			sizes := []int64{3, 5}
+			_, err := meter.Int64ObservableGauge(
+				"queue.size",
+				metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+					for i, size := range sizes {
+						o.Observe(size, metric.WithAttributes(attribute.Int("queue", i)))
+					}
+					return nil
+				}),
+				metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+					for _, size := range sizes {
+						o.Observe(size)
+					}
+					return nil
+				}),
+			)
+			require.NoError(t, err)
+			hist, err := meter.Int64Histogram("histogram")
+			require.NoError(t, err)
+
+			for range 2 {
+				hist.Record(t.Context(), 1)
+
+				var rm metricdata.ResourceMetrics
+				require.NoError(t, reader.Collect(t.Context(), &rm))
+				require.Len(t, rm.ScopeMetrics, 1)
+				require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+
+				for _, m := range rm.ScopeMetrics[0].Metrics {
+					var (
+						isGauge bool
+						temp    metricdata.Temporality
+						count   uint64
+					)
+					switch data := m.Data.(type) {
+					case metricdata.Gauge[int64]:
+						// GaugeHistogram is ignored for the default
+						// aggregation of gauges.
+						continue
+					case metricdata.Histogram[int64]:
+						isGauge, temp = data.IsGauge, data.Temporality
+						for _, dp := range data.DataPoints {
+							count += dp.Count
+						}
+					case metricdata.ExponentialHistogram[int64]:
+						isGauge, temp = data.IsGauge, data.Temporality
+						for _, dp := range data.DataPoints {
+							count += dp.Count
+						}
+					default:
+						t.Fatalf("unexpected data type %T", data)
+					}
+					assert.Equal(t, tt.wantGauge, isGauge, "IsGauge of %s", m.Name)
+					if tt.wantGauge {
+						// Only the measurements of the last collection are
+						// aggregated.
+						assert.Equal(t, metricdata.DeltaTemporality, temp)
+						if m.Name == "histogram" {
+							assert.Equal(t, uint64(1), count)
+						} else {
+							assert.Equal(t, uint64(4), count)
+						}
+					} else {
+						assert.Equal(t, metricdata.CumulativeTemporality, temp)
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestObservableDropAggregation(t *testing.T) {
 	const (
 		intPrefix         = "observable.int64."
@@ -2661,6 +2920,48 @@ func TestExemplarFilter(t *testing.T) {
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp())
 }
 
+func TestSpanExemplars(t *testing.T) {
+	rdr := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(rdr),
+		WithExemplarFilter(exemplar.AlwaysOffFilter),
+		WithSpanExemplars(InstrumentKindCounter),
+	)
+
+	m := mp.Meter("TestSpanExemplars")
+	ctr, err := m.Int64Counter("ctr")
+	require.NoError(t, err)
+	upDown, err := m.Int64UpDownCounter("updown")
+	require.NoError(t, err)
+
+	ctx := sample(t.Context())
+	ctr.Add(t.Context(), 1, metric.WithAttributes(attribute.String("span", "none")))
+	ctr.Add(ctx, 1)
+	upDown.Add(ctx, 1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+
+	sc := trace.SpanContextFromContext(ctx)
+	for _, got := range rm.ScopeMetrics[0].Metrics {
+		sum, ok := got.Data.(metricdata.Sum[int64])
+		require.True(t, ok, "unexpected data type %T", got.Data)
+		for _, dPt := range sum.DataPoints {
+			if got.Name == "updown" || dPt.Attributes.Len() > 0 {
+				assert.Empty(t, dPt.Exemplars, "exemplars of %s %v", got.Name, dPt.Attributes)
+				continue
+			}
+			require.Len(t, dPt.Exemplars, 1)
+			e := dPt.Exemplars[0]
+			assert.Equal(t, int64(1), e.Value)
+			assert.Equal(t, sc.TraceID(), trace.TraceID(e.TraceID))
+			assert.Equal(t, sc.SpanID(), trace.SpanID(e.SpanID))
+		}
+	}
+}
+
 func TestMeterDefaultAttributes(t *testing.T) {
 	k1 := attribute.Key("k1")
 	k2 := attribute.Key("k2")
@@ -80,6 +80,10 @@ type DataPoint[N int64 | float64] struct {
 	StartTime time.Time `json:",omitempty"`
 	// Time is the time when the timeseries was recorded. (optional)
 	Time time.Time `json:",omitempty"`
+	// LastUpdateTime is the time of the last measurement made for the
+	// timeseries. It is only set when the recording of this time is enabled.
+	// (optional)
+	LastUpdateTime time.Time `json:",omitzero"`
 	// Value is the value of this data point.
 	Value N
 
@@ -95,6 +99,11 @@ type Histogram[N int64 | float64] struct {
 	// Temporality describes if the aggregation is reported as the change from the
 	// last report time, or the cumulative changes since a fixed start time.
 	Temporality Temporality
+	// IsGauge indicates if the histogram describes the current distribution
+	// of a gauge, e.g. queue sizes, instead of accumulating measurements.
+	// Exporters supporting it, e.g. Prometheus, export it as a gauge
+	// histogram.
+	IsGauge bool `json:",omitempty"`
 }
 
 func (Histogram[N]) privateAggregation() {}
@@ -108,6 +117,10 @@ type HistogramDataPoint[N int64 | float64] struct {
 	StartTime time.Time
 	// Time is the time when the timeseries was recorded.
 	Time time.Time
+	// LastUpdateTime is the time of the last measurement made for the
+	// timeseries. It is only set when the recording of this time is enabled.
+	// (optional)
+	LastUpdateTime time.Time `json:",omitzero"`
 
 	// Count is the number of updates this histogram has been calculated with.
 	Count uint64
@@ -136,6 +149,11 @@ type ExponentialHistogram[N int64 | float64] struct {
 	// Temporality describes if the aggregation is reported as the change from the
 	// last report time, or the cumulative changes since a fixed start time.
 	Temporality Temporality
+	// IsGauge indicates if the histogram describes the current distribution
+	// of a gauge, e.g. queue sizes, instead of accumulating measurements.
+	// Exporters supporting it, e.g. Prometheus, export it as a gauge
+	// histogram.
+	IsGauge bool `json:",omitempty"`
 }
 
 func (ExponentialHistogram[N]) privateAggregation() {}
@@ -149,6 +167,10 @@ type ExponentialHistogramDataPoint[N int64 | float64] struct {
 	StartTime time.Time
 	// Time is the time when the timeseries was recorded.
 	Time time.Time
+	// LastUpdateTime is the time of the last measurement made for the
+	// timeseries. It is only set when the recording of this time is enabled.
+	// (optional)
+	LastUpdateTime time.Time `json:",omitzero"`
 
 	// Count is the number of updates this histogram has been calculated with.
 	Count uint64
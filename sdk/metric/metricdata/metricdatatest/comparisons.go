@@ -222,6 +222,9 @@ func equalHistograms[N int64 | float64](a, b metricdata.Histogram[N], cfg config
 	if a.Temporality != b.Temporality {
 		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
 	}
+	if a.IsGauge != b.IsGauge {
+		reasons = append(reasons, notEqualStr("IsGauge", a.IsGauge, b.IsGauge))
+	}
 
 	r := diffSlices(
 		a.DataPoints,
@@ -260,6 +263,13 @@ func equalDataPoints[N int64 | float64](
 		if !a.Time.Equal(b.Time) {
 			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
+		if !a.LastUpdateTime.Equal(b.LastUpdateTime) {
+			reasons = append(reasons, notEqualStr(
+				"LastUpdateTime",
+				a.LastUpdateTime.UnixNano(),
+				b.LastUpdateTime.UnixNano(),
+			))
+		}
 	}
 
 	if !cfg.ignoreValue {
@@ -306,6 +316,13 @@ func equalHistogramDataPoints[N int64 | float64](
 		if !a.Time.Equal(b.Time) {
 			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
+		if !a.LastUpdateTime.Equal(b.LastUpdateTime) {
+			reasons = append(reasons, notEqualStr(
+				"LastUpdateTime",
+				a.LastUpdateTime.UnixNano(),
+				b.LastUpdateTime.UnixNano(),
+			))
+		}
 	}
 	if !cfg.ignoreValue {
 		if a.Count != b.Count {
@@ -357,6 +374,9 @@ func equalExponentialHistograms[N int64 | float64](
 	if a.Temporality != b.Temporality {
 		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
 	}
+	if a.IsGauge != b.IsGauge {
+		reasons = append(reasons, notEqualStr("IsGauge", a.IsGauge, b.IsGauge))
+	}
 
 	r := diffSlices(
 		a.DataPoints,
@@ -394,6 +414,13 @@ func equalExponentialHistogramDataPoints[N int64 | float64](
 		if !a.Time.Equal(b.Time) {
 			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
 		}
+		if !a.LastUpdateTime.Equal(b.LastUpdateTime) {
+			reasons = append(reasons, notEqualStr(
+				"LastUpdateTime",
+				a.LastUpdateTime.UnixNano(),
+				b.LastUpdateTime.UnixNano(),
+			))
+		}
 	}
 	if !cfg.ignoreValue {
 		if a.Count != b.Count {
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+)
+
+// WithNameSanitizer sets the function used to sanitize invalid instrument
+// names of instruments created by the Meters of the MeterProvider.
+//
+// By default, creating an instrument with an invalid name returns an error
+// wrapping ErrInstrumentName along with the instrument. Instrumentation
+// commonly discards the instrument when an error is returned, losing its
+// measurements. With this option, an invalid name is replaced with the name
+// returned by sanitize, the replacement is reported once per name to the
+// global ErrorHandler, and no error is returned. If the sanitized name is also
+// invalid, the original name is used and the error is returned as if this
+// option was not used.
+//
+// The sanitize function is called when instruments are created, it needs to
+// be safe to call concurrently. If sanitize is nil, this option has no
+// effect.
+func WithNameSanitizer(sanitize func(string) string) Option {
+	return optionFunc(func(cfg config) config {
+		if sanitize != nil {
+			cfg.nameSanitizer = sanitize
+		}
+		return cfg
+	})
+}
+
+// nameSanitizer sanitizes the names of the instruments created by the Meters
+// of a MeterProvider.
+type nameSanitizer struct {
+	sanitize func(string) string
+	// reported holds the invalid names already reported to the global
+	// ErrorHandler, so each one is only reported once.
+	reported sync.Map
+}
+
+func newNameSanitizer(sanitize func(string) string) *nameSanitizer {
+	return &nameSanitizer{sanitize: sanitize}
+}
+
+// instrumentName returns the name an instrument created with name uses. The
+// returned error wraps ErrInstrumentName if name is invalid and cannot be
+// sanitized.
+func (ns *nameSanitizer) instrumentName(name string) (string, error) {
+	err := validateInstrumentName(name)
+	if err == nil || ns.sanitize == nil {
+		return name, err
+	}
+
+	sanitized := ns.sanitize(name)
+	if validateInstrumentName(sanitized) != nil {
+		return name, err
+	}
+	if _, loaded := ns.reported.LoadOrStore(name, struct{}{}); !loaded {
+		otel.Handle(fmt.Errorf("%w: sanitized to %s", err, sanitized))
+	}
+	return sanitized, nil
+}
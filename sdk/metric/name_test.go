@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestMeterNameSanitizer(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	sanitize := func(name string) string {
+		return strings.ReplaceAll(strings.TrimLeft(name, "_"), " ", "_")
+	}
+	reader := NewManualReader()
+	mp := NewMeterProvider(WithReader(reader), WithNameSanitizer(sanitize))
+	m := mp.Meter(t.Name())
+
+	ctr, err := m.Int64Counter("request count")
+	require.NoError(t, err)
+	ctr.Add(t.Context(), 1)
+	_, err = m.Float64ObservableGauge(
+		"_cpu usage",
+		metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+			o.Observe(1)
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, handled, 2)
+	assert.ErrorIs(t, handled[0], ErrInstrumentName)
+	assert.ErrorContains(t, handled[0], "sanitized to request_count")
+
+	// Names that cannot be sanitized are returned as an error.
+	handled = nil
+	_, err = m.Int64Histogram("1")
+	assert.ErrorIs(t, err, ErrInstrumentName)
+	assert.Empty(t, handled)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	var names []string
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	assert.ElementsMatch(t, []string{"request_count", "cpu_usage"}, names)
+}
+
+func TestMeterWithoutNameSanitizer(t *testing.T) {
+	mp := NewMeterProvider(WithNameSanitizer(nil))
+	_, err := mp.Meter(t.Name()).Int64Counter("request count")
+	assert.ErrorIs(t, err, ErrInstrumentName)
+}
+
+func TestMeterNameSanitizerReportedOnce(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	sanitize := func(name string) string { return strings.ReplaceAll(name, " ", "_") }
+	mp := NewMeterProvider(WithNameSanitizer(sanitize))
+	for _, scope := range []string{"a", "b"} {
+		m := mp.Meter(scope)
+		for range 3 {
+			_, err := m.Int64Counter("request count")
+			require.NoError(t, err)
+		}
+	}
+	assert.Len(t, handled, 1, "sanitized name reported more than once")
+
+	_, err := mp.Meter("a").Int64Counter("response count")
+	require.NoError(t, err)
+	assert.Len(t, handled, 2, "other sanitized name not reported")
+}
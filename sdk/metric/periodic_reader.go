@@ -13,6 +13,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/internal/health"
 	"go.opentelemetry.io/otel/sdk/metric/internal/observ"
 	"go.opentelemetry.io/otel/sdk/metric/internal/x"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -181,6 +182,8 @@ type PeriodicReader struct {
 	cardinalityLimitSelector CardinalityLimitSelector
 
 	inst *observ.Instrumentation
+
+	health health.Tracker
 }
 
 // Compile time check the periodicReader implements Reader and is comparable.
@@ -262,6 +265,7 @@ func (r *PeriodicReader) collectAndExport(ctx context.Context) error {
 			err = r.exporter.Export(ctx, rm)
 		}
 	}
+	r.health.Record(err)
 	return err
 }
 
@@ -318,7 +322,7 @@ func (r *PeriodicReader) collect(ctx context.Context, p any, rm *metricdata.Reso
 		rm.ScopeMetrics = append(rm.ScopeMetrics, externalMetrics...)
 	}
 
-	global.Debug("PeriodicReader collection", "Data", rm)
+	global.Component(global.ComponentMetricReader).Debug("PeriodicReader collection", "Data", rm)
 
 	return err
 }
@@ -427,6 +431,17 @@ func (r *PeriodicReader) Shutdown(ctx context.Context) error {
 	return err
 }
 
+// Health returns a snapshot of the health of the PeriodicReader.
+//
+// This method is safe to call concurrently.
+func (r *PeriodicReader) Health() otel.HealthStatus {
+	s := r.health.Status("PeriodicReader")
+	r.mu.Lock()
+	s.Shutdown = r.isShutdown
+	r.mu.Unlock()
+	return s
+}
+
 // MarshalLog returns logging data about the PeriodicReader.
 func (r *PeriodicReader) MarshalLog() any {
 	r.mu.Lock()
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1095,3 +1096,39 @@ func BenchmarkPeriodicReaderInstrumentation(b *testing.B) {
 		run(b, true)
 	})
 }
+
+func TestPeriodicReaderHealth(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	exp := &fnExporter{
+		exportFunc: func(context.Context, *metricdata.ResourceMetrics) error {
+			if fail.Load() {
+				return assert.AnError
+			}
+			return nil
+		},
+	}
+	r := NewPeriodicReader(exp, WithInterval(time.Hour))
+	mp := NewMeterProvider(WithReader(r), WithReader(NewManualReader()))
+
+	health := mp.Health()
+	require.Len(t, health, 1, "ManualReader does not report health")
+	assert.Equal(t, "PeriodicReader", health[0].Component)
+	assert.True(t, health[0].Healthy())
+
+	ctx := t.Context()
+	assert.ErrorIs(t, r.ForceFlush(ctx), assert.AnError)
+	health = mp.Health()
+	require.Len(t, health, 1)
+	assert.False(t, health[0].Healthy())
+	assert.Equal(t, 1, health[0].ConsecutiveFailures)
+	assert.ErrorIs(t, health[0].LastError, assert.AnError)
+
+	fail.Store(false)
+	require.NoError(t, r.ForceFlush(ctx))
+	assert.True(t, r.Health().Healthy())
+	assert.False(t, r.Health().LastSuccess.IsZero())
+
+	require.NoError(t, mp.Shutdown(ctx))
+	assert.True(t, r.Health().Shutdown)
+}
@@ -35,6 +35,8 @@ type instrumentSync struct {
 	description string
 	unit        string
 	compAgg     aggregate.ComputeAggregation
+	// async is whether the instrument is asynchronous.
+	async bool
 }
 
 func newPipeline(
@@ -42,19 +44,25 @@ func newPipeline(
 	reader Reader,
 	views []View,
 	exemplarFilter exemplar.Filter,
+	spanExemplars map[InstrumentKind]bool,
 	cardinalityLimit int,
+	collectConcurrency int,
+	lastUpdateTime bool,
 ) *pipeline {
 	if res == nil {
 		res = resource.Empty()
 	}
 	return &pipeline{
-		resource:         res,
-		reader:           reader,
-		views:            views,
-		int64Measures:    map[observableID[int64]][]aggregate.Measure[int64]{},
-		float64Measures:  map[observableID[float64]][]aggregate.Measure[float64]{},
-		exemplarFilter:   exemplarFilter,
-		cardinalityLimit: cardinalityLimit,
+		resource:           res,
+		reader:             reader,
+		views:              views,
+		int64Measures:      map[observableID[int64]][]aggregate.Measure[int64]{},
+		float64Measures:    map[observableID[float64]][]aggregate.Measure[float64]{},
+		exemplarFilter:     exemplarFilter,
+		spanExemplars:      spanExemplars,
+		cardinalityLimit:   cardinalityLimit,
+		collectConcurrency: collectConcurrency,
+		lastUpdateTime:     lastUpdateTime,
 		// aggregations is lazy allocated when needed.
 	}
 }
@@ -72,13 +80,23 @@ type pipeline struct {
 	views  []View
 
 	sync.Mutex
-	int64Measures    map[observableID[int64]][]aggregate.Measure[int64]
-	float64Measures  map[observableID[float64]][]aggregate.Measure[float64]
-	aggregations     map[instrumentation.Scope][]instrumentSync
-	callbacks        []func(context.Context) error
-	multiCallbacks   list.List
-	exemplarFilter   exemplar.Filter
+	int64Measures   map[observableID[int64]][]aggregate.Measure[int64]
+	float64Measures map[observableID[float64]][]aggregate.Measure[float64]
+	aggregations    map[instrumentation.Scope][]instrumentSync
+	callbacks       []callback
+	multiCallbacks  list.List
+	exemplarFilter  exemplar.Filter
+	// spanExemplars are the instrument kinds offering all the measurements
+	// made within sampled spans to their exemplar reservoirs.
+	spanExemplars    map[InstrumentKind]bool
 	cardinalityLimit int
+	// collectConcurrency is the maximum number of goroutines computing the
+	// aggregations during a collection. Aggregations are computed
+	// sequentially if it is less than 2.
+	collectConcurrency int
+	// lastUpdateTime is whether the time of the last measurement of each
+	// attribute set is recorded.
+	lastUpdateTime bool
 }
 
 // addInt64Measure adds a new int64 measure to the pipeline for each observer.
@@ -112,12 +130,18 @@ func (p *pipeline) addSync(scope instrumentation.Scope, iSync instrumentSync) {
 
 type multiCallback func(context.Context) error
 
-// addMultiCallback registers a multi-instrument callback to be run when
-// `produce()` is called.
-func (p *pipeline) addMultiCallback(c multiCallback) (unregister func()) {
+// callback is a callback registered by a meter with scope.
+type callback struct {
+	scope instrumentation.Scope
+	f     func(context.Context) error
+}
+
+// addMultiCallback registers a multi-instrument callback of the meter with
+// scope to be run when `produce()` is called.
+func (p *pipeline) addMultiCallback(scope instrumentation.Scope, c multiCallback) (unregister func()) {
 	p.Lock()
 	defer p.Unlock()
-	e := p.multiCallbacks.PushBack(c)
+	e := p.multiCallbacks.PushBack(callback{scope: scope, f: c})
 	return func() {
 		p.Lock()
 		p.multiCallbacks.Remove(e)
@@ -129,6 +153,15 @@ func (p *pipeline) addMultiCallback(c multiCallback) (unregister func()) {
 //
 // This method is safe to call concurrently.
 func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	return p.produceSelected(ctx, rm, collectConfig{})
+}
+
+// produceSelected returns the aggregated metrics of the streams selected by
+// sel from a single collection. Only the callbacks of the selected scopes are
+// run, and only the selected streams are computed.
+//
+// This method is safe to call concurrently.
+func (p *pipeline) produceSelected(ctx context.Context, rm *metricdata.ResourceMetrics, sel collectConfig) error {
 	// Only check if context is already cancelled before starting, not inside or after callback loops.
 	// If this method returns after executing some callbacks but before running all aggregations,
 	// internal aggregation state can be corrupted and result in incorrect data returned
@@ -142,15 +175,21 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 
 	var err error
 	for _, c := range p.callbacks {
+		if !sel.scope(c.scope) {
+			continue
+		}
 		// TODO make the callbacks parallel. ( #3034 )
-		if e := c(ctx); e != nil {
+		if e := c.f(ctx); e != nil {
 			err = errors.Join(err, e)
 		}
 	}
 	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+		c := e.Value.(callback)
+		if !sel.scope(c.scope) {
+			continue
+		}
 		// TODO make the callbacks parallel. ( #3034 )
-		f := e.Value.(multiCallback)
-		if e := f(ctx); e != nil {
+		if e := c.f(ctx); e != nil {
 			err = errors.Join(err, e)
 		}
 	}
@@ -158,17 +197,20 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	rm.Resource = p.resource
 	rm.ScopeMetrics = internal.ReuseSlice(rm.ScopeMetrics, len(p.aggregations))
 
+	if p.collectConcurrency > 1 {
+		p.collectConcurrent(rm, sel)
+		return err
+	}
+
 	i := 0
 	for scope, instruments := range p.aggregations {
+		if !sel.scope(scope) {
+			continue
+		}
 		rm.ScopeMetrics[i].Metrics = internal.ReuseSlice(rm.ScopeMetrics[i].Metrics, len(instruments))
 		j := 0
 		for _, inst := range instruments {
-			data := rm.ScopeMetrics[i].Metrics[j].Data
-			if n := inst.compAgg(&data); n > 0 {
-				rm.ScopeMetrics[i].Metrics[j].Name = inst.name
-				rm.ScopeMetrics[i].Metrics[j].Description = inst.description
-				rm.ScopeMetrics[i].Metrics[j].Unit = inst.unit
-				rm.ScopeMetrics[i].Metrics[j].Data = data
+			if sel.stream(inst) && collectMetric(&rm.ScopeMetrics[i].Metrics[j], inst) {
 				j++
 			}
 		}
@@ -184,6 +226,84 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	return err
 }
 
+// collectConcurrent computes the aggregations of p selected by sel into rm
+// using up to p.collectConcurrency goroutines. The ScopeMetrics of rm need to
+// have the length of p.aggregations and p needs to be locked.
+func (p *pipeline) collectConcurrent(rm *metricdata.ResourceMetrics, sel collectConfig) {
+	type job struct {
+		m         *metricdata.Metrics
+		inst      instrumentSync
+		collected *bool
+	}
+
+	var n int
+	for _, instruments := range p.aggregations {
+		n += len(instruments)
+	}
+	jobs := make(chan job)
+	var wg sync.WaitGroup
+	for range min(p.collectConcurrency, n) {
+		wg.Go(func() {
+			for j := range jobs {
+				*j.collected = collectMetric(j.m, j.inst)
+			}
+		})
+	}
+
+	// Each aggregation is computed into its own Metrics, so they can be
+	// computed concurrently.
+	collected := make([][]bool, len(rm.ScopeMetrics))
+	i := 0
+	for scope, instruments := range p.aggregations {
+		sm := &rm.ScopeMetrics[i]
+		sm.Scope = scope
+		sm.Metrics = internal.ReuseSlice(sm.Metrics, len(instruments))
+		collected[i] = make([]bool, len(instruments))
+		for j, inst := range instruments {
+			if sel.scope(scope) && sel.stream(inst) {
+				jobs <- job{m: &sm.Metrics[j], inst: inst, collected: &collected[i][j]}
+			}
+		}
+		i++
+	}
+	close(jobs)
+	wg.Wait()
+
+	// Remove the metrics without data and the scopes without metrics. They
+	// are swapped instead of overwritten so their memory can be reused.
+	i = 0
+	for k := range rm.ScopeMetrics {
+		sm := &rm.ScopeMetrics[k]
+		j := 0
+		for l := range sm.Metrics {
+			if collected[k][l] {
+				sm.Metrics[j], sm.Metrics[l] = sm.Metrics[l], sm.Metrics[j]
+				j++
+			}
+		}
+		sm.Metrics = sm.Metrics[:j]
+		if j > 0 {
+			rm.ScopeMetrics[i], rm.ScopeMetrics[k] = rm.ScopeMetrics[k], rm.ScopeMetrics[i]
+			i++
+		}
+	}
+	rm.ScopeMetrics = rm.ScopeMetrics[:i]
+}
+
+// collectMetric computes the aggregation of inst into m. It returns false,
+// leaving m unchanged, if the aggregation has no data.
+func collectMetric(m *metricdata.Metrics, inst instrumentSync) bool {
+	data := m.Data
+	if n := inst.compAgg(&data); n == 0 {
+		return false
+	}
+	m.Name = inst.name
+	m.Description = inst.description
+	m.Unit = inst.unit
+	m.Data = data
+	return true
+}
+
 // inserter facilitates inserting of new instruments from a single scope into a
 // pipeline.
 type inserter[N int64 | float64] struct {
@@ -304,12 +424,12 @@ func (i *inserter[N]) Instrument(
 	return measures, err
 }
 
-// addCallback registers a single instrument callback to be run when
-// `produce()` is called.
-func (i *inserter[N]) addCallback(cback func(context.Context) error) {
+// addCallback registers a single instrument callback of the meter with scope
+// to be run when `produce()` is called.
+func (i *inserter[N]) addCallback(scope instrumentation.Scope, cback func(context.Context) error) {
 	i.pipeline.Lock()
 	defer i.pipeline.Unlock()
-	i.pipeline.callbacks = append(i.pipeline.callbacks, cback)
+	i.pipeline.callbacks = append(i.pipeline.callbacks, callback{scope: scope, f: cback})
 }
 
 var aggIDCount atomic.Uint64
@@ -375,6 +495,9 @@ func (i *inserter[N]) cachedAggregator(
 		// The view explicitly requested the default aggregation.
 		stream.Aggregation = DefaultAggregationSelector(kind)
 	}
+	if stream.NoMinMax {
+		stream.Aggregation = withNoMinMax(stream.Aggregation)
+	}
 	if stream.ExemplarReservoirProviderSelector == nil {
 		stream.ExemplarReservoirProviderSelector = DefaultExemplarReservoirProviderSelector
 	}
@@ -401,13 +524,28 @@ func (i *inserter[N]) cachedAggregator(
 			ReservoirFunc: reservoirFunc[N](
 				kind,
 				stream.ExemplarReservoirProviderSelector(stream.Aggregation),
-				i.pipeline.exemplarFilter,
+				i.pipeline.exemplarFilterFor(kind),
 			),
 		}
 		b.Filter = stream.AttributeFilter
+		b.ValueFilter = stream.AttributeValueFilter
 		// A value less than or equal to zero will disable the aggregation
 		// limits for the builder (an all the created aggregates).
 		b.AggregationLimit = i.getCardinalityLimit(kind)
+		b.LastUpdateTime = i.pipeline.lastUpdateTime
+		_, explicit := stream.Aggregation.(AggregationExplicitBucketHistogram)
+		summary := stream.Summary != nil && explicit
+		if summary {
+			// Summaries are computed from the cumulative histogram.
+			b.Temporality = metricdata.CumulativeTemporality
+		}
+		// A summary does not describe a gauge, Summary takes precedence.
+		gauge := stream.GaugeHistogram && isHistogram(stream.Aggregation) && !summary
+		if gauge {
+			// A gauge histogram describes the measurements made since the
+			// last collection.
+			b.Temporality = metricdata.DeltaTemporality
+		}
 		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
 		if err != nil {
 			return aggVal[N]{0, nil, err}
@@ -415,6 +553,12 @@ func (i *inserter[N]) cachedAggregator(
 		if in == nil { // Drop aggregator.
 			return aggVal[N]{0, nil, nil}
 		}
+		if gauge {
+			out = gaugeHistogram(out)
+		}
+		if summary {
+			out = summarize[N](*stream.Summary, out)
+		}
 		i.pipeline.addSync(scope, instrumentSync{
 			// Use the first-seen name casing for this and all subsequent
 			// requests of this instrument.
@@ -422,6 +566,9 @@ func (i *inserter[N]) cachedAggregator(
 			description: stream.Description,
 			unit:        stream.Unit,
 			compAgg:     out,
+			async: kind == InstrumentKindObservableCounter ||
+				kind == InstrumentKindObservableUpDownCounter ||
+				kind == InstrumentKindObservableGauge,
 		})
 		id := aggIDCount.Add(1)
 		return aggVal[N]{id, in, err}
@@ -566,6 +713,38 @@ func (i *inserter[N]) aggregateFunc(
 	return meas, comp, err
 }
 
+// isHistogram returns whether agg is a histogram aggregation.
+func isHistogram(agg Aggregation) bool {
+	switch agg.(type) {
+	case AggregationExplicitBucketHistogram, AggregationBase2ExponentialHistogram:
+		return true
+	}
+	return false
+}
+
+// gaugeHistogram returns a ComputeAggregation marking the histograms computed
+// by comp as gauge histograms.
+func gaugeHistogram(comp aggregate.ComputeAggregation) aggregate.ComputeAggregation {
+	return func(dest *metricdata.Aggregation) int {
+		n := comp(dest)
+		switch h := (*dest).(type) {
+		case metricdata.Histogram[int64]:
+			h.IsGauge = true
+			*dest = h
+		case metricdata.Histogram[float64]:
+			h.IsGauge = true
+			*dest = h
+		case metricdata.ExponentialHistogram[int64]:
+			h.IsGauge = true
+			*dest = h
+		case metricdata.ExponentialHistogram[float64]:
+			h.IsGauge = true
+			*dest = h
+		}
+		return n
+	}
+}
+
 // isAggregatorCompatible checks if the aggregation can be used by the instrument.
 // Current compatibility:
 //
@@ -633,11 +812,23 @@ func newPipelines(
 	readers []Reader,
 	views []View,
 	exemplarFilter exemplar.Filter,
+	spanExemplars map[InstrumentKind]bool,
 	cardinalityLimit int,
+	collectConcurrency int,
+	lastUpdateTime bool,
 ) pipelines {
 	pipes := make([]*pipeline, 0, len(readers))
 	for _, r := range readers {
-		p := newPipeline(res, r, views, exemplarFilter, cardinalityLimit)
+		p := newPipeline(
+			res,
+			r,
+			views,
+			exemplarFilter,
+			spanExemplars,
+			cardinalityLimit,
+			collectConcurrency,
+			lastUpdateTime,
+		)
 		r.register(p)
 		pipes = append(pipes, p)
 	}
@@ -689,11 +880,13 @@ func (r resolver[N]) Aggregators(id Instrument, allowedKeys []attribute.Key) ([]
 
 // HistogramAggregators returns the histogram Aggregators that must be updated by the instrument
 // defined by key. If boundaries were provided on instrument instantiation, those take precedence
-// over boundaries provided by the reader.
+// over boundaries provided by the reader. If noMinMax is true, the min and max are not recorded
+// by the histogram aggregation provided by the reader.
 func (r resolver[N]) HistogramAggregators(
 	id Instrument,
 	allowedKeys []attribute.Key,
 	boundaries []float64,
+	noMinMax bool,
 ) ([]aggregate.Measure[N], error) {
 	var measures []aggregate.Measure[N]
 
@@ -704,6 +897,9 @@ func (r resolver[N]) HistogramAggregators(
 			histAgg.Boundaries = boundaries
 			agg = histAgg
 		}
+		if noMinMax {
+			agg = withNoMinMax(agg)
+		}
 		in, e := i.Instrument(id, allowedKeys, agg)
 		if e != nil {
 			err = errors.Join(err, e)
@@ -391,7 +391,7 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 	for _, tt := range testcases {
 		t.Run(tt.name, func(t *testing.T) {
 			var c cache[string, instID]
-			p := newPipeline(nil, tt.reader, tt.views, exemplar.AlwaysOffFilter, 0)
+			p := newPipeline(nil, tt.reader, tt.views, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 			i := newInserter[N](p, &c)
 			readerAggregation := i.readerDefaultAggregation(tt.inst.Kind)
 			input, err := i.Instrument(tt.inst, nil, readerAggregation)
@@ -413,7 +413,8 @@ func TestCreateAggregators(t *testing.T) {
 
 func testInvalidInstrumentShouldPanic[N int64 | float64]() {
 	var c cache[string, instID]
-	i := newInserter[N](newPipeline(nil, NewManualReader(), []View{defaultView}, exemplar.AlwaysOffFilter, 0), &c)
+	p := newPipeline(nil, NewManualReader(), []View{defaultView}, exemplar.AlwaysOffFilter, nil, 0, 0, false)
+	i := newInserter[N](p, &c)
 	inst := Instrument{
 		Name: "foo",
 		Kind: InstrumentKind(255),
@@ -429,7 +430,7 @@ func TestInvalidInstrumentShouldPanic(t *testing.T) {
 
 func TestPipelinesAggregatorForEachReader(t *testing.T) {
 	r0, r1 := NewManualReader(), NewManualReader()
-	pipes := newPipelines(resource.Empty(), []Reader{r0, r1}, nil, exemplar.AlwaysOffFilter, 0)
+	pipes := newPipelines(resource.Empty(), []Reader{r0, r1}, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 	require.Len(t, pipes, 2, "created pipelines")
 
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
@@ -503,7 +504,7 @@ func TestPipelineRegistryCreateAggregators(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			p := newPipelines(resource.Empty(), tt.readers, tt.views, exemplar.AlwaysOffFilter, 0)
+			p := newPipelines(resource.Empty(), tt.readers, tt.views, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 			testPipelineRegistryResolveIntAggregators(t, p, tt.wantCount)
 			testPipelineRegistryResolveFloatAggregators(t, p, tt.wantCount)
 			testPipelineRegistryResolveIntHistogramAggregators(t, p, tt.wantCount)
@@ -536,7 +537,7 @@ func testPipelineRegistryResolveIntHistogramAggregators(t *testing.T, p pipeline
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[int64](p, &c)
-	aggs, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	aggs, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3}, false)
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -546,7 +547,7 @@ func testPipelineRegistryResolveFloatHistogramAggregators(t *testing.T, p pipeli
 	inst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	var c cache[string, instID]
 	r := newResolver[float64](p, &c)
-	aggs, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	aggs, err := r.HistogramAggregators(inst, nil, []float64{1, 2, 3}, false)
 	assert.NoError(t, err)
 
 	require.Len(t, aggs, wantCount)
@@ -557,7 +558,7 @@ func TestPipelineRegistryResource(t *testing.T) {
 	readers := []Reader{NewManualReader()}
 	views := []View{defaultView, v}
 	res := resource.NewSchemaless(attribute.String("key", "val"))
-	pipes := newPipelines(res, readers, views, exemplar.AlwaysOffFilter, 0)
+	pipes := newPipelines(res, readers, views, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 	for _, p := range pipes {
 		assert.True(t, res.Equal(p.resource), "resource not set")
 	}
@@ -570,7 +571,7 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 
 	readers := []Reader{testRdrHistogram}
 	views := []View{defaultView}
-	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, 0)
+	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 	inst := Instrument{Name: "foo", Kind: InstrumentKindObservableGauge}
 
 	var vc cache[string, instID]
@@ -584,11 +585,11 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 	assert.Error(t, err)
 	assert.Empty(t, floatAggs)
 
-	intAggs, err = ri.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	intAggs, err = ri.HistogramAggregators(inst, nil, []float64{1, 2, 3}, false)
 	assert.Error(t, err)
 	assert.Empty(t, intAggs)
 
-	floatAggs, err = rf.HistogramAggregators(inst, nil, []float64{1, 2, 3})
+	floatAggs, err = rf.HistogramAggregators(inst, nil, []float64{1, 2, 3}, false)
 	assert.Error(t, err)
 	assert.Empty(t, floatAggs)
 }
@@ -630,7 +631,7 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 	fooInst := Instrument{Name: "foo", Kind: InstrumentKindCounter}
 	barInst := Instrument{Name: "bar", Kind: InstrumentKindCounter}
 
-	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, 0)
+	p := newPipelines(resource.Empty(), readers, views, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 
 	var vc cache[string, instID]
 	ri := newResolver[int64](p, &vc)
@@ -9,6 +9,8 @@ import (
 	"log"
 	"os"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -44,7 +46,7 @@ func testSumAggregateOutput(
 }
 
 func TestNewPipeline(t *testing.T) {
-	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 
 	output := metricdata.ResourceMetrics{}
 	err := pipe.produce(t.Context(), &output)
@@ -52,13 +54,13 @@ func TestNewPipeline(t *testing.T) {
 	assert.Equal(t, resource.Empty(), output.Resource)
 	assert.Empty(t, output.ScopeMetrics)
 
-	iSync := instrumentSync{"name", "desc", "1", testSumAggregateOutput}
+	iSync := instrumentSync{name: "name", description: "desc", unit: "1", compAgg: testSumAggregateOutput}
 	assert.NotPanics(t, func() {
 		pipe.addSync(instrumentation.Scope{}, iSync)
 	})
 
 	require.NotPanics(t, func() {
-		pipe.addMultiCallback(func(context.Context) error { return nil })
+		pipe.addMultiCallback(instrumentation.Scope{}, func(context.Context) error { return nil })
 	})
 
 	err = pipe.produce(t.Context(), &output)
@@ -70,7 +72,7 @@ func TestNewPipeline(t *testing.T) {
 
 func TestPipelineUsesResource(t *testing.T) {
 	res := resource.NewWithAttributes("noSchema", attribute.String("test", "resource"))
-	pipe := newPipeline(res, nil, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(res, nil, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 
 	output := metricdata.ResourceMetrics{}
 	err := pipe.produce(t.Context(), &output)
@@ -79,7 +81,7 @@ func TestPipelineUsesResource(t *testing.T) {
 }
 
 func TestPipelineConcurrentSafe(t *testing.T) {
-	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 	ctx := t.Context()
 	var output metricdata.ResourceMetrics
 
@@ -94,12 +96,12 @@ func TestPipelineConcurrentSafe(t *testing.T) {
 		go func(n int) {
 			defer wg.Done()
 			name := fmt.Sprintf("name %d", n)
-			sync := instrumentSync{name, "desc", "1", testSumAggregateOutput}
+			sync := instrumentSync{name: name, description: "desc", unit: "1", compAgg: testSumAggregateOutput}
 			pipe.addSync(instrumentation.Scope{}, sync)
 		}(i)
 
 		wg.Go(func() {
-			pipe.addMultiCallback(func(context.Context) error { return nil })
+			pipe.addMultiCallback(instrumentation.Scope{}, func(context.Context) error { return nil })
 		})
 
 		wg.Go(func() {
@@ -118,6 +120,55 @@ func TestPipelineConcurrentSafe(t *testing.T) {
 	wg.Wait()
 }
 
+func TestPipelineCollectConcurrency(t *testing.T) {
+	noData := func(*metricdata.Aggregation) int { return 0 }
+	newPipe := func(concurrency int) *pipeline {
+		pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, nil, 0, concurrency, false)
+		for i := range 5 {
+			scope := instrumentation.Scope{Name: fmt.Sprintf("scope %d", i)}
+			for j := range 4 {
+				compAgg := testSumAggregateOutput
+				if (i+j)%3 == 0 {
+					compAgg = noData
+				}
+				name := fmt.Sprintf("name %d", j)
+				pipe.addSync(scope, instrumentSync{name: name, description: "desc", unit: "1", compAgg: compAgg})
+			}
+		}
+		// A scope without data is not collected.
+		pipe.addSync(instrumentation.Scope{Name: "empty"}, instrumentSync{
+			name:        "name",
+			description: "desc",
+			unit:        "1",
+			compAgg:     noData,
+		})
+		return pipe
+	}
+
+	var want metricdata.ResourceMetrics
+	require.NoError(t, newPipe(0).produce(t.Context(), &want))
+	sortScopes := func(rm metricdata.ResourceMetrics) {
+		slices.SortFunc(rm.ScopeMetrics, func(a, b metricdata.ScopeMetrics) int {
+			return strings.Compare(a.Scope.Name, b.Scope.Name)
+		})
+	}
+	sortScopes(want)
+	require.Len(t, want.ScopeMetrics, 5)
+
+	for _, concurrency := range []int{2, 4, 100} {
+		t.Run(strconv.Itoa(concurrency), func(t *testing.T) {
+			pipe := newPipe(concurrency)
+			var got metricdata.ResourceMetrics
+			// Collect twice to check the reuse of the output.
+			for range 2 {
+				require.NoError(t, pipe.produce(t.Context(), &got))
+				sortScopes(got)
+				metricdatatest.AssertEqual(t, want, got)
+			}
+		})
+	}
+}
+
 func TestDefaultViewImplicit(t *testing.T) {
 	t.Run("Int64", testDefaultViewImplicit[int64]())
 	t.Run("Float64", testDefaultViewImplicit[float64]())
@@ -138,13 +189,13 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 		}{
 			{
 				name: "NoView",
-				pipe: newPipeline(nil, reader, nil, exemplar.AlwaysOffFilter, 0),
+				pipe: newPipeline(nil, reader, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false),
 			},
 			{
 				name: "NoMatchingView",
 				pipe: newPipeline(nil, reader, []View{
 					NewView(Instrument{Name: "foo"}, Stream{Name: "bar"}),
-				}, exemplar.AlwaysOffFilter, 0),
+				}, exemplar.AlwaysOffFilter, nil, 0, 0, false),
 			},
 		}
 
@@ -229,7 +280,7 @@ func TestLogConflictName(t *testing.T) {
 			return instID{Name: tc.existing}
 		})
 
-		i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0), &vc)
+		i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false), &vc)
 		i.logConflict(instID{Name: tc.name})
 
 		if tc.conflict {
@@ -271,7 +322,7 @@ func TestLogConflictSuggestView(t *testing.T) {
 	var vc cache[string, instID]
 	name := strings.ToLower(orig.Name)
 	_ = vc.Lookup(name, func() instID { return orig })
-	i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0), &vc)
+	i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false), &vc)
 
 	viewSuggestion := func(inst instID, stream string) string {
 		return `"NewView(Instrument{` +
@@ -376,7 +427,7 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	}
 
 	var vc cache[string, instID]
-	pipe := newPipeline(nil, NewManualReader(), nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, NewManualReader(), nil, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 	i := newInserter[int64](pipe, &vc)
 
 	readerAggregation := i.readerDefaultAggregation(kind)
@@ -424,10 +475,14 @@ func TestExemplars(t *testing.T) {
 		e, err := m.Int64Histogram("int64-expo-histogram")
 		require.NoError(t, err)
 
+		u, err := m.Int64UpDownCounter("int64-updown-counter")
+		require.NoError(t, err)
+
 		for j := 0; j < 20*nCPU; j++ { // will be >= 20 and > nCPU
 			i.Add(ctx, 1)
 			h.Record(ctx, 1)
 			e.Record(ctx, 1)
+			u.Add(ctx, -1)
 		}
 	}
 
@@ -439,7 +494,7 @@ func TestExemplars(t *testing.T) {
 
 		require.Len(t, rm.ScopeMetrics, 1, "ScopeMetrics")
 		sm := rm.ScopeMetrics[0]
-		require.Len(t, sm.Metrics, 3, "Metrics")
+		require.Len(t, sm.Metrics, 4, "Metrics")
 
 		require.IsType(t, metricdata.Sum[int64]{}, sm.Metrics[0].Data, sm.Metrics[0].Name)
 		sum := sm.Metrics[0].Data.(metricdata.Sum[int64])
@@ -452,6 +507,11 @@ func TestExemplars(t *testing.T) {
 		require.IsType(t, metricdata.ExponentialHistogram[int64]{}, sm.Metrics[2].Data, sm.Metrics[2].Name)
 		expo := sm.Metrics[2].Data.(metricdata.ExponentialHistogram[int64])
 		assert.Len(t, expo.DataPoints[0].Exemplars, nExpo)
+
+		// UpDownCounters use the same fixed size reservoir as Counters.
+		require.IsType(t, metricdata.Sum[int64]{}, sm.Metrics[3].Data, sm.Metrics[3].Name)
+		updown := sm.Metrics[3].Data.(metricdata.Sum[int64])
+		assert.Len(t, updown.DataPoints[0].Exemplars, nSum)
 	}
 
 	ctx := t.Context()
@@ -523,7 +583,10 @@ func TestExemplars(t *testing.T) {
 		v3 := NewView(Instrument{Name: "int64-histogram"}, Stream{
 			ExemplarReservoirProviderSelector: reservoirProviderSelector,
 		})
-		m := NewMeterProvider(WithReader(r), WithView(v1, v2, v3)).Meter("custom-reservoir")
+		v4 := NewView(Instrument{Name: "int64-updown-counter"}, Stream{
+			ExemplarReservoirProviderSelector: reservoirProviderSelector,
+		})
+		m := NewMeterProvider(WithReader(r), WithView(v1, v2, v3, v4)).Meter("custom-reservoir")
 		measure(ctx, m)
 		check(t, r, 0, 0, 0)
 
@@ -611,7 +674,7 @@ func TestPipelineWithMultipleReaders(t *testing.T) {
 func TestPipelineProduceErrors(t *testing.T) {
 	// Create a test pipeline with aggregations
 	pipeReader := NewManualReader()
-	pipe := newPipeline(nil, pipeReader, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, pipeReader, nil, exemplar.AlwaysOffFilter, nil, 0, 0, false)
 
 	// Set up an observable with callbacks
 	var testObsID observableID[int64]
@@ -645,29 +708,29 @@ func TestPipelineProduceErrors(t *testing.T) {
 
 	pipe.callbacks = append(pipe.callbacks,
 		// Callback 1: cancels the context during execution but continues to populate data
-		func(ctx context.Context) error {
+		callback{f: func(ctx context.Context) error {
 			callbackCounts[0]++
 			for _, m := range pipe.int64Measures[testObsID] {
 				m(ctx, 123, *attribute.EmptySet())
 			}
 			return nil
-		},
+		}},
 		// Callback 2: populates int64 observable data
-		func(context.Context) error {
+		callback{f: func(context.Context) error {
 			callbackCounts[1]++
 			if shouldCancelContext {
 				cancelCtx()
 			}
 			return nil
-		},
+		}},
 		// Callback 3: return an error
-		func(context.Context) error {
+		callback{f: func(context.Context) error {
 			callbackCounts[2]++
 			if shouldReturnError {
 				return fmt.Errorf("test callback error")
 			}
 			return nil
-		})
+		}})
 
 	assertMetrics := func(rm *metricdata.ResourceMetrics, expectVal int64) {
 		require.Len(t, rm.ScopeMetrics, 1)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync/atomic"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
@@ -22,8 +23,10 @@ import (
 type MeterProvider struct {
 	embedded.MeterProvider
 
-	pipes  pipelines
-	meters cache[instrumentation.Scope, *meter]
+	pipes          pipelines
+	meters         cache[instrumentation.Scope, *meter]
+	unitValidation *unitValidator
+	nameSanitizer  *nameSanitizer
 
 	forceFlush, shutdown func(context.Context) error
 	stopped              atomic.Bool
@@ -32,6 +35,9 @@ type MeterProvider struct {
 // Compile-time check MeterProvider implements metric.MeterProvider.
 var _ metric.MeterProvider = (*MeterProvider)(nil)
 
+// Compile-time check MeterProvider implements otel.HealthReporter.
+var _ otel.HealthReporter = (*MeterProvider)(nil)
+
 // NewMeterProvider returns a new and configured MeterProvider.
 //
 // By default, the returned MeterProvider is configured with the default
@@ -42,10 +48,23 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 	conf := newConfig(options)
 	flush, sdown := conf.readerSignals()
 
+	pipes := newPipelines(
+		conf.res,
+		conf.readers,
+		conf.views,
+		conf.exemplarFilter,
+		conf.spanExemplars,
+		conf.cardinalityLimit,
+		conf.collectConcurrency,
+		conf.lastUpdateTime,
+	)
+
 	mp := &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers, conf.views, conf.exemplarFilter, conf.cardinalityLimit),
-		forceFlush: flush,
-		shutdown:   sdown,
+		pipes:          pipes,
+		forceFlush:     flush,
+		shutdown:       sdown,
+		unitValidation: newUnitValidator(conf.unitValidation),
+		nameSanitizer:  newNameSanitizer(conf.nameSanitizer),
 	}
 	// Log after creation so all readers show correctly they are registered.
 	global.Info(
@@ -57,6 +76,20 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 	return mp
 }
 
+// Health returns a snapshot of the health of all registered readers that
+// support health reporting, like [PeriodicReader].
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) Health() []otel.HealthStatus {
+	var out []otel.HealthStatus
+	for _, p := range mp.pipes {
+		if r, ok := p.reader.(interface{ Health() otel.HealthStatus }); ok {
+			out = append(out, r.Health())
+		}
+	}
+	return out
+}
+
 // Meter returns a Meter with the given name and configured with options.
 //
 // The name should be the name of the instrumentation scope creating
@@ -94,7 +127,7 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 	)
 
 	return mp.meters.Lookup(s, func() *meter {
-		return newMeter(s, mp.pipes)
+		return newMeter(s, mp.pipes, mp.unitValidation, mp.nameSanitizer)
 	})
 }
 
@@ -120,6 +120,9 @@ type Producer interface {
 // type.
 type produceHolder struct {
 	produce func(context.Context, *metricdata.ResourceMetrics) error
+	// produceSelected produces the selected streams. It is nil if the
+	// producer does not support it.
+	produceSelected func(context.Context, *metricdata.ResourceMetrics, collectConfig) error
 }
 
 // shutdownProducer produces an ErrReaderShutdown error always.
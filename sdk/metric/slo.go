@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+var errInvalidSLO = errors.New("invalid SLO bucket parameters")
+
+// BucketsForSLO returns count explicit bucket boundaries, in seconds, for a
+// histogram measuring durations against the latency objective target. The
+// boundaries grow geometrically by factor and target is one of them, so the
+// fraction of measurements within target, the service-level indicator, is
+// exactly computed from the histogram instead of being interpolated.
+//
+// Half of the boundaries, rounded down, are lower than target. For example,
+// BucketsForSLO(100*time.Millisecond, 2, 5) returns
+// [0.025, 0.05, 0.1, 0.2, 0.4].
+//
+// The boundaries are expressed in seconds, the unit of durations recommended
+// by the OpenTelemetry semantic conventions. They need to be used with
+// instruments recording durations in seconds.
+//
+// Nil is returned if target is not positive, factor is not greater than one,
+// or count is less than one.
+func BucketsForSLO(target time.Duration, factor float64, count int) []float64 {
+	if target <= 0 || !(factor > 1) || math.IsInf(factor, 1) || count < 1 {
+		return nil
+	}
+
+	t := target.Seconds()
+	below := count / 2
+	bounds := make([]float64, count)
+	for i := range bounds {
+		bounds[i] = t * math.Pow(factor, float64(i-below))
+	}
+	return bounds
+}
+
+// NewSLOView returns a View that aggregates the instruments matching criteria
+// with an explicit bucket histogram whose boundaries are returned by
+// BucketsForSLO for target, factor, and count. This allows the histograms of
+// all the instruments measuring the same objective, possibly in different
+// services, to share the same boundaries.
+//
+// See [NewView] for how criteria is matched. If BucketsForSLO returns nil for
+// the parameters, an error is logged and the returned View does not change the
+// aggregation of the matching instruments.
+func NewSLOView(criteria Instrument, target time.Duration, factor float64, count int) View {
+	bounds := BucketsForSLO(target, factor, count)
+	if bounds == nil {
+		global.Error(
+			errInvalidSLO, "not using SLO boundaries with view",
+			"criteria", criteria,
+			"target", target,
+			"factor", factor,
+			"count", count,
+		)
+		return NewView(criteria, Stream{})
+	}
+	return NewView(criteria, Stream{
+		Aggregation: AggregationExplicitBucketHistogram{Boundaries: bounds},
+	})
+}
+
+// SLOThreshold returns the attribute with key holding target, in seconds, as
+// the latency threshold of a service-level objective. Recording it with the
+// measurements of a histogram created with [BucketsForSLO] boundaries lets
+// backends compute the service-level indicator without configuration, and
+// distinguishes the measurements of operations with different objectives.
+//
+// No semantic convention defines this attribute, so its key is chosen by the
+// caller.
+func SLOThreshold(key attribute.Key, target time.Duration) attribute.KeyValue {
+	return key.Float64(target.Seconds())
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestBucketsForSLO(t *testing.T) {
+	assert.Equal(t, []float64{0.025, 0.05, 0.1, 0.2, 0.4}, BucketsForSLO(100*time.Millisecond, 2, 5))
+	assert.Equal(t, []float64{0.5}, BucketsForSLO(500*time.Millisecond, 2, 1))
+
+	got := BucketsForSLO(100*time.Millisecond, 1.5, 12)
+	require.Len(t, got, 12)
+	assert.Equal(t, 0.1, got[6], "target boundary")
+	assert.InDelta(t, 0.1/math.Pow(1.5, 6), got[0], 1e-12)
+	assert.InDelta(t, 0.1*math.Pow(1.5, 5), got[11], 1e-12)
+	assert.NoError(t, AggregationExplicitBucketHistogram{Boundaries: got}.err())
+
+	assert.Nil(t, BucketsForSLO(0, 2, 5), "zero target")
+	assert.Nil(t, BucketsForSLO(time.Second, 1, 5), "factor one")
+	assert.Nil(t, BucketsForSLO(time.Second, math.NaN(), 5), "NaN factor")
+	assert.Nil(t, BucketsForSLO(time.Second, math.Inf(1), 5), "infinite factor")
+	assert.Nil(t, BucketsForSLO(time.Second, 2, 0), "no boundary")
+}
+
+func TestNewSLOView(t *testing.T) {
+	criteria := Instrument{Name: "http.server.request.duration"}
+	stream, ok := NewSLOView(criteria, 100*time.Millisecond, 2, 5)(criteria)
+	require.True(t, ok)
+	assert.Equal(t, AggregationExplicitBucketHistogram{
+		Boundaries: []float64{0.025, 0.05, 0.1, 0.2, 0.4},
+	}, stream.Aggregation)
+
+	stream, ok = NewSLOView(criteria, 0, 2, 5)(criteria)
+	require.True(t, ok)
+	assert.Nil(t, stream.Aggregation, "invalid parameters")
+}
+
+func TestSLOThreshold(t *testing.T) {
+	kv := SLOThreshold("app.slo.threshold", 250*time.Millisecond)
+	assert.Equal(t, attribute.Key("app.slo.threshold"), kv.Key)
+	assert.Equal(t, 0.25, kv.Value.AsFloat64())
+}
+
+func TestSLOViewMeterProvider(t *testing.T) {
+	reader := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(reader),
+		WithView(NewSLOView(Instrument{Name: "latency"}, 100*time.Millisecond, 2, 5)),
+	)
+	h, err := mp.Meter("TestSLOViewMeterProvider").Float64Histogram("latency")
+	require.NoError(t, err)
+	h.Record(t.Context(), 0.1)
+	h.Record(t.Context(), 0.15)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	hist, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+	require.True(t, ok)
+	require.Len(t, hist.DataPoints, 1)
+	// Measurements equal to the target are within the objective.
+	assert.Equal(t, []uint64{0, 0, 1, 1, 0, 0}, hist.DataPoints[0].BucketCounts)
+}
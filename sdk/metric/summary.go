@@ -0,0 +1,275 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// defaultSummaryQuantiles are the quantiles of a HistogramSummary with no
+// Quantiles.
+var defaultSummaryQuantiles = []float64{0.5, 0.9, 0.99}
+
+// errSummary is returned by misconfigured HistogramSummary.
+var errSummary = errors.New("histogram summary")
+
+// HistogramSummary configures the conversion of the explicit bucket histogram
+// aggregation of a Stream into summaries of pre-computed quantiles, for
+// backends storing each histogram bucket as a separate series.
+//
+// The quantiles are estimated from the buckets of the histogram when it is
+// collected, by linear interpolation within the bucket holding the quantile.
+// Their precision depends on the bucket boundaries of the aggregation.
+type HistogramSummary struct {
+	// Quantiles are the quantiles of the summaries, in the range [0, 1]. If
+	// empty, the 0.5, 0.9, and 0.99 quantiles are used.
+	Quantiles []float64
+	// Window is the duration of the sliding window of measurements the
+	// quantiles are computed with. The quantiles of a collection describe
+	// the measurements made since the collection at least Window old. If
+	// Window is less than or equal to zero, the quantiles describe all the
+	// measurements made since the start of the stream.
+	//
+	// The count and sum of the summaries always describe all the
+	// measurements made since the start of the stream.
+	Window time.Duration
+}
+
+// err returns an error for any misconfiguration.
+func (s HistogramSummary) err() error {
+	for _, q := range s.Quantiles {
+		if q < 0 || q > 1 || math.IsNaN(q) {
+			return fmt.Errorf("%w: quantile %v out of range [0, 1]", errSummary, q)
+		}
+	}
+	return nil
+}
+
+// copy returns a deep copy of s.
+func (s *HistogramSummary) copy() *HistogramSummary {
+	if s == nil {
+		return nil
+	}
+	return &HistogramSummary{Quantiles: slices.Clone(s.Quantiles), Window: s.Window}
+}
+
+// summarize returns a ComputeAggregation converting the cumulative explicit
+// bucket histograms computed by comp into summaries as configured by cfg.
+func summarize[N int64 | float64](
+	cfg HistogramSummary,
+	comp aggregate.ComputeAggregation,
+) aggregate.ComputeAggregation {
+	quantiles := cfg.Quantiles
+	if len(quantiles) == 0 {
+		quantiles = defaultSummaryQuantiles
+	}
+	s := &summarizer[N]{
+		comp:      comp,
+		quantiles: slices.Clone(quantiles),
+		window:    cfg.Window,
+		windows:   make(map[attribute.Distinct][]bucketSnapshot),
+	}
+	return s.compute
+}
+
+// summarizer converts the histograms computed by comp into summaries.
+type summarizer[N int64 | float64] struct {
+	comp      aggregate.ComputeAggregation
+	quantiles []float64
+	window    time.Duration
+
+	mu sync.Mutex
+	// hist holds the histogram computed by comp, reused across collections.
+	hist metricdata.Aggregation
+	// windows holds the snapshots of the cumulative bucket counts of each
+	// attribute set within the sliding window, oldest first.
+	windows map[attribute.Distinct][]bucketSnapshot
+}
+
+// bucketSnapshot is the state of the bucket counts of a cumulative histogram
+// data point at a collection.
+type bucketSnapshot struct {
+	time   time.Time
+	counts []uint64
+}
+
+func (s *summarizer[N]) compute(dest *metricdata.Aggregation) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := s.comp(&s.hist)
+	h, ok := s.hist.(metricdata.Histogram[N])
+	if !ok {
+		return n
+	}
+
+	sum, _ := (*dest).(metricdata.Summary)
+	if cap(sum.DataPoints) < len(h.DataPoints) {
+		sum.DataPoints = make([]metricdata.SummaryDataPoint, len(h.DataPoints))
+	}
+	sum.DataPoints = sum.DataPoints[:len(h.DataPoints)]
+
+	var seen map[attribute.Distinct]struct{}
+	if s.window > 0 {
+		seen = make(map[attribute.Distinct]struct{}, len(h.DataPoints))
+	}
+	for i, dPt := range h.DataPoints {
+		counts := dPt.BucketCounts
+		if s.window > 0 {
+			key := dPt.Attributes.Equivalent()
+			seen[key] = struct{}{}
+			counts = s.windowCounts(key, dPt.Time, dPt.BucketCounts)
+		}
+
+		minimum, minOK := dPt.Min.Value()
+		maximum, maxOK := dPt.Max.Value()
+		e := estimator{
+			bounds: dPt.Bounds,
+			counts: counts,
+			min:    float64(minimum),
+			max:    float64(maximum),
+			hasMin: minOK,
+			hasMax: maxOK,
+		}
+
+		qs := slices.Grow(sum.DataPoints[i].QuantileValues[:0], len(s.quantiles))
+		for _, q := range s.quantiles {
+			qs = append(qs, metricdata.QuantileValue{Quantile: q, Value: e.quantile(q)})
+		}
+		sum.DataPoints[i] = metricdata.SummaryDataPoint{
+			Attributes:     dPt.Attributes,
+			StartTime:      dPt.StartTime,
+			Time:           dPt.Time,
+			Count:          dPt.Count,
+			Sum:            float64(dPt.Sum),
+			QuantileValues: qs,
+		}
+	}
+
+	// Forget the attribute sets no longer collected.
+	for key := range s.windows {
+		if _, ok := seen[key]; !ok {
+			delete(s.windows, key)
+		}
+	}
+
+	*dest = sum
+	return n
+}
+
+// windowCounts records the cumulative bucket counts of the attribute set key
+// at t, and returns the bucket counts of the measurements made within the
+// sliding window ending at t.
+func (s *summarizer[N]) windowCounts(key attribute.Distinct, t time.Time, counts []uint64) []uint64 {
+	snaps := append(s.windows[key], bucketSnapshot{time: t, counts: slices.Clone(counts)})
+
+	// The base of the window is the newest snapshot at least window old.
+	start := t.Add(-s.window)
+	base := -1
+	for i, snap := range snaps {
+		if snap.time.After(start) {
+			break
+		}
+		base = i
+	}
+	if base > 0 {
+		// Older snapshots are no longer needed.
+		snaps = slices.Delete(snaps, 0, base)
+		base = 0
+	}
+	s.windows[key] = snaps
+
+	if base < 0 || len(snaps[base].counts) != len(counts) {
+		// The stream started within the window.
+		return counts
+	}
+	out := make([]uint64, len(counts))
+	for i, c := range counts {
+		prev := snaps[base].counts[i]
+		if c < prev {
+			// The histogram was reset.
+			return counts
+		}
+		out[i] = c - prev
+	}
+	return out
+}
+
+// estimator estimates the quantiles of the measurements counted in the
+// buckets of an explicit bucket histogram.
+type estimator struct {
+	bounds []float64
+	counts []uint64
+
+	min, max       float64
+	hasMin, hasMax bool
+}
+
+// quantile returns the estimated q quantile, or NaN if no measurement is
+// counted.
+func (e estimator) quantile(q float64) float64 {
+	var total uint64
+	for _, c := range e.counts {
+		total += c
+	}
+	if total == 0 {
+		return math.NaN()
+	}
+
+	rank := q * float64(total)
+	var cum float64
+	for i, c := range e.counts {
+		if c == 0 {
+			continue
+		}
+		if cum+float64(c) < rank {
+			cum += float64(c)
+			continue
+		}
+		lower, upper := e.bucket(i)
+		v := lower + (upper-lower)*(rank-cum)/float64(c)
+		if e.hasMin {
+			v = math.Max(v, e.min)
+		}
+		if e.hasMax {
+			v = math.Min(v, e.max)
+		}
+		return v
+	}
+	// Unreachable as rank is at most total.
+	return math.NaN()
+}
+
+// bucket returns the bounds of the bucket i. The infinite bounds of the first
+// and last buckets are replaced by the min and max, if known, or by the
+// nearest finite bound.
+func (e estimator) bucket(i int) (lower, upper float64) {
+	if len(e.bounds) == 0 {
+		return e.min, e.max
+	}
+	switch {
+	case i == 0:
+		lower, upper = e.bounds[0], e.bounds[0]
+		if e.hasMin {
+			lower = math.Min(e.min, upper)
+		}
+	case i == len(e.bounds):
+		lower, upper = e.bounds[i-1], e.bounds[i-1]
+		if e.hasMax {
+			upper = math.Max(e.max, lower)
+		}
+	default:
+		lower, upper = e.bounds[i-1], e.bounds[i]
+	}
+	return lower, upper
+}
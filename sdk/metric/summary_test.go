@@ -0,0 +1,225 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestHistogramSummaryErr(t *testing.T) {
+	assert.NoError(t, HistogramSummary{}.err())
+	assert.NoError(t, HistogramSummary{Quantiles: []float64{0, 0.5, 1}}.err())
+	for _, q := range []float64{-0.1, 1.1, math.NaN()} {
+		assert.ErrorIs(t, HistogramSummary{Quantiles: []float64{q}}.err(), errSummary, "quantile %v", q)
+	}
+}
+
+func TestHistogramSummaryCopy(t *testing.T) {
+	var nilSummary *HistogramSummary
+	assert.Nil(t, nilSummary.copy())
+
+	s := &HistogramSummary{Quantiles: []float64{0.5}, Window: time.Minute}
+	c := s.copy()
+	assert.Equal(t, s, c)
+	c.Quantiles[0] = 0.9
+	assert.Equal(t, 0.5, s.Quantiles[0], "copy shares quantiles")
+}
+
+func TestEstimatorQuantile(t *testing.T) {
+	e := estimator{
+		bounds: []float64{0, 10, 20},
+		counts: []uint64{0, 10, 10, 0},
+	}
+	assert.Equal(t, 0.0, e.quantile(0))
+	assert.Equal(t, 5.0, e.quantile(0.25))
+	assert.Equal(t, 10.0, e.quantile(0.5))
+	assert.Equal(t, 15.0, e.quantile(0.75))
+	assert.Equal(t, 20.0, e.quantile(1))
+
+	// The estimates are clamped to the min and max.
+	e.min, e.hasMin = 2, true
+	e.max, e.hasMax = 18, true
+	assert.Equal(t, 2.0, e.quantile(0))
+	assert.Equal(t, 18.0, e.quantile(1))
+
+	// The infinite bounds are replaced by the min and max.
+	e = estimator{
+		bounds: []float64{10},
+		counts: []uint64{2, 2},
+		min:    0,
+		max:    30,
+		hasMin: true,
+		hasMax: true,
+	}
+	assert.Equal(t, 5.0, e.quantile(0.25))
+	assert.Equal(t, 20.0, e.quantile(0.75))
+
+	e = estimator{bounds: []float64{10}, counts: []uint64{0, 0}}
+	assert.True(t, math.IsNaN(e.quantile(0.5)), "empty histogram")
+}
+
+func TestSummarizerWindow(t *testing.T) {
+	s := &summarizer[int64]{
+		window:  time.Minute,
+		windows: make(map[attribute.Distinct][]bucketSnapshot),
+	}
+	set := attribute.NewSet()
+	key := set.Equivalent()
+	start := time.Unix(0, 0)
+
+	// Measurements made before the window ends are all included.
+	got := s.windowCounts(key, start.Add(30*time.Second), []uint64{1, 0})
+	assert.Equal(t, []uint64{1, 0}, got)
+	got = s.windowCounts(key, start.Add(60*time.Second), []uint64{2, 1})
+	assert.Equal(t, []uint64{2, 1}, got)
+
+	// The measurements older than the window are removed.
+	got = s.windowCounts(key, start.Add(90*time.Second), []uint64{2, 3})
+	assert.Equal(t, []uint64{1, 3}, got)
+	got = s.windowCounts(key, start.Add(150*time.Second), []uint64{2, 5})
+	assert.Equal(t, []uint64{0, 2}, got)
+	assert.Len(t, s.windows[key], 2, "old snapshots retained")
+
+	// A reset histogram is used as is.
+	got = s.windowCounts(key, start.Add(210*time.Second), []uint64{1, 0})
+	assert.Equal(t, []uint64{1, 0}, got)
+}
+
+func TestHistogramSummary(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		stream Stream
+		want   []float64
+	}{
+		{
+			name:   "Default",
+			stream: Stream{Summary: &HistogramSummary{}},
+			want:   defaultSummaryQuantiles,
+		},
+		{
+			name:   "Quantiles",
+			stream: Stream{Summary: &HistogramSummary{Quantiles: []float64{0, 0.5, 1}}},
+			want:   []float64{0, 0.5, 1},
+		},
+		{
+			name: "Window",
+			stream: Stream{Summary: &HistogramSummary{
+				Quantiles: []float64{0.5},
+				Window:    time.Hour,
+			}},
+			want: []float64{0.5},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewManualReader(WithTemporalitySelector(DeltaTemporalitySelector))
+			meter := NewMeterProvider(
+				WithView(NewView(Instrument{Name: "histogram"}, tt.stream)),
+				WithReader(reader),
+			).Meter("TestHistogramSummary")
+			hist, err := meter.Float64Histogram(
+				"histogram",
+				metric.WithExplicitBucketBoundaries(0, 10, 20),
+			)
+			require.NoError(t, err)
+			counter, err := meter.Int64Counter("counter")
+			require.NoError(t, err)
+
+			ctx := t.Context()
+			counter.Add(ctx, 1)
+			for _, v := range []float64{2, 8, 12, 18} {
+				hist.Record(ctx, v)
+			}
+
+			for i := range 2 {
+				var rm metricdata.ResourceMetrics
+				require.NoError(t, reader.Collect(ctx, &rm))
+				require.Len(t, rm.ScopeMetrics, 1)
+
+				var summary metricdata.Summary
+				for _, m := range rm.ScopeMetrics[0].Metrics {
+					switch data := m.Data.(type) {
+					case metricdata.Summary:
+						summary = data
+					case metricdata.Sum[int64]:
+						// Other streams are not converted.
+					default:
+						t.Fatalf("unexpected data type %T for %s", data, m.Name)
+					}
+				}
+				require.Len(t, summary.DataPoints, 1)
+				dPt := summary.DataPoints[0]
+				// The summaries are cumulative regardless of the reader
+				// temporality.
+				assert.Equal(t, uint64(4), dPt.Count, "collection %d", i)
+				assert.Equal(t, 40.0, dPt.Sum, "collection %d", i)
+				require.Len(t, dPt.QuantileValues, len(tt.want))
+				for j, q := range tt.want {
+					assert.Equal(t, q, dPt.QuantileValues[j].Quantile)
+					v := dPt.QuantileValues[j].Value
+					assert.GreaterOrEqual(t, v, 2.0, "quantile %v", q)
+					assert.LessOrEqual(t, v, 18.0, "quantile %v", q)
+				}
+			}
+		})
+	}
+}
+
+func TestHistogramSummaryNotExplicit(t *testing.T) {
+	reader := NewManualReader()
+	meter := NewMeterProvider(
+		WithView(NewView(Instrument{Name: "*"}, Stream{
+			Aggregation: AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20},
+			Summary:     &HistogramSummary{},
+		})),
+		WithReader(reader),
+	).Meter("TestHistogramSummaryNotExplicit")
+	hist, err := meter.Int64Histogram("histogram")
+	require.NoError(t, err)
+	hist.Record(t.Context(), 1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	assert.IsType(t, metricdata.ExponentialHistogram[int64]{}, rm.ScopeMetrics[0].Metrics[0].Data)
+}
+
+func TestHistogramSummaryGaugeHistogram(t *testing.T) {
+	reader := NewManualReader(WithTemporalitySelector(DeltaTemporalitySelector))
+	view := func(i Instrument) (Stream, bool) {
+		return Stream{
+			Name:           i.Name,
+			GaugeHistogram: true,
+			Summary:        &HistogramSummary{Quantiles: []float64{0.5}},
+		}, true
+	}
+	meter := NewMeterProvider(
+		WithView(view),
+		WithReader(reader),
+	).Meter("TestHistogramSummaryGaugeHistogram")
+	hist, err := meter.Float64Histogram("histogram")
+	require.NoError(t, err)
+	hist.Record(t.Context(), 1)
+
+	// Summary takes precedence, the histogram is not a gauge histogram.
+	for i := range 2 {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		summary, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Summary)
+		require.True(t, ok, "not a summary")
+		require.Len(t, summary.DataPoints, 1)
+		assert.Equal(t, uint64(1), summary.DataPoints[0].Count, "collection %d", i)
+	}
+}
@@ -0,0 +1,355 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+)
+
+// ErrInstrumentUnit indicates the created instrument has a unit that is not a
+// valid case-sensitive UCUM (https://ucum.org) unit.
+var ErrInstrumentUnit = errors.New("invalid instrument unit")
+
+// UnitValidation defines how the units of instruments are validated when the
+// instruments are created.
+type UnitValidation int
+
+const (
+	// UnitValidationNone does not validate units. This is the default.
+	UnitValidationNone UnitValidation = iota
+
+	// UnitValidationWarn reports invalid units to the global ErrorHandler,
+	// once per unit. The unit is used as provided.
+	UnitValidationWarn
+
+	// UnitValidationError returns an error wrapping ErrInstrumentUnit from
+	// the creation of instruments with an invalid unit. Like for an invalid
+	// name, the returned instrument is still functional and uses the unit as
+	// provided.
+	UnitValidationError
+
+	// UnitValidationNormalize replaces common aliases of units, e.g.
+	// "milliseconds" or "msec", with their UCUM representation, e.g. "ms".
+	// Other invalid units are reported to the global ErrorHandler, once per
+	// unit, and used as provided.
+	UnitValidationNormalize
+)
+
+// WithUnitValidation sets how the units of instruments created by the Meters
+// of the MeterProvider are validated against UCUM (https://ucum.org).
+//
+// Malformed units can be rejected by backends ingesting the exported
+// telemetry. Validating them when instruments are created surfaces the issue
+// early.
+//
+// By default, if this option is not used, units are not validated.
+func WithUnitValidation(v UnitValidation) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.unitValidation = v
+		return cfg
+	})
+}
+
+// unitAliases maps common aliases of units to their UCUM representation.
+var unitAliases = map[string]string{
+	"nanosecond":   "ns",
+	"nanoseconds":  "ns",
+	"nsec":         "ns",
+	"microsecond":  "us",
+	"microseconds": "us",
+	"usec":         "us",
+	"μs":           "us",
+	"millisecond":  "ms",
+	"milliseconds": "ms",
+	"msec":         "ms",
+	"second":       "s",
+	"seconds":      "s",
+	"sec":          "s",
+	"minute":       "min",
+	"minutes":      "min",
+	"hour":         "h",
+	"hours":        "h",
+	"hr":           "h",
+	"day":          "d",
+	"days":         "d",
+	"byte":         "By",
+	"bytes":        "By",
+	"KiB":          "KiBy",
+	"MiB":          "MiBy",
+	"GiB":          "GiBy",
+	"TiB":          "TiBy",
+	"kilobyte":     "kBy",
+	"kilobytes":    "kBy",
+	"megabyte":     "MBy",
+	"megabytes":    "MBy",
+	"gigabyte":     "GBy",
+	"gigabytes":    "GBy",
+	"bits":         "bit",
+	"percent":      "%",
+	"celsius":      "Cel",
+	"hertz":        "Hz",
+	"meter":        "m",
+	"meters":       "m",
+	"volt":         "V",
+	"volts":        "V",
+	"watt":         "W",
+	"watts":        "W",
+	"joule":        "J",
+	"joules":       "J",
+}
+
+// unitValidator validates the units of the instruments created by the Meters
+// of a MeterProvider.
+type unitValidator struct {
+	validation UnitValidation
+	// reported holds the invalid units already reported to the global
+	// ErrorHandler, so each one is only reported once.
+	reported sync.Map
+}
+
+func newUnitValidator(v UnitValidation) *unitValidator {
+	return &unitValidator{validation: v}
+}
+
+// instrumentUnit returns the unit an instrument created with unit uses based
+// on the validation of uv. The returned error wraps ErrInstrumentUnit if unit
+// is invalid and the validation is UnitValidationError.
+func (uv *unitValidator) instrumentUnit(unit string) (string, error) {
+	v := uv.validation
+	if v == UnitValidationNone {
+		return unit, nil
+	}
+	if v == UnitValidationNormalize {
+		if u, ok := unitAliases[unit]; ok {
+			return u, nil
+		}
+	}
+
+	err := validateUnit(unit)
+	if err == nil {
+		return unit, nil
+	}
+	if v == UnitValidationError {
+		return unit, err
+	}
+	if _, loaded := uv.reported.LoadOrStore(unit, struct{}{}); !loaded {
+		otel.Handle(err)
+	}
+	return unit, nil
+}
+
+// withUnitErr returns err joined with unitErr, or err if unitErr is nil.
+func withUnitErr(err, unitErr error) error {
+	if unitErr == nil {
+		return err
+	}
+	return errors.Join(err, unitErr)
+}
+
+// validateUnit returns an error wrapping ErrInstrumentUnit if unit is not a
+// valid case-sensitive UCUM unit. An empty unit is valid.
+func validateUnit(unit string) error {
+	if unit == "" {
+		return nil
+	}
+	p := unitParser{s: unit}
+	if err := p.mainTerm(); err != nil {
+		return fmt.Errorf("%w: %q: %w", ErrInstrumentUnit, unit, err)
+	}
+	return nil
+}
+
+// unitParser parses the case-sensitive UCUM syntax:
+//
+//	mainTerm    = "/" term | term
+//	term        = component { ("." | "/") component }
+//	component   = annotatable [annotation] | annotation | factor | "(" term ")"
+//	annotatable = simpleUnit [exponent]
+//	simpleUnit  = [prefix] atom
+type unitParser struct {
+	s   string
+	pos int
+}
+
+func (p *unitParser) peek() byte {
+	if p.pos < len(p.s) {
+		return p.s[p.pos]
+	}
+	return 0
+}
+
+func (p *unitParser) mainTerm() error {
+	if p.peek() == '/' {
+		p.pos++
+	}
+	if err := p.term(); err != nil {
+		return err
+	}
+	if p.pos != len(p.s) {
+		return fmt.Errorf("unexpected %q at position %d", p.s[p.pos], p.pos)
+	}
+	return nil
+}
+
+func (p *unitParser) term() error {
+	if err := p.component(); err != nil {
+		return err
+	}
+	for c := p.peek(); c == '.' || c == '/'; c = p.peek() {
+		p.pos++
+		if err := p.component(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *unitParser) component() error {
+	switch c := p.peek(); {
+	case c == 0:
+		return errors.New("unexpected end of unit")
+	case c == '(':
+		p.pos++
+		if err := p.term(); err != nil {
+			return err
+		}
+		if p.peek() != ')' {
+			return errors.New("unbalanced parenthesis")
+		}
+		p.pos++
+		return nil
+	case c == '{':
+		return p.annotation()
+	case isDigit(c):
+		start := p.pos
+		p.digits()
+		// "10*" and "10^" are the atoms used for powers of ten, e.g. "10*3".
+		if c := p.peek(); p.s[start:p.pos] != "10" || (c != '*' && c != '^') {
+			return nil
+		}
+		p.pos++
+	default:
+		if err := p.simpleUnit(); err != nil {
+			return err
+		}
+	}
+
+	if err := p.exponent(); err != nil {
+		return err
+	}
+	if p.peek() == '{' {
+		return p.annotation()
+	}
+	return nil
+}
+
+func (p *unitParser) exponent() error {
+	if c := p.peek(); c == '+' || c == '-' {
+		p.pos++
+		if !isDigit(p.peek()) {
+			return errors.New("invalid exponent")
+		}
+	}
+	p.digits()
+	return nil
+}
+
+func (p *unitParser) digits() {
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+}
+
+func (p *unitParser) annotation() error {
+	end := strings.IndexByte(p.s[p.pos:], '}')
+	if end < 0 {
+		return errors.New("unterminated annotation")
+	}
+	for _, c := range []byte(p.s[p.pos+1 : p.pos+end]) {
+		if c < '!' || c > '~' || c == '{' {
+			return fmt.Errorf("invalid annotation character %q", c)
+		}
+	}
+	p.pos += end + 1
+	return nil
+}
+
+func (p *unitParser) simpleUnit() error {
+	start := p.pos
+	for c := p.peek(); c != 0 && !isUnitDelimiter(c); c = p.peek() {
+		if c == '[' {
+			end := strings.IndexByte(p.s[p.pos:], ']')
+			if end < 0 {
+				return errors.New("unterminated square bracket")
+			}
+			p.pos += end
+		}
+		p.pos++
+	}
+	sym := p.s[start:p.pos]
+	if sym == "" {
+		return fmt.Errorf("unexpected %q at position %d", p.s[p.pos], p.pos)
+	}
+	if isAtom(sym) {
+		return nil
+	}
+	for _, prefix := range unitPrefixes {
+		if atom, ok := strings.CutPrefix(sym, prefix); ok && metricAtoms[atom] {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown unit %q", sym)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isUnitDelimiter(c byte) bool {
+	switch c {
+	case '.', '/', '(', ')', '{', '+', '-':
+		return true
+	}
+	return isDigit(c)
+}
+
+// isAtom reports whether sym is a unit atom. Atoms in square brackets, e.g.
+// "[in_i]", are accepted without being checked against the UCUM tables.
+func isAtom(sym string) bool {
+	if metricAtoms[sym] || nonMetricAtoms[sym] {
+		return true
+	}
+	return len(sym) > 2 && sym[0] == '[' && sym[len(sym)-1] == ']'
+}
+
+// unitPrefixes are the UCUM prefixes. Two letter prefixes are listed first so
+// they are matched before their one letter counterparts.
+var unitPrefixes = []string{
+	"da", "Ki", "Mi", "Gi", "Ti",
+	"Y", "Z", "E", "P", "T", "G", "M", "k", "h",
+	"d", "c", "m", "u", "n", "p", "f", "a", "z", "y",
+}
+
+// metricAtoms are the commonly used UCUM atoms that can be prefixed.
+var metricAtoms = map[string]bool{
+	"m": true, "s": true, "g": true, "rad": true, "K": true, "C": true,
+	"cd": true, "mol": true, "sr": true, "Hz": true, "N": true, "Pa": true,
+	"J": true, "W": true, "A": true, "V": true, "F": true, "Ohm": true,
+	"S": true, "Wb": true, "Cel": true, "T": true, "H": true, "lm": true,
+	"lx": true, "Bq": true, "Gy": true, "Sv": true, "l": true, "L": true,
+	"ar": true, "t": true, "bar": true, "u": true, "eV": true, "pc": true,
+	"By": true, "bit": true, "Bd": true, "B": true, "Np": true, "cal": true,
+	"dyn": true, "erg": true, "G": true, "Mx": true, "P": true, "St": true,
+}
+
+// nonMetricAtoms are the commonly used UCUM atoms that cannot be prefixed.
+var nonMetricAtoms = map[string]bool{
+	"1": true, "%": true, "min": true, "h": true, "d": true, "wk": true,
+	"mo": true, "a": true, "deg": true, "'": true, "''": true, "gon": true,
+}
@@ -0,0 +1,179 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestValidateUnit(t *testing.T) {
+	valid := []string{
+		"",
+		"1",
+		"%",
+		"s",
+		"ms",
+		"us",
+		"By",
+		"KiBy",
+		"kBy",
+		"By/s",
+		"m/s2",
+		"s-1",
+		"m.s-2",
+		"/s",
+		"{request}",
+		"{request}/s",
+		"By{compressed}",
+		"10*3",
+		"10*-3.m",
+		"(m/s)/s",
+		"Cel",
+		"[degF]",
+		"min",
+		"mol",
+		"daN",
+	}
+	for _, u := range valid {
+		assert.NoError(t, validateUnit(u), "unit %q", u)
+	}
+
+	invalid := []string{
+		"milliseconds",
+		"bytes",
+		"s s",
+		"m/",
+		"(m/s",
+		"{request",
+		"s^2",
+		"m.",
+		"kmin",
+		"[in_i",
+		"s+",
+	}
+	for _, u := range invalid {
+		assert.ErrorIs(t, validateUnit(u), ErrInstrumentUnit, "unit %q", u)
+	}
+}
+
+func TestUnitValidationInstrumentUnit(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	tests := []struct {
+		v           UnitValidation
+		unit        string
+		want        string
+		wantErr     bool
+		wantHandled bool
+	}{
+		{v: UnitValidationNone, unit: "milliseconds", want: "milliseconds"},
+		{v: UnitValidationWarn, unit: "ms", want: "ms"},
+		{v: UnitValidationWarn, unit: "milliseconds", want: "milliseconds", wantHandled: true},
+		{v: UnitValidationError, unit: "ms", want: "ms"},
+		{v: UnitValidationError, unit: "milliseconds", want: "milliseconds", wantErr: true},
+		{v: UnitValidationNormalize, unit: "milliseconds", want: "ms"},
+		{v: UnitValidationNormalize, unit: "bytes", want: "By"},
+		{v: UnitValidationNormalize, unit: "ms", want: "ms"},
+		{v: UnitValidationNormalize, unit: "unknown", want: "unknown", wantHandled: true},
+	}
+	for _, tt := range tests {
+		handled = nil
+		got, err := newUnitValidator(tt.v).instrumentUnit(tt.unit)
+		assert.Equal(t, tt.want, got, "%d: %q", tt.v, tt.unit)
+		if tt.wantErr {
+			assert.ErrorIs(t, err, ErrInstrumentUnit, "%d: %q", tt.v, tt.unit)
+		} else {
+			assert.NoError(t, err, "%d: %q", tt.v, tt.unit)
+		}
+		if tt.wantHandled {
+			require.Len(t, handled, 1, "%d: %q", tt.v, tt.unit)
+			assert.ErrorIs(t, handled[0], ErrInstrumentUnit)
+		} else {
+			assert.Empty(t, handled, "%d: %q", tt.v, tt.unit)
+		}
+	}
+}
+
+func TestUnitValidationReportedOnce(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	m := NewMeterProvider(WithUnitValidation(UnitValidationWarn)).Meter(t.Name())
+	for range 3 {
+		_, err := m.Int64Counter("requests", metric.WithUnit("milliseconds"))
+		require.NoError(t, err)
+	}
+	_, err := m.Float64Histogram("size", metric.WithUnit("milliseconds"))
+	require.NoError(t, err)
+	assert.Len(t, handled, 1, "invalid unit reported more than once")
+
+	_, err = m.Int64Counter("bytes", metric.WithUnit("bytes"))
+	require.NoError(t, err)
+	assert.Len(t, handled, 2, "other invalid unit not reported")
+}
+
+func TestMeterUnitValidation(t *testing.T) {
+	t.Run("Normalize", func(t *testing.T) {
+		reader := NewManualReader()
+		mp := NewMeterProvider(WithReader(reader), WithUnitValidation(UnitValidationNormalize))
+		m := mp.Meter(t.Name())
+
+		ctr, err := m.Int64Counter("requests", metric.WithUnit("milliseconds"))
+		require.NoError(t, err)
+		ctr.Add(t.Context(), 1)
+		hist, err := m.Float64Histogram("size", metric.WithUnit("bytes"))
+		require.NoError(t, err)
+		hist.Record(t.Context(), 1)
+		_, err = m.Int64ObservableGauge(
+			"duration",
+			metric.WithUnit("seconds"),
+			metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(1)
+				return nil
+			}),
+		)
+		require.NoError(t, err)
+
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		units := map[string]string{}
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			units[m.Name] = m.Unit
+		}
+		assert.Equal(t, map[string]string{"requests": "ms", "size": "By", "duration": "s"}, units)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mp := NewMeterProvider(WithUnitValidation(UnitValidationError))
+		m := mp.Meter(t.Name())
+
+		ctr, err := m.Float64Counter("requests", metric.WithUnit("milliseconds"))
+		assert.ErrorIs(t, err, ErrInstrumentUnit)
+		assert.NotNil(t, ctr)
+
+		_, err = m.Int64Histogram("_", metric.WithUnit("milliseconds"))
+		assert.ErrorIs(t, err, ErrInstrumentUnit)
+		assert.ErrorIs(t, err, ErrInstrumentName)
+
+		_, err = m.Float64ObservableCounter("observed", metric.WithUnit("milliseconds"))
+		assert.ErrorIs(t, err, ErrInstrumentUnit)
+
+		_, err = m.Int64UpDownCounter("valid", metric.WithUnit("ms"))
+		assert.NoError(t, err)
+	})
+}
@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	errMultiInst = errors.New("name replacement for multiple instruments")
-	errEmptyView = errors.New("no criteria provided for view")
+	errMultiInst             = errors.New("name replacement for multiple instruments")
+	errEmptyView             = errors.New("no criteria provided for view")
+	errGaugeHistogramSummary = errors.New("histogram summary of a gauge histogram")
 
 	emptyView = func(Instrument) (Stream, bool) { return Stream{}, false }
 )
@@ -42,9 +43,9 @@ type View func(Instrument) (Stream, bool)
 //
 // The Stream mask only applies updates for non-zero-value fields. By default,
 // the Instrument the View matches against will be use for the Name,
-// Description, and Unit of the returned Stream and no Aggregation or
-// AttributeFilter are set. All non-zero-value fields of mask are used instead
-// of the default. If you need to zero out an Stream field returned from a
+// Description, and Unit of the returned Stream and no Aggregation,
+// AttributeFilter, or AttributeValueFilter are set. All non-zero-value fields
+// of mask are used instead of the default. If you need to zero out an Stream field returned from a
 // View, create a View directly.
 func NewView(criteria Instrument, mask Stream) View {
 	if criteria.IsEmpty() {
@@ -97,6 +98,27 @@ func NewView(criteria Instrument, mask Stream) View {
 		}
 	}
 
+	if mask.GaugeHistogram && mask.Summary != nil {
+		global.Error(
+			errGaugeHistogramSummary, "dropping view",
+			"criteria", criteria,
+			"mask", mask,
+		)
+		return emptyView
+	}
+
+	summary := mask.Summary.copy()
+	if summary != nil {
+		if err := summary.err(); err != nil {
+			global.Error(
+				err, "not using histogram summary with view",
+				"criteria", criteria,
+				"mask", mask,
+			)
+			summary = nil
+		}
+	}
+
 	return func(i Instrument) (Stream, bool) {
 		if matchFunc(i) {
 			return Stream{
@@ -105,7 +127,11 @@ func NewView(criteria Instrument, mask Stream) View {
 				Unit:                              nonZero(mask.Unit, i.Unit),
 				Aggregation:                       agg,
 				AttributeFilter:                   mask.AttributeFilter,
+				AttributeValueFilter:              mask.AttributeValueFilter,
 				ExemplarReservoirProviderSelector: mask.ExemplarReservoirProviderSelector,
+				NoMinMax:                          mask.NoMinMax,
+				GaugeHistogram:                    mask.GaugeHistogram,
+				Summary:                           summary,
 			}, true
 		}
 		return Stream{}, false
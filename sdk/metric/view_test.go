@@ -380,6 +380,53 @@ func TestNewViewReplace(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "NoMinMax",
+			mask: Stream{NoMinMax: true},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:        i.Name,
+					Description: i.Description,
+					Unit:        i.Unit,
+					NoMinMax:    true,
+				}
+			},
+		},
+		{
+			name: "GaugeHistogram",
+			mask: Stream{GaugeHistogram: true},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:           i.Name,
+					Description:    i.Description,
+					Unit:           i.Unit,
+					GaugeHistogram: true,
+				}
+			},
+		},
+		{
+			name: "Summary",
+			mask: Stream{Summary: &HistogramSummary{Quantiles: []float64{0.5}}},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:        i.Name,
+					Description: i.Description,
+					Unit:        i.Unit,
+					Summary:     &HistogramSummary{Quantiles: []float64{0.5}},
+				}
+			},
+		},
+		{
+			name: "InvalidSummary",
+			mask: Stream{Summary: &HistogramSummary{Quantiles: []float64{2}}},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:        i.Name,
+					Description: i.Description,
+					Unit:        i.Unit,
+				}
+			},
+		},
 		{
 			name: "Aggregation",
 			mask: Stream{Aggregation: AggregationLastValue{}},
@@ -468,6 +515,21 @@ func TestNewViewEmptyViewErrorLogged(t *testing.T) {
 	assert.Contains(t, got, errEmptyView.Error())
 }
 
+func TestNewViewGaugeHistogramSummaryErrorLogged(t *testing.T) {
+	var got string
+	otel.SetLogger(funcr.New(func(_, args string) {
+		got = args
+	}, funcr.Options{Verbosity: 6}))
+
+	view := NewView(Instrument{Name: "latency"}, Stream{
+		GaugeHistogram: true,
+		Summary:        &HistogramSummary{Quantiles: []float64{0.5}},
+	})
+	assert.Contains(t, got, errGaugeHistogramSummary.Error())
+	_, match := view(Instrument{Name: "latency"})
+	assert.False(t, match, "view not dropped")
+}
+
 func TestNewViewMultiInstMatchErrorLogged(t *testing.T) {
 	var got string
 	otel.SetLogger(funcr.New(func(_, args string) {
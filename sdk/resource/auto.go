@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // ErrPartialResource is returned by a detector when complete source
@@ -55,13 +57,30 @@ func Detect(ctx context.Context, detectors ...Detector) (*Resource, error) {
 // [ErrPartialResource] [ErrSchemaURLConflict]), a single error wrapping all of
 // these errors will be returned. Otherwise, nil is returned.
 func detect(ctx context.Context, res *Resource, detectors []Detector) error {
+	return detectPrioritized(ctx, res, detectors, nil, nil)
+}
+
+// detectPrioritized is detect where the attributes detected by
+// detectors[i] have a priority of priorities[i], and conflicts are resolved
+// using onConflict.
+//
+// If priorities and onConflict are nil, this is equivalent to detect.
+func detectPrioritized(
+	ctx context.Context,
+	res *Resource,
+	detectors []Detector,
+	priorities []int,
+	onConflict func(Conflict) attribute.Value,
+) error {
 	var (
 		r   *Resource
 		err error
 		e   error
+
+		m = newPriorityMerger(priorities, onConflict)
 	)
 
-	for _, detector := range detectors {
+	for i, detector := range detectors {
 		if detector == nil {
 			continue
 		}
@@ -72,7 +91,7 @@ func detect(ctx context.Context, res *Resource, detectors []Detector) error {
 				continue
 			}
 		}
-		r, e = Merge(res, r)
+		r, e = m.merge(res, r, i)
 		if e != nil {
 			err = errors.Join(err, e)
 		}
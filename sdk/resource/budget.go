@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/internal/attrsize"
+)
+
+// ErrBudgetExceeded is returned when a Resource exceeds its Budget.
+var ErrBudgetExceeded = errors.New("resource budget exceeded")
+
+// Budget limits the size of a Resource. As a Resource is sent with every
+// export, large Resources, e.g. detected from the command arguments of a
+// process, bloat every export payload.
+type Budget struct {
+	// MaxAttributes is the maximum number of attributes of the Resource. If
+	// it is less than or equal to zero, the number of attributes is not
+	// limited.
+	MaxAttributes int
+	// MaxBytes is the maximum estimated encoded size in bytes of the
+	// attributes of the Resource, see [Resource.Size]. If it is less than or
+	// equal to zero, the size is not limited.
+	MaxBytes int
+}
+
+// Exceeded reports whether r exceeds b.
+func (b Budget) Exceeded(r *Resource) bool {
+	return b.exceeded(r.Len(), r.Size())
+}
+
+func (b Budget) exceeded(n, size int) bool {
+	return (b.MaxAttributes > 0 && n > b.MaxAttributes) || (b.MaxBytes > 0 && size > b.MaxBytes)
+}
+
+// check returns an error wrapping ErrBudgetExceeded if r exceeds b.
+func (b Budget) check(r *Resource) error {
+	n, size := r.Len(), r.Size()
+	if !b.exceeded(n, size) {
+		return nil
+	}
+	return fmt.Errorf(
+		"%w: %d attributes (max %d), %d bytes (max %d)",
+		ErrBudgetExceeded, n, b.MaxAttributes, size, b.MaxBytes,
+	)
+}
+
+// Size returns the estimated encoded size in bytes of the attributes of r.
+//
+// The estimate approximates the OTLP protobuf encoding. It is not exact, but
+// it scales with the size of the keys and values of the attributes.
+func (r *Resource) Size() int {
+	if r == nil {
+		return 0
+	}
+	var n int
+	iter := r.Iter()
+	for iter.Next() {
+		n += attrsize.KeyValue(iter.Attribute())
+	}
+	return n
+}
+
+// WithBudget sets the Budget of the configured Resource. If the Resource
+// exceeds b, it is returned by New along with an error wrapping
+// [ErrBudgetExceeded]. Use [Trim] to reduce the Resource to b.
+func WithBudget(b Budget) Option {
+	return budgetOption(b)
+}
+
+type budgetOption Budget
+
+func (o budgetOption) apply(cfg config) config {
+	cfg.budget = Budget(o)
+	return cfg
+}
+
+// Trim returns r without the attributes of lowest priority exceeding b. The
+// priority of the attributes is returned by priority. Among attributes of the
+// same priority, the largest are dropped first. If priority is nil, all the
+// attributes have the same priority.
+//
+// If r does not exceed b, r is returned.
+func Trim(r *Resource, b Budget, priority func(attribute.KeyValue) int) *Resource {
+	n, size := r.Len(), r.Size()
+	if !b.exceeded(n, size) {
+		return r
+	}
+
+	type entry struct {
+		kv       attribute.KeyValue
+		priority int
+		size     int
+	}
+	entries := make([]entry, 0, n)
+	iter := r.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		e := entry{kv: kv, size: attrsize.KeyValue(kv)}
+		if priority != nil {
+			e.priority = priority(kv)
+		}
+		entries = append(entries, e)
+	}
+	// Sort in dropping order.
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		if c := cmp.Compare(a.priority, b.priority); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.size, a.size)
+	})
+
+	var dropped int
+	for _, e := range entries {
+		if !b.exceeded(n, size) {
+			break
+		}
+		n, size = n-1, size-e.size
+		dropped++
+	}
+
+	attrs := make([]attribute.KeyValue, 0, n)
+	for _, e := range entries[dropped:] {
+		attrs = append(attrs, e.kv)
+	}
+	return NewWithAttributes(r.SchemaURL(), attrs...)
+}
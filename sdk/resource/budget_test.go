@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestResourceSize(t *testing.T) {
+	var nilRes *resource.Resource
+	assert.Zero(t, nilRes.Size())
+	assert.Zero(t, resource.Empty().Size())
+
+	small := resource.NewSchemaless(attribute.String("k", "v"))
+	large := resource.NewSchemaless(attribute.String("k", strings.Repeat("v", 1024)))
+	assert.Positive(t, small.Size())
+	assert.Greater(t, large.Size(), 1024)
+	assert.Greater(t, large.Size(), small.Size())
+}
+
+func TestBudgetExceeded(t *testing.T) {
+	r := resource.NewSchemaless(attribute.String("a", "1"), attribute.String("b", "2"))
+
+	assert.False(t, resource.Budget{}.Exceeded(r), "zero budget")
+	assert.False(t, resource.Budget{MaxAttributes: 2}.Exceeded(r))
+	assert.True(t, resource.Budget{MaxAttributes: 1}.Exceeded(r))
+	assert.False(t, resource.Budget{MaxBytes: r.Size()}.Exceeded(r))
+	assert.True(t, resource.Budget{MaxBytes: r.Size() - 1}.Exceeded(r))
+}
+
+func TestWithBudget(t *testing.T) {
+	args := attribute.StringSlice("process.command_args", []string{strings.Repeat("a", 4096)})
+	opts := []resource.Option{resource.WithAttributes(kv11, args)}
+
+	res, err := resource.New(t.Context(), append(opts, resource.WithBudget(resource.Budget{MaxAttributes: 2}))...)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, res.Len())
+
+	res, err = resource.New(t.Context(), append(opts, resource.WithBudget(resource.Budget{MaxBytes: 1024}))...)
+	require.ErrorIs(t, err, resource.ErrBudgetExceeded)
+	assert.Equal(t, 2, res.Len(), "resource not returned")
+}
+
+func TestTrim(t *testing.T) {
+	long := attribute.String("long", strings.Repeat("a", 1024))
+	short := attribute.String("short", "a")
+	important := attribute.String("important", strings.Repeat("b", 512))
+	r := resource.NewWithAttributes("https://opentelemetry.io/schemas/1.0.0", long, short, important)
+
+	priority := func(kv attribute.KeyValue) int {
+		if kv.Key == important.Key {
+			return 1
+		}
+		return 0
+	}
+
+	tests := []struct {
+		name     string
+		budget   resource.Budget
+		priority func(attribute.KeyValue) int
+		want     []attribute.KeyValue
+	}{
+		{
+			name:   "WithinBudget",
+			budget: resource.Budget{MaxAttributes: 3},
+			want:   []attribute.KeyValue{important, long, short},
+		},
+		{
+			name:   "LargestFirst",
+			budget: resource.Budget{MaxAttributes: 2},
+			want:   []attribute.KeyValue{important, short},
+		},
+		{
+			name:     "LowestPriorityFirst",
+			budget:   resource.Budget{MaxAttributes: 1},
+			priority: priority,
+			want:     []attribute.KeyValue{important},
+		},
+		{
+			name:     "MaxBytes",
+			budget:   resource.Budget{MaxBytes: 600},
+			priority: priority,
+			want:     []attribute.KeyValue{important, short},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resource.Trim(r, tt.budget, tt.priority)
+			assert.Equal(t, tt.want, got.Attributes())
+			assert.Equal(t, r.SchemaURL(), got.SchemaURL())
+			assert.False(t, tt.budget.Exceeded(got))
+		})
+	}
+}
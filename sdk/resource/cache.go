@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// detectorCache holds the results of the cached detectors of the process.
+var detectorCache = struct {
+	sync.Mutex
+	entries map[string]*cacheEntry
+}{entries: make(map[string]*cacheEntry)}
+
+// cacheEntry is the cached result of a detection.
+type cacheEntry struct {
+	// sem is held while the result is detected. It is a channel, instead of
+	// a mutex, so waiters can give up once their context is done.
+	sem  chan struct{}
+	done bool
+	res  *Resource
+	err  error
+}
+
+// cachedDetector is a Detector whose result is cached process-wide.
+type cachedDetector struct {
+	key      string
+	detector Detector
+}
+
+// NewCachedDetector returns a Detector that caches the result of detector in
+// a cache shared by the whole process under key. Only the first detection of
+// key calls its detector, all later ones return the cached result until the
+// cache is invalidated with InvalidateDetectorCache. Concurrent detections of
+// the same key wait for a single call of the detector, or until their context
+// is done.
+//
+// This avoids repeating expensive detections, e.g. querying a cloud metadata
+// endpoint, when multiple providers are created in the same process. The key
+// needs to identify what detector detects, e.g. "gcp", as different
+// detectors using the same key share the same result.
+//
+// A result is only cached if detector returns no error, or an error wrapping
+// ErrPartialResource. Other errors, e.g. a canceled context, are returned
+// without being cached.
+func NewCachedDetector(key string, detector Detector) Detector {
+	return cachedDetector{key: key, detector: detector}
+}
+
+// Detect returns the cached result of the detection, detecting it first if it
+// is not cached.
+func (d cachedDetector) Detect(ctx context.Context) (*Resource, error) {
+	if d.detector == nil {
+		return nil, nil
+	}
+
+	detectorCache.Lock()
+	e, ok := detectorCache.entries[d.key]
+	if !ok {
+		e = &cacheEntry{sem: make(chan struct{}, 1)}
+		detectorCache.entries[d.key] = e
+	}
+	detectorCache.Unlock()
+
+	select {
+	case e.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-e.sem }()
+	if e.done {
+		return e.res, e.err
+	}
+
+	res, err := d.detector.Detect(ctx)
+	if err == nil || errors.Is(err, ErrPartialResource) {
+		e.done, e.res, e.err = true, res, err
+	}
+	return res, err
+}
+
+// InvalidateDetectorCache removes the results cached for keys by the
+// Detectors returned by NewCachedDetector. The next detection of each of keys
+// calls its detector again. If no keys are passed, all the cached results are
+// removed.
+func InvalidateDetectorCache(keys ...string) {
+	detectorCache.Lock()
+	defer detectorCache.Unlock()
+
+	if len(keys) == 0 {
+		clear(detectorCache.entries)
+		return
+	}
+	for _, key := range keys {
+		delete(detectorCache.entries, key)
+	}
+}
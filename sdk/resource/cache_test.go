@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// countingDetector counts its detections and returns err.
+type countingDetector struct {
+	calls atomic.Int64
+	err   error
+}
+
+func (d *countingDetector) Detect(context.Context) (*resource.Resource, error) {
+	n := d.calls.Add(1)
+	return resource.NewSchemaless(attribute.Int64("call", n)), d.err
+}
+
+func TestNewCachedDetector(t *testing.T) {
+	t.Cleanup(func() { resource.InvalidateDetectorCache() })
+
+	d := new(countingDetector)
+	want := resource.NewSchemaless(attribute.Int64("call", 1))
+	for range 2 {
+		// Separately created detectors share the result of the key.
+		res, err := resource.New(t.Context(), resource.WithDetectors(resource.NewCachedDetector("TestNewCachedDetector", d)))
+		require.NoError(t, err)
+		assert.Equal(t, want, res)
+	}
+	assert.Equal(t, int64(1), d.calls.Load())
+
+	resource.InvalidateDetectorCache("TestNewCachedDetector")
+	res, err := resource.NewCachedDetector("TestNewCachedDetector", d).Detect(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, resource.NewSchemaless(attribute.Int64("call", 2)), res)
+}
+
+func TestNewCachedDetectorConcurrent(t *testing.T) {
+	t.Cleanup(func() { resource.InvalidateDetectorCache() })
+
+	d := new(countingDetector)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			_, err := resource.NewCachedDetector("TestNewCachedDetectorConcurrent", d).Detect(t.Context())
+			assert.NoError(t, err)
+		})
+	}
+	wg.Wait()
+	assert.Equal(t, int64(1), d.calls.Load())
+}
+
+// blockingDetector blocks its detections until release is closed.
+type blockingDetector struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (d blockingDetector) Detect(context.Context) (*resource.Resource, error) {
+	close(d.started)
+	<-d.release
+	return resource.Empty(), nil
+}
+
+func TestNewCachedDetectorWaitCanceled(t *testing.T) {
+	t.Cleanup(func() { resource.InvalidateDetectorCache() })
+
+	const key = "TestNewCachedDetectorWaitCanceled"
+	d := blockingDetector{started: make(chan struct{}), release: make(chan struct{})}
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		_, err := resource.NewCachedDetector(key, d).Detect(t.Context())
+		assert.NoError(t, err)
+	})
+	t.Cleanup(func() {
+		close(d.release)
+		wg.Wait()
+	})
+	<-d.started
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_, err := resource.NewCachedDetector(key, d).Detect(ctx)
+	assert.ErrorIs(t, err, context.Canceled, "waiter did not give up")
+}
+
+func TestNewCachedDetectorErrors(t *testing.T) {
+	t.Cleanup(func() { resource.InvalidateDetectorCache() })
+
+	failing := &countingDetector{err: context.DeadlineExceeded}
+	cached := resource.NewCachedDetector("failing", failing)
+	for range 2 {
+		_, err := cached.Detect(t.Context())
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+	assert.Equal(t, int64(2), failing.calls.Load(), "error cached")
+
+	partial := &countingDetector{err: resource.ErrPartialResource}
+	cached = resource.NewCachedDetector("partial", partial)
+	for range 2 {
+		_, err := cached.Detect(t.Context())
+		assert.ErrorIs(t, err, resource.ErrPartialResource)
+	}
+	assert.Equal(t, int64(1), partial.calls.Load(), "partial resource not cached")
+}
+
+func TestInvalidateDetectorCacheAll(t *testing.T) {
+	a, b := new(countingDetector), new(countingDetector)
+	detectA := resource.NewCachedDetector("a", a)
+	detectB := resource.NewCachedDetector("b", b)
+	for range 2 {
+		_, _ = detectA.Detect(t.Context())
+		_, _ = detectB.Detect(t.Context())
+		resource.InvalidateDetectorCache()
+	}
+	assert.Equal(t, int64(2), a.calls.Load())
+	assert.Equal(t, int64(2), b.calls.Load())
+}
+
+func TestNewCachedDetectorNil(t *testing.T) {
+	res, err := resource.NewCachedDetector("nil", nil).Detect(t.Context())
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
@@ -13,8 +13,16 @@ import (
 type config struct {
 	// detectors that will be evaluated.
 	detectors []Detector
+	// priorities of the detectors, indexed the same as detectors.
+	priorities []int
+	// priority assigned to the detectors being added.
+	priority int
+	// onConflict resolves attributes detected by multiple detectors.
+	onConflict func(Conflict) attribute.Value
 	// SchemaURL to associate with the Resource.
 	schemaURL string
+	// budget limits the size of the Resource.
+	budget Budget
 }
 
 // Option is the interface that applies a configuration option.
@@ -49,6 +57,65 @@ type detectorsOption struct {
 
 func (o detectorsOption) apply(cfg config) config {
 	cfg.detectors = append(cfg.detectors, o.detectors...)
+	for range o.detectors {
+		cfg.priorities = append(cfg.priorities, cfg.priority)
+	}
+	return cfg
+}
+
+// WithDetectorPriority sets the priority of the detectors added by opts. For
+// example, the following ensures the "service.name" set with the
+// OTEL_SERVICE_NAME environment variable is not overridden by any other
+// detector:
+//
+//	resource.New(ctx,
+//		resource.WithDetectorPriority(1, resource.WithFromEnv()),
+//		resource.WithDetectors(myDetector),
+//	)
+//
+// When multiple detectors detect the same attribute, the value from the
+// detector with the highest priority is used. If the priorities are equal,
+// the value from the detector evaluated last is used. Detectors added without
+// this option have a priority of 0.
+//
+// Use WithConflictHandler to customize how conflicts are resolved.
+func WithDetectorPriority(priority int, opts ...Option) Option {
+	return detectorPriorityOption{priority: priority, opts: opts}
+}
+
+type detectorPriorityOption struct {
+	priority int
+	opts     []Option
+}
+
+func (o detectorPriorityOption) apply(cfg config) config {
+	prev := cfg.priority
+	cfg.priority = o.priority
+	for _, opt := range o.opts {
+		cfg = opt.apply(cfg)
+	}
+	cfg.priority = prev
+	return cfg
+}
+
+// WithConflictHandler sets f to resolve attributes detected by multiple
+// detectors. The value returned by f is used for the attribute.
+//
+// The handler is called each time a detector detects an attribute key
+// already detected by a previously evaluated detector, regardless of their
+// values being equal or not. It is called synchronously during resource
+// creation.
+//
+// By default, the value from the detector with the highest priority is used,
+// see WithDetectorPriority.
+func WithConflictHandler(f func(Conflict) attribute.Value) Option {
+	return conflictHandlerOption(f)
+}
+
+type conflictHandlerOption func(Conflict) attribute.Value
+
+func (o conflictHandlerOption) apply(cfg config) config {
+	cfg.onConflict = o
 	return cfg
 }
 
@@ -90,6 +157,7 @@ func WithOS() Option {
 	return WithDetectors(
 		osTypeDetector{},
 		osDescriptionDetector{},
+		osVersionDetector{},
 	)
 }
 
@@ -105,6 +173,17 @@ func WithOSDescription() Option {
 	return WithDetectors(osDescriptionDetector{})
 }
 
+// WithOSVersion adds attributes with the operating system name, version, and
+// build ID to the configured Resource. Only the attributes known for the
+// operating system are added.
+//
+// On Linux and other Unix-like systems, the values are read from the
+// os-release file. On macOS, they are read from the system version property
+// list. On Windows, they are read from the registry.
+func WithOSVersion() Option {
+	return WithDetectors(osVersionDetector{})
+}
+
 // WithProcess adds all the Process attributes to the configured Resource.
 //
 // Warning! This option will include process command line arguments. If these
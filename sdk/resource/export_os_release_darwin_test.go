@@ -7,3 +7,9 @@ var (
 	ParsePlistFile = parsePlistFile
 	BuildOSRelease = buildOSRelease
 )
+
+// BuildOSVersion returns the fields of the osVersionInfo built from values.
+func BuildOSVersion(values map[string]string) (name, version, buildID string) {
+	info := buildOSVersion(values)
+	return info.name, info.version, info.buildID
+}
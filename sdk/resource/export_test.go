@@ -12,6 +12,7 @@ var (
 	SetUserProviders                = setUserProviders
 	SetDefaultOSDescriptionProvider = setDefaultOSDescriptionProvider
 	SetOSDescriptionProvider        = setOSDescriptionProvider
+	SetDefaultOSVersionProvider     = setDefaultOSVersionProvider
 	SetDefaultContainerProviders    = setDefaultContainerProviders
 	SetContainerProviders           = setContainerProviders
 )
@@ -24,3 +25,10 @@ var (
 )
 
 var MapRuntimeOSToSemconvOSType = mapRuntimeOSToSemconvOSType
+
+// SetOSVersion sets the OS version provider to return the passed values.
+func SetOSVersion(name, version, buildID string, err error) {
+	setOSVersionProvider(func() (osVersionInfo, error) {
+		return osVersionInfo{name: name, version: version, buildID: buildID}, err
+	})
+}
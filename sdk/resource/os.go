@@ -25,9 +25,32 @@ func setOSDescriptionProvider(osDescriptionProvider osDescriptionProvider) {
 	osDescription = osDescriptionProvider
 }
 
+// osVersionInfo identifies the release of the operating system. Empty fields
+// are unknown.
+type osVersionInfo struct {
+	name    string
+	version string
+	buildID string
+}
+
+type osVersionProvider func() (osVersionInfo, error)
+
+var defaultOSVersionProvider osVersionProvider = platformOSVersion
+
+var osVersion = defaultOSVersionProvider
+
+func setDefaultOSVersionProvider() {
+	setOSVersionProvider(defaultOSVersionProvider)
+}
+
+func setOSVersionProvider(osVersionProvider osVersionProvider) {
+	osVersion = osVersionProvider
+}
+
 type (
 	osTypeDetector        struct{}
 	osDescriptionDetector struct{}
+	osVersionDetector     struct{}
 )
 
 // Detect returns a *Resource that describes the operating system type the
@@ -57,6 +80,32 @@ func (osDescriptionDetector) Detect(context.Context) (*Resource, error) {
 	), nil
 }
 
+// Detect returns a *Resource that describes the name, version, and build ID
+// of the operating system the service is running on. Only the attributes
+// known for the operating system are included.
+func (osVersionDetector) Detect(context.Context) (*Resource, error) {
+	info, err := osVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	var attrs []attribute.KeyValue
+	if info.name != "" {
+		attrs = append(attrs, semconv.OSName(info.name))
+	}
+	if info.version != "" {
+		attrs = append(attrs, semconv.OSVersion(info.version))
+	}
+	if info.buildID != "" {
+		attrs = append(attrs, semconv.OSBuildID(info.buildID))
+	}
+	if len(attrs) == 0 {
+		return Empty(), nil
+	}
+
+	return NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
 // mapRuntimeOSToSemconvOSType translates the OS name as provided by the Go runtime
 // into an OS type attribute with the corresponding value defined by the semantic
 // conventions. In case the provided OS name isn't mapped, it's transformed to lowercase
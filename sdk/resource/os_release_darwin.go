@@ -43,6 +43,25 @@ func osRelease() string {
 	return buildOSRelease(values)
 }
 
+// osReleaseVersion returns the name, version, and build ID of the operating
+// system based on the contents of the property list (.plist) system files. If
+// no .plist file is found, an empty osVersionInfo is returned.
+func osReleaseVersion() osVersionInfo {
+	file, err := getPlistFile()
+	if err != nil {
+		return osVersionInfo{}
+	}
+
+	defer file.Close()
+
+	values, err := parsePlistFile(file)
+	if err != nil {
+		return osVersionInfo{}
+	}
+
+	return buildOSVersion(values)
+}
+
 // getPlistFile returns a *os.File pointing to one of the well-known .plist files
 // available on macOS. If no file can be opened, it returns an error.
 func getPlistFile() (*os.File, error) {
@@ -90,3 +109,14 @@ func buildOSRelease(properties map[string]string) string {
 
 	return fmt.Sprintf("%s %s (%s)", productName, productVersion, productBuildVersion)
 }
+
+// buildOSVersion returns the name, version, and build ID of the operating
+// system from the `ProductName`, `ProductVersion`, and `ProductBuildVersion`
+// properties available on the provided map.
+func buildOSVersion(properties map[string]string) osVersionInfo {
+	return osVersionInfo{
+		name:    properties["ProductName"],
+		version: properties["ProductVersion"],
+		buildID: properties["ProductBuildVersion"],
+	}
+}
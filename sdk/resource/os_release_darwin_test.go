@@ -159,3 +159,33 @@ func TestBuildOSRelease(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildOSVersion(t *testing.T) {
+	tt := []struct {
+		Name       string
+		Properties map[string]string
+		OSName     string
+		Version    string
+		BuildID    string
+	}{
+		{"Empty properties (nil)", nil, "", "", ""},
+		{"Missing product build version", map[string]string{
+			"ProductName":    "macOS",
+			"ProductVersion": "11.3",
+		}, "macOS", "11.3", ""},
+		{"All properties available", map[string]string{
+			"ProductName":         "macOS",
+			"ProductVersion":      "11.3",
+			"ProductBuildVersion": "20E232",
+		}, "macOS", "11.3", "20E232"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			name, version, buildID := resource.BuildOSVersion(tc.Properties)
+			require.Equal(t, tc.OSName, name)
+			require.Equal(t, tc.Version, version)
+			require.Equal(t, tc.BuildID, buildID)
+		})
+	}
+}
@@ -31,6 +31,22 @@ func osRelease() string {
 	return buildOSRelease(values)
 }
 
+// osReleaseVersion returns the name, version, and build ID of the operating
+// system based on the properties of the os-release file. If no os-release file
+// is found, an empty osVersionInfo is returned.
+func osReleaseVersion() osVersionInfo {
+	file, err := getOSReleaseFile()
+	if err != nil {
+		return osVersionInfo{}
+	}
+
+	defer file.Close()
+
+	values := parseOSReleaseFile(file)
+
+	return buildOSVersion(values)
+}
+
 // getOSReleaseFile returns a *os.File pointing to one of the well-known os-release
 // files, according to their order of preference. If no file can be opened, it
 // returns an error.
@@ -140,3 +156,14 @@ func buildOSRelease(values map[string]string) string {
 
 	return osRelease
 }
+
+// buildOSVersion returns the name, version, and build ID of the operating
+// system from the `NAME`, `VERSION_ID`, and `BUILD_ID` properties available on
+// the provided map.
+func buildOSVersion(values map[string]string) osVersionInfo {
+	return osVersionInfo{
+		name:    values["NAME"],
+		version: values["VERSION_ID"],
+		buildID: values["BUILD_ID"],
+	}
+}
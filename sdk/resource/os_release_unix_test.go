@@ -281,3 +281,39 @@ func TestBuildOSRelease(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildOSVersion(t *testing.T) {
+	tt := []struct {
+		Name    string
+		Values  map[string]string
+		OSName  string
+		Version string
+		BuildID string
+	}{
+		{"Nil values", nil, "", "", ""},
+		{"Empty values", map[string]string{}, "", "", ""},
+		{"Name and version ID", map[string]string{
+			"NAME":       "Ubuntu",
+			"VERSION":    "20.04.2 LTS (Focal Fossa)",
+			"VERSION_ID": "20.04",
+		}, "Ubuntu", "20.04", ""},
+		{"Rolling release with build ID", map[string]string{
+			"NAME":     "Arch Linux",
+			"BUILD_ID": "rolling",
+		}, "Arch Linux", "", "rolling"},
+		{"All properties available", map[string]string{
+			"NAME":       "Fedora Linux",
+			"VERSION_ID": "39",
+			"BUILD_ID":   "20231105",
+		}, "Fedora Linux", "39", "20231105"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.Name, func(t *testing.T) {
+			name, version, buildID := resource.BuildOSVersion(tc.Values)
+			require.Equal(t, tc.OSName, name)
+			require.Equal(t, tc.Version, version)
+			require.Equal(t, tc.BuildID, buildID)
+		})
+	}
+}
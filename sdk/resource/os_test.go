@@ -24,6 +24,8 @@ func mockRuntimeProviders() {
 	resource.SetOSDescriptionProvider(
 		func() (string, error) { return "Test", nil },
 	)
+
+	resource.SetOSVersion("Test OS", "1.2.3", "42", nil)
 }
 
 func TestMapRuntimeOSToSemconvOSType(t *testing.T) {
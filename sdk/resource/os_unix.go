@@ -43,6 +43,12 @@ func platformOSDescription() (string, error) {
 	return uname, nil
 }
 
+// platformOSVersion returns the name, version, and build ID of the operating
+// system based on its release information.
+func platformOSVersion() (osVersionInfo, error) {
+	return osReleaseVersion(), nil
+}
+
 // uname issues a uname(2) system call (or equivalent on systems which doesn't
 // have one) and formats the output in a single string, similar to the output
 // of the `uname` commandline program. The final string resembles the one
@@ -12,3 +12,10 @@ package resource
 func platformOSDescription() (string, error) {
 	return "<unknown>", nil
 }
+
+// platformOSVersion is a placeholder implementation for OSes for which this
+// project currently doesn't support os.name, os.version, and os.build_id
+// attributes detection.
+func platformOSVersion() (osVersionInfo, error) {
+	return osVersionInfo{}, nil
+}
@@ -50,6 +50,35 @@ func platformOSDescription() (string, error) {
 	), nil
 }
 
+// platformOSVersion returns the name, version, and build ID of the operating
+// system. It does so by querying registry values under the
+// `SOFTWARE\Microsoft\Windows NT\CurrentVersion` key. The version includes the
+// update build revision, identifying the patch level of the system.
+func platformOSVersion() (osVersionInfo, error) {
+	k, err := registry.OpenKey(
+		registry.LOCAL_MACHINE, `SOFTWARE\Microsoft\Windows NT\CurrentVersion`, registry.QUERY_VALUE,
+	)
+	if err != nil {
+		return osVersionInfo{}, err
+	}
+
+	defer k.Close()
+
+	currentBuildNumber := readCurrentBuildNumber(k)
+
+	return osVersionInfo{
+		name: readProductName(k),
+		version: fmt.Sprintf(
+			"%s.%s.%s.%s",
+			readCurrentMajorVersionNumber(k),
+			readCurrentMinorVersionNumber(k),
+			currentBuildNumber,
+			readUBR(k),
+		),
+		buildID: currentBuildNumber,
+	}, nil
+}
+
 func getStringValue(name string, k registry.Key) string {
 	value, _, _ := k.GetStringValue(name)
 
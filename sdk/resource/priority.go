@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import "go.opentelemetry.io/otel/attribute"
+
+// Conflict describes an attribute detected by more than one detector.
+type Conflict struct {
+	// Key is the key of the attribute.
+	Key attribute.Key
+	// Current is the value detected by the previously evaluated detectors.
+	Current attribute.Value
+	// CurrentPriority is the priority of the detector Current was detected
+	// by.
+	CurrentPriority int
+	// Detected is the value detected by the detector being evaluated.
+	Detected attribute.Value
+	// DetectedPriority is the priority of the detector being evaluated.
+	DetectedPriority int
+}
+
+// resolve returns the value from the detector with the highest priority,
+// favoring Detected if the priorities are equal.
+func (c Conflict) resolve() attribute.Value {
+	if c.DetectedPriority >= c.CurrentPriority {
+		return c.Detected
+	}
+	return c.Current
+}
+
+// priorityMerger merges detected resources based on the priority of their
+// detectors.
+type priorityMerger struct {
+	priorities []int
+	onConflict func(Conflict) attribute.Value
+
+	// keys holds the priority of each attribute merged.
+	keys map[attribute.Key]int
+}
+
+func newPriorityMerger(priorities []int, onConflict func(Conflict) attribute.Value) *priorityMerger {
+	m := &priorityMerger{onConflict: onConflict}
+	for _, p := range priorities {
+		if p != 0 {
+			m.priorities = priorities
+			break
+		}
+	}
+	if m.priorities != nil || m.onConflict != nil {
+		m.keys = make(map[attribute.Key]int)
+	}
+	return m
+}
+
+// merge merges b, detected by the i-th detector, into a.
+func (m *priorityMerger) merge(a, b *Resource, i int) (*Resource, error) {
+	if m.keys == nil || a == nil || b == nil {
+		// All detectors have the same priority, the last value wins.
+		return Merge(a, b)
+	}
+
+	var p int
+	if i < len(m.priorities) {
+		p = m.priorities[i]
+	}
+
+	attrs := make([]attribute.KeyValue, 0, b.Len())
+	iter := b.Iter()
+	for iter.Next() {
+		kv := iter.Attribute()
+		cur, ok := a.Set().Value(kv.Key)
+		if !ok {
+			m.keys[kv.Key] = p
+			attrs = append(attrs, kv)
+			continue
+		}
+
+		c := Conflict{
+			Key:              kv.Key,
+			Current:          cur,
+			CurrentPriority:  m.keys[kv.Key],
+			Detected:         kv.Value,
+			DetectedPriority: p,
+		}
+		v := c.resolve()
+		if m.onConflict != nil {
+			v = m.onConflict(c)
+		}
+		attrs = append(attrs, attribute.KeyValue{Key: kv.Key, Value: v})
+		m.keys[kv.Key] = max(c.CurrentPriority, c.DetectedPriority)
+	}
+	return Merge(a, NewWithAttributes(b.SchemaURL(), attrs...))
+}
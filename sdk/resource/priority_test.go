@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+func TestWithDetectorPriority(t *testing.T) {
+	t.Setenv("OTEL_SERVICE_NAME", "from-env")
+	override := resource.WithAttributes(semconv.ServiceName("from-detector"), kv11)
+
+	tests := []struct {
+		name string
+		opts []resource.Option
+		want []attribute.KeyValue
+	}{
+		{
+			name: "LastWins",
+			opts: []resource.Option{resource.WithFromEnv(), override},
+			want: []attribute.KeyValue{semconv.ServiceName("from-detector"), kv11},
+		},
+		{
+			name: "EnvProtected",
+			opts: []resource.Option{
+				resource.WithDetectorPriority(1, resource.WithFromEnv()),
+				override,
+			},
+			want: []attribute.KeyValue{semconv.ServiceName("from-env"), kv11},
+		},
+		{
+			name: "DetectorProtected",
+			opts: []resource.Option{
+				resource.WithDetectorPriority(1, override),
+				resource.WithFromEnv(),
+			},
+			want: []attribute.KeyValue{semconv.ServiceName("from-detector"), kv11},
+		},
+		{
+			name: "NegativePriority",
+			opts: []resource.Option{
+				resource.WithAttributes(kv12),
+				resource.WithDetectorPriority(-1, resource.WithAttributes(kv11, kv21)),
+			},
+			want: []attribute.KeyValue{kv12, kv21},
+		},
+		{
+			name: "EqualPriorityLastWins",
+			opts: []resource.Option{
+				resource.WithDetectorPriority(2, resource.WithAttributes(kv11)),
+				resource.WithDetectorPriority(2, resource.WithAttributes(kv12)),
+			},
+			want: []attribute.KeyValue{kv12},
+		},
+		{
+			name: "PriorityRestored",
+			opts: []resource.Option{
+				resource.WithDetectorPriority(1, resource.WithAttributes(kv11)),
+				resource.WithAttributes(kv12),
+			},
+			want: []attribute.KeyValue{kv11},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := resource.New(t.Context(), tt.opts...)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, res.Attributes())
+		})
+	}
+}
+
+func TestWithConflictHandler(t *testing.T) {
+	var got []resource.Conflict
+	res, err := resource.New(t.Context(),
+		resource.WithDetectorPriority(1, resource.WithAttributes(kv11)),
+		resource.WithAttributes(kv12, kv21),
+		resource.WithConflictHandler(func(c resource.Conflict) attribute.Value {
+			got = append(got, c)
+			return attribute.StringValue(c.Current.AsString() + "+" + c.Detected.AsString())
+		}),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []resource.Conflict{{
+		Key:              kv11.Key,
+		Current:          kv11.Value,
+		CurrentPriority:  1,
+		Detected:         kv12.Value,
+		DetectedPriority: 0,
+	}}, got)
+	assert.ElementsMatch(t, []attribute.KeyValue{attribute.String("k1", "v11+v12"), kv21}, res.Attributes())
+}
+
+func TestWithDetectorPrioritySchemaURL(t *testing.T) {
+	res, err := resource.New(t.Context(),
+		resource.WithDetectorPriority(1, resource.WithDetectors(
+			resource.StringDetector(v121, semconv.ServiceNameKey, func() (string, error) { return "a", nil }),
+		)),
+		resource.WithAttributes(semconv.ServiceName("b")),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, v121, res.SchemaURL())
+	assert.Equal(t, []attribute.KeyValue{semconv.ServiceName("a")}, res.Attributes())
+}
@@ -90,6 +90,7 @@ func restoreAttributesProviders() {
 	resource.SetDefaultRuntimeProviders()
 	resource.SetDefaultUserProviders()
 	resource.SetDefaultOSDescriptionProvider()
+	resource.SetDefaultOSVersionProvider()
 	resource.SetDefaultContainerProviders()
 }
 
@@ -5,6 +5,7 @@ package resource
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -58,7 +59,8 @@ var ErrSchemaURLConflict = errors.New("conflicting Schema URL")
 // [ErrSchemaURLConflict] if merging Resources from the opts results in a
 // schema URL conflict (see [Resource.Merge] for more information). It is up to
 // the caller to determine if this returned Resource should be used or not
-// based on these errors.
+// based on these errors. If the Resource exceeds the Budget set with
+// [WithBudget], the error also contains [ErrBudgetExceeded].
 func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	cfg := config{}
 	for _, opt := range opts {
@@ -66,7 +68,11 @@ func New(ctx context.Context, opts ...Option) (*Resource, error) {
 	}
 
 	r := &Resource{schemaURL: cfg.schemaURL}
-	return r, detect(ctx, r, cfg.detectors)
+	err := detectPrioritized(ctx, r, cfg.detectors, cfg.priorities, cfg.onConflict)
+	if e := cfg.budget.check(r); e != nil {
+		err = errors.Join(err, e)
+	}
+	return r, err
 }
 
 // NewWithAttributes creates a resource from attrs and associates the resource
@@ -314,6 +320,22 @@ func (r *Resource) MarshalJSON() ([]byte, error) {
 	return r.attrs.MarshalJSON()
 }
 
+// UnmarshalJSON decodes the JSON encoding of resource attributes, as returned
+// by MarshalJSON, into r. This allows a Resource to be cached, e.g. on disk, or
+// passed to another process, e.g. an executed child process.
+//
+// The schema URL of a Resource is not part of its JSON encoding. The decoded
+// Resource has no schema URL. Use NewWithAttributes with the decoded
+// attributes to set one.
+func (r *Resource) UnmarshalJSON(data []byte) error {
+	var kvs []attribute.KeyValue
+	if err := json.Unmarshal(data, &kvs); err != nil {
+		return err
+	}
+	*r = *NewSchemaless(kvs...)
+	return nil
+}
+
 // Len returns the number of unique key-values in this Resource.
 func (r *Resource) Len() int {
 	if r == nil {
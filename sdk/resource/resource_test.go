@@ -403,6 +403,22 @@ func TestMarshalJSON(t *testing.T) {
 		string(data))
 }
 
+func TestUnmarshalJSON(t *testing.T) {
+	want := resource.NewSchemaless(
+		attribute.Int64("A", 1),
+		attribute.String("C", "D"),
+		attribute.StringSlice("E", []string{"F", "G"}),
+	)
+	data, err := json.Marshal(want)
+	require.NoError(t, err)
+
+	got := new(resource.Resource)
+	require.NoError(t, json.Unmarshal(data, got))
+	assert.True(t, want.Equal(got), "got %v, want %v", got, want)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"Key":"A"}`), got))
+}
+
 func TestNew(t *testing.T) {
 	tc := []struct {
 		name      string
@@ -658,7 +674,41 @@ func TestWithOS(t *testing.T) {
 	require.Equal(t, map[string]string{
 		"os.type":        "linux",
 		"os.description": "Test",
+		"os.name":        "Test OS",
+		"os.version":     "1.2.3",
+		"os.build_id":    "42",
+	}, toMap(res))
+}
+
+func TestWithOSVersion(t *testing.T) {
+	mockRuntimeProviders()
+	t.Cleanup(restoreAttributesProviders)
+
+	ctx := t.Context()
+
+	res, err := resource.New(ctx, resource.WithOSVersion())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"os.name":     "Test OS",
+		"os.version":  "1.2.3",
+		"os.build_id": "42",
 	}, toMap(res))
+
+	resource.SetOSVersion("Test OS", "", "", nil)
+	res, err = resource.New(ctx, resource.WithOSVersion())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"os.name": "Test OS",
+	}, toMap(res), "unknown attributes included")
+
+	resource.SetOSVersion("", "", "", nil)
+	res, err = resource.New(ctx, resource.WithOSVersion())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{}, toMap(res))
+
+	resource.SetOSVersion("", "", "", assert.AnError)
+	_, err = resource.New(ctx, resource.WithOSVersion())
+	assert.ErrorIs(t, err, assert.AnError)
 }
 
 func TestWithProcessPID(t *testing.T) {
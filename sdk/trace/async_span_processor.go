@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
+)
+
+// asyncFlush is queued by ForceFlush to synchronize with all workers of an
+// asyncSpanProcessor.
+type asyncFlush struct {
+	ReadOnlySpan
+
+	// arrived is done by each worker that receives the asyncFlush.
+	arrived *sync.WaitGroup
+	// release is closed when the workers may continue processing spans.
+	release chan struct{}
+}
+
+// asyncSpanProcessor is a SpanProcessor that calls the OnEnd method of the
+// wrapped SpanProcessor from a pool of worker goroutines.
+type asyncSpanProcessor struct {
+	inner   SpanProcessor
+	workers int
+
+	queue   chan ReadOnlySpan
+	dropped atomic.Uint64
+
+	inst *observ.ASP
+
+	// flushing serializes ForceFlush calls. Each worker blocks on the
+	// asyncFlush it receives, so the asyncFlush of concurrent calls would
+	// otherwise be split across the workers and none would complete.
+	flushing chan struct{}
+
+	// mu guards sending to queue against closing it on shutdown.
+	mu       sync.RWMutex
+	stopped  bool
+	wg       sync.WaitGroup
+	stopOnce sync.Once
+}
+
+var _ SpanProcessor = (*asyncSpanProcessor)(nil)
+
+// NewAsyncProcessor returns a SpanProcessor that calls the OnEnd method of
+// inner from a pool of workers goroutines instead of the goroutine ending the
+// span. This removes the latency of expensive processors, for example ones
+// performing enrichment lookups, from span.End.
+//
+// Ended spans are buffered in a queue holding at most queue spans. If the
+// queue is full, the ended span is dropped and is not passed to inner. The
+// number of dropped spans is reported by the experimental SDK observability
+// metrics.
+//
+// The OnStart method of inner is still called synchronously, as a span can
+// only be modified while it is being started.
+//
+// ForceFlush and Shutdown wait for all queued spans to be passed to inner
+// before calling the same method of inner.
+//
+// If workers is less than one, one worker is used. If queue is less than one,
+// DefaultMaxQueueSize is used.
+func NewAsyncProcessor(inner SpanProcessor, workers, queue int) SpanProcessor {
+	if workers < 1 {
+		workers = 1
+	}
+	if queue < 1 {
+		queue = DefaultMaxQueueSize
+	}
+
+	p := &asyncSpanProcessor{
+		inner:    inner,
+		workers:  workers,
+		queue:    make(chan ReadOnlySpan, queue),
+		flushing: make(chan struct{}, 1),
+	}
+
+	var err error
+	p.inst, err = observ.NewASP(
+		nextProcessorID(),
+		func() int64 { return int64(len(p.queue)) },
+		int64(queue),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	for range workers {
+		p.wg.Go(p.work)
+	}
+	return p
+}
+
+// work passes queued spans to the inner processor until the queue is closed.
+func (p *asyncSpanProcessor) work() {
+	ctx := context.Background()
+	for s := range p.queue {
+		if f, ok := s.(asyncFlush); ok {
+			f.arrived.Done()
+			<-f.release
+			continue
+		}
+		p.inner.OnEnd(s)
+		if p.inst != nil {
+			p.inst.Processed(ctx, 1)
+		}
+	}
+}
+
+// OnStart calls the OnStart method of the wrapped processor.
+func (p *asyncSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	p.inner.OnStart(parent, s)
+}
+
+// OnEnd queues s to be passed to the wrapped processor. If the queue is full,
+// s is dropped.
+func (p *asyncSpanProcessor) OnEnd(s ReadOnlySpan) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.stopped {
+		return
+	}
+
+	select {
+	case p.queue <- s:
+	default:
+		if p.dropped.Add(1) == 1 {
+			global.Warn("async span processor queue is full, dropping spans", "capacity", cap(p.queue))
+		}
+		if p.inst != nil {
+			p.inst.ProcessedQueueFull(context.Background(), 1)
+		}
+	}
+}
+
+// ForceFlush waits for all queued spans to be passed to the wrapped processor
+// and then flushes it.
+func (p *asyncSpanProcessor) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case p.flushing <- struct{}{}:
+		defer func() { <-p.flushing }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	p.mu.RLock()
+	if p.stopped {
+		p.mu.RUnlock()
+		return nil
+	}
+
+	// Block every worker once all spans queued before them are processed.
+	f := asyncFlush{arrived: new(sync.WaitGroup), release: make(chan struct{})}
+	defer close(f.release)
+	for range p.workers {
+		f.arrived.Add(1)
+		select {
+		case p.queue <- f:
+		case <-ctx.Done():
+			p.mu.RUnlock()
+			return ctx.Err()
+		}
+	}
+	p.mu.RUnlock()
+
+	done := make(chan struct{})
+	go func() {
+		f.arrived.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return p.inner.ForceFlush(ctx)
+}
+
+// Shutdown stops accepting spans, waits for all queued spans to be passed to
+// the wrapped processor, and then shuts it down. If ctx is done before all
+// queued spans are processed, the wrapped processor is shut down without
+// waiting for them. It only executes once. Subsequent calls do nothing.
+func (p *asyncSpanProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		p.mu.Lock()
+		p.stopped = true
+		close(p.queue)
+		p.mu.Unlock()
+
+		done := make(chan struct{})
+		go func() {
+			p.wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+			err = p.inner.Shutdown(ctx)
+		case <-ctx.Done():
+			// Still shut down the wrapped processor, the remaining queued
+			// spans are passed to it as they are processed.
+			err = errors.Join(ctx.Err(), p.inner.Shutdown(ctx))
+		}
+
+		if p.inst != nil {
+			err = errors.Join(err, p.inst.Shutdown())
+		}
+	})
+	return err
+}
+
+// Health returns a snapshot of the health of the asyncSpanProcessor.
+func (p *asyncSpanProcessor) Health() otel.HealthStatus {
+	p.mu.RLock()
+	stopped := p.stopped
+	p.mu.RUnlock()
+
+	return otel.HealthStatus{
+		Component:     "AsyncSpanProcessor",
+		QueueSize:     len(p.queue),
+		QueueCapacity: cap(p.queue),
+		Shutdown:      stopped,
+	}
+}
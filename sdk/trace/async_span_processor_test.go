@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// asyncTestProcessor is a concurrent safe SpanProcessor that records ended
+// spans. If block is not nil, OnEnd waits for it to be closed.
+type asyncTestProcessor struct {
+	block chan struct{}
+	// entered receives the name of each span passed to OnEnd, if not nil.
+	entered chan string
+
+	mu       sync.Mutex
+	ended    []string
+	flushed  int
+	shutdown int
+}
+
+func (*asyncTestProcessor) OnStart(context.Context, ReadWriteSpan) {}
+
+func (p *asyncTestProcessor) OnEnd(s ReadOnlySpan) {
+	if p.entered != nil {
+		p.entered <- s.Name()
+	}
+	if p.block != nil {
+		<-p.block
+	}
+	p.mu.Lock()
+	p.ended = append(p.ended, s.Name())
+	p.mu.Unlock()
+}
+
+func (p *asyncTestProcessor) ForceFlush(context.Context) error {
+	p.mu.Lock()
+	p.flushed++
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *asyncTestProcessor) Shutdown(context.Context) error {
+	p.mu.Lock()
+	p.shutdown++
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *asyncTestProcessor) Ended() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.ended...)
+}
+
+func TestAsyncProcessor(t *testing.T) {
+	inner := &asyncTestProcessor{}
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewAsyncProcessor(inner, 4, 10))
+	tr := tp.Tracer("AsyncProcessor")
+
+	names := []string{"a", "b", "c", "d", "e"}
+	for _, name := range names {
+		_, span := tr.Start(t.Context(), name)
+		span.End()
+	}
+
+	require.NoError(t, tp.ForceFlush(t.Context()))
+	assert.ElementsMatch(t, names, inner.Ended())
+	assert.Equal(t, 1, inner.flushed)
+}
+
+func TestAsyncProcessorDoesNotBlockOnEnd(t *testing.T) {
+	inner := &asyncTestProcessor{block: make(chan struct{})}
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewAsyncProcessor(inner, 1, 10))
+	tr := tp.Tracer("AsyncProcessor")
+
+	ended := make(chan struct{})
+	go func() {
+		defer close(ended)
+		_, span := tr.Start(t.Context(), "span")
+		span.End()
+	}()
+
+	select {
+	case <-ended:
+	case <-time.After(5 * time.Second):
+		t.Fatal("span.End blocked on the inner processor")
+	}
+	assert.Empty(t, inner.Ended())
+
+	close(inner.block)
+	require.NoError(t, tp.ForceFlush(t.Context()))
+	assert.Equal(t, []string{"span"}, inner.Ended())
+}
+
+func TestAsyncProcessorDropsWhenFull(t *testing.T) {
+	inner := &asyncTestProcessor{
+		block:   make(chan struct{}),
+		entered: make(chan string, 1),
+	}
+	p := NewAsyncProcessor(inner, 1, 1).(*asyncSpanProcessor)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+	tr := tp.Tracer("AsyncProcessor")
+
+	_, span := tr.Start(t.Context(), "processing")
+	span.End()
+	// Wait for the worker to be blocked processing the first span.
+	assert.Equal(t, "processing", <-inner.entered)
+	inner.entered = nil
+
+	for _, name := range []string{"queued", "dropped"} {
+		_, span := tr.Start(t.Context(), name)
+		span.End()
+	}
+	assert.Equal(t, uint64(1), p.dropped.Load())
+
+	h := p.Health()
+	assert.Equal(t, 1, h.QueueSize)
+	assert.Equal(t, 1, h.QueueCapacity)
+
+	close(inner.block)
+	require.NoError(t, tp.ForceFlush(t.Context()))
+	assert.Equal(t, []string{"processing", "queued"}, inner.Ended())
+}
+
+func TestAsyncProcessorForceFlushCanceled(t *testing.T) {
+	inner := &asyncTestProcessor{block: make(chan struct{})}
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewAsyncProcessor(inner, 1, 10))
+	tr := tp.Tracer("AsyncProcessor")
+
+	_, span := tr.Start(t.Context(), "span")
+	span.End()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, tp.ForceFlush(ctx), context.DeadlineExceeded)
+	assert.Equal(t, 0, inner.flushed)
+
+	close(inner.block)
+	require.NoError(t, tp.ForceFlush(t.Context()))
+	assert.Equal(t, []string{"span"}, inner.Ended())
+}
+
+func TestAsyncProcessorConcurrentForceFlush(t *testing.T) {
+	inner := &asyncTestProcessor{}
+	p := NewAsyncProcessor(inner, 4, 16)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Go(func() {
+				assert.NoError(t, p.ForceFlush(context.Background()))
+			})
+		}
+		wg.Wait()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("concurrent ForceFlush calls did not return")
+	}
+	assert.Equal(t, 8, inner.flushed)
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
+
+func TestAsyncProcessorShutdownCanceled(t *testing.T) {
+	inner := &asyncTestProcessor{block: make(chan struct{})}
+	p := NewAsyncProcessor(inner, 1, 10)
+	defer close(inner.block)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+
+	_, span := tp.Tracer("AsyncProcessor").Start(t.Context(), "span")
+	span.End()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, p.Shutdown(ctx), context.DeadlineExceeded)
+	inner.mu.Lock()
+	defer inner.mu.Unlock()
+	assert.Equal(t, 1, inner.shutdown, "inner not shut down")
+}
+
+func TestAsyncProcessorShutdown(t *testing.T) {
+	inner := &asyncTestProcessor{}
+	p := NewAsyncProcessor(inner, 2, 10)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+	tr := tp.Tracer("AsyncProcessor")
+
+	_, span := tr.Start(t.Context(), "before")
+	span.End()
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, []string{"before"}, inner.Ended(), "queued spans processed")
+	assert.Equal(t, 1, inner.shutdown)
+	assert.True(t, p.(*asyncSpanProcessor).Health().Shutdown)
+
+	_, span = tr.Start(t.Context(), "after")
+	span.End()
+	assert.Equal(t, []string{"before"}, inner.Ended(), "span ended after shutdown")
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, inner.shutdown, "inner shutdown more than once")
+	assert.NoError(t, p.ForceFlush(t.Context()))
+}
+
+func TestAsyncProcessorConcurrentSafe(t *testing.T) {
+	inner := &asyncTestProcessor{}
+	p := NewAsyncProcessor(inner, 4, 16)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+	tr := tp.Tracer("AsyncProcessor")
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 100 {
+				_, span := tr.Start(t.Context(), "span")
+				span.End()
+			}
+		})
+	}
+	wg.Go(func() {
+		_ = p.ForceFlush(t.Context())
+	})
+	wg.Wait()
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	dropped := p.(*asyncSpanProcessor).dropped.Load()
+	assert.Equal(t, 800, len(inner.Ended())+int(dropped))
+}
@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	// ErrAttributeNamespace is reported for attribute keys that are neither
+	// in one of the namespaces of an AttributeNamespacePolicy nor in one of
+	// the namespaces of the semantic conventions.
+	ErrAttributeNamespace = errors.New("attribute key not in an allowed namespace")
+
+	// ErrSemconvNamespaceCollision is reported for attribute keys in a
+	// namespace of an AttributeNamespacePolicy that is within a namespace of
+	// the semantic conventions.
+	ErrSemconvNamespaceCollision = errors.New("attribute key collides with a semantic conventions namespace")
+)
+
+// defaultSemconvNamespaces are the top-level namespaces of the attributes of
+// the OpenTelemetry semantic conventions.
+var defaultSemconvNamespaces = []string{
+	"android", "app", "artifact", "aws", "az", "azure", "browser", "cassandra",
+	"cicd", "client", "cloud", "cloudevents", "cloudfoundry", "code",
+	"container", "cpu", "cpython", "db", "deployment", "destination",
+	"device", "disk", "dns", "dotnet", "elasticsearch", "enduser", "error",
+	"event", "exception", "faas", "feature_flag", "file", "gcp", "gen_ai",
+	"geo", "go", "graphql", "heroku", "host", "http", "hw", "ios", "jvm",
+	"k8s", "linux", "log", "mainframe", "messaging", "network", "nodejs",
+	"oci", "openai", "opentracing", "os", "otel", "peer", "process",
+	"profile", "rpc", "security_rule", "server", "service", "session",
+	"source", "system", "telemetry", "test", "thread", "tls", "url", "user",
+	"user_agent", "v8js", "vcs", "webengine", "zos",
+}
+
+// AttributeNamespacePolicy is a policy enforcing the namespaces of the keys
+// of span attributes, e.g. to require custom attributes of an organization
+// to be prefixed with "myorg.".
+type AttributeNamespacePolicy struct {
+	// Namespaces are the namespaces custom attribute keys need to be in. A
+	// key is in a namespace if it is the namespace or is prefixed with the
+	// namespace followed by a dot, e.g. "myorg.team" is in "myorg".
+	Namespaces []string
+
+	// SemconvNamespaces are the namespaces of the semantic conventions.
+	// Attribute keys in these namespaces, e.g. set by instrumentation
+	// libraries, are allowed. Keys in one of Namespaces within one of these
+	// namespaces, e.g. "http.myorg.team" for the "http.myorg" namespace,
+	// collide with the semantic conventions.
+	//
+	// If nil, the namespaces of the OpenTelemetry semantic conventions are
+	// used.
+	SemconvNamespaces []string
+
+	// Reject is whether the attributes violating the policy are dropped.
+	// Otherwise, they are only reported. Dropped attributes are counted as
+	// such by the span.
+	Reject bool
+
+	// Report is called with each violation of the policy. If nil, the
+	// violations are reported to the global ErrorHandler. It is called
+	// synchronously when the attribute is set, and must not call the methods
+	// of the span.
+	Report func(*AttributeNamespaceError)
+}
+
+// AttributeNamespaceError is a violation of an AttributeNamespacePolicy by
+// the key of a span attribute.
+type AttributeNamespaceError struct {
+	// SpanName is the name of the span the attribute is set on.
+	SpanName string
+	// Key is the key of the attribute.
+	Key attribute.Key
+	// Err is either ErrAttributeNamespace or ErrSemconvNamespaceCollision.
+	Err error
+}
+
+// Error returns the violation with the key and the name of the span.
+func (e *AttributeNamespaceError) Error() string {
+	return fmt.Sprintf("invalid attribute %q of span %q: %s", e.Key, e.SpanName, e.Err)
+}
+
+// Unwrap returns ErrAttributeNamespace or ErrSemconvNamespaceCollision.
+func (e *AttributeNamespaceError) Unwrap() error {
+	return e.Err
+}
+
+// attributeNamespaces enforces an AttributeNamespacePolicy.
+type attributeNamespaces struct {
+	custom  []string
+	semconv []string
+	reject  bool
+	report  func(*AttributeNamespaceError)
+}
+
+func newAttributeNamespaces(p AttributeNamespacePolicy) *attributeNamespaces {
+	a := &attributeNamespaces{
+		custom:  p.Namespaces,
+		semconv: p.SemconvNamespaces,
+		reject:  p.Reject,
+		report:  p.Report,
+	}
+	if a.semconv == nil {
+		a.semconv = defaultSemconvNamespaces
+	}
+	if a.report == nil {
+		a.report = func(err *AttributeNamespaceError) { otel.Handle(err) }
+	}
+	return a
+}
+
+// inNamespace returns the first of namespaces key is in, and whether it is in
+// any.
+func inNamespace(key string, namespaces []string) (string, bool) {
+	for _, ns := range namespaces {
+		if strings.HasPrefix(key, ns) && (len(key) == len(ns) || key[len(ns)] == '.') {
+			return ns, true
+		}
+	}
+	return "", false
+}
+
+// check returns the violation of the policy by key, or nil.
+func (a *attributeNamespaces) check(key attribute.Key) error {
+	if ns, ok := inNamespace(string(key), a.custom); ok {
+		if _, ok := inNamespace(ns, a.semconv); ok {
+			return ErrSemconvNamespaceCollision
+		}
+		return nil
+	}
+	if _, ok := inNamespace(string(key), a.semconv); ok {
+		return nil
+	}
+	return ErrAttributeNamespace
+}
+
+// enforce reports the attributes of the span named name violating the
+// policy. It returns attrs without them if they are rejected, and the number
+// of rejected attributes. The passed attrs are not modified.
+func (a *attributeNamespaces) enforce(name string, attrs []attribute.KeyValue) ([]attribute.KeyValue, int) {
+	var kept []attribute.KeyValue
+	for i, kv := range attrs {
+		err := a.check(kv.Key)
+		if err == nil {
+			if kept != nil {
+				kept = append(kept, kv)
+			}
+			continue
+		}
+		a.report(&AttributeNamespaceError{SpanName: name, Key: kv.Key, Err: err})
+		if a.reject && kept == nil {
+			kept = make([]attribute.KeyValue, i, len(attrs)-1)
+			copy(kept, attrs[:i])
+		}
+	}
+	if kept == nil {
+		return attrs, 0
+	}
+	return kept, len(attrs) - len(kept)
+}
+
+// WithAttributeNamespacePolicy returns a TracerProviderOption enforcing
+// policy for the attributes of the spans started by the TracerProvider. This
+// can be used to enforce the hygiene of the attributes of an organization
+// centrally, without reviewing all its instrumentation.
+//
+// The policy applies to the attributes set when a span is started and with
+// its SetAttributes method, not to the attributes of its events and links.
+//
+// If policy has no Namespaces, this option has no effect. Using this option
+// multiple times, only the last one is used.
+func WithAttributeNamespacePolicy(policy AttributeNamespacePolicy) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if len(policy.Namespaces) == 0 {
+			return cfg
+		}
+		cfg.attrNamespaces = newAttributeNamespaces(policy)
+		return cfg
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAttributeNamespacesCheck(t *testing.T) {
+	a := newAttributeNamespaces(AttributeNamespacePolicy{
+		Namespaces: []string{"myorg", "acme.billing", "http.myorg"},
+	})
+	tests := []struct {
+		key  attribute.Key
+		want error
+	}{
+		{key: "myorg.team"},
+		{key: "myorg"},
+		{key: "myorg.team.name"},
+		{key: "acme.billing.plan"},
+		{key: "http.request.method"},
+		{key: "service.name"},
+		{key: "myorgs.team", want: ErrAttributeNamespace},
+		{key: "acme.team", want: ErrAttributeNamespace},
+		{key: "team", want: ErrAttributeNamespace},
+		{key: "", want: ErrAttributeNamespace},
+		{key: "http.myorg.team", want: ErrSemconvNamespaceCollision},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.key), func(t *testing.T) {
+			assert.Equal(t, tt.want, a.check(tt.key))
+		})
+	}
+}
+
+func TestAttributeNamespacesSemconvNamespaces(t *testing.T) {
+	a := newAttributeNamespaces(AttributeNamespacePolicy{
+		Namespaces:        []string{"myorg"},
+		SemconvNamespaces: []string{"http"},
+	})
+	assert.NoError(t, a.check("http.request.method"))
+	assert.ErrorIs(t, a.check("url.full"), ErrAttributeNamespace)
+}
+
+func TestAttributeNamespacesEnforce(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		attribute.String("bad.a", "a"),
+		attribute.String("myorg.b", "b"),
+		attribute.String("bad.c", "c"),
+		attribute.String("myorg.d", "d"),
+	}
+	orig := make([]attribute.KeyValue, len(attrs))
+	copy(orig, attrs)
+
+	var reported []attribute.Key
+	policy := AttributeNamespacePolicy{
+		Namespaces: []string{"myorg"},
+		Report:     func(err *AttributeNamespaceError) { reported = append(reported, err.Key) },
+	}
+
+	got, rejected := newAttributeNamespaces(policy).enforce("span", attrs)
+	assert.Equal(t, attrs, got, "attributes only reported")
+	assert.Zero(t, rejected)
+	assert.Equal(t, []attribute.Key{"bad.a", "bad.c"}, reported)
+
+	reported = nil
+	policy.Reject = true
+	got, rejected = newAttributeNamespaces(policy).enforce("span", attrs)
+	assert.Equal(t, []attribute.KeyValue{attrs[1], attrs[3]}, got)
+	assert.Equal(t, 2, rejected)
+	assert.Equal(t, []attribute.Key{"bad.a", "bad.c"}, reported)
+	assert.Equal(t, orig, attrs, "passed attributes modified")
+
+	got, rejected = newAttributeNamespaces(policy).enforce("span", attrs[1:2])
+	assert.Equal(t, attrs[1:2], got)
+	assert.Zero(t, rejected)
+}
+
+func TestWithAttributeNamespacePolicy(t *testing.T) {
+	var got []*AttributeNamespaceError
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithAttributeNamespacePolicy(AttributeNamespacePolicy{
+			Namespaces: []string{"myorg"},
+			Reject:     true,
+			Report:     func(err *AttributeNamespaceError) { got = append(got, err) },
+		}),
+	)
+
+	_, span := tp.Tracer("TestWithAttributeNamespacePolicy").Start(
+		t.Context(),
+		"span",
+		trace.WithAttributes(attribute.String("team", "core"), attribute.String("myorg.team", "core")),
+	)
+	span.SetAttributes(attribute.String("http.request.method", "GET"), attribute.Int("retries", 1))
+	span.End()
+
+	require.Len(t, got, 2)
+	assert.Equal(t, attribute.Key("team"), got[0].Key)
+	assert.Equal(t, attribute.Key("retries"), got[1].Key)
+	assert.ErrorIs(t, got[0], ErrAttributeNamespace)
+	assert.Contains(t, got[0].Error(), `"span"`)
+
+	s, ok := te.GetSpan("span")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("myorg.team", "core"),
+		attribute.String("http.request.method", "GET"),
+	}, s.Attributes())
+	assert.Equal(t, 2, s.DroppedAttributes())
+}
+
+func TestWithAttributeNamespacePolicyErrorHandler(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithAttributeNamespacePolicy(AttributeNamespacePolicy{Namespaces: []string{"myorg"}}),
+	)
+	_, span := tp.Tracer("TestWithAttributeNamespacePolicyErrorHandler").Start(t.Context(), "span")
+	span.SetAttributes(attribute.String("team", "core"))
+	span.End()
+
+	require.Len(t, handled, 1)
+	var nsErr *AttributeNamespaceError
+	require.ErrorAs(t, handled[0], &nsErr)
+	assert.Equal(t, attribute.Key("team"), nsErr.Key)
+
+	s, ok := te.GetSpan("span")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("team", "core")}, s.Attributes(), "attribute not kept")
+	assert.Zero(t, s.DroppedAttributes())
+}
+
+func TestWithAttributeNamespacePolicyNoNamespaces(t *testing.T) {
+	tp := NewTracerProvider(WithAttributeNamespacePolicy(AttributeNamespacePolicy{Reject: true}))
+	assert.Nil(t, tp.attrNamespaces)
+}
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// attributeValidation validates the attributes of the spans of a
+// TracerProvider.
+type attributeValidation struct {
+	policy   attribute.ValidationPolicy
+	sanitize bool
+}
+
+// apply returns attrs sanitized if v sanitizes them, and without the
+// attributes violating the policy, and the number of dropped attributes. The
+// passed attrs are not modified.
+func (v *attributeValidation) apply(attrs []attribute.KeyValue) ([]attribute.KeyValue, int) {
+	var (
+		out     []attribute.KeyValue
+		dropped int
+	)
+	for i, kv := range attrs {
+		changed := false
+		if v.sanitize {
+			kv, changed = attribute.Sanitize(kv, v.policy)
+		}
+		err := attribute.Validate(kv, v.policy)
+		if err != nil {
+			otel.Handle(err)
+			dropped++
+		}
+		if out == nil && (err != nil || changed) {
+			out = make([]attribute.KeyValue, i, len(attrs))
+			copy(out, attrs[:i])
+		}
+		if out != nil && err == nil {
+			out = append(out, kv)
+		}
+	}
+	if out == nil {
+		return attrs, 0
+	}
+	return out, dropped
+}
+
+// WithAttributeValidation returns a TracerProviderOption validating the
+// attributes of the spans started by the TracerProvider, and of their events
+// and links, against policy. See [attribute.Validate]. This prevents
+// malformed attributes, e.g. strings that are not valid UTF-8, from making
+// exporters fail to encode whole batches of spans.
+//
+// If sanitize is true, the attributes are sanitized first, see
+// [attribute.Sanitize]. Invalid UTF-8 is then replaced and over-long values
+// are truncated instead of being dropped.
+//
+// The attributes violating policy are dropped, counted as dropped by their
+// span, event, or link, and reported to the global ErrorHandler.
+//
+// Using this option multiple times, only the last one is used.
+func WithAttributeValidation(policy attribute.ValidationPolicy, sanitize bool) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.attrValidation = &attributeValidation{policy: policy, sanitize: sanitize}
+		return cfg
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestAttributeValidationApply(t *testing.T) {
+	attrs := []attribute.KeyValue{
+		attribute.String("valid", "ok"),
+		attribute.String("utf8", "a\xffb"),
+		attribute.String("long", "too long"),
+		attribute.String("otel.reserved", "ok"),
+	}
+	orig := make([]attribute.KeyValue, len(attrs))
+	copy(orig, attrs)
+
+	policy := attribute.ValidationPolicy{
+		RequireUTF8:      true,
+		MaxValueLength:   4,
+		ReservedPrefixes: []string{"otel."},
+	}
+
+	v := &attributeValidation{policy: policy}
+	got, dropped := v.apply(attrs)
+	assert.Equal(t, attrs[:1], got)
+	assert.Equal(t, 3, dropped)
+
+	v.sanitize = true
+	got, dropped = v.apply(attrs)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("valid", "ok"),
+		attribute.String("utf8", "a�b"),
+		attribute.String("long", "too "),
+	}, got)
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, orig, attrs, "passed attributes modified")
+
+	got, dropped = v.apply(attrs[:1])
+	assert.Equal(t, attrs[:1], got)
+	assert.Zero(t, dropped)
+}
+
+func TestWithAttributeValidation(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	policy := attribute.ValidationPolicy{RequireUTF8: true, MaxValueLength: 4}
+	invalid := attribute.String("invalid", "a\xffb")
+	long := attribute.String("long", "too long")
+
+	for _, sanitize := range []bool{false, true} {
+		handled = nil
+		te := NewTestExporter()
+		tp := NewTracerProvider(WithSyncer(te), WithAttributeValidation(policy, sanitize))
+
+		_, span := tp.Tracer("TestWithAttributeValidation").Start(
+			t.Context(),
+			"span",
+			trace.WithAttributes(invalid),
+			trace.WithLinks(trace.Link{Attributes: []attribute.KeyValue{long}}),
+		)
+		span.SetAttributes(attribute.Int("valid", 1), long)
+		span.AddEvent("event", trace.WithAttributes(invalid, attribute.Bool("valid", true)))
+		span.End()
+
+		s, ok := te.GetSpan("span")
+		require.True(t, ok)
+		require.Len(t, s.Events(), 1)
+		require.Len(t, s.Links(), 1)
+		event, link := s.Events()[0], s.Links()[0]
+
+		if !sanitize {
+			var utf8Errs int
+			for _, err := range handled {
+				if errors.Is(err, attribute.ErrInvalidUTF8) {
+					utf8Errs++
+				}
+			}
+			assert.Len(t, handled, 4)
+			assert.Equal(t, 2, utf8Errs, "invalid UTF-8 errors")
+			assert.Equal(t, []attribute.KeyValue{attribute.Int("valid", 1)}, s.Attributes())
+			assert.Equal(t, 2, s.DroppedAttributes())
+			assert.Equal(t, []attribute.KeyValue{attribute.Bool("valid", true)}, event.Attributes)
+			assert.Equal(t, 1, event.DroppedAttributeCount)
+			assert.Empty(t, link.Attributes)
+			assert.Equal(t, 1, link.DroppedAttributeCount)
+			continue
+		}
+
+		assert.Empty(t, handled)
+		assert.Equal(t, []attribute.KeyValue{
+			attribute.String("invalid", "a�b"),
+			attribute.Int("valid", 1),
+			attribute.String("long", "too "),
+		}, s.Attributes())
+		assert.Zero(t, s.DroppedAttributes())
+		assert.Equal(t, []attribute.KeyValue{
+			attribute.String("invalid", "a�b"),
+			attribute.Bool("valid", true),
+		}, event.Attributes)
+		assert.Zero(t, event.DroppedAttributeCount)
+		assert.Equal(t, []attribute.KeyValue{attribute.String("long", "too ")}, link.Attributes)
+		assert.Zero(t, link.DroppedAttributeCount)
+	}
+}
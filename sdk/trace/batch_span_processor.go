@@ -12,7 +12,10 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/internal/health"
 	"go.opentelemetry.io/otel/sdk/trace/internal/env"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
 	"go.opentelemetry.io/otel/trace"
@@ -26,6 +29,9 @@ const (
 	// DefaultExportTimeout is the duration after which an export is cancelled, in milliseconds.
 	DefaultExportTimeout      = 30000
 	DefaultMaxExportBatchSize = 512
+	// DefaultPersistentQueueMaxBytes is the default maximum size, in bytes,
+	// of the persisted batches of a persistent queue.
+	DefaultPersistentQueueMaxBytes = 64 << 20
 )
 
 // BatchSpanProcessorOption configures a BatchSpanProcessor.
@@ -55,11 +61,52 @@ type BatchSpanProcessorOptions struct {
 	// The default value of MaxExportBatchSize is 512.
 	MaxExportBatchSize int
 
+	// MaxExportBatchBytes is the maximum estimated encoded size, in bytes, of
+	// a single batch. A batch is exported before adding a span would exceed
+	// this size. A single span larger than this size is exported by itself.
+	// The size of a span is estimated from its content and approximates its
+	// size in an OTLP payload.
+	// The default value of MaxExportBatchBytes is 0, meaning batches are not
+	// limited by their estimated size.
+	MaxExportBatchBytes int
+
 	// BlockOnQueueFull blocks onEnd() and onStart() method if the queue is full
 	// AND if BlockOnQueueFull is set to true.
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// ExportTracerProvider is the TracerProvider used to trace the exports
+	// of batches. If nil, the default, exports are not traced.
+	ExportTracerProvider trace.TracerProvider
+
+	// ExportTracingAttributes are the additional attributes of the spans
+	// tracing the exports of batches.
+	ExportTracingAttributes []attribute.KeyValue
+
+	// PermanentErrorPause is the duration batches are dropped instead of
+	// exported after the exporter returns a PermanentExportError. If it is
+	// negative, all the batches are dropped after such an error.
+	// The default value of PermanentErrorPause is 0, meaning all the batches
+	// are exported whatever the errors returned by the exporter.
+	PermanentErrorPause time.Duration
+
+	// MeterProvider is the MeterProvider used to record the metrics of the
+	// BatchSpanProcessor. If nil, the default, the metrics are only recorded
+	// with the global MeterProvider if the experimental observability is
+	// enabled.
+	MeterProvider metric.MeterProvider
+
+	// PersistentQueueDir is the directory the batches failing to be
+	// exported are persisted in, to be exported later. If empty, the
+	// default, these batches are dropped.
+	PersistentQueueDir string
+
+	// PersistentQueueMaxBytes is the maximum size, in bytes, of the batches
+	// persisted in PersistentQueueDir. The oldest batches are dropped to
+	// persist new ones above this size. If it is less than or equal to zero,
+	// DefaultPersistentQueueMaxBytes is used.
+	PersistentQueueMaxBytes int64
 }
 
 // batchSpanProcessor is a SpanProcessor that batches asynchronously-received
@@ -71,15 +118,36 @@ type batchSpanProcessor struct {
 	queue   chan ReadOnlySpan
 	dropped atomic.Uint32
 
-	inst *observ.BSP
+	// pausedDropped is the number of spans dropped because the export was
+	// paused after a PermanentExportError.
+	pausedDropped atomic.Uint64
+	// paused is true if the export is paused until pausedUntil, or
+	// indefinitely if PermanentErrorPause is negative. Both are guarded by
+	// batchMutex.
+	paused      bool
+	pausedUntil time.Time
+
+	inst   *observ.BSP
+	tracer *exportTracing
+
+	// persisted holds the batches that failed to be exported. It is nil if
+	// they are dropped. It is guarded by batchMutex.
+	persisted *persistentQueue
+
+	health health.Tracker
 
 	batch      []ReadOnlySpan
+	batchBytes int
 	batchMutex sync.Mutex
 	timer      *time.Timer
 	stopWait   sync.WaitGroup
 	stopOnce   sync.Once
 	stopCh     chan struct{}
 	stopped    atomic.Bool
+
+	// exportUnsampled is true if the processor is registered with
+	// WithUnsampledProcessor.
+	exportUnsampled atomic.Bool
 }
 
 var _ SpanProcessor = (*batchSpanProcessor)(nil)
@@ -114,9 +182,13 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 		stopCh: make(chan struct{}),
 	}
 
+	id := nextProcessorID()
+	bsp.tracer = newExportTracing(o.ExportTracerProvider, observ.BSPComponentName(id), exporter, o.ExportTracingAttributes)
+
 	var err error
 	bsp.inst, err = observ.NewBSP(
-		nextProcessorID(),
+		o.MeterProvider,
+		id,
 		func() int64 { return int64(len(bsp.queue)) },
 		int64(bsp.o.MaxQueueSize),
 	)
@@ -124,6 +196,17 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 		otel.Handle(err)
 	}
 
+	if o.PersistentQueueDir != "" && exporter != nil {
+		maxBytes := o.PersistentQueueMaxBytes
+		if maxBytes <= 0 {
+			maxBytes = DefaultPersistentQueueMaxBytes
+		}
+		bsp.persisted, err = openPersistentQueue(o.PersistentQueueDir, maxBytes)
+		if err != nil {
+			otel.Handle(fmt.Errorf("failed to open batch span processor persistent queue: %w", err))
+		}
+	}
+
 	bsp.stopWait.Go(func() {
 		bsp.processQueue()
 		bsp.drainQueue()
@@ -189,6 +272,15 @@ func (bsp *batchSpanProcessor) Shutdown(ctx context.Context) error {
 	return err
 }
 
+// Health returns a snapshot of the health of the batchSpanProcessor.
+func (bsp *batchSpanProcessor) Health() otel.HealthStatus {
+	s := bsp.health.Status("BatchSpanProcessor")
+	s.QueueSize = len(bsp.queue)
+	s.QueueCapacity = bsp.o.MaxQueueSize
+	s.Shutdown = bsp.stopped.Load()
+	return s
+}
+
 type forceFlushSpan struct {
 	ReadOnlySpan
 	flushed chan struct{}
@@ -255,6 +347,23 @@ func WithMaxExportBatchSize(size int) BatchSpanProcessorOption {
 	}
 }
 
+// WithMaxExportBatchBytes returns a BatchSpanProcessorOption that configures
+// the maximum estimated encoded size, in bytes, of a batch exported by a
+// BatchSpanProcessor. It is applied in addition to the maximum export batch
+// size; whichever is reached first bounds the batch.
+//
+// The size of a span is estimated from its content. It approximates the size
+// of the span in an OTLP payload, but it is not exact. Choose a size with
+// sufficient headroom below the limit of the receiver.
+//
+// If size is less than or equal to zero, batches are not limited by their
+// estimated size.
+func WithMaxExportBatchBytes(size int) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MaxExportBatchBytes = size
+	}
+}
+
 // WithBatchTimeout returns a BatchSpanProcessorOption that configures the
 // maximum delay allowed for a BatchSpanProcessor before it will export any
 // held span (whether the queue is full or not).
@@ -282,6 +391,87 @@ func WithBlocking() BatchSpanProcessorOption {
 	}
 }
 
+// WithExportTracing returns a BatchSpanProcessorOption that configures a
+// BatchSpanProcessor to trace the export of each batch with a span created by
+// tp. The span records the number of exported spans, the type of the
+// exporter, the outcome of the export, and the additional attrs, e.g. the
+// server.address of the endpoint the exporter sends spans to.
+//
+// The tp should be separate from the TracerProvider the BatchSpanProcessor is
+// registered with, so that the export spans are sent to a different
+// destination than the spans they describe. To prevent infinite recursion, a
+// batch only holding export spans is not traced, nor is an export happening
+// while exporting a traced batch.
+func WithExportTracing(tp trace.TracerProvider, attrs ...attribute.KeyValue) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.ExportTracerProvider = tp
+		o.ExportTracingAttributes = attrs
+	}
+}
+
+// WithMeterProvider returns a BatchSpanProcessorOption that configures a
+// BatchSpanProcessor to record its metrics with mp: the size and capacity of
+// its queue, the number of processed spans, with the error type of the
+// dropped ones, and the number of exported spans and duration of the exports
+// of batches, with the error type of the failed ones. The exports are
+// recorded with the otel.sdk.exporter.span.exported and
+// otel.sdk.exporter.operation.duration semantic conventions metrics,
+// identifying the BatchSpanProcessor as component.
+//
+// This allows to monitor the spans dropped because the queue is full or the
+// exporter fails, instead of finding out from missing data in the backend.
+// The metrics are recorded whether the experimental observability is enabled
+// or not.
+func WithMeterProvider(mp metric.MeterProvider) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.MeterProvider = mp
+	}
+}
+
+// WithPersistentQueue returns a BatchSpanProcessorOption that configures a
+// BatchSpanProcessor to persist the batches failing to be exported in files
+// of dir, instead of dropping them, so that they are not lost during outages
+// of the receiver of the exporter, even if the process restarts.
+//
+// The persisted batches are exported again, oldest first, after each
+// successful export and once the batch timeout elapsed, including the
+// batches persisted by a previous process using dir. Each of them is exported
+// with its own export timeout, and the replay stops while the queue of the
+// BatchSpanProcessor is more than half full. They are removed once exported,
+// or if the exporter returns a PermanentExportError.
+//
+// The total size of the persisted batches is bounded by maxBytes. The oldest
+// batches are dropped to persist new ones above this size. If maxBytes is
+// less than or equal to zero, DefaultPersistentQueueMaxBytes is used.
+//
+// Only the batches failing to be exported are persisted: the spans queued in
+// memory are lost if the process stops without shutting down the
+// BatchSpanProcessor. The dir must not be used by other BatchSpanProcessors.
+// If dir cannot be used, the error is handled by the global ErrorHandler and
+// the failed batches are dropped.
+func WithPersistentQueue(dir string, maxBytes int64) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.PersistentQueueDir = dir
+		o.PersistentQueueMaxBytes = maxBytes
+	}
+}
+
+// WithPermanentErrorPause returns a BatchSpanProcessorOption that configures
+// a BatchSpanProcessor to pause the export of batches for d after the
+// exporter returns a PermanentExportError, e.g. because the receiver rejects
+// its credentials. The batches are dropped during the pause instead of being
+// sent to a receiver bound to reject them. The export resumes with the first
+// batch after the pause, and is paused again if it still fails permanently.
+//
+// If d is negative, all the batches are dropped after the first
+// PermanentExportError until the BatchSpanProcessor is shut down. If d is
+// zero, the default, the export is never paused.
+func WithPermanentErrorPause(d time.Duration) BatchSpanProcessorOption {
+	return func(o *BatchSpanProcessorOptions) {
+		o.PermanentErrorPause = d
+	}
+}
+
 // exportSpans is a subroutine of processing and draining the queue.
 func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.timer.Reset(bsp.o.BatchTimeout)
@@ -289,18 +479,60 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.batchMutex.Lock()
 	defer bsp.batchMutex.Unlock()
 
-	if bsp.o.ExportTimeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeoutCause(ctx, bsp.o.ExportTimeout, errors.New("processor export timeout"))
-		defer cancel()
+	if err := bsp.exportBatch(ctx); err != nil {
+		return err
+	}
+	return bsp.replay(ctx)
+}
+
+// exportContext returns a copy of ctx bounded by the export timeout of the
+// processor, if any, and its cancellation function.
+func (bsp *batchSpanProcessor) exportContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if bsp.o.ExportTimeout <= 0 {
+		return ctx, func() {}
 	}
+	return context.WithTimeoutCause(ctx, bsp.o.ExportTimeout, errors.New("processor export timeout"))
+}
+
+// exportBatch exports the current batch. It must be called with batchMutex
+// held.
+func (bsp *batchSpanProcessor) exportBatch(ctx context.Context) error {
+	ctx, cancel := bsp.exportContext(ctx)
+	defer cancel()
 
 	if l := len(bsp.batch); l > 0 {
-		global.Debug("exporting spans", "count", len(bsp.batch), "total_dropped", bsp.dropped.Load())
+		if bsp.exportPaused() {
+			bsp.pausedDropped.Add(uint64(l))
+			if bsp.inst != nil {
+				bsp.inst.ProcessedExportPaused(ctx, int64(l))
+			}
+			clear(bsp.batch)
+			bsp.batch = bsp.batch[:0]
+			bsp.batchBytes = 0
+			return nil
+		}
+
+		global.Component(global.ComponentBatchSpanProcessor).Debug(
+			"exporting spans",
+			"count", len(bsp.batch),
+			"total_dropped", bsp.dropped.Load(),
+			"total_paused_dropped", bsp.pausedDropped.Load(),
+		)
 		if bsp.inst != nil {
 			bsp.inst.Processed(ctx, int64(l))
 		}
+		ctx, end := bsp.tracer.start(ctx, bsp.batch)
+		start := time.Now()
 		err := bsp.e.ExportSpans(ctx, bsp.batch)
+		if bsp.inst != nil {
+			bsp.inst.Exported(ctx, int64(l), time.Since(start), err)
+		}
+		end(err)
+		bsp.health.Record(err)
+		bsp.pauseOnPermanentError(err)
+		if err != nil {
+			bsp.persist(err)
+		}
 
 		// A new batch is always created after exporting, even if the batch failed to be exported.
 		//
@@ -308,7 +540,63 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 		// to be exported, since it is specific to the protocol and backend being sent to.
 		clear(bsp.batch) // Erase elements to let GC collect objects
 		bsp.batch = bsp.batch[:0]
+		bsp.batchBytes = 0
+
+		return err
+	}
+	return nil
+}
 
+// persist stores the batch that failed to be exported with err in the
+// persistent queue, unless err is a PermanentExportError. It must be called
+// with batchMutex held.
+func (bsp *batchSpanProcessor) persist(err error) {
+	var pErr *PermanentExportError
+	if bsp.persisted == nil || errors.As(err, &pErr) {
+		return
+	}
+	dropped, err := bsp.persisted.push(bsp.batch)
+	if dropped > 0 {
+		global.Warn("batch span processor persistent queue full, dropping the oldest spans", "dropped", dropped)
+	}
+	if err != nil {
+		otel.Handle(fmt.Errorf("failed to persist spans: %w", err))
+	}
+}
+
+// replay exports the batches of the persistent queue, oldest first, until an
+// export fails, ctx is done, or the queue is half full, and removes the
+// exported ones. Each batch is exported with its own export timeout. It must
+// be called with batchMutex held.
+func (bsp *batchSpanProcessor) replay(ctx context.Context) error {
+	if bsp.persisted == nil || bsp.stopped.Load() {
+		return nil
+	}
+	for bsp.persisted.len() > 0 && len(bsp.queue) <= cap(bsp.queue)/2 && !bsp.exportPaused() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		spans, _, err := bsp.persisted.peek()
+		if err != nil {
+			if errors.Is(err, errSegment) {
+				// The batch cannot be read back, do not try again.
+				err = errors.Join(err, bsp.persisted.pop())
+			}
+			return err
+		}
+
+		err = bsp.replaySegment(ctx, spans)
+		bsp.health.Record(err)
+		bsp.pauseOnPermanentError(err)
+
+		var pErr *PermanentExportError
+		if err != nil && !errors.As(err, &pErr) {
+			// Keep the batch to try again later.
+			return err
+		}
+		if e := bsp.persisted.pop(); e != nil {
+			return errors.Join(err, e)
+		}
 		if err != nil {
 			return err
 		}
@@ -316,6 +604,53 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	return nil
 }
 
+// replaySegment exports spans read back from the persistent queue with the
+// export timeout of the processor. It must be called with batchMutex held.
+func (bsp *batchSpanProcessor) replaySegment(ctx context.Context, spans []ReadOnlySpan) error {
+	ctx, cancel := bsp.exportContext(ctx)
+	defer cancel()
+
+	start := time.Now()
+	err := bsp.e.ExportSpans(ctx, spans)
+	if bsp.inst != nil {
+		bsp.inst.Exported(ctx, int64(len(spans)), time.Since(start), err)
+	}
+	return err
+}
+
+// exportPaused returns true if the export of batches is paused after a
+// PermanentExportError. It must be called with batchMutex held.
+func (bsp *batchSpanProcessor) exportPaused() bool {
+	if !bsp.paused {
+		return false
+	}
+	if bsp.o.PermanentErrorPause < 0 || time.Now().Before(bsp.pausedUntil) {
+		return true
+	}
+	bsp.paused = false
+	return false
+}
+
+// pauseOnPermanentError pauses the export of batches if err is a
+// PermanentExportError and the processor is configured to pause. It must be
+// called with batchMutex held.
+func (bsp *batchSpanProcessor) pauseOnPermanentError(err error) {
+	if bsp.o.PermanentErrorPause == 0 {
+		return
+	}
+	var pErr *PermanentExportError
+	if !errors.As(err, &pErr) {
+		return
+	}
+	bsp.paused = true
+	bsp.pausedUntil = time.Now().Add(bsp.o.PermanentErrorPause)
+	global.Warn(
+		"batch span processor export paused after a permanent export error, dropping spans",
+		"pause", bsp.o.PermanentErrorPause,
+		"error", pErr,
+	)
+}
+
 // processQueue removes spans from the `queue` channel until processor
 // is shut down. It calls the exporter in batches of up to MaxExportBatchSize
 // waiting up to BatchTimeout to form a batch.
@@ -337,9 +672,16 @@ func (bsp *batchSpanProcessor) processQueue() {
 				close(ffs.flushed)
 				continue
 			}
+			size := bsp.spanSize(sd)
+			if bsp.exceedsBatchBytes(size) {
+				if err := bsp.exportSpans(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
 			bsp.batchMutex.Lock()
 			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
+			bsp.batchBytes += size
+			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize || bsp.batchBytesReached()
 			bsp.batchMutex.Unlock()
 			if shouldExport {
 				if !bsp.timer.Stop() {
@@ -370,9 +712,16 @@ func (bsp *batchSpanProcessor) drainQueue() {
 				continue
 			}
 
+			size := bsp.spanSize(sd)
+			if bsp.exceedsBatchBytes(size) {
+				if err := bsp.exportSpans(ctx); err != nil {
+					otel.Handle(err)
+				}
+			}
 			bsp.batchMutex.Lock()
 			bsp.batch = append(bsp.batch, sd)
-			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize
+			bsp.batchBytes += size
+			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize || bsp.batchBytesReached()
 			bsp.batchMutex.Unlock()
 
 			if shouldExport {
@@ -390,6 +739,35 @@ func (bsp *batchSpanProcessor) drainQueue() {
 	}
 }
 
+// spanSize returns the estimated encoded size of sd if batches are limited by
+// their estimated size. Otherwise, 0 is returned.
+func (bsp *batchSpanProcessor) spanSize(sd ReadOnlySpan) int {
+	if bsp.o.MaxExportBatchBytes <= 0 {
+		return 0
+	}
+	return estimatedSpanSize(sd)
+}
+
+// exceedsBatchBytes reports whether adding a span of the estimated size to
+// the current batch would exceed MaxExportBatchBytes. A span is always
+// accepted into an empty batch so a span larger than the limit is still
+// exported on its own.
+func (bsp *batchSpanProcessor) exceedsBatchBytes(size int) bool {
+	if bsp.o.MaxExportBatchBytes <= 0 {
+		return false
+	}
+
+	bsp.batchMutex.Lock()
+	defer bsp.batchMutex.Unlock()
+	return len(bsp.batch) > 0 && bsp.batchBytes+size > bsp.o.MaxExportBatchBytes
+}
+
+// batchBytesReached reports whether the current batch has reached
+// MaxExportBatchBytes. It must be called while holding bsp.batchMutex.
+func (bsp *batchSpanProcessor) batchBytesReached() bool {
+	return bsp.o.MaxExportBatchBytes > 0 && bsp.batchBytes >= bsp.o.MaxExportBatchBytes
+}
+
 func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
 	ctx := context.TODO()
 	if bsp.o.BlockOnQueueFull {
@@ -400,7 +778,7 @@ func (bsp *batchSpanProcessor) enqueue(sd ReadOnlySpan) {
 }
 
 func (bsp *batchSpanProcessor) enqueueBlockOnQueueFull(ctx context.Context, sd ReadOnlySpan) bool {
-	if !sd.SpanContext().IsSampled() {
+	if !sd.SpanContext().IsSampled() && !bsp.exportUnsampled.Load() {
 		return false
 	}
 
@@ -416,7 +794,7 @@ func (bsp *batchSpanProcessor) enqueueBlockOnQueueFull(ctx context.Context, sd R
 }
 
 func (bsp *batchSpanProcessor) enqueueDrop(ctx context.Context, sd ReadOnlySpan) bool {
-	if !sd.SpanContext().IsSampled() {
+	if !sd.SpanContext().IsSampled() && !bsp.exportUnsampled.Load() {
 		return false
 	}
 
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -19,8 +20,10 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/sdk"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/attrsize"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
@@ -299,6 +302,51 @@ func TestNewBatchSpanProcessorWithEnvOptions(t *testing.T) {
 	}
 }
 
+func TestBatchSpanProcessorMaxExportBatchBytes(t *testing.T) {
+	te := testBatchExporter{}
+	tp := basicTracerProvider(t)
+	tr := tp.Tracer("BatchSpanProcessorMaxExportBatchBytes")
+
+	attr := attribute.String("payload", strings.Repeat("a", 1024))
+	_, span := tr.Start(t.Context(), "span", trace.WithAttributes(attr))
+	span.End()
+	size := estimatedSpanSize(span.(ReadOnlySpan))
+
+	bsp := NewBatchSpanProcessor(
+		&te,
+		WithBlocking(),
+		WithBatchTimeout(time.Hour),
+		WithMaxExportBatchSize(100),
+		WithMaxExportBatchBytes(3*size),
+	)
+	tp.RegisterSpanProcessor(bsp)
+
+	const n = 10
+	for range n {
+		_, span := tr.Start(t.Context(), "span", trace.WithAttributes(attr))
+		span.End()
+	}
+	require.NoError(t, bsp.Shutdown(t.Context()))
+
+	assert.Equal(t, n, te.len(), "exported spans")
+	assert.Equal(t, []int{3, 3, 3, 1}, te.sizes, "batch sizes")
+}
+
+func TestEstimatedSpanSize(t *testing.T) {
+	base := estimatedSpanSize(snapshot{})
+	assert.Equal(t, spanOverhead, base)
+
+	s := snapshot{
+		name:       "name",
+		attributes: []attribute.KeyValue{attribute.String("k", "v")},
+		events:     []Event{{Name: "event"}},
+		links:      []Link{{}},
+	}
+	want := base + len("name") + attrsize.KeyValue(attribute.String("k", "v")) +
+		eventOverhead + len("event") + linkOverhead
+	assert.Equal(t, want, estimatedSpanSize(s))
+}
+
 type stuckExporter struct {
 	testBatchExporter
 }
@@ -330,6 +378,59 @@ func TestBatchSpanProcessorExportTimeout(t *testing.T) {
 	}
 }
 
+func TestBatchSpanProcessorExportTracing(t *testing.T) {
+	exportErr := errors.New("fail to export")
+	te := testBatchExporter{errors: []error{exportErr}}
+	exportTE := NewTestExporter()
+	exportTP := NewTracerProvider(WithSyncer(exportTE))
+	t.Cleanup(func() { _ = exportTP.Shutdown(context.Background()) })
+
+	endpoint := semconv.ServerAddress("collector")
+	bsp := NewBatchSpanProcessor(&te, WithBatchTimeout(time.Hour), WithExportTracing(exportTP, endpoint))
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("BatchSpanProcessorExportTracing")
+
+	generateSpan(t, tr, testOption{genNumSpans: 2})
+	require.ErrorIs(t, bsp.ForceFlush(t.Context()), exportErr)
+	generateSpan(t, tr, testOption{genNumSpans: 1})
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+
+	spans := exportTE.Spans()
+	require.Len(t, spans, 2)
+	for i, want := range []struct {
+		size   int
+		status codes.Code
+	}{{size: 2, status: codes.Error}, {size: 1, status: codes.Unset}} {
+		s := spans[i]
+		assert.Equal(t, exportSpanName, s.Name())
+		assert.Equal(t, exportTracerName, s.InstrumentationScope().Name)
+		assert.Equal(t, want.status, s.Status().Code)
+		assert.Contains(t, s.Attributes(), exportBatchSizeKey.Int(want.size))
+		assert.Contains(t, s.Attributes(), exporterTypeKey.String("*trace.testBatchExporter"))
+		assert.Contains(t, s.Attributes(), semconv.OTelComponentTypeBatchingSpanProcessor)
+		assert.Contains(t, s.Attributes(), endpoint)
+	}
+	assert.Contains(t, spans[0].Attributes(), semconv.ErrorType(exportErr))
+}
+
+func TestBatchSpanProcessorExportTracingRecursion(t *testing.T) {
+	te := testBatchExporter{}
+	tp := basicTracerProvider(t)
+	// Trace the exports with the provider whose spans are exported.
+	bsp := NewBatchSpanProcessor(&te, WithBatchTimeout(time.Hour), WithExportTracing(tp))
+	tp.RegisterSpanProcessor(bsp)
+
+	generateSpan(t, tp.Tracer("BatchSpanProcessorExportTracingRecursion"), testOption{genNumSpans: 1})
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+	// The batch only holds the span tracing the first export.
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+
+	require.Equal(t, 2, te.len())
+	assert.Equal(t, exportSpanName, te.spans[1].Name())
+}
+
 func createAndRegisterBatchSP(option testOption, te *testBatchExporter) SpanProcessor {
 	// Always use blocking queue to avoid flaky tests.
 	options := append(option.o, WithBlocking())
@@ -536,6 +637,69 @@ func TestBatchSpanProcessorDropBatchIfFailed(t *testing.T) {
 	}
 }
 
+func TestBatchSpanProcessorPermanentErrorPause(t *testing.T) {
+	permErr := &PermanentExportError{Err: errors.New("unauthenticated")}
+	exportErr := fmt.Errorf("traces export: %w", permErr)
+
+	tests := []struct {
+		name  string
+		pause time.Duration
+		err   error
+		// wantPaused is true if the second batch is expected to be dropped.
+		wantPaused bool
+	}{
+		{name: "Disabled", err: exportErr},
+		{name: "NotPermanent", pause: -1, err: errors.New("unavailable")},
+		{name: "Indefinite", pause: -1, err: exportErr, wantPaused: true},
+		{name: "Duration", pause: time.Hour, err: exportErr, wantPaused: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			te := &testBatchExporter{errors: []error{tt.err}}
+			bsp := NewBatchSpanProcessor(te, WithPermanentErrorPause(tt.pause)).(*batchSpanProcessor)
+			tracer := NewTracerProvider(WithSpanProcessor(bsp)).Tracer("TestBatchSpanProcessorPermanentErrorPause")
+			t.Cleanup(func() { assert.NoError(t, bsp.Shutdown(context.Background())) })
+
+			_, span := tracer.Start(t.Context(), "failed")
+			span.End()
+			require.ErrorIs(t, bsp.ForceFlush(t.Context()), tt.err)
+
+			_, span = tracer.Start(t.Context(), "second")
+			span.End()
+			require.NoError(t, bsp.ForceFlush(t.Context()))
+
+			if !tt.wantPaused {
+				assert.Equal(t, 1, te.len(), "second batch not exported")
+				assert.Zero(t, bsp.pausedDropped.Load())
+				return
+			}
+			assert.Equal(t, 0, te.len(), "second batch exported during pause")
+			assert.Equal(t, uint64(1), bsp.pausedDropped.Load())
+
+			if tt.pause < 0 {
+				return
+			}
+			// End the pause.
+			bsp.batchMutex.Lock()
+			bsp.pausedUntil = time.Now()
+			bsp.batchMutex.Unlock()
+
+			_, span = tracer.Start(t.Context(), "resumed")
+			span.End()
+			require.NoError(t, bsp.ForceFlush(t.Context()))
+			assert.Equal(t, 1, te.len(), "export not resumed after pause")
+		})
+	}
+}
+
+func TestPermanentExportError(t *testing.T) {
+	err := errors.New("unauthenticated")
+	permErr := &PermanentExportError{Err: err}
+	assert.EqualError(t, permErr, "permanent export error: unauthenticated")
+	assert.ErrorIs(t, permErr, err)
+	assert.EqualError(t, &PermanentExportError{}, "permanent export error")
+}
+
 func assertMaxSpanDiff(t *testing.T, want, got, maxDif int) {
 	spanDifference := want - got
 	if spanDifference < 0 {
@@ -820,6 +984,68 @@ func TestBatchSpanProcessorBlockingMetrics(t *testing.T) {
 	})
 }
 
+func TestBatchSpanProcessorWithMeterProvider(t *testing.T) {
+	// Reset for deterministic component ID.
+	processorIDCounter.Store(componentID)
+
+	// Do not set OTEL_GO_X_OBSERVABILITY, the MeterProvider enables the
+	// metrics.
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	te := &testBatchExporter{errors: []error{assert.AnError}}
+	tp := basicTracerProvider(t)
+	bsp := NewBatchSpanProcessor(
+		te,
+		WithBatchTimeout(time.Hour),
+		WithMaxExportBatchSize(2),
+		WithMeterProvider(mp),
+	)
+	tp.RegisterSpanProcessor(bsp)
+
+	tr := tp.Tracer("TestBatchSpanProcessorWithMeterProvider")
+	generateSpan(t, tr, testOption{genNumSpans: 3})
+	require.NoError(t, tp.ForceFlush(t.Context()))
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &got))
+	require.Len(t, got.ScopeMetrics, 1)
+
+	attrs := []attribute.KeyValue{
+		semconv.OTelComponentTypeBatchingSpanProcessor,
+		observ.BSPComponentName(componentID),
+	}
+	var exported metricdata.Sum[int64]
+	var durations metricdata.Histogram[float64]
+	for _, m := range got.ScopeMetrics[0].Metrics {
+		switch m.Name {
+		case otelconv.SDKExporterSpanExported{}.Name():
+			exported = m.Data.(metricdata.Sum[int64])
+		case otelconv.SDKExporterOperationDuration{}.Name():
+			durations = m.Data.(metricdata.Histogram[float64])
+		}
+	}
+
+	succeeded := attribute.NewSet(attrs...)
+	failed := attribute.NewSet(append(attrs, semconv.ErrorType(assert.AnError))...)
+	spans := make(map[attribute.Distinct]int64)
+	for _, dPt := range exported.DataPoints {
+		spans[dPt.Attributes.Equivalent()] = dPt.Value
+	}
+	assert.Equal(t, map[attribute.Distinct]int64{
+		succeeded.Equivalent(): 1,
+		failed.Equivalent():    2,
+	}, spans, "exported spans")
+
+	counts := make(map[attribute.Distinct]uint64)
+	for _, dPt := range durations.DataPoints {
+		counts[dPt.Attributes.Equivalent()] = dPt.Count
+	}
+	assert.Equal(t, map[attribute.Distinct]uint64{
+		succeeded.Equivalent(): 1,
+		failed.Equivalent():    1,
+	}, counts, "exported batches")
+}
+
 type expectMetrics struct {
 	queueCapacity      int64
 	queueSize          int64
@@ -951,3 +1177,37 @@ func (e *blockingExporter) waitForSpans(ctx context.Context, n int32) error {
 	}
 	return nil
 }
+
+func TestBatchSpanProcessorHealth(t *testing.T) {
+	exp := &testBatchExporter{errors: []error{assert.AnError}}
+	tp := basicTracerProvider(t)
+	bsp := NewBatchSpanProcessor(exp, WithMaxQueueSize(10), WithBatchTimeout(time.Hour))
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("BatchSpanProcessorHealth")
+
+	health := tp.Health()
+	require.Len(t, health, 1)
+	assert.Equal(t, "BatchSpanProcessor", health[0].Component)
+	assert.Equal(t, 10, health[0].QueueCapacity)
+	assert.True(t, health[0].Healthy())
+
+	_, span := tr.Start(t.Context(), "span")
+	span.End()
+	assert.ErrorIs(t, tp.ForceFlush(t.Context()), assert.AnError)
+	health = tp.Health()
+	require.Len(t, health, 1)
+	assert.False(t, health[0].Healthy())
+	assert.Equal(t, 1, health[0].ConsecutiveFailures)
+	assert.ErrorIs(t, health[0].LastError, assert.AnError)
+
+	_, span = tr.Start(t.Context(), "span")
+	span.End()
+	require.NoError(t, tp.ForceFlush(t.Context()))
+	health = tp.Health()
+	require.Len(t, health, 1)
+	assert.True(t, health[0].Healthy())
+	assert.False(t, health[0].LastSuccess.IsZero())
+
+	require.NoError(t, bsp.Shutdown(t.Context()))
+	assert.True(t, bsp.(*batchSpanProcessor).Health().Shutdown)
+}
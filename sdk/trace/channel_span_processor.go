@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/internal/global"
+)
+
+// ChannelProcessor is a SpanProcessor sending the ended spans to a channel,
+// so they can be consumed asynchronously, e.g. by a live debugging UI or an
+// anomaly detector, without implementing a SpanProcessor.
+//
+// A ChannelProcessor needs to be created with NewChannelProcessor.
+type ChannelProcessor struct {
+	spans   chan ReadOnlySpan
+	dropped atomic.Uint64
+
+	// mu guards sending to spans against closing it on shutdown.
+	mu      sync.RWMutex
+	stopped bool
+}
+
+var _ SpanProcessor = (*ChannelProcessor)(nil)
+
+// NewChannelProcessor returns a ChannelProcessor buffering at most buffer
+// ended spans in its channel. If the buffer is full, i.e. the spans are not
+// received fast enough, the ended span is dropped. The number of dropped
+// spans is returned by the Dropped method.
+//
+// If buffer is less than one, DefaultMaxQueueSize is used.
+func NewChannelProcessor(buffer int) *ChannelProcessor {
+	if buffer < 1 {
+		buffer = DefaultMaxQueueSize
+	}
+	return &ChannelProcessor{spans: make(chan ReadOnlySpan, buffer)}
+}
+
+// Spans returns the channel the ended spans are sent to. It is closed when
+// the ChannelProcessor is shut down, once the spans it buffers are received.
+func (p *ChannelProcessor) Spans() <-chan ReadOnlySpan {
+	return p.spans
+}
+
+// Dropped returns the number of ended spans dropped because the channel
+// buffer was full.
+func (p *ChannelProcessor) Dropped() uint64 {
+	return p.dropped.Load()
+}
+
+// OnStart does nothing.
+func (*ChannelProcessor) OnStart(context.Context, ReadWriteSpan) {}
+
+// OnEnd sends s to the channel. If the channel buffer is full, s is dropped.
+func (p *ChannelProcessor) OnEnd(s ReadOnlySpan) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.stopped {
+		return
+	}
+
+	select {
+	case p.spans <- s:
+	default:
+		if p.dropped.Add(1) == 1 {
+			global.Warn("channel span processor buffer is full, dropping spans", "capacity", cap(p.spans))
+		}
+	}
+}
+
+// ForceFlush does nothing as the spans are sent to the channel when they
+// end. It returns the error of ctx if it is done.
+func (*ChannelProcessor) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Shutdown stops sending spans and closes the channel. It only executes once.
+// Subsequent calls do nothing.
+func (p *ChannelProcessor) Shutdown(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.stopped {
+		p.stopped = true
+		close(p.spans)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelProcessor(t *testing.T) {
+	p := NewChannelProcessor(2)
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	tracer := tp.Tracer("TestChannelProcessor")
+
+	for _, name := range []string{"a", "b", "c"} {
+		_, span := tracer.Start(t.Context(), name)
+		span.End()
+	}
+	assert.Equal(t, uint64(1), p.Dropped())
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	var names []string
+	for s := range p.Spans() {
+		names = append(names, s.Name())
+	}
+	assert.Equal(t, []string{"a", "b"}, names, "buffered spans not received after shutdown")
+}
+
+func TestChannelProcessorDefaultBuffer(t *testing.T) {
+	assert.Equal(t, DefaultMaxQueueSize, cap(NewChannelProcessor(0).Spans()))
+}
+
+func TestChannelProcessorShutdown(t *testing.T) {
+	p := NewChannelProcessor(1)
+	require.NoError(t, p.Shutdown(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()), "second shutdown")
+
+	p.OnEnd(&snapshot{name: "ended after shutdown"})
+	_, ok := <-p.Spans()
+	assert.False(t, ok, "span sent after shutdown")
+	assert.Zero(t, p.Dropped())
+}
+
+func TestChannelProcessorForceFlush(t *testing.T) {
+	p := NewChannelProcessor(1)
+	assert.NoError(t, p.ForceFlush(t.Context()))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	assert.ErrorIs(t, p.ForceFlush(ctx), context.Canceled)
+}
+
+func TestChannelProcessorConcurrentSafe(t *testing.T) {
+	const goRoutineN = 10
+
+	p := NewChannelProcessor(goRoutineN)
+	tracer := NewTracerProvider(WithSpanProcessor(p)).Tracer("TestChannelProcessorConcurrentSafe")
+
+	var wg sync.WaitGroup
+	for range goRoutineN {
+		wg.Go(func() {
+			_, span := tracer.Start(t.Context(), "span")
+			span.End()
+		})
+	}
+	wg.Go(func() { assert.NoError(t, p.Shutdown(t.Context())) })
+	wg.Wait()
+
+	var n uint64
+	for range p.Spans() {
+		n++
+	}
+	// Spans ending after the shutdown are neither received nor dropped.
+	assert.LessOrEqual(t, n+p.Dropped(), uint64(goRoutineN))
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// exportTracerName is the instrumentation scope name of the spans
+	// tracing the exports of a BatchSpanProcessor.
+	exportTracerName = "go.opentelemetry.io/otel/sdk/trace"
+
+	// exportSpanName is the name of the spans tracing the exports of a
+	// BatchSpanProcessor.
+	exportSpanName = "BatchSpanProcessor.ExportSpans"
+
+	// exportBatchSizeKey is the attribute key of the number of spans in an
+	// exported batch. No semantic convention defines it, so it uses the
+	// namespace of the project instead of the reserved otel one.
+	exportBatchSizeKey = attribute.Key("io.opentelemetry.go.sdk.span.export.batch_size")
+
+	// exporterTypeKey is the attribute key of the Go type of the exporter. It
+	// uses the same namespace as exportBatchSizeKey.
+	exporterTypeKey = attribute.Key("io.opentelemetry.go.sdk.span.exporter.type")
+)
+
+// exportTracingKey is the context key marking the exports being traced.
+type exportTracingKey struct{}
+
+// exportTracing traces the exports of a BatchSpanProcessor.
+type exportTracing struct {
+	tracer trace.Tracer
+	attrs  []attribute.KeyValue
+}
+
+// newExportTracing returns an exportTracing using tp to trace the exports of
+// the BatchSpanProcessor named cmpnt to e. It returns nil if tp is nil.
+func newExportTracing(
+	tp trace.TracerProvider,
+	cmpnt attribute.KeyValue,
+	e SpanExporter,
+	attrs []attribute.KeyValue,
+) *exportTracing {
+	if tp == nil {
+		return nil
+	}
+	return &exportTracing{
+		tracer: tp.Tracer(
+			exportTracerName,
+			trace.WithInstrumentationVersion(sdk.Version()),
+			trace.WithSchemaURL(semconv.SchemaURL),
+		),
+		attrs: append([]attribute.KeyValue{
+			cmpnt,
+			semconv.OTelComponentTypeBatchingSpanProcessor,
+			exporterTypeKey.String(fmt.Sprintf("%T", e)),
+		}, attrs...),
+	}
+}
+
+// start starts a span tracing the export of batch. The returned function
+// needs to be called with the outcome of the export to end the span.
+//
+// The export is not traced if it happens while exporting spans that are
+// already traced, or if batch only holds spans tracing exports. This
+// prevents exports from being traced recursively when the spans tracing them
+// are exported by a traced BatchSpanProcessor, e.g. the one being traced.
+func (t *exportTracing) start(ctx context.Context, batch []ReadOnlySpan) (context.Context, func(error)) {
+	if t == nil || ctx.Value(exportTracingKey{}) != nil || onlyExportSpans(batch) {
+		return ctx, func(error) {}
+	}
+
+	ctx = context.WithValue(ctx, exportTracingKey{}, true)
+	ctx, span := t.tracer.Start(
+		ctx,
+		exportSpanName,
+		trace.WithNewRoot(),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(t.attrs...),
+		trace.WithAttributes(exportBatchSizeKey.Int(len(batch))),
+	)
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			span.SetAttributes(semconv.ErrorType(err))
+		}
+		span.End()
+	}
+}
+
+// onlyExportSpans reports whether all spans of batch trace exports.
+func onlyExportSpans(batch []ReadOnlySpan) bool {
+	for _, s := range batch {
+		if s.InstrumentationScope().Name != exportTracerName || s.Name() != exportSpanName {
+			return false
+		}
+	}
+	return true
+}
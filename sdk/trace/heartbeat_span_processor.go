@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultHeartbeatInterval is the interval used by NewHeartbeatSpanProcessor
+// if a non-positive one is provided.
+const defaultHeartbeatInterval = time.Minute
+
+// defaultHeartbeatMaxSpans is the default maximum number of spans tracked by
+// a heartbeatSpanProcessor.
+const defaultHeartbeatMaxSpans = 2048
+
+// HeartbeatOption configures a SpanProcessor returned by
+// [NewHeartbeatSpanProcessor].
+type HeartbeatOption interface {
+	apply(heartbeatConfig) heartbeatConfig
+}
+
+type heartbeatConfig struct {
+	maxSpans int
+}
+
+type heartbeatOptionFunc func(heartbeatConfig) heartbeatConfig
+
+func (fn heartbeatOptionFunc) apply(c heartbeatConfig) heartbeatConfig {
+	return fn(c)
+}
+
+// WithHeartbeatMaxSpans sets the maximum number of spans not ended yet
+// tracked at once. The spans started while it is reached are not tracked and
+// their heartbeats are not exported. The default is 2048. If n is less than
+// one, the default is used.
+func WithHeartbeatMaxSpans(n int) HeartbeatOption {
+	return heartbeatOptionFunc(func(c heartbeatConfig) heartbeatConfig {
+		if n > 0 {
+			c.maxSpans = n
+		}
+		return c
+	})
+}
+
+// spanKey identifies a span.
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+// heartbeatSpan is a span tracked by a heartbeatSpanProcessor.
+type heartbeatSpan struct {
+	*recordingSpan
+	// res is the Resource the snapshots of the span are reported with if not
+	// nil, e.g. when the processor is wrapped by WithProcessorResourceFilter.
+	res *resource.Resource
+}
+
+// heartbeatSpanProcessor is a SpanProcessor periodically exporting snapshots
+// of the spans that have not ended yet.
+type heartbeatSpanProcessor struct {
+	exporter SpanExporter
+	interval time.Duration
+	maxSpans int
+
+	mu    sync.Mutex
+	spans map[spanKey]heartbeatSpan
+
+	// exportMu serializes the use of exporter and guards stopped.
+	exportMu sync.Mutex
+	stopped  bool
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+var _ SpanProcessor = (*heartbeatSpanProcessor)(nil)
+
+// NewHeartbeatSpanProcessor returns a SpanProcessor that exports a snapshot
+// of each span started at least interval ago and not ended yet to exporter,
+// every interval. This makes long-running spans, e.g. the ones of batch jobs
+// running for hours, visible before they end.
+//
+// The exported snapshots have a zero EndTime, which is how exporter can
+// distinguish them from ended spans. The ended spans are not exported by this
+// SpanProcessor, another SpanProcessor needs to be registered to export them.
+// The exporter is dedicated to this SpanProcessor, it is shut down when the
+// SpanProcessor is.
+//
+// The spans not ended yet are held in memory. The memory use is bounded by the
+// maximum number of tracked spans set with [WithHeartbeatMaxSpans].
+//
+// If interval is not positive, an interval of 1 minute is used.
+func NewHeartbeatSpanProcessor(
+	exporter SpanExporter,
+	interval time.Duration,
+	opts ...HeartbeatOption,
+) SpanProcessor {
+	if interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+	cfg := heartbeatConfig{maxSpans: defaultHeartbeatMaxSpans}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	p := &heartbeatSpanProcessor{
+		exporter: exporter,
+		interval: interval,
+		maxSpans: cfg.maxSpans,
+		spans:    make(map[spanKey]heartbeatSpan),
+		stopCh:   make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+// run exports the heartbeats every interval until the processor is shut
+// down.
+func (p *heartbeatSpanProcessor) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := p.export(context.Background()); err != nil {
+				otel.Handle(err)
+			}
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// OnStart tracks s until it ends, unless the maximum number of tracked spans
+// is reached.
+func (p *heartbeatSpanProcessor) OnStart(_ context.Context, s ReadWriteSpan) {
+	var hs heartbeatSpan
+	// Unwrap the views of s passed by the SpanProcessor wrappers.
+	for {
+		f, ok := s.(resourceFilteredReadWriteSpan)
+		if !ok {
+			break
+		}
+		if hs.res == nil {
+			hs.res = f.res
+		}
+		s = f.ReadWriteSpan
+	}
+	rs, ok := s.(*recordingSpan)
+	if !ok {
+		return
+	}
+	hs.recordingSpan = rs
+
+	sc := rs.SpanContext()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.spans == nil || len(p.spans) >= p.maxSpans {
+		return
+	}
+	p.spans[spanKey{sc.TraceID(), sc.SpanID()}] = hs
+}
+
+// OnEnd stops tracking s.
+func (p *heartbeatSpanProcessor) OnEnd(s ReadOnlySpan) {
+	sc := s.SpanContext()
+	p.mu.Lock()
+	delete(p.spans, spanKey{sc.TraceID(), sc.SpanID()})
+	p.mu.Unlock()
+}
+
+// export exports a snapshot of the spans started at least p.interval ago and
+// not ended yet.
+func (p *heartbeatSpanProcessor) export(ctx context.Context) error {
+	threshold := time.Now().Add(-p.interval)
+
+	p.mu.Lock()
+	var running []heartbeatSpan
+	for _, s := range p.spans {
+		if !s.startTime.After(threshold) {
+			running = append(running, s)
+		}
+	}
+	p.mu.Unlock()
+
+	snaps := make([]ReadOnlySpan, 0, len(running))
+	for _, s := range running {
+		snap := s.snapshot()
+		if !snap.EndTime().IsZero() {
+			continue
+		}
+		if s.res != nil {
+			snap = resourceFilteredReadOnlySpan{ReadOnlySpan: snap, res: s.res}
+		}
+		snaps = append(snaps, snap)
+	}
+	if len(snaps) == 0 {
+		return nil
+	}
+
+	p.exportMu.Lock()
+	defer p.exportMu.Unlock()
+	if p.stopped {
+		return nil
+	}
+	return p.exporter.ExportSpans(ctx, snaps)
+}
+
+// ForceFlush exports the heartbeats of the spans started at least the
+// interval ago and not ended yet immediately. It does nothing once the
+// processor is shut down.
+func (p *heartbeatSpanProcessor) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return p.export(ctx)
+}
+
+// Shutdown stops exporting heartbeats and shuts down the exporter.
+func (p *heartbeatSpanProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+
+		p.mu.Lock()
+		p.spans = nil
+		p.mu.Unlock()
+
+		p.exportMu.Lock()
+		defer p.exportMu.Unlock()
+		p.stopped = true
+		err = p.exporter.Shutdown(ctx)
+	})
+	return err
+}
@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type shutdownExporter struct {
+	*testExporter
+	shutdown bool
+}
+
+func (e *shutdownExporter) Shutdown(context.Context) error {
+	e.shutdown = true
+	return nil
+}
+
+func TestHeartbeatSpanProcessor(t *testing.T) {
+	exp := &shutdownExporter{testExporter: NewTestExporter()}
+	hsp := NewHeartbeatSpanProcessor(exp, time.Hour)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(hsp)
+	tracer := tp.Tracer("TestHeartbeatSpanProcessor")
+
+	start := time.Now().Add(-2 * time.Hour)
+	_, long := tracer.Start(t.Context(), "long", trace.WithTimestamp(start))
+	_, short := tracer.Start(t.Context(), "short")
+	_, ended := tracer.Start(t.Context(), "ended", trace.WithTimestamp(start))
+	ended.End()
+
+	require.NoError(t, hsp.ForceFlush(t.Context()))
+	require.Equal(t, 1, exp.Len(), "only running spans older than the interval")
+	got, ok := exp.GetSpan("long")
+	require.True(t, ok)
+	assert.Equal(t, long.SpanContext(), got.SpanContext())
+	assert.True(t, got.EndTime().IsZero(), "heartbeat has an end time")
+
+	long.End()
+	require.NoError(t, hsp.ForceFlush(t.Context()))
+	assert.Equal(t, 1, exp.Len(), "heartbeat of ended span")
+
+	short.End()
+	require.NoError(t, tp.Shutdown(t.Context()))
+	assert.True(t, exp.shutdown, "exporter not shut down")
+	assert.NoError(t, hsp.Shutdown(t.Context()), "repeated Shutdown")
+}
+
+func TestHeartbeatSpanProcessorInterval(t *testing.T) {
+	exp := NewTestExporter()
+	hsp := NewHeartbeatSpanProcessor(exp, time.Millisecond)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(hsp)
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	_, span := tp.Tracer("TestHeartbeatSpanProcessorInterval").Start(t.Context(), "span")
+	assert.Eventually(t, func() bool {
+		return exp.Len() >= 2
+	}, 5*time.Second, time.Millisecond, "heartbeats not exported periodically")
+	span.End()
+}
+
+func TestHeartbeatSpanProcessorDefaultInterval(t *testing.T) {
+	hsp := NewHeartbeatSpanProcessor(NewTestExporter(), 0)
+	t.Cleanup(func() { _ = hsp.Shutdown(context.Background()) })
+	assert.Equal(t, defaultHeartbeatInterval, hsp.(*heartbeatSpanProcessor).interval)
+}
+
+func TestHeartbeatSpanProcessorMaxSpans(t *testing.T) {
+	exp := NewTestExporter()
+	hsp := NewHeartbeatSpanProcessor(exp, time.Hour, WithHeartbeatMaxSpans(1))
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(hsp)
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	tracer := tp.Tracer("TestHeartbeatSpanProcessorMaxSpans")
+
+	start := time.Now().Add(-2 * time.Hour)
+	_, first := tracer.Start(t.Context(), "first", trace.WithTimestamp(start))
+	_, second := tracer.Start(t.Context(), "second", trace.WithTimestamp(start))
+
+	require.NoError(t, hsp.ForceFlush(t.Context()))
+	require.Equal(t, 1, exp.Len(), "spans tracked beyond the maximum")
+	_, ok := exp.GetSpan("first")
+	assert.True(t, ok, "first span not tracked")
+
+	first.End()
+	second.End()
+}
+
+func TestHeartbeatSpanProcessorResourceFilter(t *testing.T) {
+	exp := new(resourceExporter)
+	hsp := NewHeartbeatSpanProcessor(exp, time.Hour)
+	filtered := resource.NewSchemaless(attribute.String("service.name", "filtered"))
+	tp := NewTracerProvider(
+		WithResource(resource.NewSchemaless(attribute.String("service.name", "svc"))),
+		WithProcessorResourceFilter(hsp, func(*resource.Resource) *resource.Resource { return filtered }),
+	)
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	start := time.Now().Add(-2 * time.Hour)
+	_, span := tp.Tracer("TestHeartbeatSpanProcessorResourceFilter").Start(
+		t.Context(), "span", trace.WithTimestamp(start),
+	)
+	require.NoError(t, hsp.ForceFlush(t.Context()))
+	exp.mu.Lock()
+	assert.Equal(t, []*resource.Resource{filtered}, exp.res, "span wrapped by a resource filter")
+	exp.mu.Unlock()
+	span.End()
+}
+
+func TestHeartbeatSpanProcessorForceFlushAfterShutdown(t *testing.T) {
+	exp := &shutdownExporter{testExporter: NewTestExporter()}
+	hsp := NewHeartbeatSpanProcessor(exp, time.Hour)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(hsp)
+
+	require.NoError(t, hsp.Shutdown(t.Context()))
+
+	// The processor is still registered, spans keep being passed to it.
+	start := time.Now().Add(-2 * time.Hour)
+	_, span := tp.Tracer("TestHeartbeatSpanProcessorForceFlushAfterShutdown").Start(
+		t.Context(), "span", trace.WithTimestamp(start),
+	)
+	require.NoError(t, hsp.ForceFlush(t.Context()))
+	assert.Equal(t, 0, exp.Len(), "exported after shutdown")
+	span.End()
+}
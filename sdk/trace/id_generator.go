@@ -21,7 +21,7 @@ type IDGenerator interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
 
-	// NewSpanID returns a ID for a new span in the trace with traceID.
+	// NewSpanID returns an ID for a new span in the trace with traceID.
 	NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
@@ -45,23 +45,45 @@ func (*randomIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID
 
 // NewIDs returns a non-zero trace ID and a non-zero span ID from a
 // randomly-chosen sequence.
-func (*randomIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+func (g *randomIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	tid := randomTraceID()
+	return tid, g.NewSpanID(ctx, tid)
+}
+
+// randomTraceID returns a non-zero trace ID from a randomly-chosen sequence.
+func randomTraceID() trace.TraceID {
 	tid := trace.TraceID{}
-	sid := trace.SpanID{}
 	for {
 		binary.NativeEndian.PutUint64(tid[:8], rand.Uint64())
 		binary.NativeEndian.PutUint64(tid[8:], rand.Uint64())
 		if tid.IsValid() {
-			break
-		}
-	}
-	for {
-		binary.NativeEndian.PutUint64(sid[:], rand.Uint64())
-		if sid.IsValid() {
-			break
+			return tid
 		}
 	}
-	return tid, sid
+}
+
+// SpanIDGenerator allows custom generators for SpanID while keeping the
+// default generation of TraceID.
+type SpanIDGenerator interface {
+	// NewSpanID returns an ID for a new span in the trace with traceID. It is
+	// used for all spans, including root spans and spans with a remote
+	// parent.
+	NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID
+}
+
+// spanIDGenerator is an IDGenerator generating trace IDs randomly and span
+// IDs with a SpanIDGenerator.
+type spanIDGenerator struct {
+	SpanIDGenerator
+}
+
+var _ IDGenerator = spanIDGenerator{}
+
+// NewIDs returns a non-zero trace ID from a randomly-chosen sequence and a
+// span ID from the SpanIDGenerator.
+func (g spanIDGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	tid := randomTraceID()
+	return tid, g.NewSpanID(ctx, tid)
 }
 
 func defaultIDGenerator() IDGenerator {
@@ -4,6 +4,9 @@
 package trace
 
 import (
+	"context"
+	"encoding/binary"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,3 +41,63 @@ func TestNewSpanIDWithInvalidTraceID(t *testing.T) {
 	spanID := gen.NewSpanID(t.Context(), trace.TraceID{})
 	assert.Truef(t, spanID.IsValid(), "span id: %s", spanID.String())
 }
+
+// seqSpanIDGenerator is a SpanIDGenerator returning sequential span IDs and
+// recording the trace IDs it is called with.
+type seqSpanIDGenerator struct {
+	mu       sync.Mutex
+	next     uint64
+	traceIDs []trace.TraceID
+}
+
+func (g *seqSpanIDGenerator) NewSpanID(_ context.Context, traceID trace.TraceID) trace.SpanID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.next++
+	g.traceIDs = append(g.traceIDs, traceID)
+	var sid trace.SpanID
+	binary.BigEndian.PutUint64(sid[:], g.next)
+	return sid
+}
+
+func TestWithSpanIDGenerator(t *testing.T) {
+	gen := new(seqSpanIDGenerator)
+	tp := NewTracerProvider(WithSpanIDGenerator(gen))
+	tr := tp.Tracer(t.Name())
+
+	ctx, root := tr.Start(t.Context(), "root")
+	rootSC := root.SpanContext()
+	assert.True(t, rootSC.TraceID().IsValid(), "default trace ID not generated")
+	assert.Equal(t, trace.SpanID{7: 1}, rootSC.SpanID())
+
+	_, child := tr.Start(ctx, "child")
+	assert.Equal(t, rootSC.TraceID(), child.SpanContext().TraceID())
+	assert.Equal(t, trace.SpanID{7: 2}, child.SpanContext().SpanID())
+
+	// The generator is used for spans with a remote parent, with the trace ID
+	// of the parent.
+	remote := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	_, span := tr.Start(trace.ContextWithRemoteSpanContext(t.Context(), remote), "remote child")
+	assert.Equal(t, remote.TraceID(), span.SpanContext().TraceID())
+	assert.Equal(t, trace.SpanID{7: 3}, span.SpanContext().SpanID())
+
+	assert.Equal(t, []trace.TraceID{rootSC.TraceID(), rootSC.TraceID(), remote.TraceID()}, gen.traceIDs)
+}
+
+func TestWithSpanIDGeneratorOrder(t *testing.T) {
+	gen := new(seqSpanIDGenerator)
+
+	tp := NewTracerProvider(WithIDGenerator(defaultIDGenerator()), WithSpanIDGenerator(gen))
+	assert.Equal(t, spanIDGenerator{gen}, tp.idGenerator)
+
+	tp = NewTracerProvider(WithSpanIDGenerator(gen), WithIDGenerator(defaultIDGenerator()))
+	assert.Equal(t, defaultIDGenerator(), tp.idGenerator)
+
+	tp = NewTracerProvider(WithSpanIDGenerator(nil))
+	assert.Equal(t, defaultIDGenerator(), tp.idGenerator)
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observ
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk"
+	"go.opentelemetry.io/otel/sdk/internal/x"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
+)
+
+// ComponentTypeAsyncSpanProcessor is the component type of an asynchronous
+// span processor.
+const ComponentTypeAsyncSpanProcessor otelconv.ComponentTypeAttr = "async_span_processor"
+
+// ASPComponentName returns the component name attribute for an asynchronous
+// span processor with the given ID.
+func ASPComponentName(id int64) attribute.KeyValue {
+	name := fmt.Sprintf("%s/%d", ComponentTypeAsyncSpanProcessor, id)
+	return semconv.OTelComponentName(name)
+}
+
+// ASP is the instrumentation for an OTel SDK asynchronous span processor.
+type ASP struct {
+	reg metric.Registration
+
+	processed              metric.Int64Counter
+	processedOpts          []metric.AddOption
+	processedQueueFullOpts []metric.AddOption
+}
+
+// NewASP returns instrumentation for an OTel SDK asynchronous span processor
+// with the provided ID, queue length function, and queue capacity.
+//
+// If the experimental observability is disabled, nil is returned.
+func NewASP(id int64, qLen func() int64, qMax int64) (*ASP, error) {
+	if !x.Observability.Enabled() {
+		return nil, nil
+	}
+
+	meter := otel.GetMeterProvider().Meter(
+		ScopeName,
+		metric.WithInstrumentationVersion(sdk.Version()),
+		metric.WithSchemaURL(SchemaURL),
+	)
+
+	qCap, err := otelconv.NewSDKProcessorSpanQueueCapacity(meter)
+	if err != nil {
+		err = fmt.Errorf("failed to create ASP queue capacity metric: %w", err)
+	}
+	qCapInst := qCap.Inst()
+
+	qSize, e := otelconv.NewSDKProcessorSpanQueueSize(meter)
+	if e != nil {
+		e := fmt.Errorf("failed to create ASP queue size metric: %w", e)
+		err = errors.Join(err, e)
+	}
+	qSizeInst := qSize.Inst()
+
+	cmpntT := semconv.OTelComponentTypeKey.String(string(ComponentTypeAsyncSpanProcessor))
+	cmpnt := ASPComponentName(id)
+	set := attribute.NewSet(cmpnt, cmpntT)
+
+	obsOpts := []metric.ObserveOption{metric.WithAttributeSet(set)}
+	reg, e := meter.RegisterCallback(
+		func(_ context.Context, o metric.Observer) error {
+			o.ObserveInt64(qSizeInst, qLen(), obsOpts...)
+			o.ObserveInt64(qCapInst, qMax, obsOpts...)
+			return nil
+		},
+		qSizeInst,
+		qCapInst,
+	)
+	if e != nil {
+		e := fmt.Errorf("failed to register ASP queue size/capacity callback: %w", e)
+		err = errors.Join(err, e)
+	}
+
+	processed, e := otelconv.NewSDKProcessorSpanProcessed(meter)
+	if e != nil {
+		e := fmt.Errorf("failed to create ASP processed spans metric: %w", e)
+		err = errors.Join(err, e)
+	}
+	processedOpts := []metric.AddOption{metric.WithAttributeSet(set)}
+
+	set = attribute.NewSet(cmpnt, cmpntT, ErrQueueFull)
+	processedQueueFullOpts := []metric.AddOption{metric.WithAttributeSet(set)}
+
+	return &ASP{
+		reg:                    reg,
+		processed:              processed.Inst(),
+		processedOpts:          processedOpts,
+		processedQueueFullOpts: processedQueueFullOpts,
+	}, err
+}
+
+// Shutdown unregisters the queue size and capacity callback.
+func (a *ASP) Shutdown() error { return a.reg.Unregister() }
+
+// Processed records that n spans have been passed to the wrapped processor.
+func (a *ASP) Processed(ctx context.Context, n int64) {
+	a.processed.Add(ctx, n, a.processedOpts...)
+}
+
+// ProcessedQueueFull records that n spans have been dropped because the
+// queue was full.
+func (a *ASP) ProcessedQueueFull(ctx context.Context, n int64) {
+	a.processed.Add(ctx, n, a.processedQueueFullOpts...)
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package observ_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
+)
+
+func TestASPComponentName(t *testing.T) {
+	got := observ.ASPComponentName(42)
+	want := semconv.OTelComponentName("async_span_processor/42")
+	assert.Equal(t, want, got)
+}
+
+func TestNewASPDisabled(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY
+	asp, err := observ.NewASP(id, nil, 0)
+	assert.NoError(t, err)
+	assert.Nil(t, asp)
+}
+
+func TestNewASPErrors(t *testing.T) {
+	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+
+	orig := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(orig) })
+
+	mp := &errMeterProvider{err: assert.AnError}
+	otel.SetMeterProvider(mp)
+
+	_, err := observ.NewASP(id, nil, 0)
+	require.ErrorIs(t, err, assert.AnError, "new instrument errors")
+
+	assert.ErrorContains(t, err, "create ASP queue capacity metric")
+	assert.ErrorContains(t, err, "create ASP queue size metric")
+	assert.ErrorContains(t, err, "register ASP queue size/capacity callback")
+	assert.ErrorContains(t, err, "create ASP processed spans metric")
+}
+
+func aspSet(attrs ...attribute.KeyValue) attribute.Set {
+	return attribute.NewSet(append([]attribute.KeyValue{
+		semconv.OTelComponentTypeKey.String("async_span_processor"),
+		observ.ASPComponentName(id),
+	}, attrs...)...)
+}
+
+func TestASPCallback(t *testing.T) {
+	collect := setup(t)
+
+	var n int64 = 3
+	asp, err := observ.NewASP(id, func() int64 { return n }, 5)
+	require.NoError(t, err)
+	require.NotNil(t, asp)
+
+	queue := func(name, desc, unit string, v int64) metricdata.Metrics {
+		return metricdata.Metrics{
+			Name:        name,
+			Description: desc,
+			Unit:        unit,
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				DataPoints:  []metricdata.DataPoint[int64]{dPt(aspSet(), v)},
+			},
+		}
+	}
+	size, capacity := otelconv.SDKProcessorSpanQueueSize{}, otelconv.SDKProcessorSpanQueueCapacity{}
+	check(t, collect(),
+		queue(size.Name(), size.Description(), size.Unit(), n),
+		queue(capacity.Name(), capacity.Description(), capacity.Unit(), 5),
+	)
+
+	require.NoError(t, asp.Shutdown())
+	got := collect()
+	assert.Empty(t, got.Metrics, "no metrics after shutdown")
+}
+
+func TestASPProcessed(t *testing.T) {
+	collect := setup(t)
+
+	asp, err := observ.NewASP(id, nil, 0)
+	require.NoError(t, err)
+	require.NotNil(t, asp)
+	require.NoError(t, asp.Shutdown()) // Unregister callback.
+
+	ctx := t.Context()
+	asp.Processed(ctx, 10)
+	asp.ProcessedQueueFull(ctx, 2)
+	check(t, collect(), processed(
+		dPt(aspSet(), 10),
+		dPt(aspSet(observ.ErrQueueFull), 2),
+	))
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -30,6 +32,11 @@ var ErrQueueFull = otelconv.SDKProcessorSpanProcessed{}.AttrErrorType(
 	otelconv.ErrorTypeAttr("queue_full"),
 )
 
+// ErrExportPaused is the attribute value for the "export_paused" error type.
+var ErrExportPaused = otelconv.SDKProcessorSpanProcessed{}.AttrErrorType(
+	otelconv.ErrorTypeAttr("export_paused"),
+)
+
 // BSPComponentName returns the component name attribute for a
 // BatchSpanProcessor with the given ID.
 func BSPComponentName(id int64) attribute.KeyValue {
@@ -42,17 +49,32 @@ func BSPComponentName(id int64) attribute.KeyValue {
 type BSP struct {
 	reg metric.Registration
 
-	processed              metric.Int64Counter
-	processedOpts          []metric.AddOption
-	processedQueueFullOpts []metric.AddOption
+	processed                 metric.Int64Counter
+	processedOpts             []metric.AddOption
+	processedQueueFullOpts    []metric.AddOption
+	processedExportPausedOpts []metric.AddOption
+
+	exported     metric.Int64Counter
+	duration     metric.Float64Histogram
+	attrs        []attribute.KeyValue
+	exportedOpts []metric.AddOption
+	durationOpts []metric.RecordOption
 }
 
-func NewBSP(id int64, qLen func() int64, qMax int64) (*BSP, error) {
-	if !x.Observability.Enabled() {
-		return nil, nil
+// NewBSP returns instrumentation for an OTel SDK BatchSpanProcessor with the
+// provided ID, queue length function, and queue capacity, recorded with mp.
+//
+// If mp is nil, the global MeterProvider is used, and nil is returned if the
+// experimental observability is disabled.
+func NewBSP(mp metric.MeterProvider, id int64, qLen func() int64, qMax int64) (*BSP, error) {
+	if mp == nil {
+		if !x.Observability.Enabled() {
+			return nil, nil
+		}
+		mp = otel.GetMeterProvider()
 	}
 
-	meter := otel.GetMeterProvider().Meter(
+	meter := mp.Meter(
 		ScopeName,
 		metric.WithInstrumentationVersion(sdk.Version()),
 		metric.WithSchemaURL(SchemaURL),
@@ -96,15 +118,38 @@ func NewBSP(id int64, qLen func() int64, qMax int64) (*BSP, error) {
 		err = errors.Join(err, e)
 	}
 	processedOpts := []metric.AddOption{metric.WithAttributeSet(set)}
+	exportedOpts := []metric.AddOption{metric.WithAttributeSet(set)}
+	durationOpts := []metric.RecordOption{metric.WithAttributeSet(set)}
 
 	set = attribute.NewSet(cmpnt, cmpntT, ErrQueueFull)
 	processedQueueFullOpts := []metric.AddOption{metric.WithAttributeSet(set)}
 
+	set = attribute.NewSet(cmpnt, cmpntT, ErrExportPaused)
+	processedExportPausedOpts := []metric.AddOption{metric.WithAttributeSet(set)}
+
+	exported, e := otelconv.NewSDKExporterSpanExported(meter)
+	if e != nil {
+		e := fmt.Errorf("failed to create BSP exported spans metric: %w", e)
+		err = errors.Join(err, e)
+	}
+
+	duration, e := otelconv.NewSDKExporterOperationDuration(meter)
+	if e != nil {
+		e := fmt.Errorf("failed to create BSP export duration metric: %w", e)
+		err = errors.Join(err, e)
+	}
+
 	return &BSP{
-		reg:                    reg,
-		processed:              processed.Inst(),
-		processedOpts:          processedOpts,
-		processedQueueFullOpts: processedQueueFullOpts,
+		reg:                       reg,
+		processed:                 processed.Inst(),
+		processedOpts:             processedOpts,
+		processedQueueFullOpts:    processedQueueFullOpts,
+		processedExportPausedOpts: processedExportPausedOpts,
+		exported:                  exported.Inst(),
+		duration:                  duration.Inst(),
+		attrs:                     []attribute.KeyValue{cmpnt, cmpntT},
+		exportedOpts:              exportedOpts,
+		durationOpts:              durationOpts,
 	}, err
 }
 
@@ -117,3 +162,22 @@ func (b *BSP) Processed(ctx context.Context, n int64) {
 func (b *BSP) ProcessedQueueFull(ctx context.Context, n int64) {
 	b.processed.Add(ctx, n, b.processedQueueFullOpts...)
 }
+
+func (b *BSP) ProcessedExportPaused(ctx context.Context, n int64) {
+	b.processed.Add(ctx, n, b.processedExportPausedOpts...)
+}
+
+// Exported records the export of a batch of n spans that lasted d and failed
+// with err, if not nil, with the semantic conventions metrics of exporters.
+func (b *BSP) Exported(ctx context.Context, n int64, d time.Duration, err error) {
+	if err == nil {
+		b.exported.Add(ctx, n, b.exportedOpts...)
+		b.duration.Record(ctx, d.Seconds(), b.durationOpts...)
+		return
+	}
+	// Failed exports are expected to be rare, do not cache their attributes.
+	attrs := append(slices.Clip(b.attrs), semconv.ErrorType(err))
+	set := attribute.NewSet(attrs...)
+	b.exported.Add(ctx, n, metric.WithAttributeSet(set))
+	b.duration.Record(ctx, d.Seconds(), metric.WithAttributeSet(set))
+}
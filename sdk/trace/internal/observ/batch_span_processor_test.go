@@ -5,6 +5,7 @@ package observ_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,7 @@ import (
 	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
@@ -29,7 +31,7 @@ func TestBSPComponentName(t *testing.T) {
 
 func TestNewBSPDisabled(t *testing.T) {
 	// Do not set OTEL_GO_X_OBSERVABILITY
-	bsp, err := observ.NewBSP(id, nil, 0)
+	bsp, err := observ.NewBSP(nil, id, nil, 0)
 	assert.NoError(t, err)
 	assert.Nil(t, bsp)
 }
@@ -43,13 +45,31 @@ func TestNewBSPErrors(t *testing.T) {
 	mp := &errMeterProvider{err: assert.AnError}
 	otel.SetMeterProvider(mp)
 
-	_, err := observ.NewBSP(id, nil, 0)
+	_, err := observ.NewBSP(nil, id, nil, 0)
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors")
 
 	assert.ErrorContains(t, err, "create BSP queue capacity metric")
 	assert.ErrorContains(t, err, "create BSP queue size metric")
 	assert.ErrorContains(t, err, "register BSP queue size/capacity callback")
 	assert.ErrorContains(t, err, "create BSP processed spans metric")
+	assert.ErrorContains(t, err, "create BSP exported spans metric")
+	assert.ErrorContains(t, err, "create BSP export duration metric")
+}
+
+func TestNewBSPMeterProvider(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY, the MeterProvider enables it.
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+
+	bsp, err := observ.NewBSP(mp, id, func() int64 { return 1 }, 5)
+	require.NoError(t, err)
+	require.NotNil(t, bsp)
+	t.Cleanup(func() { assert.NoError(t, bsp.Shutdown()) })
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &got))
+	require.Len(t, got.ScopeMetrics, 1)
+	check(t, got.ScopeMetrics[0], qSize(1), qCap(5))
 }
 
 func bspSet(attrs ...attribute.KeyValue) attribute.Set {
@@ -93,7 +113,7 @@ func TestBSPCallback(t *testing.T) {
 	collect := setup(t)
 
 	var n int64 = 3
-	bsp, err := observ.NewBSP(id, func() int64 { return n }, 5)
+	bsp, err := observ.NewBSP(nil, id, func() int64 { return n }, 5)
 	require.NoError(t, err)
 	require.NotNil(t, bsp)
 
@@ -123,7 +143,7 @@ func processed(dPts ...metricdata.DataPoint[int64]) metricdata.Metrics {
 func TestBSPProcessed(t *testing.T) {
 	collect := setup(t)
 
-	bsp, err := observ.NewBSP(id, nil, 0)
+	bsp, err := observ.NewBSP(nil, id, nil, 0)
 	require.NoError(t, err)
 	require.NotNil(t, bsp)
 	require.NoError(t, bsp.Shutdown()) // Unregister callback.
@@ -133,27 +153,97 @@ func TestBSPProcessed(t *testing.T) {
 	bsp.Processed(ctx, p0)
 	const e0 int64 = 1
 	bsp.ProcessedQueueFull(ctx, e0)
+	const x0 int64 = 3
+	bsp.ProcessedExportPaused(ctx, x0)
 	check(t, collect(), processed(
 		dPt(bspSet(), p0),
 		dPt(bspSet(observ.ErrQueueFull), e0),
+		dPt(bspSet(observ.ErrExportPaused), x0),
 	))
 
 	const p1 int64 = 20
 	bsp.Processed(ctx, p1)
 	const e1 int64 = 2
 	bsp.ProcessedQueueFull(ctx, e1)
+	const x1 int64 = 4
+	bsp.ProcessedExportPaused(ctx, x1)
 	check(t, collect(), processed(
 		dPt(bspSet(), p0+p1),
 		dPt(bspSet(observ.ErrQueueFull), e0+e1),
+		dPt(bspSet(observ.ErrExportPaused), x0+x1),
 	))
 }
 
+func TestBSPExported(t *testing.T) {
+	collect := setup(t)
+
+	bsp, err := observ.NewBSP(nil, id, nil, 0)
+	require.NoError(t, err)
+	require.NotNil(t, bsp)
+	require.NoError(t, bsp.Shutdown()) // Unregister callback.
+
+	ctx := t.Context()
+	bsp.Exported(ctx, 2, 2*time.Second, nil)
+	bsp.Exported(ctx, 4, time.Second, assert.AnError)
+
+	got := collect()
+	require.Len(t, got.Metrics, 2)
+
+	o := []metricdatatest.Option{
+		metricdatatest.IgnoreTimestamp(),
+		metricdatatest.IgnoreExemplars(),
+		metricdatatest.IgnoreValue(),
+	}
+	exported := otelconv.SDKExporterSpanExported{}
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        exported.Name(),
+		Description: exported.Description(),
+		Unit:        exported.Unit(),
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: bspSet()},
+				{Attributes: bspSet(semconv.ErrorType(assert.AnError))},
+			},
+		},
+	}, got.Metrics[0], o...)
+	duration := otelconv.SDKExporterOperationDuration{}
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        duration.Name(),
+		Description: duration.Description(),
+		Unit:        duration.Unit(),
+		Data: metricdata.Histogram[float64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.HistogramDataPoint[float64]{
+				{Attributes: bspSet()},
+				{Attributes: bspSet(semconv.ErrorType(assert.AnError))},
+			},
+		},
+	}, got.Metrics[1], o...)
+
+	for _, dPt := range got.Metrics[0].Data.(metricdata.Sum[int64]).DataPoints {
+		want := int64(2)
+		if dPt.Attributes.HasValue(semconv.ErrorTypeKey) {
+			want = 4
+		}
+		assert.Equal(t, want, dPt.Value)
+	}
+	for _, dPt := range got.Metrics[1].Data.(metricdata.Histogram[float64]).DataPoints {
+		want := 2.0
+		if dPt.Attributes.HasValue(semconv.ErrorTypeKey) {
+			want = 1.0
+		}
+		assert.InDelta(t, want, dPt.Sum, 1e-9)
+	}
+}
+
 func BenchmarkBSP(b *testing.B) {
 	b.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
 
 	newBSP := func(b *testing.B) *observ.BSP {
 		b.Helper()
-		bsp, err := observ.NewBSP(id, func() int64 { return 3 }, 5)
+		bsp, err := observ.NewBSP(nil, id, func() int64 { return 3 }, 5)
 		require.NoError(b, err)
 		require.NotNil(b, bsp)
 		b.Cleanup(func() {
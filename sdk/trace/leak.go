@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/internal/stack"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxLeakStackDepth is the maximum number of frames recorded for the stack
+// trace of where a span is started when leak detection is enabled.
+const maxLeakStackDepth = 32
+
+// handleLeak reports a leaked span. It is a variable so it can be replaced in
+// tests.
+var handleLeak = otel.Handle
+
+// SpanLeakError is the error reported to the global ErrorHandler when leak
+// detection is enabled and a span is not ended within the grace period.
+type SpanLeakError struct {
+	// Name is the name of the leaked span.
+	Name string
+	// SpanContext is the SpanContext of the leaked span.
+	SpanContext trace.SpanContext
+	// StartTime is the time the leaked span was started.
+	StartTime time.Time
+	// Grace is the grace period the span was not ended within.
+	Grace time.Duration
+
+	pcs []uintptr
+}
+
+// Error returns a description of the leaked span and the stack trace of where
+// it was started.
+func (e *SpanLeakError) Error() string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(
+		&b, "span %q (trace ID %s, span ID %s) not ended %s after it was started, started at:",
+		e.Name, e.SpanContext.TraceID(), e.SpanContext.SpanID(), e.Grace,
+	)
+	_, _ = b.WriteString(e.Stack())
+	return b.String()
+}
+
+// Stack returns the stack trace of where the leaked span was started.
+func (e *SpanLeakError) Stack() string {
+	return stack.Format(e.pcs)
+}
+
+// detectLeak reports s to the global ErrorHandler with the stack trace of its
+// caller if s is not ended within grace. The returned timer needs to be
+// stopped when s is ended.
+func detectLeak(s *recordingSpan, grace time.Duration, skip int) *time.Timer {
+	// Skip detectLeak.
+	pcs := stack.Callers(skip+1, maxLeakStackDepth)
+
+	return time.AfterFunc(grace, func() {
+		s.mu.Lock()
+		recording, name := s.isRecording(), s.name
+		s.mu.Unlock()
+		if !recording {
+			return
+		}
+		handleLeak(&SpanLeakError{
+			Name:        name,
+			SpanContext: s.spanContext,
+			StartTime:   s.startTime,
+			Grace:       grace,
+			pcs:         pcs,
+		})
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func captureLeaks(t *testing.T) <-chan error {
+	t.Helper()
+
+	ch := make(chan error, 10)
+	orig := handleLeak
+	t.Cleanup(func() { handleLeak = orig })
+	handleLeak = func(err error) { ch <- err }
+	return ch
+}
+
+func TestLeakDetection(t *testing.T) {
+	leaks := captureLeaks(t)
+
+	tp := NewTracerProvider(WithLeakDetection(10 * time.Millisecond))
+	tracer := tp.Tracer("TestLeakDetection")
+
+	_, ended := tracer.Start(t.Context(), "ended")
+	ended.End()
+	_, leaked := tracer.Start(t.Context(), "leaked")
+	leaked.SetName("renamed")
+
+	var err error
+	select {
+	case err = <-leaks:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "leaked span not reported")
+	}
+
+	var leakErr *SpanLeakError
+	require.ErrorAs(t, err, &leakErr)
+	assert.Equal(t, "renamed", leakErr.Name)
+	assert.Equal(t, leaked.SpanContext(), leakErr.SpanContext)
+	assert.Equal(t, 10*time.Millisecond, leakErr.Grace)
+	assert.Contains(t, leakErr.Stack(), "TestLeakDetection", "start call site")
+	assert.NotContains(t, leakErr.Stack(), "(*tracer).Start", "SDK frames")
+	assert.Contains(t, err.Error(), leaked.SpanContext().SpanID().String())
+
+	// The ended span is not reported.
+	select {
+	case err := <-leaks:
+		assert.Fail(t, "unexpected leak reported", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestLeakDetectionDisabled(t *testing.T) {
+	leaks := captureLeaks(t)
+
+	tp := NewTracerProvider(WithLeakDetection(10*time.Millisecond), WithLeakDetection(0))
+	_, span := tp.Tracer("TestLeakDetectionDisabled").Start(t.Context(), "span")
+	assert.Nil(t, span.(*recordingSpan).leakTimer)
+
+	select {
+	case err := <-leaks:
+		assert.Fail(t, "unexpected leak reported", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// errExportInProgress is returned by a SpanExporter returned by
+// NewTimeoutExporter while an abandoned export is still running.
+var errExportInProgress = errors.New("previous span export still in progress, spans dropped")
+
+// NewMultiExporter returns a SpanExporter exporting the spans passed to it
+// with each of exporters concurrently. This allows the spans of a single
+// BatchSpanProcessor to be sent to multiple backends, without duplicating
+// its queue.
+//
+// The exporters are isolated from one another: the failure of an exporter
+// does not prevent the others from exporting the spans. If some exporters
+// fail, their errors are sent to the global ErrorHandler and the export is
+// considered successful, so the spans are not exported again to the
+// exporters that succeeded. If all the exporters fail, their errors are
+// returned.
+//
+// An export returns once all the exporters returned. To prevent a slow
+// backend from delaying the others, wrap its exporter with
+// NewTimeoutExporter.
+//
+// Shutdown shuts down all the exporters concurrently and returns their
+// errors. The nil exporters are ignored.
+func NewMultiExporter(exporters ...SpanExporter) SpanExporter {
+	m := &multiExporter{}
+	for _, e := range exporters {
+		if e != nil {
+			m.exporters = append(m.exporters, e)
+		}
+	}
+	return m
+}
+
+// multiExporter is a SpanExporter exporting spans with multiple SpanExporters
+// concurrently.
+type multiExporter struct {
+	exporters []SpanExporter
+}
+
+var _ SpanExporter = (*multiExporter)(nil)
+
+// ExportSpans exports spans with all the exporters of e concurrently.
+func (e *multiExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	errs := e.each(func(exp SpanExporter) error {
+		return exp.ExportSpans(ctx, spans)
+	})
+
+	var failed int
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+	if failed < len(errs) {
+		for i, err := range errs {
+			if err != nil {
+				otel.Handle(fmt.Errorf("span exporter %d: %w", i, err))
+			}
+		}
+		return nil
+	}
+	return e.join(errs)
+}
+
+// Shutdown shuts down all the exporters of e concurrently.
+func (e *multiExporter) Shutdown(ctx context.Context) error {
+	return e.join(e.each(func(exp SpanExporter) error {
+		return exp.Shutdown(ctx)
+	}))
+}
+
+// each calls f with each exporter of e concurrently, and returns the errors
+// it returned, in the order of the exporters.
+func (e *multiExporter) each(f func(SpanExporter) error) []error {
+	errs := make([]error, len(e.exporters))
+	var wg sync.WaitGroup
+	for i, exp := range e.exporters {
+		wg.Go(func() { errs[i] = f(exp) })
+	}
+	wg.Wait()
+	return errs
+}
+
+// join returns the errors of the exporters of e joined, annotated with the
+// index of their exporter.
+func (*multiExporter) join(errs []error) error {
+	var out []error
+	for i, err := range errs {
+		if err != nil {
+			out = append(out, fmt.Errorf("span exporter %d: %w", i, err))
+		}
+	}
+	return errors.Join(out...)
+}
+
+// NewTimeoutExporter returns a SpanExporter exporting spans with exporter,
+// bounding the duration of each export by timeout.
+//
+// The context passed to exporter is cancelled once timeout elapses. If
+// exporter does not return by then, e.g. because it does not honor the
+// cancellation, the export returns an error wrapping
+// context.DeadlineExceeded without waiting for it. Until the abandoned export
+// returns, the spans of the next exports are dropped and an error is returned,
+// so exporter is never called concurrently.
+//
+// If timeout is less than or equal to zero, exporter is returned unchanged.
+func NewTimeoutExporter(exporter SpanExporter, timeout time.Duration) SpanExporter {
+	if timeout <= 0 {
+		return exporter
+	}
+	return &timeoutExporter{SpanExporter: exporter, timeout: timeout}
+}
+
+// timeoutExporter is a SpanExporter bounding the duration of the exports of
+// the wrapped SpanExporter.
+type timeoutExporter struct {
+	SpanExporter
+
+	timeout time.Duration
+	// running is held while an export of the wrapped SpanExporter runs.
+	running sync.Mutex
+}
+
+var _ SpanExporter = (*timeoutExporter)(nil)
+
+// ExportSpans exports spans with the wrapped SpanExporter, returning once it
+// returns or the timeout elapses.
+func (e *timeoutExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if !e.running.TryLock() {
+		return errExportInProgress
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	// The caller may reuse spans once the export returns, while an abandoned
+	// export still reads it.
+	spans = slices.Clone(spans)
+	done := make(chan error, 1)
+	go func() {
+		defer e.running.Unlock()
+		defer cancel()
+		done <- e.SpanExporter.ExportSpans(ctx, spans)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		// Prefer the result of the export if it returned meanwhile.
+		select {
+		case err := <-done:
+			return err
+		default:
+		}
+		return fmt.Errorf("span export abandoned: %w", ctx.Err())
+	}
+}
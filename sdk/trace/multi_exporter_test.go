@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+)
+
+// hungExporter is a SpanExporter whose exports ignore the cancellation of
+// their context and block until release is closed.
+type hungExporter struct {
+	release chan struct{}
+
+	mu    sync.Mutex
+	calls int
+}
+
+func newHungExporter(t *testing.T) *hungExporter {
+	e := &hungExporter{release: make(chan struct{})}
+	t.Cleanup(func() { e.unblock() })
+	return e
+}
+
+func (e *hungExporter) ExportSpans(context.Context, []ReadOnlySpan) error {
+	e.mu.Lock()
+	e.calls++
+	e.mu.Unlock()
+	<-e.release
+	return nil
+}
+
+func (*hungExporter) Shutdown(context.Context) error { return nil }
+
+func (e *hungExporter) unblock() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	select {
+	case <-e.release:
+	default:
+		close(e.release)
+	}
+}
+
+func (e *hungExporter) len() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls
+}
+
+func TestMultiExporter(t *testing.T) {
+	a, b := NewTestExporter(), NewTestExporter()
+	exp := NewMultiExporter(a, nil, b)
+
+	spans := []ReadOnlySpan{&snapshot{name: "span"}}
+	require.NoError(t, exp.ExportSpans(t.Context(), spans))
+	assert.Equal(t, 1, a.Len())
+	assert.Equal(t, 1, b.Len())
+
+	require.NoError(t, exp.Shutdown(t.Context()))
+	assert.Equal(t, 0, a.Len(), "exporter not shut down")
+	assert.Equal(t, 0, b.Len(), "exporter not shut down")
+}
+
+func TestMultiExporterPartialFailure(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	ok := NewTestExporter()
+	failed := &testBatchExporter{errors: []error{assert.AnError}}
+	exp := NewMultiExporter(failed, ok)
+
+	require.NoError(t, exp.ExportSpans(t.Context(), []ReadOnlySpan{&snapshot{name: "span"}}))
+	assert.Equal(t, 1, ok.Len())
+	require.Len(t, handled, 1)
+	assert.ErrorIs(t, handled[0], assert.AnError)
+	assert.EqualError(t, handled[0], "span exporter 0: "+assert.AnError.Error())
+}
+
+func TestMultiExporterAllFailed(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	exp := NewMultiExporter(
+		&testBatchExporter{errors: []error{errA}},
+		&testBatchExporter{errors: []error{errB}},
+	)
+
+	err := exp.ExportSpans(t.Context(), []ReadOnlySpan{&snapshot{name: "span"}})
+	assert.ErrorIs(t, err, errA)
+	assert.ErrorIs(t, err, errB)
+	assert.EqualError(t, err, "span exporter 0: a\nspan exporter 1: b")
+}
+
+func TestMultiExporterShutdown(t *testing.T) {
+	errA := errors.New("a")
+	shutdown := &testBatchExporter{}
+	exp := NewMultiExporter(&shutdownErrorExporter{err: errA}, shutdown)
+
+	assert.ErrorIs(t, exp.Shutdown(t.Context()), errA)
+	assert.Equal(t, 1, shutdown.shutdownCount)
+}
+
+type shutdownErrorExporter struct {
+	testBatchExporter
+
+	err error
+}
+
+func (e *shutdownErrorExporter) Shutdown(context.Context) error { return e.err }
+
+func TestMultiExporterTimeout(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	stuck := newHungExporter(t)
+	ok := NewTestExporter()
+	exp := NewMultiExporter(NewTimeoutExporter(stuck, 10*time.Millisecond), ok)
+
+	// The stuck exporter does not prevent the other one from exporting.
+	require.NoError(t, exp.ExportSpans(t.Context(), []ReadOnlySpan{&snapshot{name: "span"}}))
+	assert.Equal(t, 1, ok.Len())
+	require.Len(t, handled, 1)
+	assert.ErrorIs(t, handled[0], context.DeadlineExceeded)
+}
+
+func TestTimeoutExporter(t *testing.T) {
+	stuck := newHungExporter(t)
+	exp := NewTimeoutExporter(stuck, 10*time.Millisecond)
+	spans := []ReadOnlySpan{&snapshot{name: "span"}}
+
+	err := exp.ExportSpans(t.Context(), spans)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	// The abandoned export is still running, the next one is dropped.
+	assert.ErrorIs(t, exp.ExportSpans(t.Context(), spans), errExportInProgress)
+	assert.Equal(t, 1, stuck.len())
+
+	stuck.unblock()
+	assert.Eventually(t, func() bool {
+		return exp.ExportSpans(t.Context(), spans) == nil
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, 2, stuck.len())
+}
+
+func TestTimeoutExporterResult(t *testing.T) {
+	te := &testBatchExporter{errors: []error{assert.AnError}}
+	exp := NewTimeoutExporter(te, time.Minute)
+	spans := []ReadOnlySpan{&snapshot{name: "span"}}
+
+	assert.ErrorIs(t, exp.ExportSpans(t.Context(), spans), assert.AnError)
+	require.NoError(t, exp.ExportSpans(t.Context(), spans))
+	assert.Equal(t, 1, te.len())
+
+	assert.Same(t, te, NewTimeoutExporter(te, 0))
+}
+
+func TestBatchSpanProcessorMultiExporter(t *testing.T) {
+	stuck := newHungExporter(t)
+	ok := NewTestExporter()
+	tp := basicTracerProvider(t)
+	bsp := NewBatchSpanProcessor(NewMultiExporter(NewTimeoutExporter(stuck, 10*time.Millisecond), ok))
+	tp.RegisterSpanProcessor(bsp)
+
+	generateSpan(t, tp.Tracer("TestBatchSpanProcessorMultiExporter"), testOption{genNumSpans: 3})
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+	assert.Equal(t, 3, ok.Len())
+}
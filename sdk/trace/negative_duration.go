@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// NegativeDurationKey is the attribute key set on spans that ended before they
+// started when a NegativeDurationHandling other than
+// NegativeDurationUnchanged is used. Its value is the negative duration, in
+// nanoseconds, the span would have had.
+const NegativeDurationKey = attribute.Key("io.opentelemetry.go.sdk.span.negative_duration")
+
+// NegativeDurationHandling defines how the end time of a span ending before it
+// started is handled. This happens when the start or end timestamp of a span
+// is provided, e.g. with [go.opentelemetry.io/otel/trace.WithTimestamp], and
+// read from a wall clock adjusted in between, or from different clocks.
+type NegativeDurationHandling int
+
+const (
+	// NegativeDurationUnchanged keeps the end time of spans ending before they
+	// started. Their negative duration is exported. This is the default.
+	NegativeDurationUnchanged NegativeDurationHandling = iota
+	// NegativeDurationClamp sets the end time of spans ending before they
+	// started to their start time, clamping their duration to zero. The
+	// spans are annotated with the NegativeDurationKey attribute.
+	NegativeDurationClamp
+	// NegativeDurationMonotonic sets the end time of spans ending before they
+	// started to their start time plus the duration measured with the
+	// monotonic clock of the process from their start to the call of their
+	// End method. The duration is clamped to zero if it cannot be measured,
+	// e.g. because the start time was provided in the future. The spans are
+	// annotated with the NegativeDurationKey attribute.
+	NegativeDurationMonotonic
+	// NegativeDurationAnnotate keeps the end time of spans ending before they
+	// started and annotates them with the NegativeDurationKey attribute.
+	NegativeDurationAnnotate
+)
+
+// WithNegativeDurationHandling returns a TracerProviderOption that configures
+// how the spans ending before they started are handled. See
+// NegativeDurationHandling for the possible values.
+//
+// By default, NegativeDurationUnchanged is used and the negative durations of
+// spans are exported as is, which can break the computations of backends.
+func WithNegativeDurationHandling(h NegativeDurationHandling) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.negativeDuration = h
+		return cfg
+	})
+}
+
+// handleNegativeDuration returns the end time of s ending at end, before its
+// start time, according to the NegativeDurationHandling of its provider. now is
+// the monotonic time at which s was ended. It must be called while holding
+// s.mu.
+func (s *recordingSpan) handleNegativeDuration(end, now time.Time) time.Time {
+	h := s.tracer.provider.negativeDuration
+	if h == NegativeDurationUnchanged {
+		return end
+	}
+
+	s.setAttributes([]attribute.KeyValue{NegativeDurationKey.Int64(int64(end.Sub(s.startTime)))})
+	switch h {
+	case NegativeDurationClamp:
+		return s.startTime
+	case NegativeDurationMonotonic:
+		if now.Before(s.startTime) {
+			return s.startTime
+		}
+		return now
+	}
+	return end
+}
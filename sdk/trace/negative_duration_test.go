@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNegativeDurationHandling(t *testing.T) {
+	start := time.Now()
+	end := start.Add(-time.Second)
+	annotation := NegativeDurationKey.Int64(int64(-time.Second))
+
+	tests := []struct {
+		name     string
+		opts     []TracerProviderOption
+		wantEnd  func(*testing.T, time.Time)
+		annotate bool
+	}{
+		{
+			name:    "Default",
+			wantEnd: func(t *testing.T, got time.Time) { assert.Equal(t, end, got) },
+		},
+		{
+			name:    "Unchanged",
+			opts:    []TracerProviderOption{WithNegativeDurationHandling(NegativeDurationUnchanged)},
+			wantEnd: func(t *testing.T, got time.Time) { assert.Equal(t, end, got) },
+		},
+		{
+			name:     "Clamp",
+			opts:     []TracerProviderOption{WithNegativeDurationHandling(NegativeDurationClamp)},
+			wantEnd:  func(t *testing.T, got time.Time) { assert.Equal(t, start, got) },
+			annotate: true,
+		},
+		{
+			name: "Monotonic",
+			opts: []TracerProviderOption{WithNegativeDurationHandling(NegativeDurationMonotonic)},
+			wantEnd: func(t *testing.T, got time.Time) {
+				assert.False(t, got.Before(start), "end before start")
+				assert.False(t, got.After(time.Now()), "end in the future")
+			},
+			annotate: true,
+		},
+		{
+			name:     "Annotate",
+			opts:     []TracerProviderOption{WithNegativeDurationHandling(NegativeDurationAnnotate)},
+			wantEnd:  func(t *testing.T, got time.Time) { assert.Equal(t, end, got) },
+			annotate: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			te := NewTestExporter()
+			tp := NewTracerProvider(append(tt.opts, WithSyncer(te))...)
+			t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+			_, span := tp.Tracer("TestNegativeDurationHandling").Start(
+				t.Context(), "span", trace.WithTimestamp(start),
+			)
+			span.End(trace.WithTimestamp(end))
+
+			got, ok := te.GetSpan("span")
+			require.True(t, ok)
+			assert.Equal(t, start, got.StartTime())
+			tt.wantEnd(t, got.EndTime())
+			if tt.annotate {
+				assert.Equal(t, []attribute.KeyValue{annotation}, got.Attributes())
+			} else {
+				assert.Empty(t, got.Attributes())
+			}
+		})
+	}
+}
+
+func TestNegativeDurationHandlingPositiveDuration(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithNegativeDurationHandling(NegativeDurationClamp))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	start := time.Now()
+	end := start.Add(time.Second)
+	_, span := tp.Tracer("TestNegativeDurationHandlingPositiveDuration").Start(
+		t.Context(), "span", trace.WithTimestamp(start),
+	)
+	span.End(trace.WithTimestamp(end))
+
+	got, ok := te.GetSpan("span")
+	require.True(t, ok)
+	assert.Equal(t, end, got.EndTime())
+	assert.Empty(t, got.Attributes())
+}
@@ -0,0 +1,551 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// segmentExt is the extension of the segment files of a persistentQueue.
+	segmentExt = ".spans"
+	// segmentTmpExt is the extension of the segment files of a
+	// persistentQueue being written.
+	segmentTmpExt = ".tmp"
+	// segmentVersion is the version of the encoding of the segment files.
+	segmentVersion = 1
+)
+
+// errSegment is returned for segment files that cannot be decoded.
+var errSegment = errors.New("invalid persistent queue segment")
+
+// segment is a file of a persistentQueue holding a batch of spans.
+type segment struct {
+	seq   uint64
+	spans int
+	size  int64
+}
+
+// name returns the file name of s. The name is ordered by sequence number and
+// holds the number of spans so they can be counted when s is dropped without
+// decoding it.
+func (s segment) name() string {
+	return fmt.Sprintf("%020d-%d%s", s.seq, s.spans, segmentExt)
+}
+
+// parseSegment returns the segment of the file with name, and false if it is
+// not a segment file.
+func parseSegment(name string) (segment, bool) {
+	base, ok := strings.CutSuffix(name, segmentExt)
+	if !ok {
+		return segment{}, false
+	}
+	var s segment
+	if n, err := fmt.Sscanf(base, "%d-%d", &s.seq, &s.spans); err != nil || n != 2 {
+		return segment{}, false
+	}
+	return s, true
+}
+
+// persistentQueue stores the batches of spans a BatchSpanProcessor failed to
+// export in the segment files of a directory, until they are exported. The
+// total size of the segments is bounded, the oldest segments are dropped to
+// store new ones.
+//
+// A persistentQueue is not safe for concurrent use.
+type persistentQueue struct {
+	dir      string
+	maxBytes int64
+
+	// segments are the stored segments, oldest first.
+	segments []segment
+	size     int64
+	next     uint64
+}
+
+// openPersistentQueue returns a persistentQueue storing its segments in dir,
+// with the segments stored by a previous persistentQueue using dir.
+func openPersistentQueue(dir string, maxBytes int64) (*persistentQueue, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &persistentQueue{dir: dir, maxBytes: maxBytes}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(e.Name(), segmentTmpExt) {
+			// Partially written before the process stopped.
+			_ = os.Remove(filepath.Join(dir, e.Name()))
+			continue
+		}
+		s, ok := parseSegment(e.Name())
+		if !ok {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		s.size = info.Size()
+		q.segments = append(q.segments, s)
+		q.size += s.size
+		q.next = max(q.next, s.seq+1)
+	}
+	slices.SortFunc(q.segments, func(a, b segment) int { return cmp.Compare(a.seq, b.seq) })
+	return q, nil
+}
+
+// len returns the number of stored segments.
+func (q *persistentQueue) len() int {
+	return len(q.segments)
+}
+
+// push stores spans in a new segment, and returns the number of spans of the
+// older segments dropped to bound the size of q. If the new segment is larger
+// than the bound by itself, it is not stored and its spans are counted as
+// dropped.
+func (q *persistentQueue) push(spans []ReadOnlySpan) (int, error) {
+	s := segment{seq: q.next, spans: len(spans)}
+	path := filepath.Join(q.dir, s.name())
+	tmp := path + segmentTmpExt
+
+	size, err := writeSegment(tmp, spans)
+	if err != nil {
+		_ = os.Remove(tmp)
+		return 0, err
+	}
+	if q.maxBytes > 0 && size > q.maxBytes {
+		_ = os.Remove(tmp)
+		return len(spans), nil
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return 0, err
+	}
+	q.next++
+	s.size = size
+	q.segments = append(q.segments, s)
+	q.size += size
+
+	var dropped int
+	for q.maxBytes > 0 && q.size > q.maxBytes && len(q.segments) > 1 {
+		dropped += q.segments[0].spans
+		err = errors.Join(err, q.pop())
+	}
+	return dropped, err
+}
+
+// peek returns the spans of the oldest segment. It returns false if q is
+// empty. If the segment cannot be decoded, an error wrapping errSegment is
+// returned, the segment needs to be popped.
+func (q *persistentQueue) peek() ([]ReadOnlySpan, bool, error) {
+	if len(q.segments) == 0 {
+		return nil, false, nil
+	}
+	spans, err := readSegment(filepath.Join(q.dir, q.segments[0].name()))
+	return spans, true, err
+}
+
+// pop removes the oldest segment.
+func (q *persistentQueue) pop() error {
+	if len(q.segments) == 0 {
+		return nil
+	}
+	s := q.segments[0]
+	q.segments = q.segments[1:]
+	q.size -= s.size
+	err := os.Remove(filepath.Join(q.dir, s.name()))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// writeSegment writes spans to the file at path, synced to disk, and returns
+// its size.
+func writeSegment(path string, spans []ReadOnlySpan) (int64, error) {
+	f, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(f)
+	err = gob.NewEncoder(w).Encode(encodeSegment(spans))
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	var size int64
+	if err == nil {
+		var info os.FileInfo
+		if info, err = f.Stat(); err == nil {
+			size = info.Size()
+		}
+	}
+	return size, errors.Join(err, f.Close())
+}
+
+// readSegment returns the spans of the segment file at path.
+func readSegment(path string) ([]ReadOnlySpan, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var seg walSegment
+	if err := gob.NewDecoder(bufio.NewReader(f)).Decode(&seg); err != nil {
+		return nil, fmt.Errorf("%w %s: %w", errSegment, path, err)
+	}
+	if seg.Version != segmentVersion {
+		return nil, fmt.Errorf("%w %s: unsupported version %d", errSegment, path, seg.Version)
+	}
+	spans, err := seg.decode()
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", errSegment, path, err)
+	}
+	return spans, nil
+}
+
+// walSegment is the encoding of a segment file. The resources and scopes
+// shared by the spans are only encoded once.
+type walSegment struct {
+	Version   int
+	Resources []walResource
+	Scopes    []walScope
+	Spans     []walSpan
+}
+
+type walResource struct {
+	SchemaURL  string
+	Attributes []walKeyValue
+}
+
+type walScope struct {
+	Name, Version, SchemaURL string
+	Attributes               []walKeyValue
+}
+
+type walSpanContext struct {
+	TraceID    trace.TraceID
+	SpanID     trace.SpanID
+	TraceFlags trace.TraceFlags
+	TraceState string
+	Remote     bool
+}
+
+type walEvent struct {
+	Name                  string
+	Time                  time.Time
+	Attributes            []walKeyValue
+	DroppedAttributeCount int
+}
+
+type walLink struct {
+	SpanContext           walSpanContext
+	Attributes            []walKeyValue
+	DroppedAttributeCount int
+}
+
+type walSpan struct {
+	Name                  string
+	SpanContext           walSpanContext
+	Parent                walSpanContext
+	SpanKind              trace.SpanKind
+	StartTime, EndTime    time.Time
+	Attributes            []walKeyValue
+	Events                []walEvent
+	Links                 []walLink
+	StatusCode            codes.Code
+	StatusDescription     string
+	ChildSpanCount        int
+	DroppedAttributes     int
+	DroppedEvents         int
+	DroppedLinks          int
+	Resource, Scope       int
+	HasResource, HasScope bool
+}
+
+type walKeyValue struct {
+	Key   string
+	Value walValue
+}
+
+// walValue is the encoding of an attribute.Value. Only the field of its type
+// is set.
+type walValue struct {
+	Type     attribute.Type
+	Bool     bool
+	Int64    int64
+	Float64  float64
+	String   string
+	Bools    []bool
+	Int64s   []int64
+	Float64s []float64
+	Strings  []string
+	Bytes    []byte
+	Slice    []walValue
+	Map      []walKeyValue
+}
+
+// encodeSegment returns the encoding of spans.
+func encodeSegment(spans []ReadOnlySpan) walSegment {
+	seg := walSegment{Version: segmentVersion, Spans: make([]walSpan, len(spans))}
+	resources := make(map[*resource.Resource]int)
+	scopes := make(map[instrumentation.Scope]int)
+	for i, s := range spans {
+		ws := walSpan{
+			Name:              s.Name(),
+			SpanContext:       encodeSpanContext(s.SpanContext()),
+			Parent:            encodeSpanContext(s.Parent()),
+			SpanKind:          s.SpanKind(),
+			StartTime:         s.StartTime(),
+			EndTime:           s.EndTime(),
+			Attributes:        encodeAttributes(s.Attributes()),
+			StatusCode:        s.Status().Code,
+			StatusDescription: s.Status().Description,
+			ChildSpanCount:    s.ChildSpanCount(),
+			DroppedAttributes: s.DroppedAttributes(),
+			DroppedEvents:     s.DroppedEvents(),
+			DroppedLinks:      s.DroppedLinks(),
+		}
+		for _, e := range s.Events() {
+			ws.Events = append(ws.Events, walEvent{
+				Name:                  e.Name,
+				Time:                  e.Time,
+				Attributes:            encodeAttributes(e.Attributes),
+				DroppedAttributeCount: e.DroppedAttributeCount,
+			})
+		}
+		for _, l := range s.Links() {
+			ws.Links = append(ws.Links, walLink{
+				SpanContext:           encodeSpanContext(l.SpanContext),
+				Attributes:            encodeAttributes(l.Attributes),
+				DroppedAttributeCount: l.DroppedAttributeCount,
+			})
+		}
+		if res := s.Resource(); res != nil {
+			idx, ok := resources[res]
+			if !ok {
+				idx = len(seg.Resources)
+				resources[res] = idx
+				seg.Resources = append(seg.Resources, walResource{
+					SchemaURL:  res.SchemaURL(),
+					Attributes: encodeAttributes(res.Attributes()),
+				})
+			}
+			ws.Resource, ws.HasResource = idx, true
+		}
+		if sc := s.InstrumentationScope(); sc != (instrumentation.Scope{}) {
+			idx, ok := scopes[sc]
+			if !ok {
+				idx = len(seg.Scopes)
+				scopes[sc] = idx
+				seg.Scopes = append(seg.Scopes, walScope{
+					Name:       sc.Name,
+					Version:    sc.Version,
+					SchemaURL:  sc.SchemaURL,
+					Attributes: encodeAttributes(sc.Attributes.ToSlice()),
+				})
+			}
+			ws.Scope, ws.HasScope = idx, true
+		}
+		seg.Spans[i] = ws
+	}
+	return seg
+}
+
+// decode returns the spans of seg.
+func (seg walSegment) decode() ([]ReadOnlySpan, error) {
+	resources := make([]*resource.Resource, len(seg.Resources))
+	for i, r := range seg.Resources {
+		resources[i] = resource.NewWithAttributes(r.SchemaURL, decodeAttributes(r.Attributes)...)
+	}
+	scopes := make([]instrumentation.Scope, len(seg.Scopes))
+	for i, sc := range seg.Scopes {
+		scopes[i] = instrumentation.Scope{
+			Name:       sc.Name,
+			Version:    sc.Version,
+			SchemaURL:  sc.SchemaURL,
+			Attributes: attribute.NewSet(decodeAttributes(sc.Attributes)...),
+		}
+	}
+
+	spans := make([]ReadOnlySpan, len(seg.Spans))
+	for i, ws := range seg.Spans {
+		s := &snapshot{
+			name:                  ws.Name,
+			spanContext:           decodeSpanContext(ws.SpanContext),
+			parent:                decodeSpanContext(ws.Parent),
+			spanKind:              ws.SpanKind,
+			startTime:             ws.StartTime,
+			endTime:               ws.EndTime,
+			attributes:            decodeAttributes(ws.Attributes),
+			status:                Status{Code: ws.StatusCode, Description: ws.StatusDescription},
+			childSpanCount:        ws.ChildSpanCount,
+			droppedAttributeCount: ws.DroppedAttributes,
+			droppedEventCount:     ws.DroppedEvents,
+			droppedLinkCount:      ws.DroppedLinks,
+		}
+		for _, e := range ws.Events {
+			s.events = append(s.events, Event{
+				Name:                  e.Name,
+				Time:                  e.Time,
+				Attributes:            decodeAttributes(e.Attributes),
+				DroppedAttributeCount: e.DroppedAttributeCount,
+			})
+		}
+		for _, l := range ws.Links {
+			s.links = append(s.links, Link{
+				SpanContext:           decodeSpanContext(l.SpanContext),
+				Attributes:            decodeAttributes(l.Attributes),
+				DroppedAttributeCount: l.DroppedAttributeCount,
+			})
+		}
+		if ws.HasResource {
+			if ws.Resource < 0 || ws.Resource >= len(resources) {
+				return nil, fmt.Errorf("resource index %d out of range", ws.Resource)
+			}
+			s.resource = resources[ws.Resource]
+		}
+		if ws.HasScope {
+			if ws.Scope < 0 || ws.Scope >= len(scopes) {
+				return nil, fmt.Errorf("scope index %d out of range", ws.Scope)
+			}
+			s.instrumentationScope = scopes[ws.Scope]
+		}
+		spans[i] = s
+	}
+	return spans, nil
+}
+
+func encodeSpanContext(sc trace.SpanContext) walSpanContext {
+	return walSpanContext{
+		TraceID:    sc.TraceID(),
+		SpanID:     sc.SpanID(),
+		TraceFlags: sc.TraceFlags(),
+		TraceState: sc.TraceState().String(),
+		Remote:     sc.IsRemote(),
+	}
+}
+
+func decodeSpanContext(w walSpanContext) trace.SpanContext {
+	// The trace state was valid when encoded.
+	ts, _ := trace.ParseTraceState(w.TraceState)
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    w.TraceID,
+		SpanID:     w.SpanID,
+		TraceFlags: w.TraceFlags,
+		TraceState: ts,
+		Remote:     w.Remote,
+	})
+}
+
+func encodeAttributes(attrs []attribute.KeyValue) []walKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]walKeyValue, len(attrs))
+	for i, a := range attrs {
+		out[i] = walKeyValue{Key: string(a.Key), Value: encodeValue(a.Value)}
+	}
+	return out
+}
+
+func decodeAttributes(attrs []walKeyValue) []attribute.KeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]attribute.KeyValue, len(attrs))
+	for i, a := range attrs {
+		out[i] = attribute.KeyValue{Key: attribute.Key(a.Key), Value: decodeValue(a.Value)}
+	}
+	return out
+}
+
+func encodeValue(v attribute.Value) walValue {
+	w := walValue{Type: v.Type()}
+	switch v.Type() {
+	case attribute.BOOL:
+		w.Bool = v.AsBool()
+	case attribute.INT64:
+		w.Int64 = v.AsInt64()
+	case attribute.FLOAT64:
+		w.Float64 = v.AsFloat64()
+	case attribute.STRING:
+		w.String = v.AsString()
+	case attribute.BOOLSLICE:
+		w.Bools = v.AsBoolSlice()
+	case attribute.INT64SLICE:
+		w.Int64s = v.AsInt64Slice()
+	case attribute.FLOAT64SLICE:
+		w.Float64s = v.AsFloat64Slice()
+	case attribute.STRINGSLICE:
+		w.Strings = v.AsStringSlice()
+	case attribute.BYTESLICE:
+		w.Bytes = v.AsByteSlice()
+	case attribute.SLICE:
+		for _, e := range v.AsSlice() {
+			w.Slice = append(w.Slice, encodeValue(e))
+		}
+	case attribute.MAP:
+		w.Map = encodeAttributes(v.AsMap())
+	}
+	return w
+}
+
+func decodeValue(w walValue) attribute.Value {
+	switch w.Type {
+	case attribute.BOOL:
+		return attribute.BoolValue(w.Bool)
+	case attribute.INT64:
+		return attribute.Int64Value(w.Int64)
+	case attribute.FLOAT64:
+		return attribute.Float64Value(w.Float64)
+	case attribute.STRING:
+		return attribute.StringValue(w.String)
+	case attribute.BOOLSLICE:
+		return attribute.BoolSliceValue(w.Bools)
+	case attribute.INT64SLICE:
+		return attribute.Int64SliceValue(w.Int64s)
+	case attribute.FLOAT64SLICE:
+		return attribute.Float64SliceValue(w.Float64s)
+	case attribute.STRINGSLICE:
+		return attribute.StringSliceValue(w.Strings)
+	case attribute.BYTESLICE:
+		return attribute.ByteSliceValue(w.Bytes)
+	case attribute.SLICE:
+		values := make([]attribute.Value, len(w.Slice))
+		for i, e := range w.Slice {
+			values[i] = decodeValue(e)
+		}
+		return attribute.SliceValue(values...)
+	case attribute.MAP:
+		return attribute.MapValue(decodeAttributes(w.Map)...)
+	default:
+		return attribute.Value{}
+	}
+}
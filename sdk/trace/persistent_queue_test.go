@@ -0,0 +1,310 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func persistedSpan(name string) *snapshot {
+	ts, err := trace.ParseTraceState("vendor=value")
+	if err != nil {
+		panic(err)
+	}
+	start := time.Date(2026, time.January, 2, 3, 4, 5, 6, time.UTC)
+	return &snapshot{
+		name: name,
+		spanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: trace.FlagsSampled,
+			TraceState: ts,
+		}),
+		parent: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{3},
+			Remote:  true,
+		}),
+		spanKind:  trace.SpanKindServer,
+		startTime: start,
+		endTime:   start.Add(time.Second),
+		attributes: []attribute.KeyValue{
+			attribute.Bool("bool", true),
+			attribute.Int64("int", math.MaxInt64),
+			attribute.Float64("float", math.NaN()),
+			attribute.String("string", "value"),
+			attribute.BoolSlice("bools", []bool{true, false}),
+			attribute.Int64Slice("ints", []int64{1, -1}),
+			attribute.Float64Slice("floats", []float64{1.5}),
+			attribute.StringSlice("strings", []string{"a", "b"}),
+			attribute.ByteSlice("bytes", []byte{0, 1}),
+			attribute.Slice("slice", attribute.IntValue(1), attribute.StringValue("a")),
+			attribute.Map("map", attribute.String("key", "value")),
+		},
+		events: []Event{{
+			Name:                  "event",
+			Time:                  start.Add(time.Millisecond),
+			Attributes:            []attribute.KeyValue{attribute.String("key", "value")},
+			DroppedAttributeCount: 1,
+		}},
+		links: []Link{{
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{4},
+				SpanID:  trace.SpanID{5},
+			}),
+			Attributes:            []attribute.KeyValue{attribute.Int("n", 1)},
+			DroppedAttributeCount: 2,
+		}},
+		status:                Status{Code: codes.Error, Description: "failed"},
+		childSpanCount:        3,
+		droppedAttributeCount: 4,
+		droppedEventCount:     5,
+		droppedLinkCount:      6,
+		resource:              resource.NewWithAttributes("https://schema", attribute.String("service.name", "svc")),
+		instrumentationScope: instrumentation.Scope{
+			Name:       "scope",
+			Version:    "v1",
+			SchemaURL:  "https://schema",
+			Attributes: attribute.NewSet(attribute.String("scope.key", "value")),
+		},
+	}
+}
+
+func TestPersistentQueueRoundTrip(t *testing.T) {
+	q, err := openPersistentQueue(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	a, b, empty := persistedSpan("a"), persistedSpan("b"), &snapshot{name: "empty"}
+	b.resource = a.resource
+	want := []ReadOnlySpan{a, b, empty}
+	dropped, err := q.push(want)
+	require.NoError(t, err)
+	assert.Zero(t, dropped)
+	assert.Equal(t, 1, q.len())
+
+	got, ok, err := q.peek()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, got, 3)
+
+	// NaN is not equal to itself, compare it separately.
+	for i := range 2 {
+		w, g := want[i].(*snapshot), got[i].(*snapshot)
+		assert.True(t, math.IsNaN(g.attributes[2].Value.AsFloat64()))
+		g.attributes[2], w.attributes[2] = attribute.KeyValue{}, attribute.KeyValue{}
+		assert.Equal(t, w, g)
+	}
+	assert.Equal(t, empty, got[2])
+	assert.Same(t, got[0].Resource(), got[1].Resource(), "resource not shared")
+
+	require.NoError(t, q.pop())
+	_, ok, err = q.peek()
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestPersistentQueueReopen(t *testing.T) {
+	dir := t.TempDir()
+	q, err := openPersistentQueue(dir, 0)
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b"} {
+		_, err = q.push([]ReadOnlySpan{&snapshot{name: name}})
+		require.NoError(t, err)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "partial"+segmentExt+segmentTmpExt), []byte("x"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.txt"), []byte("x"), 0o600))
+
+	q, err = openPersistentQueue(dir, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 2, q.len())
+	assert.NoFileExists(t, filepath.Join(dir, "partial"+segmentExt+segmentTmpExt))
+	assert.FileExists(t, filepath.Join(dir, "other.txt"))
+
+	_, err = q.push([]ReadOnlySpan{&snapshot{name: "c"}})
+	require.NoError(t, err)
+	for _, name := range []string{"a", "b", "c"} {
+		got, ok, err := q.peek()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Len(t, got, 1)
+		assert.Equal(t, name, got[0].Name())
+		require.NoError(t, q.pop())
+	}
+}
+
+func TestPersistentQueueMaxBytes(t *testing.T) {
+	dir := t.TempDir()
+	q, err := openPersistentQueue(dir, 0)
+	require.NoError(t, err)
+	_, err = q.push([]ReadOnlySpan{persistedSpan("a")})
+	require.NoError(t, err)
+	size := q.size
+
+	// Room for two segments.
+	q.maxBytes = 2*size + size/2
+	_, err = q.push([]ReadOnlySpan{persistedSpan("b")})
+	require.NoError(t, err)
+	dropped, err := q.push([]ReadOnlySpan{persistedSpan("c"), persistedSpan("c")})
+	require.NoError(t, err)
+	assert.Equal(t, 1, dropped, "oldest segment not dropped")
+	assert.Equal(t, 2, q.len())
+	assert.LessOrEqual(t, q.size, q.maxBytes)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	got, _, err := q.peek()
+	require.NoError(t, err)
+	assert.Equal(t, "b", got[0].Name())
+
+	// A segment larger than the bound by itself is not stored.
+	q.maxBytes = size / 2
+	dropped, err = q.push([]ReadOnlySpan{persistedSpan("d"), persistedSpan("d")})
+	require.NoError(t, err)
+	assert.Equal(t, 2, dropped)
+	assert.Equal(t, 2, q.len())
+}
+
+func TestPersistentQueueCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	q, err := openPersistentQueue(dir, 0)
+	require.NoError(t, err)
+	_, err = q.push([]ReadOnlySpan{&snapshot{name: "a"}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, q.segments[0].name()), []byte("corrupted"), 0o600))
+
+	_, ok, err := q.peek()
+	assert.True(t, ok)
+	assert.ErrorIs(t, err, errSegment)
+}
+
+func TestBatchSpanProcessorPersistentQueue(t *testing.T) {
+	dir := t.TempDir()
+	te := &testBatchExporter{errors: []error{assert.AnError}}
+	tp := basicTracerProvider(t)
+	bsp := NewBatchSpanProcessor(te, WithBatchTimeout(time.Hour), WithPersistentQueue(dir, 0))
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("TestBatchSpanProcessorPersistentQueue")
+
+	generateSpan(t, tr, testOption{name: "failed", genNumSpans: 2})
+	require.ErrorIs(t, bsp.ForceFlush(t.Context()), assert.AnError)
+	assert.Equal(t, 0, te.len())
+	assert.Equal(t, 2, te.droppedCount)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "failed batch not persisted")
+
+	// The failed batch is exported after the next successful export.
+	generateSpan(t, tr, testOption{name: "exported", genNumSpans: 1})
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+	assert.Equal(t, 3, te.len())
+	assert.Equal(t, 2, te.getBatchCount())
+	entries, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "exported batch not removed")
+}
+
+func TestBatchSpanProcessorPersistentQueueRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	// The receiver of the exporter is down until the process stops.
+	down := &testBatchExporter{errors: []error{assert.AnError, assert.AnError}}
+	tp := basicTracerProvider(t)
+	bsp := NewBatchSpanProcessor(down, WithBatchTimeout(time.Hour), WithPersistentQueue(dir, 0))
+	tp.RegisterSpanProcessor(bsp)
+	generateSpan(t, tp.Tracer("before"), testOption{name: "span", genNumSpans: 3})
+	require.NoError(t, bsp.Shutdown(t.Context()))
+	assert.Zero(t, down.len())
+
+	// The spans are exported by the next process.
+	te := &testBatchExporter{}
+	bsp = NewBatchSpanProcessor(te, WithBatchTimeout(time.Hour), WithPersistentQueue(dir, 0))
+	t.Cleanup(func() {
+		//nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		assert.NoError(t, bsp.Shutdown(context.Background()))
+	})
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+	assert.Equal(t, 3, te.len())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestBatchSpanProcessorPersistentQueuePermanentError(t *testing.T) {
+	dir := t.TempDir()
+	te := &testBatchExporter{errors: []error{&PermanentExportError{Err: assert.AnError}}}
+	tp := basicTracerProvider(t)
+	bsp := NewBatchSpanProcessor(te, WithBatchTimeout(time.Hour), WithPersistentQueue(dir, 0))
+	tp.RegisterSpanProcessor(bsp)
+
+	generateSpan(t, tp.Tracer("TestBatchSpanProcessorPersistentQueuePermanentError"), testOption{genNumSpans: 1})
+	var pErr *PermanentExportError
+	require.ErrorAs(t, bsp.ForceFlush(t.Context()), &pErr)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "batch rejected permanently persisted")
+}
+
+// deadlineExporter is a SpanExporter failing the first fail exports and
+// recording the deadline of the contexts of the other ones.
+type deadlineExporter struct {
+	fail      int
+	deadlines []time.Time
+}
+
+func (e *deadlineExporter) ExportSpans(ctx context.Context, _ []ReadOnlySpan) error {
+	if e.fail > 0 {
+		e.fail--
+		return assert.AnError
+	}
+	d, _ := ctx.Deadline()
+	e.deadlines = append(e.deadlines, d)
+	// Let the clock move between the exports.
+	time.Sleep(time.Millisecond)
+	return nil
+}
+
+func (*deadlineExporter) Shutdown(context.Context) error { return nil }
+
+func TestBatchSpanProcessorPersistentQueueReplayTimeout(t *testing.T) {
+	e := &deadlineExporter{fail: 2}
+	tp := basicTracerProvider(t)
+	bsp := NewBatchSpanProcessor(
+		e,
+		WithBatchTimeout(time.Hour),
+		WithExportTimeout(time.Minute),
+		WithPersistentQueue(t.TempDir(), 0),
+	)
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer("TestBatchSpanProcessorPersistentQueueReplayTimeout")
+
+	for range 2 {
+		generateSpan(t, tr, testOption{name: "failed", genNumSpans: 1})
+		require.ErrorIs(t, bsp.ForceFlush(t.Context()), assert.AnError)
+	}
+	generateSpan(t, tr, testOption{name: "exported", genNumSpans: 1})
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+
+	// The batch and both replayed batches are exported with their own
+	// timeout.
+	require.Len(t, e.deadlines, 3)
+	for i := 1; i < len(e.deadlines); i++ {
+		assert.True(t, e.deadlines[i].After(e.deadlines[i-1]), "export %d shares a deadline", i)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
@@ -41,11 +43,42 @@ type tracerProviderConfig struct {
 	// spanLimits defines the attribute, event, and link limits for spans.
 	spanLimits SpanLimits
 
+	// scopeSpanLimits are the span limits overriding spanLimits for the
+	// tracers of an instrumentation scope name. It is nil if no limits are
+	// overridden.
+	scopeSpanLimits map[string]SpanLimits
+
 	// resource contains attributes representing an entity that produces telemetry.
 	resource *resource.Resource
 
 	// panicRecordingDisabled disables recording exception events from panics.
 	panicRecordingDisabled bool
+
+	// okStatusDescription enables recording the description of Ok statuses.
+	okStatusDescription bool
+
+	// leakGrace is the duration after which spans not ended are reported as
+	// leaked. Leak detection is disabled if it is not positive.
+	leakGrace time.Duration
+
+	// spanValidation validates spans when they end. It is nil if spans are
+	// not validated.
+	spanValidation *spanValidation
+
+	// attrNamespaces enforces the namespaces of span attribute keys. It is
+	// nil if they are not enforced.
+	attrNamespaces *attributeNamespaces
+
+	// truncationExempt are the attribute keys exempt from the attribute
+	// value length limit. It is nil if no key is exempt.
+	truncationExempt *truncationExemptions
+
+	// attrValidation validates the attributes of spans, events, and links.
+	// It is nil if they are not validated.
+	attrValidation *attributeValidation
+
+	// negativeDuration is the handling of spans ending before they start.
+	negativeDuration NegativeDurationHandling
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this Provider.
@@ -55,15 +88,21 @@ func (cfg tracerProviderConfig) MarshalLog() any {
 		SamplerType            string
 		IDGeneratorType        string
 		SpanLimits             SpanLimits
+		ScopeSpanLimits        map[string]SpanLimits
 		Resource               *resource.Resource
 		PanicRecordingDisabled bool
+		OkStatusDescription    bool
+		LeakDetectionGrace     time.Duration
 	}{
 		SpanProcessors:         cfg.processors,
 		SamplerType:            fmt.Sprintf("%T", cfg.sampler),
 		IDGeneratorType:        fmt.Sprintf("%T", cfg.idGenerator),
 		SpanLimits:             cfg.spanLimits,
+		ScopeSpanLimits:        cfg.scopeSpanLimits,
 		Resource:               cfg.resource,
 		PanicRecordingDisabled: cfg.panicRecordingDisabled,
+		OkStatusDescription:    cfg.okStatusDescription,
+		LeakDetectionGrace:     cfg.leakGrace,
 	}
 }
 
@@ -83,11 +122,20 @@ type TracerProvider struct {
 	sampler                Sampler
 	idGenerator            IDGenerator
 	spanLimits             SpanLimits
+	scopeSpanLimits        map[string]SpanLimits
 	resource               *resource.Resource
 	panicRecordingDisabled bool
+	okStatusDescription    bool
+	leakGrace              time.Duration
+	spanValidation         *spanValidation
+	attrNamespaces         *attributeNamespaces
+	truncationExempt       *truncationExemptions
+	attrValidation         *attributeValidation
+	negativeDuration       NegativeDurationHandling
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
+var _ otel.HealthReporter = &TracerProvider{}
 
 type experimentalOption interface {
 	Experimental()
@@ -123,8 +171,16 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 		sampler:                o.sampler,
 		idGenerator:            o.idGenerator,
 		spanLimits:             o.spanLimits,
+		scopeSpanLimits:        o.scopeSpanLimits,
 		resource:               o.resource,
 		panicRecordingDisabled: o.panicRecordingDisabled,
+		okStatusDescription:    o.okStatusDescription,
+		leakGrace:              o.leakGrace,
+		spanValidation:         o.spanValidation,
+		attrNamespaces:         o.attrNamespaces,
+		truncationExempt:       o.truncationExempt,
+		attrValidation:         o.attrValidation,
+		negativeDuration:       o.negativeDuration,
 	}
 	global.Info("TracerProvider created", "config", o)
 
@@ -174,6 +230,10 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 			t = &tracer{
 				provider:             p,
 				instrumentationScope: is,
+				spanLimits:           p.spanLimits,
+			}
+			if sl, ok := p.scopeSpanLimits[name]; ok {
+				t.spanLimits = sl
 			}
 
 			var err error
@@ -250,7 +310,7 @@ func (p *TracerProvider) UnregisterSpanProcessor(sp SpanProcessor) {
 	var stopOnce *spanProcessorState
 	var idx int
 	for i, sps := range spss {
-		if sps.sp == sp {
+		if unwrapSpanProcessor(sps.sp) == sp {
 			stopOnce = sps
 			idx = i
 		}
@@ -295,6 +355,10 @@ func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 // Shutdown shuts down TracerProvider. All registered span processors are shut down
 // in the order they were registered and any held computational resources are released.
 // After Shutdown is called, all methods are no-ops.
+//
+// Spans started or ended concurrently with Shutdown may still call OnStart or
+// OnEnd of the span processors after they are shut down. See SpanProcessor for
+// the ordering guarantees.
 func (p *TracerProvider) Shutdown(ctx context.Context) error {
 	// This check prevents deadlocks in case of recursive shutdown.
 	if p.isShutdown.Load() {
@@ -325,6 +389,34 @@ func (p *TracerProvider) Shutdown(ctx context.Context) error {
 	return retErr
 }
 
+// Health returns a snapshot of the health of all registered span processors
+// that support health reporting, like the one returned by
+// [NewBatchSpanProcessor].
+func (p *TracerProvider) Health() []otel.HealthStatus {
+	var out []otel.HealthStatus
+	for _, sps := range p.getSpanProcessors() {
+		if r, ok := unwrapSpanProcessor(sps.sp).(interface{ Health() otel.HealthStatus }); ok {
+			out = append(out, r.Health())
+		}
+	}
+	return out
+}
+
+// SpanProcessors returns a snapshot of the SpanProcessors registered with the
+// TracerProvider, in the order they are called.
+//
+// The returned slice is not updated by later calls to RegisterSpanProcessor,
+// UnregisterSpanProcessor, or Shutdown. After Shutdown is called, an empty
+// slice is returned.
+func (p *TracerProvider) SpanProcessors() []SpanProcessor {
+	spss := p.getSpanProcessors()
+	out := make([]SpanProcessor, len(spss))
+	for i, sps := range spss {
+		out[i] = unwrapSpanProcessor(sps.sp)
+	}
+	return out
+}
+
 func (p *TracerProvider) getSpanProcessors() spanProcessorStates {
 	return *p.spanProcessors.Load()
 }
@@ -376,6 +468,39 @@ func WithoutPanicRecording() TracerProviderOption {
 	})
 }
 
+// WithOkStatusDescription configures the TracerProvider to record the
+// description passed to SetStatus when the status code is Ok. By default, as
+// required by the OpenTelemetry specification, the description is only
+// recorded when the status code is Error.
+//
+// This can be used to record the reason of a success, e.g. for canary
+// analysis. Exporters not supporting it, or backends, may drop the
+// description of Ok statuses.
+func WithOkStatusDescription() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.okStatusDescription = true
+		return cfg
+	})
+}
+
+// WithLeakDetection returns a TracerProviderOption that enables the
+// detection of leaked spans: spans started by the TracerProvider that are not
+// ended within grace are reported to the global ErrorHandler with a
+// *SpanLeakError containing the stack trace of where they were started.
+//
+// Leaked spans are never exported and keep their memory allocated. This
+// option is meant to find them while debugging. It records a stack trace for
+// every span started, which is expensive, and should not be used in
+// production.
+//
+// If grace is not positive, leak detection is disabled.
+func WithLeakDetection(grace time.Duration) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.leakGrace = grace
+		return cfg
+	})
+}
+
 // WithResource returns a TracerProviderOption that will configure the
 // Resource r as a TracerProvider's Resource. The configured Resource is
 // referenced by all the Tracers the TracerProvider creates. It represents the
@@ -410,6 +535,22 @@ func WithIDGenerator(g IDGenerator) TracerProviderOption {
 	})
 }
 
+// WithSpanIDGenerator returns a TracerProviderOption that will configure the
+// SpanIDGenerator g to generate the Span IDs of the Tracers the
+// TracerProvider creates. Trace IDs are generated by the default random
+// number generator.
+//
+// This option overrides, and is overridden by, the WithIDGenerator option
+// according to their order. If g is nil, this option has no effect.
+func WithSpanIDGenerator(g SpanIDGenerator) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if g != nil {
+			cfg.idGenerator = spanIDGenerator{g}
+		}
+		return cfg
+	})
+}
+
 // WithSampler returns a TracerProviderOption that will configure the Sampler
 // s as a TracerProvider's Sampler. The configured Sampler is used by the
 // Tracers the TracerProvider creates to make their sampling decisions for the
@@ -491,6 +632,30 @@ func WithRawSpanLimits(limits SpanLimits) TracerProviderOption {
 	})
 }
 
+// WithScopeSpanLimits returns a TracerProviderOption that configures a
+// TracerProvider to use limits, instead of the limits set with
+// WithRawSpanLimits or WithSpanLimits, for the spans created by the Tracers
+// of the instrumentation scope named scopeName, regardless of their version.
+// This allows the limits to fit each instrumentation, e.g. higher attribute
+// limits for an HTTP instrumentation recording many headers than for a
+// database instrumentation.
+//
+// The limits are used as-is, like with WithRawSpanLimits. The limits need to
+// be constructed using NewSpanLimits and updated accordingly.
+//
+// This option can be passed multiple times to configure multiple scopes. If
+// it is passed multiple times for the same scopeName, the last limits are
+// used.
+func WithScopeSpanLimits(scopeName string, limits SpanLimits) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		scopeLimits := make(map[string]SpanLimits, len(cfg.scopeSpanLimits)+1)
+		maps.Copy(scopeLimits, cfg.scopeSpanLimits)
+		scopeLimits[scopeName] = limits
+		cfg.scopeSpanLimits = scopeLimits
+		return cfg
+	})
+}
+
 func applyTracerProviderEnvConfigs(cfg tracerProviderConfig) tracerProviderConfig {
 	for _, opt := range tracerProviderOptionsFromEnv() {
 		cfg = opt.apply(cfg)
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand/v2"
+	"sync"
 	"testing"
 
 	"github.com/go-logr/logr/funcr"
@@ -282,6 +283,116 @@ func TestRegisterAfterShutdownWithProcessors(t *testing.T) {
 	assert.Empty(t, stp.getSpanProcessors())
 }
 
+func TestTracerProviderSpanProcessors(t *testing.T) {
+	sp1, sp2, sp3 := &basicSpanProcessor{}, &basicSpanProcessor{}, &basicSpanProcessor{}
+	stp := NewTracerProvider(WithSpanProcessor(sp1))
+	stp.RegisterSpanProcessor(sp2)
+
+	snapshot := stp.SpanProcessors()
+	assert.Equal(t, []SpanProcessor{sp1, sp2}, snapshot)
+
+	stp.RegisterSpanProcessor(sp3)
+	stp.UnregisterSpanProcessor(sp1)
+	assert.Equal(t, []SpanProcessor{sp1, sp2}, snapshot, "snapshot modified")
+	assert.Equal(t, []SpanProcessor{sp2, sp3}, stp.SpanProcessors())
+
+	require.NoError(t, stp.Shutdown(t.Context()))
+	assert.Empty(t, stp.SpanProcessors())
+}
+
+// orderSpanProcessor records the lifecycle calls it receives in a log shared
+// with other processors.
+type orderSpanProcessor struct {
+	name string
+	mu   *sync.Mutex
+	log  *[]string
+}
+
+func (p orderSpanProcessor) record(method string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	*p.log = append(*p.log, p.name+"."+method)
+}
+
+func (p orderSpanProcessor) OnStart(context.Context, ReadWriteSpan) { p.record("OnStart") }
+func (p orderSpanProcessor) OnEnd(ReadOnlySpan)                     { p.record("OnEnd") }
+func (p orderSpanProcessor) ForceFlush(context.Context) error {
+	p.record("ForceFlush")
+	return nil
+}
+
+func (p orderSpanProcessor) Shutdown(context.Context) error {
+	p.record("Shutdown")
+	return nil
+}
+
+func TestSpanProcessorLifecycleOrder(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		log []string
+	)
+	a := orderSpanProcessor{name: "a", mu: &mu, log: &log}
+	b := orderSpanProcessor{name: "b", mu: &mu, log: &log}
+	stp := NewTracerProvider(WithSpanProcessor(a), WithSpanProcessor(b))
+	tr := stp.Tracer(t.Name())
+
+	_, span := tr.Start(t.Context(), "span")
+	span.End()
+	require.NoError(t, stp.Shutdown(t.Context()))
+
+	// Spans started or ended after Shutdown returns do not call processors.
+	_, span = tr.Start(t.Context(), "after shutdown")
+	span.End()
+
+	want := []string{
+		"a.OnStart", "b.OnStart",
+		"a.OnEnd", "b.OnEnd",
+		"a.Shutdown", "b.Shutdown",
+	}
+	assert.Equal(t, want, log)
+}
+
+func TestSpanProcessorNotCalledAfterConcurrentShutdown(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		log []string
+	)
+	sp := orderSpanProcessor{name: "sp", mu: &mu, log: &log}
+	stp := NewTracerProvider(WithSpanProcessor(sp))
+	tr := stp.Tracer(t.Name())
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				_, span := tr.Start(t.Context(), "span")
+				span.End()
+			}
+		})
+	}
+	require.NoError(t, stp.Shutdown(t.Context()))
+	wg.Wait()
+
+	// Spans ended concurrently with Shutdown may call the processor after it
+	// is shut down, but spans started after Shutdown returns do not.
+	mu.Lock()
+	n := len(log)
+	mu.Unlock()
+	_, span := tr.Start(t.Context(), "after shutdown")
+	span.End()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, log, n)
+	var shutdowns int
+	for _, call := range log {
+		if call == "sp.Shutdown" {
+			shutdowns++
+		}
+	}
+	assert.Equal(t, 1, shutdowns)
+}
+
 func TestTracerProviderForceFlush(t *testing.T) {
 	t.Run("AfterShutdown", func(t *testing.T) {
 		stp := NewTracerProvider()
@@ -0,0 +1,305 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RedactionOption configures a SpanProcessor returned by
+// [NewRedactionProcessor].
+type RedactionOption interface {
+	apply(redactionConfig) redactionConfig
+}
+
+type redactionConfig struct {
+	allow      []keyPattern
+	deny       []keyPattern
+	transforms []redactionTransform
+}
+
+// redactionTransform transforms the attributes with keys matching one of
+// patterns.
+type redactionTransform struct {
+	patterns  []keyPattern
+	transform func(attribute.KeyValue) attribute.KeyValue
+}
+
+type redactionOptionFunc func(redactionConfig) redactionConfig
+
+func (fn redactionOptionFunc) apply(c redactionConfig) redactionConfig {
+	return fn(c)
+}
+
+// WithRedactionAllow sets the patterns of the attribute keys allowed by the
+// processor. The attributes with keys matching none of the allowed patterns
+// are dropped. If no pattern is allowed, all the keys are allowed.
+//
+// A pattern matches a key if they are equal, ignoring case, with each "*" of
+// the pattern matching any sequence of characters. For example,
+// "*password*" matches "db.Password" and "user.password.hash".
+func WithRedactionAllow(patterns ...string) RedactionOption {
+	return redactionOptionFunc(func(c redactionConfig) redactionConfig {
+		c.allow = append(c.allow, newKeyPatterns(patterns)...)
+		return c
+	})
+}
+
+// WithRedactionDeny sets the patterns of the attribute keys denied by the
+// processor. The attributes with keys matching one of the denied patterns are
+// dropped, even if they match an allowed pattern. See [WithRedactionAllow] for
+// the syntax of the patterns.
+func WithRedactionDeny(patterns ...string) RedactionOption {
+	return redactionOptionFunc(func(c redactionConfig) redactionConfig {
+		c.deny = append(c.deny, newKeyPatterns(patterns)...)
+		return c
+	})
+}
+
+// WithRedactionHash replaces the values of the attributes with keys matching
+// one of patterns with the hex encoded SHA-256 hash of their string
+// representation. This keeps the values correlatable, e.g. to count the
+// distinct users, without exposing them. See [WithRedactionAllow] for the
+// syntax of the patterns.
+//
+// Values with few possible values, like phone numbers, can be recovered from
+// their hash by brute force. Use [WithRedactionTransform] with a keyed hash to
+// protect them.
+func WithRedactionHash(patterns ...string) RedactionOption {
+	return WithRedactionTransform(hashAttribute, patterns...)
+}
+
+// WithRedactionTransform replaces the attributes with keys matching one of
+// patterns with the result of transform, e.g. to mask all but the last digits
+// of a card number. See [WithRedactionAllow] for the syntax of the patterns.
+//
+// Only the first transform, hash included, matching the key of an attribute
+// is applied. The key of the attribute returned by transform is not matched
+// against the allowed and denied patterns again. If transform returns an
+// invalid attribute, the attribute is dropped. If transform is nil, this
+// option has no effect.
+//
+// The transform needs to be safe to call concurrently.
+func WithRedactionTransform(transform func(attribute.KeyValue) attribute.KeyValue, patterns ...string) RedactionOption {
+	return redactionOptionFunc(func(c redactionConfig) redactionConfig {
+		if transform == nil || len(patterns) == 0 {
+			return c
+		}
+		c.transforms = append(c.transforms, redactionTransform{
+			patterns:  newKeyPatterns(patterns),
+			transform: transform,
+		})
+		return c
+	})
+}
+
+// hashAttribute returns kv with its value replaced by the hex encoded SHA-256
+// hash of its string representation.
+func hashAttribute(kv attribute.KeyValue) attribute.KeyValue {
+	sum := sha256.Sum256([]byte(kv.Value.Emit()))
+	return kv.Key.String(hex.EncodeToString(sum[:]))
+}
+
+// keyPattern is an attribute key pattern split around its "*" wildcards, in
+// lower case.
+type keyPattern []string
+
+func newKeyPatterns(patterns []string) []keyPattern {
+	out := make([]keyPattern, len(patterns))
+	for i, p := range patterns {
+		out[i] = strings.Split(strings.ToLower(p), "*")
+	}
+	return out
+}
+
+// match reports whether the lower case key matches p.
+func (p keyPattern) match(key string) bool {
+	if len(p) == 1 {
+		return key == p[0]
+	}
+	if !strings.HasPrefix(key, p[0]) {
+		return false
+	}
+	key = key[len(p[0]):]
+	last := p[len(p)-1]
+	for _, part := range p[1 : len(p)-1] {
+		i := strings.Index(key, part)
+		if i < 0 {
+			return false
+		}
+		key = key[i+len(part):]
+	}
+	return strings.HasSuffix(key, last)
+}
+
+func matchAny(patterns []keyPattern, key string) bool {
+	for _, p := range patterns {
+		if p.match(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactionProcessor is a SpanProcessor passing spans with their attributes
+// redacted to the next SpanProcessor.
+type redactionProcessor struct {
+	next SpanProcessor
+	cfg  redactionConfig
+}
+
+var _ SpanProcessor = (*redactionProcessor)(nil)
+
+// NewRedactionProcessor returns a SpanProcessor that redacts the attributes
+// of the ended spans it passes to next, e.g. to remove personal data before it
+// is exported. The attributes are dropped or transformed based on the
+// patterns of their keys:
+//
+//   - The attributes not matching the patterns of [WithRedactionAllow], if
+//     any, or matching the patterns of [WithRedactionDeny] are dropped.
+//   - The values of the other attributes matching the patterns of
+//     [WithRedactionHash] or [WithRedactionTransform] are transformed.
+//
+// The attributes of the spans, and of their events and links, are redacted.
+// The dropped attributes are not counted as dropped by the span, to not
+// reveal their presence.
+//
+// The redaction is applied to a copy of the span when it ends, the span seen
+// by other processors is not modified. To redact the spans of all the
+// pipelines of a TracerProvider, redact their attributes where they are set
+// instead.
+func NewRedactionProcessor(next SpanProcessor, opts ...RedactionOption) SpanProcessor {
+	var cfg redactionConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &redactionProcessor{next: next, cfg: cfg}
+}
+
+// OnStart passes s to the next processor.
+func (p *redactionProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd passes s with its attributes redacted to the next processor.
+func (p *redactionProcessor) OnEnd(s ReadOnlySpan) {
+	p.next.OnEnd(p.redact(s))
+}
+
+// Shutdown shuts down the next processor.
+func (p *redactionProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next processor.
+func (p *redactionProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// redact returns s, or a copy of s with its attributes redacted if any is.
+func (p *redactionProcessor) redact(s ReadOnlySpan) ReadOnlySpan {
+	attrs, changed := p.attributes(s.Attributes())
+
+	events := s.Events()
+	var eventsChanged bool
+	for i, e := range events {
+		redacted, ok := p.attributes(e.Attributes)
+		if !ok {
+			continue
+		}
+		if !eventsChanged {
+			events = append([]Event(nil), events...)
+			eventsChanged = true
+		}
+		events[i].Attributes = redacted
+	}
+
+	links := s.Links()
+	var linksChanged bool
+	for i, l := range links {
+		redacted, ok := p.attributes(l.Attributes)
+		if !ok {
+			continue
+		}
+		if !linksChanged {
+			links = append([]Link(nil), links...)
+			linksChanged = true
+		}
+		links[i].Attributes = redacted
+	}
+
+	if !changed && !eventsChanged && !linksChanged {
+		return s
+	}
+	return &snapshot{
+		name:                  s.Name(),
+		spanContext:           s.SpanContext(),
+		parent:                s.Parent(),
+		spanKind:              s.SpanKind(),
+		startTime:             s.StartTime(),
+		endTime:               s.EndTime(),
+		attributes:            attrs,
+		events:                events,
+		links:                 links,
+		status:                s.Status(),
+		childSpanCount:        s.ChildSpanCount(),
+		droppedAttributeCount: s.DroppedAttributes(),
+		droppedEventCount:     s.DroppedEvents(),
+		droppedLinkCount:      s.DroppedLinks(),
+		resource:              s.Resource(),
+		instrumentationScope:  s.InstrumentationScope(),
+	}
+}
+
+// attributes returns attrs redacted, and whether any attribute is. The attrs
+// are not modified.
+func (p *redactionProcessor) attributes(attrs []attribute.KeyValue) ([]attribute.KeyValue, bool) {
+	var out []attribute.KeyValue
+	for i, a := range attrs {
+		redacted, keep := p.attribute(a)
+		if out == nil {
+			if keep && redacted == a {
+				continue
+			}
+			// First redacted attribute.
+			out = make([]attribute.KeyValue, i, len(attrs))
+			copy(out, attrs[:i])
+		}
+		if keep {
+			out = append(out, redacted)
+		}
+	}
+	if out == nil {
+		return attrs, false
+	}
+	return out, true
+}
+
+// attribute returns a redacted, and whether it is kept.
+func (p *redactionProcessor) attribute(a attribute.KeyValue) (attribute.KeyValue, bool) {
+	cfg := p.cfg
+	if len(cfg.allow) == 0 && len(cfg.deny) == 0 && len(cfg.transforms) == 0 {
+		return a, true
+	}
+
+	key := strings.ToLower(string(a.Key))
+	if len(cfg.allow) > 0 && !matchAny(cfg.allow, key) {
+		return a, false
+	}
+	if matchAny(cfg.deny, key) {
+		return a, false
+	}
+	for _, t := range cfg.transforms {
+		if matchAny(t.patterns, key) {
+			a = t.transform(a)
+			return a, a.Valid()
+		}
+	}
+	return a, true
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestKeyPatternMatch(t *testing.T) {
+	tests := []struct {
+		pattern, key string
+		want         bool
+	}{
+		{pattern: "http.request.header.authorization", key: "http.request.header.authorization", want: true},
+		{pattern: "http.request.header.authorization", key: "http.request.header.cookie"},
+		{pattern: "*password*", key: "db.password", want: true},
+		{pattern: "*password*", key: "password", want: true},
+		{pattern: "*password*", key: "user.password.hash", want: true},
+		{pattern: "*password*", key: "user.passwd"},
+		{pattern: "http.*", key: "http.method", want: true},
+		{pattern: "http.*", key: "rpc.method"},
+		{pattern: "*.token", key: "auth.token", want: true},
+		{pattern: "*.token", key: "auth.tokens"},
+		{pattern: "a*b*c", key: "abc", want: true},
+		{pattern: "a*b*c", key: "axbxcxc", want: true},
+		{pattern: "a*b*c", key: "acb"},
+		{pattern: "ab*ba", key: "aba"},
+		{pattern: "*", key: "", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+"/"+tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, newKeyPatterns([]string{tt.pattern})[0].match(tt.key))
+		})
+	}
+}
+
+func TestRedactionProcessor(t *testing.T) {
+	te := NewTestExporter()
+	raw := NewTestExporter()
+	mask := func(kv attribute.KeyValue) attribute.KeyValue {
+		s := kv.Value.AsString()
+		return kv.Key.String(strings.Repeat("*", len(s)-4) + s[len(s)-4:])
+	}
+	tp := NewTracerProvider(
+		WithSyncer(raw),
+		WithSpanProcessor(NewRedactionProcessor(
+			NewSimpleSpanProcessor(te),
+			WithRedactionDeny("http.request.header.authorization", "*password*"),
+			WithRedactionHash("enduser.id"),
+			WithRedactionTransform(mask, "card.number"),
+			WithRedactionTransform(func(attribute.KeyValue) attribute.KeyValue {
+				return attribute.KeyValue{}
+			}, "invalid"),
+		)),
+	)
+
+	link := trace.Link{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{1},
+		}),
+		Attributes: []attribute.KeyValue{attribute.String("db.Password", "secret")},
+	}
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span", trace.WithLinks(link))
+	span.SetAttributes(
+		attribute.String("http.request.header.authorization", "Bearer secret"),
+		attribute.String("http.request.method", "GET"),
+		attribute.String("enduser.id", "alice"),
+		attribute.String("card.number", "4111111111111111"),
+		attribute.String("invalid", "value"),
+	)
+	span.AddEvent("login", trace.WithAttributes(attribute.String("user.password", "secret")))
+	span.AddEvent("other", trace.WithAttributes(attribute.String("key", "value")))
+	span.End()
+
+	sum := sha256.Sum256([]byte("alice"))
+	s, ok := te.GetSpan("span")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("enduser.id", hex.EncodeToString(sum[:])),
+		attribute.String("card.number", "************1111"),
+	}, s.Attributes())
+	assert.Zero(t, s.DroppedAttributes())
+	require.Len(t, s.Events(), 2)
+	assert.Empty(t, s.Events()[0].Attributes)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("key", "value")}, s.Events()[1].Attributes)
+	require.Len(t, s.Links(), 1)
+	assert.Empty(t, s.Links()[0].Attributes)
+
+	// Other pipelines are not affected.
+	r, ok := raw.GetSpan("span")
+	require.True(t, ok)
+	assert.Len(t, r.Attributes(), 5)
+	assert.Len(t, r.Events()[0].Attributes, 1)
+	assert.Len(t, r.Links()[0].Attributes, 1)
+}
+
+func TestRedactionProcessorAllow(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSpanProcessor(NewRedactionProcessor(
+		NewSimpleSpanProcessor(te),
+		WithRedactionAllow("http.*", "service.name"),
+		WithRedactionDeny("http.request.header.*"),
+	)))
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	span.SetAttributes(
+		attribute.String("http.request.method", "GET"),
+		attribute.String("http.request.header.cookie", "secret"),
+		attribute.String("user.email", "alice@example.com"),
+		attribute.String("service.name", "svc"),
+	)
+	span.End()
+
+	s, ok := te.GetSpan("span")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.request.method", "GET"),
+		attribute.String("service.name", "svc"),
+	}, s.Attributes())
+}
+
+func TestRedactionProcessorUnchanged(t *testing.T) {
+	type span struct{ snapshot }
+	s := span{snapshot{
+		attributes: []attribute.KeyValue{attribute.String("key", "value")},
+		events:     []Event{{Name: "event", Attributes: []attribute.KeyValue{attribute.Int("n", 1)}}},
+	}}
+	p := NewRedactionProcessor(NewTestSpanProcessor("next"), WithRedactionDeny("secret")).(*redactionProcessor)
+	assert.Equal(t, ReadOnlySpan(s), p.redact(s), "span without redacted attribute copied")
+
+	s.events[0].Attributes = append(s.events[0].Attributes, attribute.Bool("secret", true))
+	got := p.redact(s)
+	require.IsType(t, &snapshot{}, got)
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("n", 1)}, got.Events()[0].Attributes)
+	assert.Len(t, s.events[0].Attributes, 2, "span modified")
+}
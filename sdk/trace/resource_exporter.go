@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// NewResourceAttributesExporter returns a SpanExporter exporting the spans
+// passed to it with exporter, with the attributes returned by attrs added to
+// their Resource. The attributes returned by attrs replace the attributes of
+// the Resource with the same keys.
+//
+// The attributes are evaluated at each export, allowing them to change
+// without recreating the TracerProvider. For example, to report the
+// deployment.environment.name of a process promoted from a canary
+// environment at runtime, or to route the batches of the same process to
+// different backends by environment.
+//
+// The attrs function receives the context of the export. It is called once
+// per export, must be safe to call concurrently, and must not retain the
+// returned slice after it returns. If attrs is nil or returns no attribute,
+// the spans are exported unchanged.
+func NewResourceAttributesExporter(
+	exporter SpanExporter,
+	attrs func(context.Context) []attribute.KeyValue,
+) SpanExporter {
+	return &resourceAttributesExporter{SpanExporter: exporter, attrs: attrs}
+}
+
+// resourceAttributesExporter is a SpanExporter that exports spans with
+// attributes added to their Resource with the wrapped SpanExporter.
+type resourceAttributesExporter struct {
+	SpanExporter
+
+	attrs func(context.Context) []attribute.KeyValue
+	// cache holds the last Resource with the attributes added.
+	cache atomic.Pointer[resourceWithAttributes]
+}
+
+// resourceWithAttributes is the src Resource with the attrs added.
+type resourceWithAttributes struct {
+	src   *resource.Resource
+	attrs attribute.Distinct
+	res   *resource.Resource
+}
+
+// ExportSpans exports spans with the wrapped SpanExporter, with the attributes
+// returned by attrs added to their Resource.
+func (e *resourceAttributesExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if e.attrs == nil || len(spans) == 0 {
+		return e.SpanExporter.ExportSpans(ctx, spans)
+	}
+	kvs := e.attrs(ctx)
+	if len(kvs) == 0 {
+		return e.SpanExporter.ExportSpans(ctx, spans)
+	}
+
+	set := attribute.NewSet(kvs...)
+	out := make([]ReadOnlySpan, len(spans))
+	for i, s := range spans {
+		out[i] = resourceFilteredReadOnlySpan{
+			ReadOnlySpan: s,
+			res:          e.resource(s.Resource(), &set),
+		}
+	}
+	return e.SpanExporter.ExportSpans(ctx, out)
+}
+
+// resource returns src with the attributes of set added.
+func (e *resourceAttributesExporter) resource(src *resource.Resource, set *attribute.Set) *resource.Resource {
+	key := set.Equivalent()
+	if c := e.cache.Load(); c != nil && c.src == src && c.attrs == key {
+		return c.res
+	}
+	// Attributes of set replace the ones of src with the same keys as the last
+	// duplicate key wins.
+	attrs := slices.Concat(src.Attributes(), set.ToSlice())
+	c := &resourceWithAttributes{
+		src:   src,
+		attrs: key,
+		res:   resource.NewWithAttributes(src.SchemaURL(), attrs...),
+	}
+	e.cache.Store(c)
+	return c.res
+}
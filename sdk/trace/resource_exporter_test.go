@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// resourceExporter is a SpanExporter that records the Resource of the spans
+// it exports.
+type resourceExporter struct {
+	mu       sync.Mutex
+	res      []*resource.Resource
+	shutdown int
+}
+
+func (e *resourceExporter) ExportSpans(_ context.Context, spans []ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, s := range spans {
+		e.res = append(e.res, s.Resource())
+	}
+	return nil
+}
+
+func (e *resourceExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown++
+	return nil
+}
+
+func TestNewResourceAttributesExporter(t *testing.T) {
+	res := resource.NewWithAttributes(
+		"https://opentelemetry.io/schemas/1.0.0",
+		attribute.String("service.name", "svc"),
+		attribute.String("deployment.environment.name", "canary"),
+	)
+
+	var env string
+	rec := new(resourceExporter)
+	exp := NewResourceAttributesExporter(rec, func(context.Context) []attribute.KeyValue {
+		if env == "" {
+			return nil
+		}
+		return []attribute.KeyValue{attribute.String("deployment.environment.name", env)}
+	})
+	tp := NewTracerProvider(WithResource(res), WithSyncer(exp))
+	tr := tp.Tracer("TestNewResourceAttributesExporter")
+
+	for _, e := range []string{"", "production", "production"} {
+		env = e
+		_, span := tr.Start(t.Context(), "span")
+		span.End()
+	}
+
+	require.Len(t, rec.res, 3)
+	assert.Same(t, tp.resource, rec.res[0], "resource changed without attributes")
+	want := resource.NewWithAttributes(
+		"https://opentelemetry.io/schemas/1.0.0",
+		attribute.String("service.name", "svc"),
+		attribute.String("deployment.environment.name", "production"),
+	)
+	assert.Equal(t, want, rec.res[1])
+	assert.Same(t, rec.res[1], rec.res[2], "resource not reused")
+	v, _ := tp.resource.Set().Value("deployment.environment.name")
+	assert.Equal(t, "canary", v.AsString(), "provider resource modified")
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+	assert.Equal(t, 1, rec.shutdown)
+}
+
+func TestNewResourceAttributesExporterNil(t *testing.T) {
+	rec := new(resourceExporter)
+	exp := NewResourceAttributesExporter(rec, nil)
+	tp := NewTracerProvider(WithSyncer(exp))
+	_, span := tp.Tracer("TestNewResourceAttributesExporterNil").Start(t.Context(), "span")
+	span.End()
+
+	require.Len(t, rec.res, 1)
+	assert.Same(t, tp.resource, rec.res[0])
+}
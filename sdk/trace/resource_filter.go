@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// WithProcessorResourceFilter registers the SpanProcessor proc with a
+// TracerProvider, like WithSpanProcessor, but proc receives spans that
+// report the Resource returned by filter instead of the Resource of the
+// TracerProvider.
+//
+// This can be used to provide a different view of the Resource to a
+// specific exporter. For example, to remove the process.command_args
+// attribute from the spans sent to a third-party vendor while keeping it for
+// the spans sent to an internal backend.
+//
+// The filter is evaluated lazily, when the first span is passed to proc, and
+// its result is reused for all subsequent spans sharing the same Resource.
+// The filter must be safe to call concurrently and must not modify the
+// Resource it receives. If filter is nil, proc is registered unchanged.
+//
+// The registered SpanProcessor can be unregistered by passing proc to
+// UnregisterSpanProcessor.
+func WithProcessorResourceFilter(proc SpanProcessor, filter func(*resource.Resource) *resource.Resource) TracerProviderOption {
+	if filter == nil {
+		return WithSpanProcessor(proc)
+	}
+	return WithSpanProcessor(&resourceFilterProcessor{SpanProcessor: proc, filter: filter})
+}
+
+// resourceFilterProcessor is a SpanProcessor that passes spans with a
+// filtered Resource to the wrapped SpanProcessor.
+type resourceFilterProcessor struct {
+	SpanProcessor
+
+	filter func(*resource.Resource) *resource.Resource
+	// cache holds the last evaluated filter result.
+	cache atomic.Pointer[filteredResource]
+}
+
+// filteredResource is the result of a filter applied to the src Resource.
+type filteredResource struct {
+	src, res *resource.Resource
+}
+
+// resource returns the result of the filter applied to src.
+func (p *resourceFilterProcessor) resource(src *resource.Resource) *resource.Resource {
+	if c := p.cache.Load(); c != nil && c.src == src {
+		return c.res
+	}
+	c := &filteredResource{src: src, res: p.filter(src)}
+	p.cache.Store(c)
+	return c.res
+}
+
+// OnStart calls the OnStart method of the wrapped SpanProcessor with a view of
+// s reporting the filtered Resource.
+func (p *resourceFilterProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	p.SpanProcessor.OnStart(parent, resourceFilteredReadWriteSpan{
+		ReadWriteSpan: s,
+		res:           p.resource(s.Resource()),
+	})
+}
+
+// OnEnding calls the OnEnding method of the wrapped SpanProcessor, if it is an
+// OnEndingSpanProcessor, with a view of s reporting the filtered Resource.
+func (p *resourceFilterProcessor) OnEnding(s ReadWriteSpan) {
+	if sp, ok := p.SpanProcessor.(OnEndingSpanProcessor); ok {
+		sp.OnEnding(resourceFilteredReadWriteSpan{
+			ReadWriteSpan: s,
+			res:           p.resource(s.Resource()),
+		})
+	}
+}
+
+// OnEnd calls the OnEnd method of the wrapped SpanProcessor with a view of s
+// reporting the filtered Resource.
+func (p *resourceFilterProcessor) OnEnd(s ReadOnlySpan) {
+	p.SpanProcessor.OnEnd(resourceFilteredReadOnlySpan{
+		ReadOnlySpan: s,
+		res:          p.resource(s.Resource()),
+	})
+}
+
+// unwrapSpanProcessor returns the SpanProcessor registered by the user as sp.
+func unwrapSpanProcessor(sp SpanProcessor) SpanProcessor {
+	switch p := sp.(type) {
+	case *resourceFilterProcessor:
+		return p.SpanProcessor
+	case *unsampledProcessor:
+		return p.SpanProcessor
+	}
+	return sp
+}
+
+// resourceFilteredReadOnlySpan is a ReadOnlySpan reporting a filtered
+// Resource.
+type resourceFilteredReadOnlySpan struct {
+	ReadOnlySpan
+
+	res *resource.Resource
+}
+
+// Resource returns the filtered Resource.
+func (s resourceFilteredReadOnlySpan) Resource() *resource.Resource { return s.res }
+
+// resourceFilteredReadWriteSpan is a ReadWriteSpan reporting a filtered
+// Resource.
+type resourceFilteredReadWriteSpan struct {
+	ReadWriteSpan
+
+	res *resource.Resource
+}
+
+// Resource returns the filtered Resource.
+func (s resourceFilteredReadWriteSpan) Resource() *resource.Resource { return s.res }
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// resourceRecorder is a SpanProcessor that records the Resource of the spans
+// it receives.
+type resourceRecorder struct {
+	started, ended []*resource.Resource
+	shutdown       int
+}
+
+func (p *resourceRecorder) OnStart(_ context.Context, s ReadWriteSpan) {
+	p.started = append(p.started, s.Resource())
+}
+
+func (p *resourceRecorder) OnEnd(s ReadOnlySpan) {
+	p.ended = append(p.ended, s.Resource())
+}
+
+func (*resourceRecorder) ForceFlush(context.Context) error { return nil }
+
+func (p *resourceRecorder) Shutdown(context.Context) error {
+	p.shutdown++
+	return nil
+}
+
+func TestWithProcessorResourceFilter(t *testing.T) {
+	res := resource.NewSchemaless(
+		attribute.String("service.name", "svc"),
+		attribute.String("process.command_args", "--secret"),
+	)
+	stripped := resource.NewSchemaless(attribute.String("service.name", "svc"))
+
+	var calls atomic.Int64
+	filter := func(r *resource.Resource) *resource.Resource {
+		calls.Add(1)
+		set, _ := attribute.NewSetWithFiltered(r.Attributes(), func(kv attribute.KeyValue) bool {
+			return kv.Key != "process.command_args"
+		})
+		return resource.NewWithAttributes(r.SchemaURL(), set.ToSlice()...)
+	}
+
+	internal, vendor := new(resourceRecorder), new(resourceRecorder)
+	tp := NewTracerProvider(
+		WithResource(res),
+		WithSpanProcessor(internal),
+		WithProcessorResourceFilter(vendor, filter),
+	)
+	assert.Equal(t, int64(0), calls.Load(), "filter evaluated eagerly")
+
+	tr := tp.Tracer("TestWithProcessorResourceFilter")
+	for range 3 {
+		_, span := tr.Start(t.Context(), "span")
+		span.End()
+	}
+
+	assert.Equal(t, int64(1), calls.Load(), "filter result not reused")
+	require.Len(t, internal.ended, 3)
+	require.Len(t, vendor.ended, 3)
+	for i := range 3 {
+		assert.Equal(t, tp.resource, internal.started[i])
+		assert.Equal(t, tp.resource, internal.ended[i])
+		assert.Equal(t, stripped.Set(), vendor.started[i].Set())
+		assert.Equal(t, stripped.Set(), vendor.ended[i].Set())
+	}
+
+	tp.UnregisterSpanProcessor(vendor)
+	assert.Equal(t, 1, vendor.shutdown)
+	_, span := tr.Start(t.Context(), "unregistered")
+	span.End()
+	assert.Len(t, vendor.ended, 3, "unregistered processor received span")
+	assert.Len(t, internal.ended, 4)
+}
+
+func TestWithProcessorResourceFilterNil(t *testing.T) {
+	p := new(resourceRecorder)
+	cfg := WithProcessorResourceFilter(p, nil).apply(tracerProviderConfig{})
+	assert.Equal(t, []SpanProcessor{p}, cfg.processors)
+}
+
+func TestWithProcessorResourceFilterHealth(t *testing.T) {
+	p := NewAsyncProcessor(new(asyncTestProcessor), 1, 10)
+	tp := NewTracerProvider(WithProcessorResourceFilter(p, func(r *resource.Resource) *resource.Resource {
+		return r
+	}))
+	t.Cleanup(func() { assert.NoError(t, tp.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+
+	h := tp.Health()
+	require.Len(t, h, 1)
+	assert.Equal(t, "AsyncSpanProcessor", h[0].Component)
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// ErrSamplerStateNotFound is returned by a SamplerStateStore if no state is
+// stored for a key.
+var ErrSamplerStateNotFound = errors.New("sampler state not found")
+
+// StatefulSampler is a Sampler with a lightweight state, e.g. the target rate
+// of an adaptive sampler, that can be persisted and shared with other
+// processes using a SamplerStateStore.
+//
+// Multiple replicas of a service can use the same state to coordinate their
+// sampling, and an external controller can guide the replicas by updating the
+// stored state.
+type StatefulSampler interface {
+	Sampler
+
+	// SamplerState returns an encoding of the current state of the Sampler.
+	//
+	// This method needs to be concurrent safe.
+	SamplerState() ([]byte, error)
+
+	// SetSamplerState replaces the state of the Sampler with the decoded
+	// state. An error is returned if state is not a valid encoding of the
+	// state of the Sampler, in which case the state is unchanged.
+	//
+	// This method needs to be concurrent safe.
+	SetSamplerState(state []byte) error
+}
+
+// SamplerStateStore persists the state of StatefulSamplers, e.g. in a file or
+// in shared memory.
+type SamplerStateStore interface {
+	// LoadSamplerState returns the state stored for key. An error wrapping
+	// ErrSamplerStateNotFound is returned if no state is stored for key.
+	//
+	// This method needs to be concurrent safe.
+	LoadSamplerState(ctx context.Context, key string) ([]byte, error)
+
+	// StoreSamplerState stores state for key, replacing any state previously
+	// stored for key.
+	//
+	// This method needs to be concurrent safe.
+	StoreSamplerState(ctx context.Context, key string, state []byte) error
+}
+
+// SyncSamplerState loads the state stored for key in store into s. If no
+// state is stored for key, the current state of s is stored instead.
+//
+// It is intended to be called when a process starts and periodically after,
+// so changes made to the stored state by other processes are applied to s.
+func SyncSamplerState(ctx context.Context, s StatefulSampler, store SamplerStateStore, key string) error {
+	state, err := store.LoadSamplerState(ctx, key)
+	if errors.Is(err, ErrSamplerStateNotFound) {
+		if state, err = s.SamplerState(); err != nil {
+			return fmt.Errorf("sampler state: %w", err)
+		}
+		return store.StoreSamplerState(ctx, key, state)
+	}
+	if err != nil {
+		return err
+	}
+	return s.SetSamplerState(state)
+}
+
+// NewFileSamplerStateStore returns a SamplerStateStore that stores the state
+// of each key in its own file in the directory dir. The directory needs to
+// exist. The keys are escaped so they cannot name a file outside of dir, and
+// the empty, "." and ".." keys are rejected.
+//
+// State is stored atomically, a concurrent load from another process
+// never observes a partially written state.
+func NewFileSamplerStateStore(dir string) SamplerStateStore {
+	return fileSamplerStateStore{dir: dir}
+}
+
+type fileSamplerStateStore struct {
+	dir string
+}
+
+// path returns the path of the file storing the state of key. An error is
+// returned if key does not name a file in the directory of s.
+func (s fileSamplerStateStore) path(key string) (string, error) {
+	switch key {
+	case "", ".", "..":
+		return "", fmt.Errorf("invalid sampler state key: %q", key)
+	}
+	return filepath.Join(s.dir, url.PathEscape(key)), nil
+}
+
+func (s fileSamplerStateStore) LoadSamplerState(ctx context.Context, key string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	state, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %q", ErrSamplerStateNotFound, key)
+	}
+	return state, err
+}
+
+func (s fileSamplerStateStore) StoreSamplerState(ctx context.Context, key string, state []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first and rename it so the state is replaced
+	// atomically.
+	f, err := os.CreateTemp(s.dir, ".sampler-state-*")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(state)
+	err = errors.Join(err, f.Close())
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
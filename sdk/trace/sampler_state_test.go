@@ -0,0 +1,137 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rateSampler is a StatefulSampler whose state is a sampling rate.
+type rateSampler struct {
+	Sampler
+
+	rate atomic.Int64
+}
+
+func (s *rateSampler) SamplerState() ([]byte, error) {
+	return strconv.AppendInt(nil, s.rate.Load(), 10), nil
+}
+
+func (s *rateSampler) SetSamplerState(state []byte) error {
+	rate, err := strconv.ParseInt(string(state), 10, 64)
+	if err != nil {
+		return err
+	}
+	s.rate.Store(rate)
+	return nil
+}
+
+func TestFileSamplerStateStore(t *testing.T) {
+	dir := t.TempDir()
+	store := NewFileSamplerStateStore(dir)
+	ctx := t.Context()
+
+	_, err := store.LoadSamplerState(ctx, "svc/adaptive")
+	assert.ErrorIs(t, err, ErrSamplerStateNotFound)
+
+	require.NoError(t, store.StoreSamplerState(ctx, "svc/adaptive", []byte("1")))
+	require.NoError(t, store.StoreSamplerState(ctx, "svc/adaptive", []byte("2")))
+	state, err := store.LoadSamplerState(ctx, "svc/adaptive")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), state)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temporary files left behind")
+
+	// Another store using the same directory shares the state.
+	state, err = NewFileSamplerStateStore(dir).LoadSamplerState(ctx, "svc/adaptive")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("2"), state)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.ErrorIs(t, store.StoreSamplerState(canceled, "svc/adaptive", nil), context.Canceled)
+	_, err = store.LoadSamplerState(canceled, "svc/adaptive")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestFileSamplerStateStoreInvalidKey(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "state")
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	store := NewFileSamplerStateStore(dir)
+
+	for _, key := range []string{"", ".", ".."} {
+		assert.Error(t, store.StoreSamplerState(t.Context(), key, []byte("1")), key)
+		_, err := store.LoadSamplerState(t.Context(), key)
+		assert.Error(t, err, key)
+		assert.NotErrorIs(t, err, ErrSamplerStateNotFound, key)
+	}
+
+	require.NoError(t, store.StoreSamplerState(t.Context(), "../escaped", []byte("1")))
+	entries, err := os.ReadDir(parent)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "state written outside of the directory")
+	assert.Equal(t, "state", entries[0].Name())
+}
+
+func TestFileSamplerStateStoreMissingDir(t *testing.T) {
+	store := NewFileSamplerStateStore(t.TempDir() + "/missing")
+	assert.Error(t, store.StoreSamplerState(t.Context(), "key", []byte("1")))
+}
+
+func TestFileSamplerStateStoreConcurrentSafe(t *testing.T) {
+	store := NewFileSamplerStateStore(t.TempDir())
+	ctx := t.Context()
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			assert.NoError(t, store.StoreSamplerState(ctx, "key", []byte(strconv.Itoa(i))))
+		})
+		wg.Go(func() {
+			state, err := store.LoadSamplerState(ctx, "key")
+			if err == nil {
+				_, err = strconv.Atoi(string(state))
+				assert.NoError(t, err, "partial state loaded")
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func TestSyncSamplerState(t *testing.T) {
+	store := NewFileSamplerStateStore(t.TempDir())
+	ctx := t.Context()
+
+	first := &rateSampler{Sampler: AlwaysSample()}
+	first.rate.Store(10)
+	require.NoError(t, SyncSamplerState(ctx, first, store, "key"))
+	state, err := store.LoadSamplerState(ctx, "key")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("10"), state, "initial state not stored")
+
+	second := &rateSampler{Sampler: AlwaysSample()}
+	require.NoError(t, SyncSamplerState(ctx, second, store, "key"))
+	assert.Equal(t, int64(10), second.rate.Load(), "stored state not loaded")
+
+	// An external controller updates the state.
+	require.NoError(t, store.StoreSamplerState(ctx, "key", []byte("20")))
+	require.NoError(t, SyncSamplerState(ctx, first, store, "key"))
+	assert.Equal(t, int64(20), first.rate.Load())
+
+	require.NoError(t, store.StoreSamplerState(ctx, "key", []byte("invalid")))
+	assert.Error(t, SyncSamplerState(ctx, first, store, "key"))
+	assert.Equal(t, int64(20), first.rate.Load())
+}
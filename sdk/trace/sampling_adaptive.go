@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultAdaptiveInterval is the default interval at which an AdaptiveSampler
+// adjusts its sampling probability.
+const defaultAdaptiveInterval = time.Second
+
+// AdaptiveSamplerOption configures an AdaptiveSampler.
+type AdaptiveSamplerOption interface {
+	apply(adaptiveConfig) adaptiveConfig
+}
+
+type adaptiveSamplerOptionFunc func(adaptiveConfig) adaptiveConfig
+
+func (fn adaptiveSamplerOptionFunc) apply(cfg adaptiveConfig) adaptiveConfig {
+	return fn(cfg)
+}
+
+type adaptiveConfig struct {
+	floor, ceiling float64
+}
+
+// WithProbabilityFloor sets the lowest sampling probability an
+// AdaptiveSampler uses, regardless of the measured throughput. Values are
+// clamped to the range [0, 1]. By default, the floor is 0.
+func WithProbabilityFloor(p float64) AdaptiveSamplerOption {
+	return adaptiveSamplerOptionFunc(func(cfg adaptiveConfig) adaptiveConfig {
+		cfg.floor = clampProbability(p)
+		return cfg
+	})
+}
+
+// WithProbabilityCeiling sets the highest sampling probability an
+// AdaptiveSampler uses, regardless of the measured throughput. Values are
+// clamped to the range [0, 1]. By default, the ceiling is 1.
+func WithProbabilityCeiling(p float64) AdaptiveSamplerOption {
+	return adaptiveSamplerOptionFunc(func(cfg adaptiveConfig) adaptiveConfig {
+		cfg.ceiling = clampProbability(p)
+		return cfg
+	})
+}
+
+func clampProbability(p float64) float64 {
+	if math.IsNaN(p) {
+		return 0
+	}
+	return min(max(p, 0), 1)
+}
+
+// AdaptiveSampler returns a Sampler that adjusts its sampling probability so
+// the number of sampled root spans per second approaches targetSPS. This
+// keeps the volume of sampled traces steady for services with large swings
+// in traffic.
+//
+// Every adjustInterval, the throughput of root spans, spans without a valid
+// parent, measured over the elapsed interval is used to compute the
+// probability targetSPS / throughput. The probability is bounded by the
+// floor and ceiling set with WithProbabilityFloor and WithProbabilityCeiling.
+// Until the first adjustment, the ceiling is used. If adjustInterval is less
+// than or equal to zero, an interval of one second is used. A targetSPS less
+// than zero is treated as zero.
+//
+// Spans are sampled based on their trace ID, like with TraceIDRatioBased. To
+// respect the sampling decision of the parent span, the AdaptiveSampler should
+// be used as the root sampler of ParentBased.
+//
+// The returned Sampler is a StatefulSampler. Its state is the target number
+// of spans per second, allowing an external controller to adjust the target
+// of multiple replicas through a SamplerStateStore.
+func AdaptiveSampler(targetSPS float64, adjustInterval time.Duration, opts ...AdaptiveSamplerOption) StatefulSampler {
+	cfg := adaptiveConfig{ceiling: 1}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	cfg.floor = min(cfg.floor, cfg.ceiling)
+
+	if adjustInterval <= 0 {
+		adjustInterval = defaultAdaptiveInterval
+	}
+
+	s := &adaptiveSampler{
+		interval: adjustInterval,
+		floor:    cfg.floor,
+		ceiling:  cfg.ceiling,
+		now:      time.Now,
+	}
+	s.setTarget(targetSPS)
+	s.setProbability(cfg.ceiling)
+	s.last = s.now()
+	return s
+}
+
+type adaptiveSampler struct {
+	interval       time.Duration
+	floor, ceiling float64
+	now            func() time.Time
+
+	// target holds the bits of the float64 target spans per second.
+	target atomic.Uint64
+	// probability holds the bits of the float64 sampling probability.
+	probability atomic.Uint64
+	// traceIDUpperBound is derived from probability like for the
+	// traceIDRatioSampler.
+	traceIDUpperBound atomic.Uint64
+	// roots is the number of root spans seen since the last adjustment.
+	roots atomic.Uint64
+
+	// mu guards the adjustment of the probability.
+	mu   sync.Mutex
+	last time.Time
+}
+
+var _ StatefulSampler = (*adaptiveSampler)(nil)
+
+func (s *adaptiveSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	if !psc.IsValid() {
+		s.roots.Add(1)
+		s.adjust()
+	}
+
+	x := binary.BigEndian.Uint64(p.TraceID[8:16]) >> 1
+	if x < s.traceIDUpperBound.Load() {
+		return SamplingResult{
+			Decision:   RecordAndSample,
+			Tracestate: psc.TraceState(),
+		}
+	}
+	return SamplingResult{
+		Decision:   Drop,
+		Tracestate: psc.TraceState(),
+	}
+}
+
+// adjust updates the sampling probability based on the throughput of root
+// spans if the adjustment interval has elapsed.
+func (s *adaptiveSampler) adjust() {
+	// Skip the adjustment if it is already being done by another goroutine.
+	if !s.mu.TryLock() {
+		return
+	}
+	defer s.mu.Unlock()
+
+	now := s.now()
+	elapsed := now.Sub(s.last)
+	if elapsed < s.interval {
+		return
+	}
+	s.last = now
+
+	throughput := float64(s.roots.Swap(0)) / elapsed.Seconds()
+	p := s.ceiling
+	if throughput > 0 {
+		p = min(max(s.Target()/throughput, s.floor), s.ceiling)
+	}
+	s.setProbability(p)
+}
+
+// Target returns the target number of sampled root spans per second.
+func (s *adaptiveSampler) Target() float64 {
+	return math.Float64frombits(s.target.Load())
+}
+
+func (s *adaptiveSampler) setTarget(target float64) {
+	if math.IsNaN(target) || target < 0 {
+		target = 0
+	}
+	s.target.Store(math.Float64bits(target))
+}
+
+// Probability returns the current sampling probability.
+func (s *adaptiveSampler) Probability() float64 {
+	return math.Float64frombits(s.probability.Load())
+}
+
+func (s *adaptiveSampler) setProbability(p float64) {
+	s.probability.Store(math.Float64bits(p))
+	s.traceIDUpperBound.Store(uint64(p * (1 << 63)))
+}
+
+func (s *adaptiveSampler) Description() string {
+	return fmt.Sprintf("AdaptiveSampler{%g}", s.Target())
+}
+
+// adaptiveState is the state of an adaptiveSampler shared with a
+// SamplerStateStore.
+type adaptiveState struct {
+	TargetSPS float64 `json:"target_sps"`
+}
+
+// SamplerState returns the JSON encoding of the target spans per second.
+func (s *adaptiveSampler) SamplerState() ([]byte, error) {
+	return json.Marshal(adaptiveState{TargetSPS: s.Target()})
+}
+
+// SetSamplerState sets the target spans per second from its JSON encoding.
+// The new target is used from the next adjustment of the probability.
+func (s *adaptiveSampler) SetSamplerState(state []byte) error {
+	var st adaptiveState
+	if err := json.Unmarshal(state, &st); err != nil {
+		return fmt.Errorf("invalid adaptive sampler state: %w", err)
+	}
+	s.setTarget(st.TargetSPS)
+	return nil
+}
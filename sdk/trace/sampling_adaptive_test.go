@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fakeClock is a manually advanced clock.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+func newTestAdaptiveSampler(targetSPS float64, opts ...AdaptiveSamplerOption) (*adaptiveSampler, *fakeClock) {
+	s := AdaptiveSampler(targetSPS, time.Second, opts...).(*adaptiveSampler)
+	clock := &fakeClock{now: s.last}
+	s.now = clock.Now
+	return s, clock
+}
+
+// sampleRoots passes n root spans with random trace IDs to s and returns the
+// number sampled.
+func sampleRoots(t *testing.T, s Sampler, n int) int {
+	t.Helper()
+	rng := rand.New(rand.NewPCG(1, 2))
+	var sampled int
+	for range n {
+		var tid trace.TraceID
+		binary.BigEndian.PutUint64(tid[:8], rng.Uint64())
+		binary.BigEndian.PutUint64(tid[8:], rng.Uint64())
+		res := s.ShouldSample(SamplingParameters{ParentContext: t.Context(), TraceID: tid})
+		if res.Decision == RecordAndSample {
+			sampled++
+		}
+	}
+	return sampled
+}
+
+func TestAdaptiveSampler(t *testing.T) {
+	s, clock := newTestAdaptiveSampler(100)
+	assert.Equal(t, "AdaptiveSampler{100}", s.Description())
+	assert.Equal(t, 1.0, s.Probability(), "initial probability is the ceiling")
+
+	// 10k root spans in the first second.
+	assert.Equal(t, 10_000, sampleRoots(t, s, 10_000))
+	clock.Advance(time.Second)
+	sampleRoots(t, s, 1) // Triggers the adjustment.
+	assert.InDelta(t, 0.01, s.Probability(), 1e-3)
+
+	sampled := sampleRoots(t, s, 10_000)
+	assert.InDelta(t, 100, sampled, 30)
+
+	// Traffic drops to 50 root spans per second.
+	clock.Advance(time.Second)
+	sampleRoots(t, s, 1)
+	clock.Advance(time.Second)
+	sampleRoots(t, s, 50)
+	assert.Equal(t, 1.0, s.Probability())
+}
+
+func TestAdaptiveSamplerNoAdjustmentWithinInterval(t *testing.T) {
+	s, clock := newTestAdaptiveSampler(1)
+	sampleRoots(t, s, 1000)
+	clock.Advance(500 * time.Millisecond)
+	sampleRoots(t, s, 1)
+	assert.Equal(t, 1.0, s.Probability())
+}
+
+func TestAdaptiveSamplerFloorCeiling(t *testing.T) {
+	s, clock := newTestAdaptiveSampler(1, WithProbabilityFloor(0.1), WithProbabilityCeiling(0.5))
+	assert.Equal(t, 0.5, s.Probability())
+
+	sampleRoots(t, s, 1000)
+	clock.Advance(time.Second)
+	sampleRoots(t, s, 1)
+	assert.Equal(t, 0.1, s.Probability(), "floor")
+
+	clock.Advance(time.Second)
+	sampleRoots(t, s, 1)
+	assert.Equal(t, 0.5, s.Probability(), "ceiling")
+
+	s, _ = newTestAdaptiveSampler(1, WithProbabilityFloor(2), WithProbabilityCeiling(0.3))
+	assert.Equal(t, 0.3, s.floor, "floor above ceiling")
+	assert.Equal(t, 0.3, s.ceiling)
+}
+
+func TestAdaptiveSamplerOnlyCountsRoots(t *testing.T) {
+	s, clock := newTestAdaptiveSampler(1)
+	parent := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	}))
+	for range 1000 {
+		s.ShouldSample(SamplingParameters{ParentContext: parent, TraceID: trace.TraceID{1}})
+	}
+	clock.Advance(time.Second)
+	sampleRoots(t, s, 1)
+	assert.Equal(t, 1.0, s.Probability())
+}
+
+func TestAdaptiveSamplerDefaults(t *testing.T) {
+	s := AdaptiveSampler(-1, 0).(*adaptiveSampler)
+	assert.Equal(t, defaultAdaptiveInterval, s.interval)
+	assert.Equal(t, 0.0, s.Target())
+	assert.Equal(t, 0.0, s.floor)
+	assert.Equal(t, 1.0, s.ceiling)
+}
+
+func TestAdaptiveSamplerState(t *testing.T) {
+	store := NewFileSamplerStateStore(t.TempDir())
+	ctx := t.Context()
+
+	controller := AdaptiveSampler(10, time.Second)
+	require.NoError(t, SyncSamplerState(ctx, controller, store, "adaptive"))
+
+	replica := AdaptiveSampler(100, time.Second)
+	require.NoError(t, SyncSamplerState(ctx, replica, store, "adaptive"))
+	assert.Equal(t, 10.0, replica.(*adaptiveSampler).Target())
+	assert.Equal(t, "AdaptiveSampler{10}", replica.Description())
+
+	assert.Error(t, replica.SetSamplerState([]byte("{")))
+	assert.Equal(t, 10.0, replica.(*adaptiveSampler).Target())
+}
+
+func TestAdaptiveSamplerConcurrentSafe(t *testing.T) {
+	s := AdaptiveSampler(10, time.Millisecond)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for ctx.Err() == nil {
+				s.ShouldSample(SamplingParameters{ParentContext: ctx})
+			}
+		})
+	}
+	wg.Go(func() {
+		for ctx.Err() == nil {
+			_ = s.SetSamplerState([]byte(`{"target_sps":5}`))
+			_ = s.Description()
+		}
+	})
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultSamplerCacheSize is the default maximum number of decisions cached
+// by a CachedSampler.
+const defaultSamplerCacheSize = 1024
+
+// NameKindSampler is a Sampler whose sampling decision depends only on the
+// name and kind of the span being sampled.
+//
+// Samplers that evaluate expensive rules, e.g. regular expressions matched
+// against span names, can implement this interface so their decisions can be
+// memoized with CachedSampler.
+type NameKindSampler interface {
+	Sampler
+
+	// SamplesByNameAndKind reports whether the SamplingResult returned from
+	// ShouldSample depends only on the Name and Kind of the passed
+	// SamplingParameters. If false is returned, the decision is never cached.
+	SamplesByNameAndKind() bool
+}
+
+// CachedSampler returns a Sampler that memoizes the decisions of s for each
+// span name and kind pair, avoiding the re-evaluation of s for every span
+// started.
+//
+// If s does not implement NameKindSampler, or its SamplesByNameAndKind method
+// returns false, s is returned unchanged.
+//
+// At most maxEntries decisions are cached. Once that limit is reached, s is
+// evaluated for any span name and kind pair not already cached. If maxEntries
+// is less than or equal to zero, a default of 1024 is used.
+//
+// The Tracestate of a cached result is always the one of the parent span, a
+// cached Sampler is not able to modify it.
+func CachedSampler(s Sampler, maxEntries int) Sampler {
+	nk, ok := s.(NameKindSampler)
+	if !ok || !nk.SamplesByNameAndKind() {
+		return s
+	}
+	if maxEntries <= 0 {
+		maxEntries = defaultSamplerCacheSize
+	}
+	return &cachedSampler{
+		sampler:    s,
+		maxEntries: maxEntries,
+		cache:      make(map[nameKind]cachedResult),
+	}
+}
+
+type nameKind struct {
+	name string
+	kind trace.SpanKind
+}
+
+type cachedResult struct {
+	decision   SamplingDecision
+	attributes []attribute.KeyValue
+}
+
+type cachedSampler struct {
+	sampler    Sampler
+	maxEntries int
+
+	mu    sync.RWMutex
+	cache map[nameKind]cachedResult
+}
+
+func (s *cachedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	key := nameKind{name: p.Name, kind: p.Kind}
+
+	s.mu.RLock()
+	r, ok := s.cache[key]
+	s.mu.RUnlock()
+	if ok {
+		return SamplingResult{
+			Decision: r.decision,
+			// The caller may modify the returned attributes, e.g. by
+			// appending to them, so the cached ones are never shared.
+			Attributes: slices.Clone(r.attributes),
+			Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+		}
+	}
+
+	res := s.sampler.ShouldSample(p)
+
+	s.mu.Lock()
+	if len(s.cache) < s.maxEntries {
+		s.cache[key] = cachedResult{
+			decision: res.Decision,
+			// Copy the attributes so a result returned to a caller is never
+			// shared with the cache.
+			attributes: slices.Clone(res.Attributes),
+		}
+	}
+	s.mu.Unlock()
+
+	return res
+}
+
+func (s *cachedSampler) Description() string {
+	return fmt.Sprintf("CachedSampler{%s}", s.sampler.Description())
+}
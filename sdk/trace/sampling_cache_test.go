@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type nameKindSampler struct {
+	byNameKind bool
+	calls      atomic.Int64
+}
+
+func (s *nameKindSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	s.calls.Add(1)
+	d := Drop
+	if p.Name == "sampled" {
+		d = RecordAndSample
+	}
+	return SamplingResult{
+		Decision:   d,
+		Attributes: []attribute.KeyValue{attribute.String("name", p.Name)},
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+func (*nameKindSampler) Description() string { return "nameKindSampler" }
+
+func (s *nameKindSampler) SamplesByNameAndKind() bool { return s.byNameKind }
+
+func TestCachedSamplerNotCacheable(t *testing.T) {
+	s := AlwaysSample()
+	assert.Equal(t, s, CachedSampler(s, 0), "non-NameKindSampler wrapped")
+
+	nk := &nameKindSampler{byNameKind: false}
+	assert.Same(t, nk, CachedSampler(nk, 0), "non-cacheable NameKindSampler wrapped")
+}
+
+func TestCachedSampler(t *testing.T) {
+	nk := &nameKindSampler{byNameKind: true}
+	s := CachedSampler(nk, 0)
+	assert.Equal(t, "CachedSampler{nameKindSampler}", s.Description())
+
+	ctx := t.Context()
+	for range 3 {
+		res := s.ShouldSample(SamplingParameters{ParentContext: ctx, Name: "sampled"})
+		assert.Equal(t, RecordAndSample, res.Decision)
+		assert.Equal(t, []attribute.KeyValue{attribute.String("name", "sampled")}, res.Attributes)
+
+		res = s.ShouldSample(SamplingParameters{ParentContext: ctx, Name: "dropped"})
+		assert.Equal(t, Drop, res.Decision)
+	}
+	assert.Equal(t, int64(2), nk.calls.Load())
+
+	// Kind is part of the cache key.
+	res := s.ShouldSample(SamplingParameters{ParentContext: ctx, Name: "sampled", Kind: trace.SpanKindServer})
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, int64(3), nk.calls.Load())
+}
+
+func TestCachedSamplerAttributesNotShared(t *testing.T) {
+	s := CachedSampler(&nameKindSampler{byNameKind: true}, 0)
+	p := SamplingParameters{ParentContext: t.Context(), Name: "sampled"}
+	want := []attribute.KeyValue{attribute.String("name", "sampled")}
+
+	s.ShouldSample(p)
+	res := s.ShouldSample(p)
+	require.Equal(t, want, res.Attributes)
+	res.Attributes[0] = attribute.String("name", "modified")
+	assert.Equal(t, want, s.ShouldSample(p).Attributes, "cached attributes modified")
+}
+
+func TestCachedSamplerParentTracestate(t *testing.T) {
+	s := CachedSampler(&nameKindSampler{byNameKind: true}, 0)
+
+	ts, err := trace.ParseTraceState("k=v")
+	require.NoError(t, err)
+	ctx := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceState: ts,
+	}))
+
+	_ = s.ShouldSample(SamplingParameters{ParentContext: t.Context(), Name: "sampled"})
+	res := s.ShouldSample(SamplingParameters{ParentContext: ctx, Name: "sampled"})
+	assert.Equal(t, ts, res.Tracestate)
+}
+
+func TestCachedSamplerMaxEntries(t *testing.T) {
+	nk := &nameKindSampler{byNameKind: true}
+	s := CachedSampler(nk, 1)
+
+	ctx := t.Context()
+	for range 2 {
+		s.ShouldSample(SamplingParameters{ParentContext: ctx, Name: "a"})
+		s.ShouldSample(SamplingParameters{ParentContext: ctx, Name: "b"})
+	}
+	// Only "a" is cached.
+	assert.Equal(t, int64(3), nk.calls.Load())
+}
+
+func TestCachedSamplerConcurrentSafe(t *testing.T) {
+	s := CachedSampler(&nameKindSampler{byNameKind: true}, 2)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			name := []string{"a", "b", "c"}[i%3]
+			for range 100 {
+				_ = s.ShouldSample(SamplingParameters{ParentContext: t.Context(), Name: name})
+			}
+		})
+	}
+	wg.Wait()
+}
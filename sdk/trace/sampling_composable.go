@@ -0,0 +1,380 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// AlwaysSampleThreshold is the rejection threshold of a SamplingIntent
+	// sampling all spans.
+	AlwaysSampleThreshold uint64 = 0
+	// NeverSampleThreshold is the rejection threshold of a SamplingIntent
+	// sampling no span.
+	NeverSampleThreshold uint64 = 1 << 56
+
+	// otTraceStateKey is the key of the OpenTelemetry values in a tracestate.
+	otTraceStateKey = "ot"
+	// thresholdMaxHexDigits is the number of hexadecimal digits of the 56
+	// bits thresholds and randomness values in a tracestate.
+	thresholdMaxHexDigits = 14
+	// randomnessMask masks the 56 bits of randomness of a trace ID.
+	randomnessMask = NeverSampleThreshold - 1
+)
+
+// ComposableSampler is a sampler that can be composed with others to build
+// consistent probability sampling chains, as described by the OpenTelemetry
+// specification. Instead of a sampling decision, it returns a SamplingIntent
+// holding the rejection threshold of the span. The decision is made from the
+// threshold by the Sampler returned by CompositeSampler, which also records
+// the threshold in the "th" sub-key of the OpenTelemetry tracestate value.
+type ComposableSampler interface {
+	// SamplingIntent returns the SamplingIntent of a span based on the
+	// passed parameters.
+	SamplingIntent(parameters SamplingParameters) SamplingIntent
+
+	// Description returns information describing the ComposableSampler.
+	Description() string
+}
+
+// SamplingIntent is the sampling intent of a ComposableSampler for a span.
+type SamplingIntent struct {
+	// Threshold is the rejection threshold of the span. The span is sampled
+	// if its 56 bits randomness value is greater than or equal to Threshold.
+	// AlwaysSampleThreshold samples all spans, while NeverSampleThreshold, or
+	// any greater value, samples none.
+	Threshold uint64
+	// ThresholdReliable is whether Threshold reflects the actual sampling
+	// probability of the span. If it is false, the threshold is not recorded
+	// in the tracestate, so it is not used to compute span counts.
+	ThresholdReliable bool
+	// Attributes are added to the span if it is sampled.
+	Attributes []attribute.KeyValue
+	// UpdateTraceState, if not nil, is called with the tracestate of the
+	// span, once the threshold is recorded in it, and returns the tracestate
+	// to use. It allows vendors to propagate their own values.
+	UpdateTraceState func(trace.TraceState) trace.TraceState
+}
+
+// CompositeSamplerOption configures a Sampler returned by CompositeSampler.
+type CompositeSamplerOption interface {
+	apply(compositeConfig) compositeConfig
+}
+
+type compositeConfig struct {
+	explicitRandomness bool
+}
+
+type compositeSamplerOptionFunc func(compositeConfig) compositeConfig
+
+func (fn compositeSamplerOptionFunc) apply(c compositeConfig) compositeConfig {
+	return fn(c)
+}
+
+// WithExplicitRandomness configures the Sampler to generate a random 56 bits
+// randomness value for each root span, a span without a valid parent, and to
+// record it in the "rv" sub-key of the OpenTelemetry tracestate value. The
+// sampling decisions of the trace, including the ones of the downstream
+// services, are then consistently based on this value instead of the trace
+// ID.
+//
+// This should be used if the trace IDs are not random enough to base the
+// sampling decisions on, e.g. when they are generated by a custom
+// IDGenerator.
+func WithExplicitRandomness() CompositeSamplerOption {
+	return compositeSamplerOptionFunc(func(c compositeConfig) compositeConfig {
+		c.explicitRandomness = true
+		return c
+	})
+}
+
+// CompositeSampler returns a Sampler making its decisions from the
+// SamplingIntent of s.
+//
+// A span is sampled if its randomness value is greater than or equal to the
+// threshold of the intent. The randomness value is the "rv" sub-key of the
+// OpenTelemetry tracestate value if present, or the 56 least significant bits
+// of the trace ID otherwise. The threshold of a sampled span is recorded in
+// the "th" sub-key of the OpenTelemetry tracestate value if it is reliable,
+// otherwise the sub-key is removed.
+func CompositeSampler(s ComposableSampler, opts ...CompositeSamplerOption) Sampler {
+	var cfg compositeConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	cs := compositeSampler{sampler: s}
+	if cfg.explicitRandomness {
+		cs.randomness = func() uint64 {
+			return rand.Uint64() & randomnessMask //nolint:gosec // Sampling randomness is not security sensitive.
+		}
+	}
+	return cs
+}
+
+type compositeSampler struct {
+	sampler ComposableSampler
+	// randomness, if not nil, returns the explicit randomness value of root
+	// spans.
+	randomness func() uint64
+}
+
+func (cs compositeSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	ts := psc.TraceState()
+	intent := cs.sampler.SamplingIntent(p)
+
+	rnd, ok := randomnessFromTraceState(ts)
+	switch {
+	case ok:
+	case cs.randomness != nil && !psc.IsValid():
+		rnd = cs.randomness()
+		ts = setOTSubKey(ts, "rv", fmt.Sprintf("%0*x", thresholdMaxHexDigits, rnd))
+	default:
+		rnd = binary.BigEndian.Uint64(p.TraceID[8:16]) & randomnessMask
+	}
+	sampled := intent.Threshold < NeverSampleThreshold && rnd >= intent.Threshold
+
+	if sampled && intent.ThresholdReliable {
+		ts = setOTSubKey(ts, "th", formatThreshold(intent.Threshold))
+	} else {
+		ts = setOTSubKey(ts, "th", "")
+	}
+	if intent.UpdateTraceState != nil {
+		ts = intent.UpdateTraceState(ts)
+	}
+
+	if !sampled {
+		return SamplingResult{Decision: Drop, Tracestate: ts}
+	}
+	return SamplingResult{
+		Decision:   RecordAndSample,
+		Attributes: intent.Attributes,
+		Tracestate: ts,
+	}
+}
+
+func (cs compositeSampler) Description() string {
+	return cs.sampler.Description()
+}
+
+// ThresholdFromTraceState returns the rejection threshold recorded in the
+// "th" sub-key of the OpenTelemetry value of ts. False is returned if ts holds
+// no valid threshold.
+func ThresholdFromTraceState(ts trace.TraceState) (uint64, bool) {
+	v, ok := otSubKey(ts, "th")
+	if !ok || v == "" || len(v) > thresholdMaxHexDigits {
+		return 0, false
+	}
+	// Trailing zeros are omitted from the encoding.
+	v += strings.Repeat("0", thresholdMaxHexDigits-len(v))
+	th, err := strconv.ParseUint(v, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return th, true
+}
+
+// AdjustedCountFromTraceState returns the adjusted count of a span with the
+// tracestate ts, the number of spans it represents once sampled, e.g. 4 for a
+// span sampled with a probability of 25%. It is derived from the rejection
+// threshold recorded in the "th" sub-key of the OpenTelemetry value of ts.
+// False is returned if ts holds no valid threshold, as the adjusted count is
+// then unknown.
+func AdjustedCountFromTraceState(ts trace.TraceState) (float64, bool) {
+	th, ok := ThresholdFromTraceState(ts)
+	if !ok {
+		return 0, false
+	}
+	return float64(NeverSampleThreshold) / float64(NeverSampleThreshold-th), true
+}
+
+// randomnessFromTraceState returns the randomness value recorded in the "rv"
+// sub-key of the OpenTelemetry value of ts.
+func randomnessFromTraceState(ts trace.TraceState) (uint64, bool) {
+	v, ok := otSubKey(ts, "rv")
+	if !ok || len(v) != thresholdMaxHexDigits {
+		return 0, false
+	}
+	rv, err := strconv.ParseUint(v, 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return rv, true
+}
+
+// formatThreshold returns the tracestate encoding of th.
+func formatThreshold(th uint64) string {
+	if th == 0 {
+		return "0"
+	}
+	s := fmt.Sprintf("%0*x", thresholdMaxHexDigits, th)
+	return strings.TrimRight(s, "0")
+}
+
+// otSubKey returns the value of the sub-key key of the OpenTelemetry value of
+// ts.
+func otSubKey(ts trace.TraceState, key string) (string, bool) {
+	for kv := range strings.SplitSeq(ts.Get(otTraceStateKey), ";") {
+		if k, v, ok := strings.Cut(kv, ":"); ok && k == key {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// setOTSubKey returns a copy of ts where the sub-key key of the OpenTelemetry
+// value is set to value, or removed if value is empty. The other sub-keys are
+// kept. If the resulting tracestate is invalid, ts is returned.
+func setOTSubKey(ts trace.TraceState, key, value string) trace.TraceState {
+	var kvs []string
+	if ot := ts.Get(otTraceStateKey); ot != "" {
+		kvs = strings.Split(ot, ";")
+	}
+	kvs = slices.DeleteFunc(kvs, func(kv string) bool {
+		k, _, _ := strings.Cut(kv, ":")
+		return k == key
+	})
+	if value != "" {
+		kvs = append([]string{key + ":" + value}, kvs...)
+	}
+
+	if len(kvs) == 0 {
+		return ts.Delete(otTraceStateKey)
+	}
+	updated, err := ts.Insert(otTraceStateKey, strings.Join(kvs, ";"))
+	if err != nil {
+		return ts
+	}
+	return updated
+}
+
+// ComposableAlwaysOn returns a ComposableSampler sampling all spans.
+func ComposableAlwaysOn() ComposableSampler {
+	return composableAlwaysOn{}
+}
+
+type composableAlwaysOn struct{}
+
+func (composableAlwaysOn) SamplingIntent(SamplingParameters) SamplingIntent {
+	return SamplingIntent{Threshold: AlwaysSampleThreshold, ThresholdReliable: true}
+}
+
+func (composableAlwaysOn) Description() string {
+	return "ComposableAlwaysOn"
+}
+
+// ComposableAlwaysOff returns a ComposableSampler sampling no span.
+func ComposableAlwaysOff() ComposableSampler {
+	return composableAlwaysOff{}
+}
+
+type composableAlwaysOff struct{}
+
+func (composableAlwaysOff) SamplingIntent(SamplingParameters) SamplingIntent {
+	return SamplingIntent{Threshold: NeverSampleThreshold}
+}
+
+func (composableAlwaysOff) Description() string {
+	return "ComposableAlwaysOff"
+}
+
+// ComposableProbability returns a ComposableSampler sampling the given
+// fraction of traces. Fractions >= 1 sample all traces, fractions <= 0 sample
+// none.
+func ComposableProbability(fraction float64) ComposableSampler {
+	if fraction >= 1 {
+		return composableProbability{
+			threshold:   AlwaysSampleThreshold,
+			description: "ComposableProbability{1}",
+		}
+	}
+	if fraction <= 0 || math.IsNaN(fraction) {
+		return composableProbability{
+			threshold:   NeverSampleThreshold,
+			description: "ComposableProbability{0}",
+		}
+	}
+	return composableProbability{
+		threshold:   uint64(math.Round((1 - fraction) * float64(NeverSampleThreshold))),
+		description: fmt.Sprintf("ComposableProbability{%g}", fraction),
+	}
+}
+
+type composableProbability struct {
+	threshold   uint64
+	description string
+}
+
+func (s composableProbability) SamplingIntent(SamplingParameters) SamplingIntent {
+	return SamplingIntent{
+		Threshold:         s.threshold,
+		ThresholdReliable: s.threshold < NeverSampleThreshold,
+	}
+}
+
+func (s composableProbability) Description() string {
+	return s.description
+}
+
+// ComposableParentThreshold returns a ComposableSampler using the sampling
+// decision of the parent of a span. If the parent is sampled, its threshold
+// recorded in the tracestate is used, or AlwaysSampleThreshold if none is
+// recorded. If the parent is not sampled, the span is not sampled either.
+// Root spans are sampled by root.
+func ComposableParentThreshold(root ComposableSampler) ComposableSampler {
+	return composableParentThreshold{root: root}
+}
+
+type composableParentThreshold struct {
+	root ComposableSampler
+}
+
+func (s composableParentThreshold) SamplingIntent(p SamplingParameters) SamplingIntent {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	if !psc.IsValid() {
+		return s.root.SamplingIntent(p)
+	}
+	if !psc.IsSampled() {
+		return SamplingIntent{Threshold: NeverSampleThreshold}
+	}
+	if th, ok := ThresholdFromTraceState(psc.TraceState()); ok {
+		return SamplingIntent{Threshold: th, ThresholdReliable: true}
+	}
+	return SamplingIntent{Threshold: AlwaysSampleThreshold}
+}
+
+func (s composableParentThreshold) Description() string {
+	return "ComposableParentThreshold{root:" + s.root.Description() + "}"
+}
+
+// ComposableAnnotating returns a ComposableSampler adding attrs to the spans
+// sampled by s, e.g. to record which rule of a sampling chain sampled them.
+func ComposableAnnotating(s ComposableSampler, attrs ...attribute.KeyValue) ComposableSampler {
+	return composableAnnotating{sampler: s, attrs: slices.Clone(attrs)}
+}
+
+type composableAnnotating struct {
+	sampler ComposableSampler
+	attrs   []attribute.KeyValue
+}
+
+func (s composableAnnotating) SamplingIntent(p SamplingParameters) SamplingIntent {
+	intent := s.sampler.SamplingIntent(p)
+	intent.Attributes = slices.Concat(intent.Attributes, s.attrs)
+	return intent
+}
+
+func (s composableAnnotating) Description() string {
+	return "ComposableAnnotating{" + s.sampler.Description() + "}"
+}
@@ -0,0 +1,257 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// composableParams returns SamplingParameters for a span of a trace with the
+// 56 bits randomness value rnd whose parent has the flags and tracestate.
+func composableParams(t *testing.T, rnd uint64, flags trace.TraceFlags, ts string) SamplingParameters {
+	t.Helper()
+	tid := trace.TraceID{0x01}
+	for i := 15; i >= 9; i-- {
+		tid[i] = byte(rnd)
+		rnd >>= 8
+	}
+	ctx := t.Context()
+	if flags != noParent {
+		state, err := trace.ParseTraceState(ts)
+		require.NoError(t, err)
+		psc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    tid,
+			SpanID:     trace.SpanID{0x01},
+			TraceFlags: flags,
+			TraceState: state,
+			Remote:     true,
+		})
+		ctx = trace.ContextWithSpanContext(ctx, psc)
+	}
+	return SamplingParameters{ParentContext: ctx, TraceID: tid}
+}
+
+// noParent is the flags passed to composableParams for a root span.
+const noParent trace.TraceFlags = 0xff
+
+func TestCompositeSamplerProbability(t *testing.T) {
+	s := CompositeSampler(ComposableProbability(0.5))
+	assert.Equal(t, "ComposableProbability{0.5}", s.Description())
+
+	res := s.ShouldSample(composableParams(t, 0x80000000000000, noParent, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8", res.Tracestate.Get("ot"))
+
+	res = s.ShouldSample(composableParams(t, 0x7fffffffffffff, noParent, ""))
+	assert.Equal(t, Drop, res.Decision)
+	assert.Empty(t, res.Tracestate.Get("ot"))
+}
+
+func TestCompositeSamplerRandomnessFromTraceState(t *testing.T) {
+	s := CompositeSampler(ComposableProbability(0.5))
+
+	// The trace ID randomness is ignored in favor of the rv sub-key.
+	res := s.ShouldSample(composableParams(t, 0, trace.FlagsSampled, "ot=rv:c0000000000000,vendor=x"))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8;rv:c0000000000000", res.Tracestate.Get("ot"))
+	assert.Equal(t, "x", res.Tracestate.Get("vendor"))
+}
+
+func TestCompositeSamplerAlways(t *testing.T) {
+	on := CompositeSampler(ComposableAlwaysOn())
+	res := on.ShouldSample(composableParams(t, 0, noParent, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:0", res.Tracestate.Get("ot"))
+
+	off := CompositeSampler(ComposableAlwaysOff())
+	res = off.ShouldSample(composableParams(t, randomnessMask, noParent, ""))
+	assert.Equal(t, Drop, res.Decision)
+
+	assert.Equal(t, "ComposableProbability{1}", ComposableProbability(2).Description())
+	assert.Equal(t, "ComposableProbability{0}", ComposableProbability(-1).Description())
+}
+
+func TestComposableParentThreshold(t *testing.T) {
+	s := CompositeSampler(ComposableParentThreshold(ComposableAlwaysOff()))
+	assert.Equal(t, "ComposableParentThreshold{root:ComposableAlwaysOff}", s.Description())
+
+	// The threshold of a sampled parent is propagated.
+	res := s.ShouldSample(composableParams(t, 0xf0000000000000, trace.FlagsSampled, "ot=th:c"))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:c", res.Tracestate.Get("ot"))
+
+	// A sampled parent without threshold has an unknown probability.
+	res = s.ShouldSample(composableParams(t, 0, trace.FlagsSampled, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Empty(t, res.Tracestate.Get("ot"))
+
+	// Not sampled parent, the threshold is erased.
+	res = s.ShouldSample(composableParams(t, randomnessMask, 0, "ot=th:c"))
+	assert.Equal(t, Drop, res.Decision)
+	assert.Empty(t, res.Tracestate.Get("ot"))
+
+	// Root spans use the root sampler.
+	res = s.ShouldSample(composableParams(t, randomnessMask, noParent, ""))
+	assert.Equal(t, Drop, res.Decision)
+}
+
+func TestComposableAnnotating(t *testing.T) {
+	attr := attribute.String("rule", "all")
+	s := CompositeSampler(ComposableAnnotating(ComposableAlwaysOn(), attr))
+	assert.Equal(t, "ComposableAnnotating{ComposableAlwaysOn}", s.Description())
+
+	res := s.ShouldSample(composableParams(t, 0, noParent, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, []attribute.KeyValue{attr}, res.Attributes)
+
+	res = CompositeSampler(ComposableAnnotating(ComposableAlwaysOff(), attr)).
+		ShouldSample(composableParams(t, 0, noParent, ""))
+	assert.Equal(t, Drop, res.Decision)
+	assert.Empty(t, res.Attributes)
+}
+
+// vendorSampler is a ComposableSampler propagating a vendor value.
+type vendorSampler struct{}
+
+func (vendorSampler) SamplingIntent(SamplingParameters) SamplingIntent {
+	return SamplingIntent{
+		Threshold:         AlwaysSampleThreshold,
+		ThresholdReliable: true,
+		UpdateTraceState: func(ts trace.TraceState) trace.TraceState {
+			ts, _ = ts.Insert("vendor", "sampled")
+			return ts
+		},
+	}
+}
+
+func (vendorSampler) Description() string { return "vendorSampler" }
+
+func TestCompositeSamplerUpdateTraceState(t *testing.T) {
+	res := CompositeSampler(vendorSampler{}).ShouldSample(composableParams(t, 0, noParent, ""))
+	assert.Equal(t, "vendor=sampled,ot=th:0", res.Tracestate.String())
+}
+
+func TestThresholdFromTraceState(t *testing.T) {
+	tests := []struct {
+		ts   string
+		want uint64
+		ok   bool
+	}{
+		{ts: "", ok: false},
+		{ts: "ot=rv:00000000000000", ok: false},
+		{ts: "ot=th:0", want: 0, ok: true},
+		{ts: "ot=th:8", want: 0x80000000000000, ok: true},
+		{ts: "ot=rv:00000000000000;th:fffffffffffff", want: 0xfffffffffffff0, ok: true},
+		{ts: "ot=th:123456789abcdef", ok: false},
+		{ts: "ot=th:zz", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ts, func(t *testing.T) {
+			ts, err := trace.ParseTraceState(tt.ts)
+			require.NoError(t, err)
+			got, ok := ThresholdFromTraceState(ts)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompositeSamplerTracerProvider(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSampler(CompositeSampler(ComposableParentThreshold(ComposableAlwaysOn()))),
+		WithSyncer(te),
+	)
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	ctx, span := tp.Tracer("TestCompositeSamplerTracerProvider").Start(t.Context(), "parent")
+	_, child := tp.Tracer("TestCompositeSamplerTracerProvider").Start(ctx, "child")
+	child.End()
+	span.End()
+
+	got, ok := te.GetSpan("child")
+	require.True(t, ok)
+	assert.Equal(t, "th:0", got.SpanContext().TraceState().Get("ot"))
+}
+
+func TestCompositeSamplerExplicitRandomness(t *testing.T) {
+	s := CompositeSampler(ComposableProbability(0.5), WithExplicitRandomness()).(compositeSampler)
+	rnd := uint64(0xc0000000000000)
+	s.randomness = func() uint64 { return rnd }
+
+	// The randomness of the trace ID is ignored for root spans.
+	res := s.ShouldSample(composableParams(t, 0, noParent, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8;rv:c0000000000000", res.Tracestate.Get("ot"))
+
+	rnd = 0x40000000000000
+	res = s.ShouldSample(composableParams(t, 0xffffffffffffff, noParent, ""))
+	assert.Equal(t, Drop, res.Decision)
+	assert.Equal(t, "rv:40000000000000", res.Tracestate.Get("ot"), "randomness not propagated")
+
+	// The randomness of the parent is used.
+	res = s.ShouldSample(composableParams(t, 0, trace.FlagsSampled, "ot=rv:80000000000000"))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8;rv:80000000000000", res.Tracestate.Get("ot"))
+
+	// Spans with a parent without randomness use the trace ID.
+	res = s.ShouldSample(composableParams(t, 0xffffffffffffff, trace.FlagsSampled, ""))
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "th:8", res.Tracestate.Get("ot"))
+}
+
+func TestCompositeSamplerExplicitRandomnessTracerProvider(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSampler(CompositeSampler(ComposableParentThreshold(ComposableAlwaysOn()), WithExplicitRandomness())),
+		WithSyncer(te),
+	)
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	tr := tp.Tracer("TestCompositeSamplerExplicitRandomnessTracerProvider")
+	ctx, span := tr.Start(t.Context(), "parent")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	span.End()
+
+	parent, ok := te.GetSpan("parent")
+	require.True(t, ok)
+	rv, ok := otSubKey(parent.SpanContext().TraceState(), "rv")
+	require.True(t, ok)
+	assert.Len(t, rv, thresholdMaxHexDigits)
+
+	got, ok := te.GetSpan("child")
+	require.True(t, ok)
+	assert.Equal(t, parent.SpanContext().TraceState(), got.SpanContext().TraceState())
+}
+
+func TestAdjustedCountFromTraceState(t *testing.T) {
+	tests := []struct {
+		ts   string
+		want float64
+		ok   bool
+	}{
+		{ts: "", ok: false},
+		{ts: "ot=rv:00000000000000", ok: false},
+		{ts: "ot=th:0", want: 1, ok: true},
+		{ts: "ot=th:8", want: 2, ok: true},
+		{ts: "ot=th:c", want: 4, ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ts, func(t *testing.T) {
+			ts, err := trace.ParseTraceState(tt.ts)
+			require.NoError(t, err)
+			got, ok := AdjustedCountFromTraceState(ts)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// defaultFileSamplerPollInterval is the default interval at which a
+// FileSampler checks its file for changes.
+const defaultFileSamplerPollInterval = 10 * time.Second
+
+// FileSamplerOption configures a FileSampler.
+type FileSamplerOption interface {
+	apply(fileSamplerConfig) fileSamplerConfig
+}
+
+type fileSamplerConfig struct {
+	service      string
+	pollInterval time.Duration
+	decode       func([]byte, any) error
+}
+
+type fileSamplerOptionFunc func(fileSamplerConfig) fileSamplerConfig
+
+func (fn fileSamplerOptionFunc) apply(cfg fileSamplerConfig) fileSamplerConfig {
+	return fn(cfg)
+}
+
+// WithFileSamplerServiceName sets the name of the service the rules of a
+// FileSampler are selected for. By default, the service.name of the default
+// Resource is used, e.g. the value of the OTEL_SERVICE_NAME environment
+// variable.
+func WithFileSamplerServiceName(name string) FileSamplerOption {
+	return fileSamplerOptionFunc(func(cfg fileSamplerConfig) fileSamplerConfig {
+		cfg.service = name
+		return cfg
+	})
+}
+
+// WithFileSamplerPollInterval sets the interval at which a FileSampler checks
+// whether its file changed. By default, the file is checked every 10 seconds.
+// If d is less than or equal to zero, the default is used.
+func WithFileSamplerPollInterval(d time.Duration) FileSamplerOption {
+	return fileSamplerOptionFunc(func(cfg fileSamplerConfig) fileSamplerConfig {
+		if d > 0 {
+			cfg.pollInterval = d
+		}
+		return cfg
+	})
+}
+
+// WithFileSamplerDecoder sets the function decoding the content of the file
+// of a FileSampler. By default, the file is decoded as JSON, rejecting
+// unknown fields.
+//
+// This allows other formats to be used, e.g. YAML by passing the Unmarshal
+// function of a YAML package. The fields of the decoded value are tagged with
+// their names for both the json and yaml keys. If decode is nil, the default
+// is used.
+func WithFileSamplerDecoder(decode func(data []byte, v any) error) FileSamplerOption {
+	return fileSamplerOptionFunc(func(cfg fileSamplerConfig) fileSamplerConfig {
+		if decode != nil {
+			cfg.decode = decode
+		}
+		return cfg
+	})
+}
+
+// decodeStrictJSON decodes the JSON encoded data into v, rejecting unknown
+// fields so a misspelled rule is reported instead of ignored.
+func decodeStrictJSON(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// fileSamplerFile is the content of the file of a FileSampler.
+type fileSamplerFile struct {
+	// DefaultRatio is the ratio of the spans matched by no rule. If nil,
+	// these spans are all sampled.
+	DefaultRatio *float64          `json:"default_ratio" yaml:"default_ratio"`
+	Rules        []fileSamplerRule `json:"rules"         yaml:"rules"`
+}
+
+// fileSamplerRule is a sampling rule of the file of a FileSampler.
+type fileSamplerRule struct {
+	// Service is the service name matched, or empty to match any service.
+	Service string `json:"service" yaml:"service"`
+	// SpanName is the span name matched, or empty to match any span name.
+	SpanName string   `json:"span_name" yaml:"span_name"`
+	Ratio    *float64 `json:"ratio"     yaml:"ratio"`
+}
+
+// fileSamplerPolicy is the sampling policy of a FileSampler built from its
+// file for its service.
+type fileSamplerPolicy struct {
+	// byName are the samplers of the span names matched by a rule.
+	byName map[string]Sampler
+	// fallback is the sampler of the other spans.
+	fallback Sampler
+}
+
+func (p *fileSamplerPolicy) sampler(name string) Sampler {
+	if s, ok := p.byName[name]; ok {
+		return s
+	}
+	return p.fallback
+}
+
+// newFileSamplerPolicy returns the policy of the rules of f for service.
+func newFileSamplerPolicy(f fileSamplerFile, service string) (*fileSamplerPolicy, error) {
+	p := &fileSamplerPolicy{byName: make(map[string]Sampler)}
+	if f.DefaultRatio != nil {
+		if err := validateRatio(*f.DefaultRatio); err != nil {
+			return nil, fmt.Errorf("default_ratio: %w", err)
+		}
+	}
+	for i, r := range f.Rules {
+		if r.Ratio == nil {
+			return nil, fmt.Errorf("rule %d: missing ratio", i)
+		}
+		if err := validateRatio(*r.Ratio); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+		if r.Service != "" && r.Service != service {
+			continue
+		}
+
+		// The first rule matching a span applies.
+		switch {
+		case r.SpanName != "":
+			if _, ok := p.byName[r.SpanName]; !ok {
+				p.byName[r.SpanName] = TraceIDRatioBased(*r.Ratio)
+			}
+		case p.fallback == nil:
+			p.fallback = TraceIDRatioBased(*r.Ratio)
+		}
+	}
+	if p.fallback == nil {
+		p.fallback = AlwaysSample()
+		if f.DefaultRatio != nil {
+			p.fallback = TraceIDRatioBased(*f.DefaultRatio)
+		}
+	}
+	return p, nil
+}
+
+func validateRatio(r float64) error {
+	if math.IsNaN(r) || r < 0 || r > 1 {
+		return fmt.Errorf("invalid ratio %g, not in [0, 1]", r)
+	}
+	return nil
+}
+
+// FileSampler is a Sampler using the sampling rules of a file, reloaded when
+// the file changes. This allows the sampling of a running process to be
+// adjusted, e.g. during an incident, without restarting it.
+//
+// The file holds the ratio of the spans sampled by default and a list of
+// rules. Each rule samples the spans of a service, a span name, or both, with
+// a ratio. For example, in JSON:
+//
+//	{
+//	  "default_ratio": 0.1,
+//	  "rules": [
+//	    {"service": "checkout", "span_name": "GET /health", "ratio": 0},
+//	    {"service": "checkout", "ratio": 0.5},
+//	    {"span_name": "payment", "ratio": 1}
+//	  ]
+//	}
+//
+// The rules of other services than the one of the FileSampler are ignored. A
+// span is sampled with the ratio of the first rule matching its name, else of
+// the first rule without a span name, else with the default ratio. If the
+// default ratio is omitted, all the spans matched by no rule are sampled. The
+// ratios are applied like with TraceIDRatioBased. To respect the sampling
+// decision of the parent span, the FileSampler should be used as the root
+// sampler of ParentBased.
+//
+// The file is checked for changes periodically, based on its modification
+// time and size. The new rules replace the previous ones atomically, spans
+// being sampled concurrently use either of them. If the file cannot be read
+// or its rules are invalid, the error is sent to the global ErrorHandler and
+// the previous rules are kept.
+//
+// Close needs to be called to stop checking the file once the FileSampler is
+// no longer used.
+type FileSampler struct {
+	path    string
+	service string
+	decode  func([]byte, any) error
+
+	policy atomic.Pointer[fileSamplerPolicy]
+
+	// mu guards the reload of the file.
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ Sampler = (*FileSampler)(nil)
+
+// NewFileSampler returns a FileSampler using the sampling rules of the file
+// at path. An error is returned if the file cannot be read or its rules are
+// invalid.
+func NewFileSampler(path string, opts ...FileSamplerOption) (*FileSampler, error) {
+	cfg := fileSamplerConfig{
+		pollInterval: defaultFileSamplerPollInterval,
+		decode:       decodeStrictJSON,
+	}
+	if v, ok := resource.Default().Set().Value(attribute.Key("service.name")); ok {
+		cfg.service = v.AsString()
+	}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	s := &FileSampler{
+		path:    path,
+		service: cfg.service,
+		decode:  cfg.decode,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if err := s.Reload(); err != nil {
+		return nil, err
+	}
+	go s.watch(cfg.pollInterval)
+	return s, nil
+}
+
+// watch reloads the file of s when it changes, every interval, until s is
+// closed.
+func (s *FileSampler) watch(interval time.Duration) {
+	defer close(s.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if err := s.reloadIfChanged(); err != nil {
+				otel.Handle(err)
+			}
+		}
+	}
+}
+
+// reloadIfChanged reloads the file of s if its modification time or size
+// changed since it was last loaded.
+func (s *FileSampler) reloadIfChanged() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("sampler file %q: %w", s.path, err)
+	}
+
+	s.mu.Lock()
+	changed := !info.ModTime().Equal(s.modTime) || info.Size() != s.size
+	s.mu.Unlock()
+	if !changed {
+		return nil
+	}
+	return s.Reload()
+}
+
+// Reload loads the sampling rules of the file of s, regardless of whether it
+// changed. If the file cannot be read or its rules are invalid, an error is
+// returned and the previous rules are kept.
+//
+// This allows the rules to be reloaded on demand, e.g. on a signal, in
+// addition to the periodic checks.
+func (s *FileSampler) Reload() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return fmt.Errorf("sampler file %q: %w", s.path, err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("sampler file %q: %w", s.path, err)
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return fmt.Errorf("sampler file %q: %w", s.path, err)
+	}
+
+	// Record the file as loaded even if it is invalid, so it is not reported
+	// again until it changes.
+	s.modTime, s.size = info.ModTime(), info.Size()
+
+	var file fileSamplerFile
+	if err := s.decode(data, &file); err != nil {
+		return fmt.Errorf("invalid sampler file %q: %w", s.path, err)
+	}
+	policy, err := newFileSamplerPolicy(file, s.service)
+	if err != nil {
+		return fmt.Errorf("invalid sampler file %q: %w", s.path, err)
+	}
+	s.policy.Store(policy)
+	return nil
+}
+
+// ShouldSample samples the span with the rules matching its name.
+func (s *FileSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	return s.policy.Load().sampler(p.Name).ShouldSample(p)
+}
+
+// Description returns the description of s.
+func (s *FileSampler) Description() string {
+	return fmt.Sprintf("FileSampler{%s}", s.path)
+}
+
+// Close stops checking the file of s for changes. The last loaded rules keep
+// being used. It is safe to call Close multiple times.
+func (s *FileSampler) Close() error {
+	s.closeOnce.Do(func() { close(s.stop) })
+	<-s.done
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const testSamplerFile = `{
+  "default_ratio": 0,
+  "rules": [
+    {"service": "checkout", "span_name": "health", "ratio": 0},
+    {"service": "checkout", "ratio": 1},
+    {"service": "other", "span_name": "other", "ratio": 1},
+    {"span_name": "payment", "ratio": 1},
+    {"span_name": "health", "ratio": 1}
+  ]
+}`
+
+func writeSamplerFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func newTestFileSampler(t *testing.T, path string, opts ...FileSamplerOption) *FileSampler {
+	t.Helper()
+	s, err := NewFileSampler(path, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+	return s
+}
+
+func sampleName(s Sampler, name string) SamplingDecision {
+	return s.ShouldSample(SamplingParameters{
+		ParentContext: context.Background(),
+		TraceID:       trace.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		Name:          name,
+	}).Decision
+}
+
+func TestFileSamplerRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sampler.json")
+	writeSamplerFile(t, path, testSamplerFile)
+
+	checkout := newTestFileSampler(t, path, WithFileSamplerServiceName("checkout"))
+	assert.Equal(t, Drop, sampleName(checkout, "health"))
+	assert.Equal(t, RecordAndSample, sampleName(checkout, "payment"))
+	assert.Equal(t, RecordAndSample, sampleName(checkout, "any"))
+
+	cart := newTestFileSampler(t, path, WithFileSamplerServiceName("cart"))
+	assert.Equal(t, RecordAndSample, sampleName(cart, "health"))
+	assert.Equal(t, RecordAndSample, sampleName(cart, "payment"))
+	assert.Equal(t, Drop, sampleName(cart, "other"))
+	assert.Equal(t, Drop, sampleName(cart, "any"))
+
+	assert.Equal(t, "FileSampler{"+path+"}", cart.Description())
+}
+
+func TestFileSamplerDefaultRatio(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sampler.json")
+	writeSamplerFile(t, path, `{"rules": [{"span_name": "dropped", "ratio": 0}]}`)
+
+	s := newTestFileSampler(t, path)
+	assert.Equal(t, Drop, sampleName(s, "dropped"))
+	assert.Equal(t, RecordAndSample, sampleName(s, "any"), "spans not sampled by default")
+}
+
+func TestFileSamplerInvalid(t *testing.T) {
+	tests := []struct {
+		name, content, want string
+	}{
+		{"Syntax", `{`, "unexpected EOF"},
+		{"UnknownField", `{"default_rate": 0.5}`, "unknown field"},
+		{"DefaultRatio", `{"default_ratio": 2}`, "default_ratio: invalid ratio 2"},
+		{"MissingRatio", `{"rules": [{"span_name": "a"}]}`, "rule 0: missing ratio"},
+		{"Ratio", `{"rules": [{"ratio": -1}]}`, "rule 0: invalid ratio -1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "sampler.json")
+			writeSamplerFile(t, path, tt.content)
+			_, err := NewFileSampler(path)
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+
+	_, err := NewFileSampler(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestFileSamplerReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sampler.json")
+	writeSamplerFile(t, path, `{"default_ratio": 0}`)
+	s := newTestFileSampler(t, path)
+	require.Equal(t, Drop, sampleName(s, "span"))
+
+	writeSamplerFile(t, path, `{"default_ratio": 1}`)
+	require.NoError(t, s.Reload())
+	assert.Equal(t, RecordAndSample, sampleName(s, "span"))
+
+	// Invalid rules keep the previous ones.
+	writeSamplerFile(t, path, `{"default_ratio": -1}`)
+	assert.Error(t, s.Reload())
+	assert.Equal(t, RecordAndSample, sampleName(s, "span"))
+}
+
+type errorRecorder struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (r *errorRecorder) Handle(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errs = append(r.errs, err)
+}
+
+func (r *errorRecorder) len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.errs)
+}
+
+func TestFileSamplerWatch(t *testing.T) {
+	errs := &errorRecorder{}
+	orig := otel.GetErrorHandler()
+	otel.SetErrorHandler(errs)
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+
+	path := filepath.Join(t.TempDir(), "sampler.json")
+	writeSamplerFile(t, path, `{"default_ratio": 0}`)
+	s := newTestFileSampler(t, path, WithFileSamplerPollInterval(time.Millisecond))
+	require.Equal(t, Drop, sampleName(s, "span"))
+
+	writeSamplerFile(t, path, `{"default_ratio": 1}`)
+	// Ensure the change is detected on file systems with a coarse
+	// modification time.
+	require.NoError(t, os.Chtimes(path, time.Time{}, time.Now().Add(time.Hour)))
+	assert.Eventually(t, func() bool {
+		return sampleName(s, "span") == RecordAndSample
+	}, 5*time.Second, time.Millisecond)
+
+	writeSamplerFile(t, path, `{`)
+	require.NoError(t, os.Chtimes(path, time.Time{}, time.Now().Add(2*time.Hour)))
+	assert.Eventually(t, func() bool { return errs.len() > 0 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, RecordAndSample, sampleName(s, "span"), "invalid rules applied")
+
+	// The invalid file is reported once.
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, 1, errs.len())
+}
+
+func TestFileSamplerDecoder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sampler.txt")
+	writeSamplerFile(t, path, "0")
+	decode := func(data []byte, v any) error {
+		ratio := 0.0
+		if string(data) == "1" {
+			ratio = 1
+		}
+		v.(*fileSamplerFile).DefaultRatio = &ratio
+		return nil
+	}
+
+	s := newTestFileSampler(t, path, WithFileSamplerDecoder(decode))
+	assert.Equal(t, Drop, sampleName(s, "span"))
+	writeSamplerFile(t, path, "1")
+	require.NoError(t, s.Reload())
+	assert.Equal(t, RecordAndSample, sampleName(s, "span"))
+}
+
+func TestFileSamplerClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sampler.json")
+	writeSamplerFile(t, path, `{}`)
+	s, err := NewFileSampler(path)
+	require.NoError(t, err)
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+	assert.Equal(t, RecordAndSample, sampleName(s, "span"))
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RateLimited returns a Sampler that samples at most perSecond spans per
+// second. It uses a token bucket holding up to perSecond tokens, or one if
+// perSecond is less than one, refilled continuously at the rate of perSecond
+// tokens per second. Each sampled span consumes a token, and spans are
+// dropped while the bucket is empty.
+//
+// Unlike TraceIDRatioBased, the volume of sampled spans does not grow with
+// the traffic, which suits services whose traffic varies by orders of
+// magnitude. To cap the sampled root spans and respect the sampling decision
+// of the parent spans, the Sampler should be used as the root sampler of
+// ParentBased.
+//
+// If perSecond is less than or equal to zero, or NaN, no span is sampled.
+func RateLimited(perSecond float64) Sampler {
+	if math.IsNaN(perSecond) || perSecond < 0 {
+		perSecond = 0
+	}
+	s := &rateLimitedSampler{
+		rate:     perSecond,
+		capacity: max(perSecond, 1),
+		now:      time.Now,
+	}
+	s.tokens = s.capacity
+	s.last = s.now()
+	return s
+}
+
+type rateLimitedSampler struct {
+	rate, capacity float64
+	now            func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func (s *rateLimitedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	decision := Drop
+	if s.take() {
+		decision = RecordAndSample
+	}
+	return SamplingResult{
+		Decision:   decision,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+// take takes a token from the bucket and reports whether one was available.
+func (s *rateLimitedSampler) take() bool {
+	if s.rate == 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if elapsed := now.Sub(s.last); elapsed > 0 {
+		s.tokens = min(s.tokens+elapsed.Seconds()*s.rate, s.capacity)
+		s.last = now
+	}
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+func (s *rateLimitedSampler) Description() string {
+	return fmt.Sprintf("RateLimited{%g}", s.rate)
+}
@@ -0,0 +1,110 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRateLimited(t *testing.T) {
+	s := RateLimited(2).(*rateLimitedSampler)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+	s.last = now
+
+	sample := func() SamplingDecision {
+		return s.ShouldSample(SamplingParameters{ParentContext: t.Context()}).Decision
+	}
+	assert.Equal(t, RecordAndSample, sample())
+	assert.Equal(t, RecordAndSample, sample())
+	assert.Equal(t, Drop, sample(), "bucket not empty")
+
+	now = now.Add(250 * time.Millisecond)
+	assert.Equal(t, Drop, sample(), "half a token")
+	now = now.Add(250 * time.Millisecond)
+	assert.Equal(t, RecordAndSample, sample())
+	assert.Equal(t, Drop, sample())
+
+	// The bucket holds at most a second of tokens.
+	now = now.Add(time.Hour)
+	assert.Equal(t, RecordAndSample, sample())
+	assert.Equal(t, RecordAndSample, sample())
+	assert.Equal(t, Drop, sample())
+
+	assert.Equal(t, "RateLimited{2}", s.Description())
+}
+
+func TestRateLimitedFraction(t *testing.T) {
+	s := RateLimited(0.5).(*rateLimitedSampler)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+	s.last = now
+
+	sample := func() SamplingDecision {
+		return s.ShouldSample(SamplingParameters{ParentContext: t.Context()}).Decision
+	}
+	assert.Equal(t, RecordAndSample, sample())
+	now = now.Add(time.Second)
+	assert.Equal(t, Drop, sample())
+	now = now.Add(time.Second)
+	assert.Equal(t, RecordAndSample, sample())
+}
+
+func TestRateLimitedNone(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN()} {
+		s := RateLimited(rate)
+		assert.Equal(t, Drop, s.ShouldSample(SamplingParameters{ParentContext: t.Context()}).Decision, "rate %v", rate)
+	}
+}
+
+func TestRateLimitedParentBased(t *testing.T) {
+	s := ParentBased(RateLimited(1))
+	ts, err := trace.ParseTraceState("k=v")
+	assert.NoError(t, err)
+	parent := trace.ContextWithSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+	}))
+
+	assert.Equal(t, RecordAndSample, s.ShouldSample(SamplingParameters{ParentContext: t.Context()}).Decision)
+	assert.Equal(t, Drop, s.ShouldSample(SamplingParameters{ParentContext: t.Context()}).Decision)
+	// Children of sampled spans are not limited.
+	for range 3 {
+		res := s.ShouldSample(SamplingParameters{ParentContext: parent})
+		assert.Equal(t, RecordAndSample, res.Decision)
+		assert.Equal(t, ts, res.Tracestate)
+	}
+}
+
+func TestRateLimitedConcurrentSafe(t *testing.T) {
+	s := RateLimited(100)
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sampled int
+	)
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				if s.ShouldSample(SamplingParameters{ParentContext: t.Context()}).Decision == RecordAndSample {
+					mu.Lock()
+					sampled++
+					mu.Unlock()
+				}
+			}
+		})
+	}
+	wg.Wait()
+	assert.GreaterOrEqual(t, sampled, 100)
+	assert.Less(t, sampled, 1000)
+}
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SamplingPredicate reports whether the span being sampled with p matches a
+// SamplingRule.
+//
+// A predicate needs to be safe to call concurrently.
+type SamplingPredicate func(p SamplingParameters) bool
+
+// MatchSpanName returns a SamplingPredicate matching the spans with one of
+// names.
+func MatchSpanName(names ...string) SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		return slices.Contains(names, p.Name)
+	}
+}
+
+// MatchSpanKind returns a SamplingPredicate matching the spans with one of
+// kinds.
+func MatchSpanKind(kinds ...trace.SpanKind) SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		return slices.Contains(kinds, p.Kind)
+	}
+}
+
+// MatchAttribute returns a SamplingPredicate matching the spans started with
+// the attribute kv, with an equal value. Only the attributes passed when the
+// span is started are available to the predicate.
+func MatchAttribute(kv attribute.KeyValue) SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		for _, a := range p.Attributes {
+			if a.Key == kv.Key && a.Value == kv.Value {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MatchRootSpan returns a SamplingPredicate matching the spans without a
+// valid parent span.
+func MatchRootSpan() SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		return !trace.SpanContextFromContext(p.ParentContext).IsValid()
+	}
+}
+
+// MatchRemoteParent returns a SamplingPredicate matching the spans with a
+// valid remote parent span, e.g. the server spans of a distributed trace.
+func MatchRemoteParent() SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		psc := trace.SpanContextFromContext(p.ParentContext)
+		return psc.IsValid() && psc.IsRemote()
+	}
+}
+
+// MatchSampledParent returns a SamplingPredicate matching the spans with a
+// valid parent span that is sampled.
+func MatchSampledParent() SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		psc := trace.SpanContextFromContext(p.ParentContext)
+		return psc.IsValid() && psc.IsSampled()
+	}
+}
+
+// MatchAll returns a SamplingPredicate matching the spans matched by all
+// predicates. If no predicate is passed, all the spans are matched.
+func MatchAll(predicates ...SamplingPredicate) SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		for _, pred := range predicates {
+			if !pred(p) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MatchAny returns a SamplingPredicate matching the spans matched by any of
+// predicates. If no predicate is passed, no span is matched.
+func MatchAny(predicates ...SamplingPredicate) SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		for _, pred := range predicates {
+			if pred(p) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MatchNot returns a SamplingPredicate matching the spans not matched by
+// predicate.
+func MatchNot(predicate SamplingPredicate) SamplingPredicate {
+	return func(p SamplingParameters) bool {
+		return !predicate(p)
+	}
+}
+
+// SamplingRule delegates the sampling of the spans matched by its Predicate
+// to its Sampler.
+type SamplingRule struct {
+	// Predicate matches the spans the rule applies to. If nil, the rule
+	// applies to all the spans.
+	Predicate SamplingPredicate
+	// Sampler samples the spans the rule applies to. If nil, the rule is
+	// ignored.
+	Sampler Sampler
+}
+
+// RuleBased returns a Sampler delegating the sampling of each span to the
+// Sampler of the first of rules matching it, in order, or to fallback if no
+// rule matches. For example, to drop the health checks, always sample the
+// consumer spans, and sample the other spans like the default Sampler:
+//
+//	RuleBased(
+//		ParentBased(AlwaysSample()),
+//		SamplingRule{Predicate: MatchSpanName("GET /healthz"), Sampler: NeverSample()},
+//		SamplingRule{Predicate: MatchSpanKind(trace.SpanKindConsumer), Sampler: AlwaysSample()},
+//	)
+//
+// The rules are evaluated for every span started, including the spans with a
+// sampled parent. Use MatchRootSpan or ParentBased in the rules to respect
+// the sampling decision of the parent span where needed. If fallback is nil,
+// ParentBased(AlwaysSample()) is used.
+func RuleBased(fallback Sampler, rules ...SamplingRule) Sampler {
+	if fallback == nil {
+		fallback = ParentBased(AlwaysSample())
+	}
+	s := ruleBasedSampler{fallback: fallback}
+	for _, r := range rules {
+		if r.Sampler != nil {
+			s.rules = append(s.rules, r)
+		}
+	}
+	return s
+}
+
+type ruleBasedSampler struct {
+	rules    []SamplingRule
+	fallback Sampler
+}
+
+func (s ruleBasedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	for _, r := range s.rules {
+		if r.Predicate == nil || r.Predicate(p) {
+			return r.Sampler.ShouldSample(p)
+		}
+	}
+	return s.fallback.ShouldSample(p)
+}
+
+func (s ruleBasedSampler) Description() string {
+	var b strings.Builder
+	b.WriteString("RuleBased{rules:[")
+	for i, r := range s.rules {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(r.Sampler.Description())
+	}
+	fmt.Fprintf(&b, "],fallback:%s}", s.fallback.Description())
+	return b.String()
+}
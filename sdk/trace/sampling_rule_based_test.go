@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSamplingPredicates(t *testing.T) {
+	local := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+	remote := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	}))
+	params := SamplingParameters{
+		ParentContext: local,
+		Name:          "span",
+		Kind:          trace.SpanKindConsumer,
+		Attributes:    []attribute.KeyValue{attribute.String("url.path", "/healthz")},
+	}
+	root := SamplingParameters{ParentContext: context.Background()}
+	remoteChild := SamplingParameters{ParentContext: remote}
+
+	tests := []struct {
+		name string
+		pred SamplingPredicate
+		p    SamplingParameters
+		want bool
+	}{
+		{"SpanName", MatchSpanName("other", "span"), params, true},
+		{"SpanNameMismatch", MatchSpanName("other"), params, false},
+		{"SpanKind", MatchSpanKind(trace.SpanKindConsumer), params, true},
+		{"SpanKindMismatch", MatchSpanKind(trace.SpanKindServer), params, false},
+		{"Attribute", MatchAttribute(attribute.String("url.path", "/healthz")), params, true},
+		{"AttributeValueMismatch", MatchAttribute(attribute.String("url.path", "/")), params, false},
+		{"AttributeTypeMismatch", MatchAttribute(attribute.Int("url.path", 1)), params, false},
+		{"RootSpan", MatchRootSpan(), root, true},
+		{"RootSpanChild", MatchRootSpan(), params, false},
+		{"RemoteParent", MatchRemoteParent(), remoteChild, true},
+		{"RemoteParentLocal", MatchRemoteParent(), params, false},
+		{"RemoteParentRoot", MatchRemoteParent(), root, false},
+		{"SampledParent", MatchSampledParent(), params, true},
+		{"SampledParentNotSampled", MatchSampledParent(), remoteChild, false},
+		{"SampledParentRoot", MatchSampledParent(), root, false},
+		{"All", MatchAll(MatchSpanName("span"), MatchSampledParent()), params, true},
+		{"AllMismatch", MatchAll(MatchSpanName("span"), MatchRootSpan()), params, false},
+		{"AllEmpty", MatchAll(), params, true},
+		{"Any", MatchAny(MatchSpanName("other"), MatchSampledParent()), params, true},
+		{"AnyMismatch", MatchAny(MatchSpanName("other"), MatchRootSpan()), params, false},
+		{"AnyEmpty", MatchAny(), params, false},
+		{"Not", MatchNot(MatchRootSpan()), params, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.pred(tt.p))
+		})
+	}
+}
+
+func TestRuleBased(t *testing.T) {
+	s := RuleBased(
+		NeverSample(),
+		SamplingRule{Predicate: MatchSpanName("/healthz"), Sampler: NeverSample()},
+		SamplingRule{Predicate: MatchSpanKind(trace.SpanKindConsumer), Sampler: AlwaysSample()},
+		SamplingRule{Predicate: MatchSpanName("ignored"), Sampler: nil},
+		SamplingRule{Predicate: MatchSpanName("/healthz", "api"), Sampler: AlwaysSample()},
+	)
+
+	sample := func(name string, kind trace.SpanKind) SamplingDecision {
+		return s.ShouldSample(SamplingParameters{
+			ParentContext: context.Background(),
+			Name:          name,
+			Kind:          kind,
+		}).Decision
+	}
+	assert.Equal(t, Drop, sample("/healthz", trace.SpanKindConsumer), "first matching rule not applied")
+	assert.Equal(t, RecordAndSample, sample("process", trace.SpanKindConsumer))
+	assert.Equal(t, RecordAndSample, sample("api", trace.SpanKindServer))
+	assert.Equal(t, Drop, sample("ignored", trace.SpanKindServer), "fallback not applied")
+
+	assert.Equal(
+		t,
+		"RuleBased{rules:[AlwaysOffSampler,AlwaysOnSampler,AlwaysOnSampler],fallback:AlwaysOffSampler}",
+		s.Description(),
+	)
+}
+
+func TestRuleBasedNilPredicate(t *testing.T) {
+	s := RuleBased(AlwaysSample(), SamplingRule{Sampler: NeverSample()})
+	res := s.ShouldSample(SamplingParameters{ParentContext: context.Background(), Name: "span"})
+	assert.Equal(t, Drop, res.Decision)
+}
+
+func TestRuleBasedDefaultFallback(t *testing.T) {
+	s := RuleBased(nil)
+	assert.Equal(t, "RuleBased{rules:[],fallback:"+ParentBased(AlwaysSample()).Description()+"}", s.Description())
+
+	notSampled := trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	}))
+	res := s.ShouldSample(SamplingParameters{ParentContext: notSampled})
+	assert.Equal(t, Drop, res.Decision, "parent decision not respected")
+	res = s.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+	assert.Equal(t, RecordAndSample, res.Decision)
+}
+
+func TestRuleBasedTracerProvider(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithSampler(RuleBased(AlwaysSample(), SamplingRule{
+			Predicate: MatchAttribute(attribute.String("url.path", "/healthz")),
+			Sampler:   NeverSample(),
+		})),
+	)
+	tr := tp.Tracer("TestRuleBasedTracerProvider")
+
+	_, s := tr.Start(t.Context(), "health", trace.WithAttributes(attribute.String("url.path", "/healthz")))
+	s.End()
+	_, s = tr.Start(t.Context(), "api", trace.WithAttributes(attribute.String("url.path", "/api")))
+	s.End()
+
+	assert.Equal(t, 1, te.Len())
+	_, ok := te.GetSpan("api")
+	assert.True(t, ok)
+}
@@ -23,6 +23,10 @@ type simpleSpanProcessor struct {
 	stopOnce   sync.Once
 
 	inst *observ.SSP
+
+	// exportUnsampled is true if the processor is registered with
+	// WithUnsampledProcessor.
+	exportUnsampled atomic.Bool
 }
 
 var _ SpanProcessor = (*simpleSpanProcessor)(nil)
@@ -68,7 +72,7 @@ func (ssp *simpleSpanProcessor) OnEnd(s ReadOnlySpan) {
 	defer ssp.exporterMu.Unlock()
 
 	var err error
-	if ssp.exporter != nil && s.SpanContext().TraceFlags().IsSampled() {
+	if ssp.exporter != nil && (s.SpanContext().TraceFlags().IsSampled() || ssp.exportUnsampled.Load()) {
 		err = ssp.exporter.ExportSpans(context.Background(), []ReadOnlySpan{s})
 		if err != nil {
 			otel.Handle(err)
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/internal/attrsize"
+)
+
+const (
+	// spanOverhead is the estimated encoded size of the fixed-size fields of
+	// a span (IDs, timestamps, kind, flags, dropped counts) and the framing
+	// that surrounds it in an export payload.
+	spanOverhead = 96
+	// eventOverhead is the estimated encoded size of the fixed-size fields of
+	// an event.
+	eventOverhead = 16
+	// linkOverhead is the estimated encoded size of the fixed-size fields of
+	// a link.
+	linkOverhead = 40
+)
+
+// estimatedSpanSize returns an estimate of the number of bytes s will occupy
+// in an encoded export payload.
+//
+// The estimate approximates the OTLP protobuf encoding. It is not exact, but
+// it scales with the size of the span's variable-length content which is what
+// dominates payload sizes.
+func estimatedSpanSize(s ReadOnlySpan) int {
+	n := spanOverhead + len(s.Name()) + len(s.Status().Description)
+	n += len(s.SpanContext().TraceState().String())
+	n += attributesSize(s.Attributes())
+	for _, e := range s.Events() {
+		n += eventOverhead + len(e.Name) + attributesSize(e.Attributes)
+	}
+	for _, l := range s.Links() {
+		n += linkOverhead + len(l.SpanContext.TraceState().String()) + attributesSize(l.Attributes)
+	}
+	return n
+}
+
+func attributesSize(attrs []attribute.KeyValue) int {
+	var n int
+	for _, kv := range attrs {
+		n += attrsize.KeyValue(kv)
+	}
+	return n
+}
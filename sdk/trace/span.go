@@ -156,6 +156,19 @@ type recordingSpan struct {
 	// when ending the span to ensure any metrics are recorded with a context
 	// containing this span without requiring an additional allocation.
 	origCtx context.Context
+
+	// leakTimer reports this span as leaked if it is not ended in time. It is
+	// nil if leak detection is disabled.
+	leakTimer *time.Timer
+
+	// truncationExempt are the attribute keys exempt from the attribute
+	// value length limit for this span. It must not be modified.
+	truncationExempt map[attribute.Key]struct{}
+
+	// ending is true while the OnEnding methods of the span processors are
+	// called. The span can then only be modified through the endingSpan
+	// passed to them.
+	ending bool
 }
 
 var (
@@ -200,8 +213,9 @@ func (s *recordingSpan) isRecording() bool {
 
 // SetStatus sets the status of the Span in the form of a code and a
 // description, overriding previous values set. The description is only
-// included in the set status when the code is for an error. If this span is
-// not being recorded than this method does nothing.
+// included in the set status when the code is for an error, or when the code
+// is Ok and the TracerProvider is configured with WithOkStatusDescription. If
+// this span is not being recorded than this method does nothing.
 func (s *recordingSpan) SetStatus(code codes.Code, description string) {
 	if s == nil {
 		return
@@ -212,18 +226,29 @@ func (s *recordingSpan) SetStatus(code codes.Code, description string) {
 	if !s.isRecording() {
 		return
 	}
+	s.setStatus(code, description)
+}
+
+// setStatus sets the status of s. It must be called while holding s.mu.
+func (s *recordingSpan) setStatus(code codes.Code, description string) {
 	if s.status.Code > code {
 		return
 	}
 
 	status := Status{Code: code}
-	if code == codes.Error {
+	if code == codes.Error || (code == codes.Ok && s.okStatusDescription()) {
 		status.Description = description
 	}
 
 	s.status = status
 }
 
+// okStatusDescription reports whether the description of an Ok status is
+// recorded.
+func (s *recordingSpan) okStatusDescription() bool {
+	return s.tracer != nil && s.tracer.provider.okStatusDescription
+}
+
 // SetAttributes sets attributes of this span.
 //
 // If a key from attributes already exists the value associated with that key
@@ -244,8 +269,30 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 	if !s.isRecording() {
 		return
 	}
+	s.setAttributes(attributes)
+}
+
+// setAttributes sets attributes as attributes of s. It must be called while
+// holding s.mu.
+func (s *recordingSpan) setAttributes(attributes []attribute.KeyValue) {
+	if v := s.tracer.provider.attrValidation; v != nil {
+		var dropped int
+		attributes, dropped = v.apply(attributes)
+		s.droppedAttributes += dropped
+		if len(attributes) == 0 {
+			return
+		}
+	}
+	if ns := s.tracer.provider.attrNamespaces; ns != nil {
+		var rejected int
+		attributes, rejected = ns.enforce(s.name, attributes)
+		s.droppedAttributes += rejected
+		if len(attributes) == 0 {
+			return
+		}
+	}
 
-	limit := s.tracer.provider.spanLimits.AttributeCountLimit
+	limit := s.tracer.spanLimits.AttributeCountLimit
 	if limit == 0 {
 		// No attributes allowed.
 		s.addDroppedAttr(len(attributes))
@@ -269,7 +316,7 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 			continue
 		}
 		a = dedupAttr(a)
-		a = attrnorm.Truncate(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+		a = s.truncate(a)
 		s.attributes = append(s.attributes, a)
 	}
 }
@@ -330,7 +377,7 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 		if idx, ok := exists[a.Key]; ok {
 			// Perform all updates before dropping, even when at capacity.
 			a = dedupAttr(a)
-			a = attrnorm.Truncate(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+			a = s.truncate(a)
 			s.attributes[idx] = a
 			continue
 		}
@@ -341,13 +388,22 @@ func (s *recordingSpan) addOverCapAttrs(limit int, attrs []attribute.KeyValue) {
 			s.addDroppedAttr(1)
 		} else {
 			a = dedupAttr(a)
-			a = attrnorm.Truncate(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+			a = s.truncate(a)
 			s.attributes = append(s.attributes, a)
 			exists[a.Key] = len(s.attributes) - 1
 		}
 	}
 }
 
+// truncate returns a with its value truncated to the attribute value length
+// limit, unless its key is exempt.
+func (s *recordingSpan) truncate(a attribute.KeyValue) attribute.KeyValue {
+	if _, ok := s.truncationExempt[a.Key]; ok {
+		return a
+	}
+	return attrnorm.Truncate(s.tracer.spanLimits.AttributeValueLengthLimit, a)
+}
+
 func dedupAttr(attr attribute.KeyValue) attribute.KeyValue {
 	switch attr.Value.Type() {
 	case attribute.SLICE, attribute.MAP:
@@ -411,14 +467,21 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 		s.mu.Lock()
 	}
 
-	// Setting endTime to non-zero marks the span as ended and not recording.
-	if config.Timestamp().IsZero() {
-		s.endTime = et
-	} else {
-		s.endTime = config.Timestamp()
+	end := et
+	if ts := config.Timestamp(); !ts.IsZero() {
+		end = ts
+	}
+	if end.Before(s.startTime) {
+		end = s.handleNegativeDuration(end, et)
 	}
+	// Setting endTime to non-zero marks the span as ended and not recording.
+	s.endTime = end
 	s.mu.Unlock()
 
+	if s.leakTimer != nil {
+		s.leakTimer.Stop()
+	}
+
 	if s.tracer.inst.Enabled() {
 		ctx := s.origCtx
 		if ctx == nil {
@@ -431,15 +494,145 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 	}
 
 	sps := s.tracer.provider.getSpanProcessors()
-	if len(sps) == 0 {
+	validation := s.tracer.provider.spanValidation
+	if s.tracer.provider.isShutdown.Load() {
+		// Spans ending after the TracerProvider is shut down are not
+		// validated.
+		validation = nil
+	}
+	if len(sps) == 0 && validation == nil {
 		return
 	}
+	s.onEnding(sps)
 	snap := s.snapshot()
+	if validation != nil {
+		validation.validate(snap)
+	}
 	for _, sp := range sps {
 		sp.sp.OnEnd(snap)
 	}
 }
 
+// onEnding calls the OnEnding method of the span processors of sps
+// implementing OnEndingSpanProcessor, in order. The span is ended, so it can
+// only be modified by them until they all return.
+func (s *recordingSpan) onEnding(sps spanProcessorStates) {
+	var ending bool
+	for _, sp := range sps {
+		if sp.onEnding == nil {
+			continue
+		}
+		if !ending {
+			s.mu.Lock()
+			s.ending = true
+			s.mu.Unlock()
+			ending = true
+		}
+		sp.onEnding.OnEnding(endingSpan{s})
+	}
+	if ending {
+		s.mu.Lock()
+		s.ending = false
+		s.mu.Unlock()
+	}
+}
+
+// endingSpan is the ReadWriteSpan passed to the OnEnding method of span
+// processors. It modifies the ended span while the OnEnding methods are
+// called, and does nothing afterward.
+type endingSpan struct {
+	*recordingSpan
+}
+
+// IsRecording reports whether the span can be modified, i.e. whether the
+// OnEnding methods of the span processors are being called.
+func (s endingSpan) IsRecording() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ending
+}
+
+// SetStatus sets the status of the span.
+func (s endingSpan) SetStatus(code codes.Code, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ending {
+		s.setStatus(code, description)
+	}
+}
+
+// SetAttributes sets attributes of the span.
+func (s endingSpan) SetAttributes(attributes ...attribute.KeyValue) {
+	if len(attributes) == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ending {
+		s.setAttributes(attributes)
+	}
+}
+
+// RecordError records err as an event of the span.
+func (s endingSpan) RecordError(err error, opts ...trace.EventOption) {
+	if err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.ending {
+		return
+	}
+
+	opts = append(opts, trace.WithAttributes(
+		semconv.ExceptionType(typeStr(err)),
+		semconv.ExceptionMessage(err.Error()),
+	))
+
+	c := trace.NewEventConfig(opts...)
+	if c.StackTrace() {
+		opts = append(opts, trace.WithAttributes(
+			semconv.ExceptionStacktrace(recordStackTrace()),
+		))
+	}
+
+	s.addEvent(semconv.ExceptionEventName, opts...)
+}
+
+// AddEvent adds an event to the span.
+func (s endingSpan) AddEvent(name string, opts ...trace.EventOption) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ending {
+		s.addEvent(name, opts...)
+	}
+}
+
+// AddLink adds a link to the span.
+func (s endingSpan) AddLink(link trace.Link) {
+	if !link.SpanContext.IsValid() && len(link.Attributes) == 0 &&
+		link.SpanContext.TraceState().Len() == 0 {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ending {
+		s.addLink(link)
+	}
+}
+
+// SetName sets the name of the span.
+func (s endingSpan) SetName(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ending {
+		s.name = name
+	}
+}
+
+// End does nothing, the span is already ended.
+func (endingSpan) End(...trace.SpanEndOption) {}
+
 // monotonicEndTime returns the end time at present but offset from start,
 // monotonically.
 //
@@ -517,17 +710,21 @@ func (s *recordingSpan) AddEvent(name string, o ...trace.EventOption) {
 func (s *recordingSpan) addEvent(name string, o ...trace.EventOption) {
 	c := trace.NewEventConfig(o...)
 	attrs, _ := attrnorm.KeyValues(c.Attributes())
-	e := Event{Name: name, Attributes: attrs, Time: c.Timestamp()}
+	var dropped int
+	if v := s.tracer.provider.attrValidation; v != nil {
+		attrs, dropped = v.apply(attrs)
+	}
+	e := Event{Name: name, Attributes: attrs, Time: c.Timestamp(), DroppedAttributeCount: dropped}
 
 	// Discard attributes over limit.
-	limit := s.tracer.provider.spanLimits.AttributePerEventCountLimit
+	limit := s.tracer.spanLimits.AttributePerEventCountLimit
 	if limit == 0 {
 		// Drop all attributes.
-		e.DroppedAttributeCount = len(e.Attributes)
+		e.DroppedAttributeCount += len(e.Attributes)
 		e.Attributes = nil
 	} else if limit > 0 && len(e.Attributes) > limit {
 		// Drop over capacity.
-		e.DroppedAttributeCount = len(e.Attributes) - limit
+		e.DroppedAttributeCount += len(e.Attributes) - limit
 		e.Attributes = e.Attributes[:limit]
 	}
 
@@ -689,18 +886,26 @@ func (s *recordingSpan) AddLink(link trace.Link) {
 	if !s.isRecording() {
 		return
 	}
+	s.addLink(link)
+}
 
+// addLink adds link to s. It must be called while holding s.mu.
+func (s *recordingSpan) addLink(link trace.Link) {
 	attrs, _ := attrnorm.KeyValues(link.Attributes)
-	l := Link{SpanContext: link.SpanContext, Attributes: attrs}
+	var dropped int
+	if v := s.tracer.provider.attrValidation; v != nil {
+		attrs, dropped = v.apply(attrs)
+	}
+	l := Link{SpanContext: link.SpanContext, Attributes: attrs, DroppedAttributeCount: dropped}
 
 	// Discard attributes over limit.
-	limit := s.tracer.provider.spanLimits.AttributePerLinkCountLimit
+	limit := s.tracer.spanLimits.AttributePerLinkCountLimit
 	if limit == 0 {
 		// Drop all attributes.
-		l.DroppedAttributeCount = len(l.Attributes)
+		l.DroppedAttributeCount += len(l.Attributes)
 		l.Attributes = nil
 	} else if limit > 0 && len(l.Attributes) > limit {
-		l.DroppedAttributeCount = len(l.Attributes) - limit
+		l.DroppedAttributeCount += len(l.Attributes) - limit
 		l.Attributes = l.Attributes[:limit]
 	}
 
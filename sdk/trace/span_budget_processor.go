@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanBudgetExceededKey is the attribute key set to true on spans that
+// exceeded the budget of a processor returned by [NewSpanBudgetProcessor].
+const SpanBudgetExceededKey = attribute.Key("io.opentelemetry.go.sdk.span.budget_exceeded")
+
+// SpanBudgetOption configures a SpanProcessor returned by
+// [NewSpanBudgetProcessor].
+type SpanBudgetOption interface {
+	apply(spanBudgetConfig) spanBudgetConfig
+}
+
+type spanBudgetConfig struct {
+	markOnly bool
+}
+
+type spanBudgetOptionFunc func(spanBudgetConfig) spanBudgetConfig
+
+func (fn spanBudgetOptionFunc) apply(c spanBudgetConfig) spanBudgetConfig {
+	return fn(c)
+}
+
+// WithSpanBudgetMarkOnly configures the processor to pass spans exceeding the
+// budget to the next processor instead of dropping them. These spans are still
+// marked with the [SpanBudgetExceededKey] attribute.
+func WithSpanBudgetMarkOnly() SpanBudgetOption {
+	return spanBudgetOptionFunc(func(c spanBudgetConfig) spanBudgetConfig {
+		c.markOnly = true
+		return c
+	})
+}
+
+// traceBudget tracks the spans of a single trace started in this process.
+type traceBudget struct {
+	// started is the number of spans started for the trace.
+	started int
+	// active is the number of started spans that have not ended.
+	active int
+	// exceeded holds the active spans that exceeded the budget.
+	exceeded map[trace.SpanID]struct{}
+}
+
+// spanBudgetProcessor is a SpanProcessor that limits the number of spans of
+// each trace passed to the next SpanProcessor.
+type spanBudgetProcessor struct {
+	next   SpanProcessor
+	budget int
+	cfg    spanBudgetConfig
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*traceBudget
+}
+
+var _ SpanProcessor = (*spanBudgetProcessor)(nil)
+
+// NewSpanBudgetProcessor returns a SpanProcessor that passes at most budget
+// spans of each trace to next. This protects against instrumentation
+// pathologically creating a very large number of spans within a single trace,
+// for example in a loop.
+//
+// Spans of a trace started after the budget is reached are marked with the
+// [SpanBudgetExceededKey] attribute and are not passed to next. Use
+// [WithSpanBudgetMarkOnly] to pass them to next instead.
+//
+// Spans are counted from the time the first span of a trace is started in
+// this process until all started spans of that trace have ended. Only the
+// active spans of each trace are tracked, so memory use is bounded by the
+// number of active spans.
+//
+// If budget is less than one, no span is considered to exceed the budget.
+func NewSpanBudgetProcessor(next SpanProcessor, budget int, opts ...SpanBudgetOption) SpanProcessor {
+	var cfg spanBudgetConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &spanBudgetProcessor{
+		next:   next,
+		budget: budget,
+		cfg:    cfg,
+		traces: make(map[trace.TraceID]*traceBudget),
+	}
+}
+
+// OnStart counts s against the budget of its trace and passes it to the next
+// processor if the budget is not exceeded or the processor only marks spans.
+func (p *spanBudgetProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	if p.budget < 1 {
+		p.next.OnStart(parent, s)
+		return
+	}
+
+	sc := s.SpanContext()
+	p.mu.Lock()
+	tb, ok := p.traces[sc.TraceID()]
+	if !ok {
+		tb = &traceBudget{}
+		p.traces[sc.TraceID()] = tb
+	}
+	tb.started++
+	tb.active++
+	exceeded := tb.started > p.budget
+	first := tb.started == p.budget+1
+	if exceeded {
+		if tb.exceeded == nil {
+			tb.exceeded = make(map[trace.SpanID]struct{})
+		}
+		tb.exceeded[sc.SpanID()] = struct{}{}
+	}
+	p.mu.Unlock()
+
+	if exceeded {
+		s.SetAttributes(SpanBudgetExceededKey.Bool(true))
+		if first {
+			global.Warn("span budget exceeded for trace", "trace_id", sc.TraceID(), "budget", p.budget)
+		}
+		if !p.cfg.markOnly {
+			return
+		}
+	}
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd passes s to the next processor unless it exceeded the budget of its
+// trace and the processor drops such spans.
+func (p *spanBudgetProcessor) OnEnd(s ReadOnlySpan) {
+	if p.budget < 1 {
+		p.next.OnEnd(s)
+		return
+	}
+
+	sc := s.SpanContext()
+	var exceeded bool
+	p.mu.Lock()
+	if tb, ok := p.traces[sc.TraceID()]; ok {
+		if _, exceeded = tb.exceeded[sc.SpanID()]; exceeded {
+			delete(tb.exceeded, sc.SpanID())
+		}
+		tb.active--
+		if tb.active <= 0 {
+			delete(p.traces, sc.TraceID())
+		}
+	}
+	p.mu.Unlock()
+
+	if exceeded && !p.cfg.markOnly {
+		return
+	}
+	p.next.OnEnd(s)
+}
+
+// Shutdown shuts down the next processor and releases all tracked state.
+func (p *spanBudgetProcessor) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	clear(p.traces)
+	p.mu.Unlock()
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next processor.
+func (p *spanBudgetProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
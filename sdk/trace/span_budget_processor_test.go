@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func hasAttribute(attrs []attribute.KeyValue, kv attribute.KeyValue) bool {
+	for _, a := range attrs {
+		if a == kv {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSpanBudgetProcessorDrop(t *testing.T) {
+	next := NewTestSpanProcessor("next")
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewSpanBudgetProcessor(next, 3))
+	tr := tp.Tracer("SpanBudgetProcessor")
+
+	ctx, root := tr.Start(t.Context(), "root")
+	for range 5 {
+		_, child := tr.Start(ctx, "child")
+		child.End()
+	}
+	root.End()
+
+	assert.Len(t, next.spansStarted, 3, "started")
+	require.Len(t, next.spansEnded, 3, "ended")
+	for _, s := range next.spansEnded {
+		assert.False(t, hasAttribute(s.Attributes(), SpanBudgetExceededKey.Bool(true)), s.Name())
+	}
+
+	// All spans of the trace ended, a new trace gets a new budget.
+	_, span := tr.Start(t.Context(), "next")
+	span.End()
+	assert.Len(t, next.spansEnded, 4, "new trace")
+}
+
+func TestSpanBudgetProcessorMarkOnly(t *testing.T) {
+	next := NewTestSpanProcessor("next")
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewSpanBudgetProcessor(next, 2, WithSpanBudgetMarkOnly()))
+	tr := tp.Tracer("SpanBudgetProcessor")
+
+	ctx, root := tr.Start(t.Context(), "root")
+	for range 3 {
+		_, child := tr.Start(ctx, "child")
+		child.End()
+	}
+	root.End()
+
+	require.Len(t, next.spansEnded, 4, "ended")
+	var marked int
+	for _, s := range next.spansEnded {
+		if hasAttribute(s.Attributes(), attribute.Bool("io.opentelemetry.go.sdk.span.budget_exceeded", true)) {
+			marked++
+		}
+	}
+	assert.Equal(t, 2, marked, "marked spans")
+}
+
+func TestSpanBudgetProcessorReleasesState(t *testing.T) {
+	next := NewTestSpanProcessor("next")
+	p := NewSpanBudgetProcessor(next, 1).(*spanBudgetProcessor)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+	tr := tp.Tracer("SpanBudgetProcessor")
+
+	ctx, root := tr.Start(t.Context(), "root")
+	_, child := tr.Start(ctx, "child")
+	p.mu.Lock()
+	tb := p.traces[root.SpanContext().TraceID()]
+	require.NotNil(t, tb)
+	assert.Equal(t, 2, tb.active)
+	assert.Len(t, tb.exceeded, 1)
+	p.mu.Unlock()
+
+	child.End()
+	root.End()
+	p.mu.Lock()
+	assert.Empty(t, p.traces)
+	p.mu.Unlock()
+}
+
+func TestSpanBudgetProcessorDisabled(t *testing.T) {
+	next := NewTestSpanProcessor("next")
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewSpanBudgetProcessor(next, 0))
+	tr := tp.Tracer("SpanBudgetProcessor")
+
+	ctx, root := tr.Start(t.Context(), "root")
+	for range 3 {
+		_, child := tr.Start(ctx, "child", trace.WithSpanKind(trace.SpanKindInternal))
+		child.End()
+	}
+	root.End()
+	assert.Len(t, next.spansEnded, 4)
+}
+
+func TestSpanBudgetProcessorShutdown(t *testing.T) {
+	next := NewTestSpanProcessor("next")
+	p := NewSpanBudgetProcessor(next, 1)
+	require.NoError(t, p.ForceFlush(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, next.shutdownCount)
+}
@@ -5,6 +5,29 @@ package trace
 
 import "context"
 
+// PermanentExportError is an error returned by a SpanExporter when the export
+// failed and the export of any other spans is expected to fail the same way,
+// e.g. because the receiver rejects the credentials of the exporter. Retrying
+// the export is useless until the configuration of the exporter is fixed.
+//
+// Exporters may wrap it in the errors they return. See
+// WithPermanentErrorPause for how a BatchSpanProcessor handles it.
+type PermanentExportError struct {
+	// Err is the error of the failed export.
+	Err error
+}
+
+// Error returns the error message of the failed export.
+func (e *PermanentExportError) Error() string {
+	if e.Err == nil {
+		return "permanent export error"
+	}
+	return "permanent export error: " + e.Err.Error()
+}
+
+// Unwrap returns the error of the failed export.
+func (e *PermanentExportError) Unwrap() error { return e.Err }
+
 // SpanExporter handles the delivery of spans to external receivers. This is
 // the final component in the trace export pipeline.
 type SpanExporter interface {
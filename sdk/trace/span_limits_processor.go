@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/internal/attrnorm"
+)
+
+// spanLimitsProcessor is a SpanProcessor applying SpanLimits to the spans it
+// passes to the next SpanProcessor.
+type spanLimitsProcessor struct {
+	next   SpanProcessor
+	limits SpanLimits
+}
+
+var _ SpanProcessor = (*spanLimitsProcessor)(nil)
+
+// NewSpanLimitsProcessor returns a SpanProcessor that applies limits to the
+// ended spans it passes to next. This allows each pipeline to use its own
+// limits, e.g. a debug exporter keeping full attribute values while an
+// exporter sending to a backend truncates them.
+//
+// The limits are applied to a copy of the span when it ends, the span seen by
+// other processors is not modified. As spans are also bound by the limits of
+// the TracerProvider when they are recorded, limits less strict than the
+// ones of the TracerProvider have no effect. Use [WithRawSpanLimits] to set
+// the limits of the TracerProvider to the least strict limits of all its
+// pipelines.
+//
+// The limits are used as-is, like [WithRawSpanLimits] does. A negative limit
+// means no limit is applied, and a zero limit drops all of the related
+// resources.
+func NewSpanLimitsProcessor(next SpanProcessor, limits SpanLimits) SpanProcessor {
+	return &spanLimitsProcessor{next: next, limits: limits}
+}
+
+// OnStart passes s to the next processor.
+func (p *spanLimitsProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd passes s with the limits applied to the next processor.
+func (p *spanLimitsProcessor) OnEnd(s ReadOnlySpan) {
+	p.next.OnEnd(p.limit(s))
+}
+
+// Shutdown shuts down the next processor.
+func (p *spanLimitsProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next processor.
+func (p *spanLimitsProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// limit returns a copy of s with the limits of p applied.
+func (p *spanLimitsProcessor) limit(s ReadOnlySpan) ReadOnlySpan {
+	sd := snapshot{
+		name:                  s.Name(),
+		spanContext:           s.SpanContext(),
+		parent:                s.Parent(),
+		spanKind:              s.SpanKind(),
+		startTime:             s.StartTime(),
+		endTime:               s.EndTime(),
+		status:                s.Status(),
+		childSpanCount:        s.ChildSpanCount(),
+		droppedAttributeCount: s.DroppedAttributes(),
+		droppedEventCount:     s.DroppedEvents(),
+		droppedLinkCount:      s.DroppedLinks(),
+		resource:              s.Resource(),
+		instrumentationScope:  s.InstrumentationScope(),
+	}
+
+	var dropped int
+	sd.attributes, dropped = p.attributes(s.Attributes(), p.limits.AttributeCountLimit)
+	sd.droppedAttributeCount += dropped
+
+	events := s.Events()
+	// Like the recording span, keep the most recent events.
+	if n := p.limits.EventCountLimit; n >= 0 && len(events) > n {
+		sd.droppedEventCount += len(events) - n
+		events = events[len(events)-n:]
+	}
+	if len(events) > 0 {
+		sd.events = make([]Event, len(events))
+		for i, e := range events {
+			e.Attributes, dropped = p.attributes(e.Attributes, p.limits.AttributePerEventCountLimit)
+			e.DroppedAttributeCount += dropped
+			sd.events[i] = e
+		}
+	}
+
+	links := s.Links()
+	// Like the recording span, keep the most recent links.
+	if n := p.limits.LinkCountLimit; n >= 0 && len(links) > n {
+		sd.droppedLinkCount += len(links) - n
+		links = links[len(links)-n:]
+	}
+	if len(links) > 0 {
+		sd.links = make([]Link, len(links))
+		for i, l := range links {
+			l.Attributes, dropped = p.attributes(l.Attributes, p.limits.AttributePerLinkCountLimit)
+			l.DroppedAttributeCount += dropped
+			sd.links[i] = l
+		}
+	}
+	return sd
+}
+
+// attributes returns attrs limited to limit attributes, with their values
+// truncated, and the number of attributes dropped. The first attributes are
+// kept, like the recording span does. The attrs are not modified.
+func (p *spanLimitsProcessor) attributes(attrs []attribute.KeyValue, limit int) ([]attribute.KeyValue, int) {
+	var dropped int
+	if limit >= 0 && len(attrs) > limit {
+		dropped = len(attrs) - limit
+		attrs = attrs[:limit]
+	}
+	if len(attrs) == 0 || p.limits.AttributeValueLengthLimit < 0 {
+		return attrs, dropped
+	}
+
+	truncated := make([]attribute.KeyValue, len(attrs))
+	for i, a := range attrs {
+		truncated[i] = attrnorm.Truncate(p.limits.AttributeValueLengthLimit, a)
+	}
+	return truncated, dropped
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSpanLimitsProcessor(t *testing.T) {
+	unlimited := SpanLimits{
+		AttributeValueLengthLimit:   -1,
+		AttributeCountLimit:         -1,
+		EventCountLimit:             -1,
+		LinkCountLimit:              -1,
+		AttributePerEventCountLimit: -1,
+		AttributePerLinkCountLimit:  -1,
+	}
+	limits := SpanLimits{
+		AttributeValueLengthLimit:   3,
+		AttributeCountLimit:         1,
+		EventCountLimit:             1,
+		LinkCountLimit:              0,
+		AttributePerEventCountLimit: 0,
+		AttributePerLinkCountLimit:  -1,
+	}
+
+	raw := NewTestSpanProcessor("raw")
+	limited := NewTestSpanProcessor("limited")
+	tp := NewTracerProvider(
+		WithRawSpanLimits(unlimited),
+		WithSpanProcessor(raw),
+		WithSpanProcessor(NewSpanLimitsProcessor(limited, limits)),
+	)
+
+	link := trace.Link{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{1},
+		}),
+		Attributes: []attribute.KeyValue{attribute.String("link", "value")},
+	}
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span", trace.WithLinks(link))
+	span.SetAttributes(attribute.String("a", "abcdef"), attribute.StringSlice("b", []string{"abcdef"}))
+	span.AddEvent("first", trace.WithAttributes(attribute.String("event", "value")))
+	span.AddEvent("second", trace.WithAttributes(attribute.String("event", "value")))
+	span.End()
+
+	require.Len(t, raw.spansEnded, 1)
+	r := raw.spansEnded[0]
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("a", "abcdef"),
+		attribute.StringSlice("b", []string{"abcdef"}),
+	}, r.Attributes())
+	// The test processors each add an event when the span starts.
+	assert.Len(t, r.Events(), 4)
+	assert.Len(t, r.Links(), 1)
+	assert.Zero(t, r.DroppedAttributes())
+
+	require.Len(t, limited.spansEnded, 1)
+	l := limited.spansEnded[0]
+	assert.Equal(t, r.SpanContext(), l.SpanContext())
+	assert.Equal(t, "span", l.Name())
+	assert.Equal(t, []attribute.KeyValue{attribute.String("a", "abc")}, l.Attributes())
+	assert.Equal(t, 1, l.DroppedAttributes())
+	require.Len(t, l.Events(), 1)
+	assert.Equal(t, "second", l.Events()[0].Name)
+	assert.Empty(t, l.Events()[0].Attributes)
+	assert.Equal(t, 1, l.Events()[0].DroppedAttributeCount)
+	assert.Equal(t, 3, l.DroppedEvents())
+	assert.Empty(t, l.Links())
+	assert.Equal(t, 1, l.DroppedLinks())
+}
@@ -269,3 +269,41 @@ func TestSpanLimits(t *testing.T) {
 		}
 	})
 }
+
+func TestScopeSpanLimits(t *testing.T) {
+	httpLimits := NewSpanLimits()
+	httpLimits.AttributeCountLimit = 3
+	httpLimits.EventCountLimit = 0
+	dbLimits := NewSpanLimits()
+	dbLimits.AttributeCountLimit = 2
+	limits := NewSpanLimits()
+	limits.AttributeCountLimit = 1
+
+	rec := new(recorder)
+	tp := NewTracerProvider(
+		WithRawSpanLimits(limits),
+		WithScopeSpanLimits("http", dbLimits),
+		WithScopeSpanLimits("http", httpLimits),
+		WithScopeSpanLimits("db", dbLimits),
+		WithSpanProcessor(rec),
+	)
+	attrs := []attribute.KeyValue{attribute.Int("a", 1), attribute.Int("b", 2), attribute.Int("c", 3)}
+	for _, tr := range []trace.Tracer{
+		tp.Tracer("http", trace.WithInstrumentationVersion("v1")),
+		tp.Tracer("db"),
+		tp.Tracer("other"),
+	} {
+		_, s := tr.Start(t.Context(), "span", trace.WithAttributes(attrs...))
+		s.AddEvent("event")
+		s.End()
+	}
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	require.Len(t, *rec, 3)
+	assert.Len(t, (*rec)[0].Attributes(), 3, "http")
+	assert.Empty(t, (*rec)[0].Events(), "http")
+	assert.Len(t, (*rec)[1].Attributes(), 2, "db")
+	assert.Len(t, (*rec)[1].Events(), 1, "db")
+	assert.Len(t, (*rec)[2].Attributes(), 1, "other")
+	assert.Equal(t, 2, (*rec)[2].DroppedAttributes(), "other")
+}
@@ -12,6 +12,25 @@ import (
 // SpanProcessors registered with a TracerProvider and are called at the start
 // and end of a Span's lifecycle, and are called in the order they are
 // registered.
+//
+// A TracerProvider guarantees the following ordering of calls:
+//
+//   - OnStart is called for a span before the span is returned to the caller
+//     of Start, and OnEnd is called before End returns.
+//   - For each span, the SpanProcessors are called in the order returned by
+//     TracerProvider.SpanProcessors when the span was started or ended.
+//   - Shutdown is called at most once per registration, by
+//     TracerProvider.Shutdown or TracerProvider.UnregisterSpanProcessor.
+//   - Spans started or ended after TracerProvider.Shutdown returns do not call
+//     the SpanProcessor.
+//
+// The SpanProcessors used by a span are loaded when it is started and ended
+// without synchronizing with Shutdown. A span started or ended concurrently
+// with TracerProvider.Shutdown may therefore call OnStart or OnEnd during or
+// after the Shutdown of the SpanProcessor, and a span started before the
+// SpanProcessor is registered may call OnEnd without OnStart. Implementations
+// need to handle these calls. The LifecycleChecker of
+// [go.opentelemetry.io/otel/sdk/trace/tracetest] can be used to test them.
 type SpanProcessor interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
@@ -49,13 +68,39 @@ type SpanProcessor interface {
 	// must never be done outside of a new major release.
 }
 
+// OnEndingSpanProcessor is a SpanProcessor notified of the spans ending
+// while they can still be modified, e.g. to set attributes computed when a
+// span ends.
+//
+// Register it with a TracerProvider like any SpanProcessor.
+type OnEndingSpanProcessor interface {
+	SpanProcessor
+
+	// OnEnding is called synchronously when s is ended, before the OnEnd
+	// method of all the SpanProcessors is called. The end time of s is set,
+	// but s can still be modified, only through the passed s and only until
+	// OnEnding returns. It should not block.
+	//
+	// The OnEnding methods of the SpanProcessors are called in the order
+	// they are registered. The modifications done by one are visible to the
+	// next ones and to OnEnd.
+	OnEnding(s ReadWriteSpan)
+}
+
 type spanProcessorState struct {
-	sp    SpanProcessor
-	state sync.Once
+	sp SpanProcessor
+	// onEnding is sp if the SpanProcessor registered by the user implements
+	// OnEndingSpanProcessor, and nil otherwise.
+	onEnding OnEndingSpanProcessor
+	state    sync.Once
 }
 
 func newSpanProcessorState(sp SpanProcessor) *spanProcessorState {
-	return &spanProcessorState{sp: sp}
+	s := &spanProcessorState{sp: sp}
+	if _, ok := unwrapSpanProcessor(sp).(OnEndingSpanProcessor); ok {
+		s.onEnding, _ = sp.(OnEndingSpanProcessor)
+	}
+	return s
 }
 
 type spanProcessorStates []*spanProcessorState
@@ -6,8 +6,13 @@ package trace
 import (
 	"context"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -254,3 +259,86 @@ func NewNamedTestSpanProcessors(names []string) []*testSpanProcessor {
 	}
 	return tsp
 }
+
+// onEndingSpanProcessor is a testSpanProcessor calling onEnding with the
+// spans ending.
+type onEndingSpanProcessor struct {
+	testSpanProcessor
+
+	onEnding func(ReadWriteSpan)
+}
+
+func (p *onEndingSpanProcessor) OnEnding(s ReadWriteSpan) {
+	p.onEnding(s)
+}
+
+func TestOnEndingSpanProcessor(t *testing.T) {
+	var (
+		calls   []string
+		span    trace.Span
+		ending  ReadWriteSpan
+		endTime time.Time
+	)
+	first := &onEndingSpanProcessor{onEnding: func(s ReadWriteSpan) {
+		calls = append(calls, "first")
+		ending, endTime = s, s.EndTime()
+		assert.True(t, s.IsRecording(), "ending span not recording")
+
+		// The span can only be modified through s.
+		span.SetAttributes(attribute.Bool("span", true))
+		s.SetAttributes(attribute.Int("queue.latency", 10))
+		s.SetName("renamed")
+		s.SetStatus(codes.Error, "failed")
+		s.AddEvent("ending")
+		s.AddLink(trace.Link{Attributes: []attribute.KeyValue{attribute.Bool("link", true)}})
+		s.RecordError(assert.AnError)
+		s.End()
+	}}
+	second := &onEndingSpanProcessor{onEnding: func(s ReadWriteSpan) {
+		calls = append(calls, "second")
+		assert.Contains(t, s.Attributes(), attribute.Int("queue.latency", 10), "modifications not visible")
+	}}
+	ended := NewTestSpanProcessor("ended")
+	tp := NewTracerProvider(WithSpanProcessor(ended), WithSpanProcessor(first), WithSpanProcessor(second))
+
+	_, span = tp.Tracer("TestOnEndingSpanProcessor").Start(t.Context(), "span")
+	span.End()
+
+	assert.Equal(t, []string{"first", "second"}, calls, "OnEnding calls")
+	require.Len(t, ended.spansEnded, 1, "OnEnd calls")
+	got := ended.spansEnded[0]
+	assert.Equal(t, "renamed", got.Name())
+	assert.Equal(t, endTime, got.EndTime(), "end time changed by OnEnding")
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("queue.latency", 10)}, got.Attributes())
+	assert.Equal(t, Status{Code: codes.Error, Description: "failed"}, got.Status())
+	// The last events are added by OnEnding, the others by OnStart.
+	events := got.Events()
+	require.GreaterOrEqual(t, len(events), 2)
+	assert.Equal(t, "ending", events[len(events)-2].Name)
+	assert.Equal(t, "exception", events[len(events)-1].Name)
+	assert.Len(t, got.Links(), 1)
+	assert.False(t, span.IsRecording(), "span recording after End")
+
+	// The span passed to OnEnding cannot be modified afterward.
+	assert.False(t, ending.IsRecording(), "ending span recording after OnEnding")
+	ending.SetAttributes(attribute.Bool("late", true))
+	assert.Equal(t, []attribute.KeyValue{attribute.Int("queue.latency", 10)}, ending.Attributes())
+}
+
+func TestOnEndingSpanProcessorUnsampled(t *testing.T) {
+	var names []string
+	p := &onEndingSpanProcessor{onEnding: func(s ReadWriteSpan) {
+		names = append(names, s.Name())
+	}}
+	tp := NewTracerProvider(WithUnsampledProcessor(p))
+	tracer := tp.Tracer("TestOnEndingSpanProcessorUnsampled")
+
+	_, span := tracer.Start(t.Context(), "sampled")
+	span.End()
+
+	tp = NewTracerProvider(WithSampler(RecordingOnly()), WithUnsampledProcessor(p))
+	_, span = tp.Tracer("TestOnEndingSpanProcessorUnsampled").Start(t.Context(), "unsampled")
+	span.End()
+
+	assert.Equal(t, []string{"unsampled"}, names)
+}
@@ -0,0 +1,176 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultSpanStartQueueSize is the maximum number of span starts queued by
+// the SpanProcessor returned by NewSpanStartProcessor.
+const defaultSpanStartQueueSize = 2048
+
+// SpanStart describes a span that started.
+type SpanStart struct {
+	// SpanContext is the SpanContext of the span.
+	SpanContext trace.SpanContext
+	// Parent is the SpanContext of the parent of the span. It is invalid if
+	// the span is a root span.
+	Parent trace.SpanContext
+	// Name is the name of the span when it started.
+	Name string
+	// SpanKind is the kind of the span.
+	SpanKind trace.SpanKind
+	// StartTime is the time the span started.
+	StartTime time.Time
+}
+
+// SpanStartExporter exports the start of spans, e.g. to a live view of
+// traces or to detect spans that never end.
+type SpanStartExporter interface {
+	// ExportSpanStarts exports a batch of span starts.
+	//
+	// This function is called synchronously, so there is no concurrency
+	// safety requirement. The starts slice and its contents must not be
+	// retained after the call returns.
+	ExportSpanStarts(ctx context.Context, starts []SpanStart) error
+
+	// Shutdown notifies the exporter of a pending halt to operations. The
+	// exporter is expected to perform any cleanup or synchronization it
+	// requires while honoring all timeouts and cancellations contained in
+	// the passed context.
+	Shutdown(ctx context.Context) error
+}
+
+// spanStartProcessor is a SpanProcessor exporting the start of spans.
+type spanStartProcessor struct {
+	exporter SpanStartExporter
+
+	mu    sync.Mutex
+	queue []SpanStart
+
+	// exportMu serializes the calls to the exporter.
+	exportMu sync.Mutex
+
+	notify   chan struct{}
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+var _ SpanProcessor = (*spanStartProcessor)(nil)
+
+// NewSpanStartProcessor returns a SpanProcessor that exports the start of
+// each span to exporter, while SpanProcessors like the BatchSpanProcessor
+// only export the spans that ended. This enables live views of traces, and
+// the detection of spans that never end.
+//
+// Span starts are exported asynchronously in batches. If more than 2048 span
+// starts are waiting to be exported, the new ones are dropped. Only the
+// start of spans are exported, another SpanProcessor needs to be registered
+// to export the ended spans.
+func NewSpanStartProcessor(exporter SpanStartExporter) SpanProcessor {
+	p := &spanStartProcessor{
+		exporter: exporter,
+		notify:   make(chan struct{}, 1),
+		stopCh:   make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+// run exports the queued span starts until the processor is shut down.
+func (p *spanStartProcessor) run() {
+	defer close(p.done)
+	for {
+		select {
+		case <-p.notify:
+			if err := p.export(context.Background()); err != nil {
+				otel.Handle(err)
+			}
+		case <-p.stopCh:
+			return
+		}
+	}
+}
+
+// OnStart queues the start of s to be exported.
+func (p *spanStartProcessor) OnStart(_ context.Context, s ReadWriteSpan) {
+	start := SpanStart{
+		SpanContext: s.SpanContext(),
+		Parent:      s.Parent(),
+		Name:        s.Name(),
+		SpanKind:    s.SpanKind(),
+		StartTime:   s.StartTime(),
+	}
+
+	p.mu.Lock()
+	queued := len(p.queue) < defaultSpanStartQueueSize
+	if queued {
+		p.queue = append(p.queue, start)
+	}
+	p.mu.Unlock()
+
+	if queued {
+		select {
+		case p.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// OnEnd does nothing.
+func (*spanStartProcessor) OnEnd(ReadOnlySpan) {}
+
+// export exports the queued span starts.
+func (p *spanStartProcessor) export(ctx context.Context) error {
+	p.exportMu.Lock()
+	defer p.exportMu.Unlock()
+
+	p.mu.Lock()
+	starts := p.queue
+	p.queue = nil
+	p.mu.Unlock()
+
+	if len(starts) == 0 {
+		return nil
+	}
+	return p.exporter.ExportSpanStarts(ctx, starts)
+}
+
+// ForceFlush exports the queued span starts.
+func (p *spanStartProcessor) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return p.export(ctx)
+}
+
+// Shutdown exports the queued span starts and shuts down the exporter.
+func (p *spanStartProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+
+		err = p.export(ctx)
+
+		p.exportMu.Lock()
+		defer p.exportMu.Unlock()
+		err = errors.Join(err, p.exporter.Shutdown(ctx))
+	})
+	return err
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type spanStartExporter struct {
+	mu       sync.Mutex
+	starts   []SpanStart
+	shutdown bool
+}
+
+func (e *spanStartExporter) ExportSpanStarts(_ context.Context, starts []SpanStart) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.starts = append(e.starts, starts...)
+	return nil
+}
+
+func (e *spanStartExporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown = true
+	return nil
+}
+
+func (e *spanStartExporter) Starts() []SpanStart {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]SpanStart(nil), e.starts...)
+}
+
+func TestSpanStartProcessor(t *testing.T) {
+	exp := new(spanStartExporter)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewSpanStartProcessor(exp))
+	tracer := tp.Tracer("TestSpanStartProcessor")
+
+	start := time.Now().Add(-time.Minute)
+	ctx, parent := tracer.Start(t.Context(), "parent", trace.WithTimestamp(start))
+	_, child := tracer.Start(ctx, "child", trace.WithSpanKind(trace.SpanKindClient))
+
+	// Starts are exported before the spans end.
+	assert.Eventually(t, func() bool {
+		return len(exp.Starts()) == 2
+	}, 5*time.Second, time.Millisecond)
+
+	starts := exp.Starts()
+	assert.Equal(t, SpanStart{
+		SpanContext: parent.SpanContext(),
+		Name:        "parent",
+		SpanKind:    trace.SpanKindInternal,
+		StartTime:   start,
+	}, starts[0])
+	assert.Equal(t, child.SpanContext(), starts[1].SpanContext)
+	assert.Equal(t, parent.SpanContext(), starts[1].Parent)
+	assert.Equal(t, trace.SpanKindClient, starts[1].SpanKind)
+
+	child.End()
+	parent.End()
+	require.NoError(t, tp.Shutdown(t.Context()))
+	assert.Len(t, exp.Starts(), 2, "ended spans exported")
+	assert.True(t, exp.shutdown, "exporter not shut down")
+}
+
+func TestSpanStartProcessorNewRoot(t *testing.T) {
+	exp := new(spanStartExporter)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewSpanStartProcessor(exp))
+	tracer := tp.Tracer("TestSpanStartProcessorNewRoot")
+
+	ctx, parent := tracer.Start(t.Context(), "parent")
+	_, root := tracer.Start(ctx, "root", trace.WithNewRoot())
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	starts := exp.Starts()
+	require.Len(t, starts, 2)
+	assert.Equal(t, root.SpanContext(), starts[1].SpanContext)
+	assert.False(t, starts[1].Parent.IsValid(), "new root reported with a parent")
+	parent.End()
+	root.End()
+}
+
+func TestSpanStartProcessorShutdownFlushes(t *testing.T) {
+	exp := new(spanStartExporter)
+	ssp := NewSpanStartProcessor(exp)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(ssp)
+	_, span := tp.Tracer("TestSpanStartProcessorShutdownFlushes").Start(t.Context(), "span")
+
+	require.NoError(t, ssp.Shutdown(t.Context()))
+	assert.Len(t, exp.Starts(), 1)
+	span.End()
+}
+
+func TestSpanStartProcessorDrops(t *testing.T) {
+	p := &spanStartProcessor{notify: make(chan struct{}, 1)}
+	_, span := basicTracerProvider(t).Tracer("TestSpanStartProcessorDrops").Start(t.Context(), "span")
+	for range defaultSpanStartQueueSize + 1 {
+		p.OnStart(t.Context(), span.(ReadWriteSpan))
+	}
+	assert.Len(t, p.queue, defaultSpanStartQueueSize)
+}
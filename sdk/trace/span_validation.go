@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// ErrEmptySpanName is returned by RequireSpanName for spans with an empty
+	// name.
+	ErrEmptySpanName = errors.New("span name is empty")
+
+	// ErrRemoteSpanKind is returned by RequireRemoteSpanKind for spans
+	// describing a remote call that do not have a remote span kind.
+	ErrRemoteSpanKind = errors.New("span describing a remote call has an internal span kind")
+)
+
+// SpanValidationRule validates a span that ended. It returns an error
+// describing the violation if the span does not comply with the rule, and nil
+// otherwise.
+//
+// A SpanValidationRule needs to be safe to call concurrently.
+type SpanValidationRule func(ReadOnlySpan) error
+
+// RequireSpanName is a SpanValidationRule requiring spans to have a
+// non-empty name.
+func RequireSpanName(s ReadOnlySpan) error {
+	if s.Name() == "" {
+		return ErrEmptySpanName
+	}
+	return nil
+}
+
+// remoteAttrPrefixes are the prefixes of the semantic convention attribute
+// keys describing a remote call.
+var remoteAttrPrefixes = []string{"url.", "http.", "rpc."}
+
+// RequireRemoteSpanKind is a SpanValidationRule requiring spans with url,
+// http, or rpc semantic convention attributes, which describe a remote call,
+// to have a span kind other than internal, e.g. server or client.
+func RequireRemoteSpanKind(s ReadOnlySpan) error {
+	if s.SpanKind() != trace.SpanKindInternal && s.SpanKind() != trace.SpanKindUnspecified {
+		return nil
+	}
+	for _, kv := range s.Attributes() {
+		for _, prefix := range remoteAttrPrefixes {
+			if strings.HasPrefix(string(kv.Key), prefix) {
+				return fmt.Errorf("%w: %s attribute set", ErrRemoteSpanKind, kv.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// SpanValidationError is a violation of a SpanValidationRule by a span.
+type SpanValidationError struct {
+	// Span is the span violating the rule.
+	Span ReadOnlySpan
+	// Err is the error returned by the rule.
+	Err error
+}
+
+// Error returns the violation with the name and IDs of the span.
+func (e *SpanValidationError) Error() string {
+	sc := e.Span.SpanContext()
+	return fmt.Sprintf(
+		"invalid span %q (trace ID %s, span ID %s): %s",
+		e.Span.Name(), sc.TraceID(), sc.SpanID(), e.Err,
+	)
+}
+
+// Unwrap returns the error returned by the rule.
+func (e *SpanValidationError) Unwrap() error {
+	return e.Err
+}
+
+// spanValidation validates the spans of a TracerProvider when they end.
+type spanValidation struct {
+	rules  []SpanValidationRule
+	report func(*SpanValidationError)
+}
+
+// validate reports each rule s violates.
+func (v *spanValidation) validate(s ReadOnlySpan) {
+	for _, rule := range v.rules {
+		if err := rule(s); err != nil {
+			v.report(&SpanValidationError{Span: s, Err: err})
+		}
+	}
+}
+
+// WithSpanValidation returns a TracerProviderOption that validates the spans
+// started by the TracerProvider against rules when they end. This can be used
+// to enforce instrumentation standards, e.g. using RequireSpanName and
+// RequireRemoteSpanKind.
+//
+// Each violation is passed to report. If report is nil, violations are
+// reported to the global ErrorHandler. Spans are exported regardless of their
+// violations. The rules and report are called synchronously when spans end,
+// before the registered SpanProcessors are called. They are not called for
+// the spans ending after the TracerProvider is shut down.
+//
+// If no rules are passed, this option has no effect. Using this option
+// multiple times, only the last one is used.
+func WithSpanValidation(report func(*SpanValidationError), rules ...SpanValidationRule) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if len(rules) == 0 {
+			return cfg
+		}
+		if report == nil {
+			report = func(err *SpanValidationError) { otel.Handle(err) }
+		}
+		cfg.spanValidation = &spanValidation{rules: rules, report: report}
+		return cfg
+	})
+}
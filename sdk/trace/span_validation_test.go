@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRequireSpanName(t *testing.T) {
+	assert.NoError(t, RequireSpanName(&snapshot{name: "span"}))
+	assert.ErrorIs(t, RequireSpanName(&snapshot{}), ErrEmptySpanName)
+}
+
+func TestRequireRemoteSpanKind(t *testing.T) {
+	url := attribute.String("url.full", "https://example.com")
+	tests := []struct {
+		name    string
+		kind    trace.SpanKind
+		attrs   []attribute.KeyValue
+		wantErr bool
+	}{
+		{name: "Internal", kind: trace.SpanKindInternal},
+		{name: "InternalOtherAttribute", kind: trace.SpanKindInternal, attrs: []attribute.KeyValue{attribute.Int("key", 1)}},
+		{name: "InternalURL", kind: trace.SpanKindInternal, attrs: []attribute.KeyValue{url}, wantErr: true},
+		{name: "UnspecifiedRPC", attrs: []attribute.KeyValue{attribute.String("rpc.method", "Get")}, wantErr: true},
+		{name: "Client", kind: trace.SpanKindClient, attrs: []attribute.KeyValue{url}},
+		{name: "Server", kind: trace.SpanKindServer, attrs: []attribute.KeyValue{url}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := RequireRemoteSpanKind(&snapshot{spanKind: tt.kind, attributes: tt.attrs})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrRemoteSpanKind)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWithSpanValidation(t *testing.T) {
+	var got []*SpanValidationError
+	report := func(err *SpanValidationError) { got = append(got, err) }
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithSpanValidation(report, RequireSpanName, RequireRemoteSpanKind),
+	)
+	tracer := tp.Tracer("TestWithSpanValidation")
+
+	_, valid := tracer.Start(t.Context(), "valid", trace.WithSpanKind(trace.SpanKindClient))
+	valid.SetAttributes(attribute.String("url.full", "https://example.com"))
+	valid.End()
+	assert.Empty(t, got)
+
+	_, invalid := tracer.Start(t.Context(), "")
+	invalid.SetAttributes(attribute.String("http.request.method", "GET"))
+	invalid.End()
+	require.Len(t, got, 2)
+	assert.ErrorIs(t, got[0], ErrEmptySpanName)
+	assert.ErrorIs(t, got[1], ErrRemoteSpanKind)
+	assert.Equal(t, invalid.SpanContext(), got[0].Span.SpanContext())
+	assert.Contains(t, got[0].Error(), invalid.SpanContext().SpanID().String())
+
+	assert.Equal(t, 2, te.Len(), "invalid spans not exported")
+}
+
+func TestWithSpanValidationErrorHandler(t *testing.T) {
+	var handled []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { handled = append(handled, err) }))
+
+	// Spans are validated without any SpanProcessor registered.
+	tp := NewTracerProvider(WithSpanValidation(nil, RequireSpanName))
+	_, span := tp.Tracer("TestWithSpanValidationErrorHandler").Start(t.Context(), "")
+	span.End()
+
+	require.Len(t, handled, 1)
+	var vErr *SpanValidationError
+	require.ErrorAs(t, handled[0], &vErr)
+	assert.ErrorIs(t, vErr, ErrEmptySpanName)
+}
+
+func TestWithSpanValidationNoRules(t *testing.T) {
+	tp := NewTracerProvider(WithSpanValidation(nil))
+	assert.Nil(t, tp.spanValidation)
+}
+
+func TestWithSpanValidationAfterShutdown(t *testing.T) {
+	var got []*SpanValidationError
+	report := func(err *SpanValidationError) { got = append(got, err) }
+	tp := NewTracerProvider(WithSpanValidation(report, RequireSpanName))
+	_, span := tp.Tracer("TestWithSpanValidationAfterShutdown").Start(t.Context(), "")
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+	span.End()
+	assert.Empty(t, got, "span validated after shutdown")
+}
@@ -0,0 +1,30 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import "go.opentelemetry.io/otel/attribute"
+
+// StatusDetailPrefix is the prefix of the keys of attributes recording
+// structured details about the status of a Span, e.g. the reason of a
+// success or the code returned by a dependency. The part of the key after the
+// prefix names the detail.
+//
+// The prefix is not defined by the OpenTelemetry semantic conventions, and
+// backends give no special meaning to these attributes.
+const StatusDetailPrefix = "status_detail."
+
+// StatusDetail returns an attribute recording the status detail name with
+// value. Its key is name prefixed with StatusDetailPrefix.
+//
+// Status details complement the code and description passed to SetStatus,
+// which cannot be extended. They are recorded like any other attribute:
+//
+//	span.SetStatus(codes.Ok, "")
+//	span.SetAttributes(
+//		trace.StatusDetail("reason", attribute.StringValue("cache_hit")),
+//		trace.StatusDetail("retries", attribute.IntValue(2)),
+//	)
+func StatusDetail(name string, value attribute.Value) attribute.KeyValue {
+	return attribute.KeyValue{Key: attribute.Key(StatusDetailPrefix + name), Value: value}
+}
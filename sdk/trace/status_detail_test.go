@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestStatusDetail(t *testing.T) {
+	kv := StatusDetail("reason", attribute.StringValue("cache_hit"))
+	assert.Equal(t, attribute.String("status_detail.reason", "cache_hit"), kv)
+	assert.True(t, kv.Valid())
+}
@@ -0,0 +1,413 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultTailSamplingDecisionWait is the default maximum duration the spans
+// of a trace are buffered for before the sampling decision is made.
+const defaultTailSamplingDecisionWait = 30 * time.Second
+
+// defaultTailSamplingMaxTraceLifetime is the default maximum duration a trace
+// without ended spans is tracked for.
+const defaultTailSamplingMaxTraceLifetime = 5 * time.Minute
+
+// defaultTailSamplingMaxTraces is the default maximum number of traces
+// tracked at once.
+const defaultTailSamplingMaxTraces = 50000
+
+// TailSamplingPolicy decides whether the spans of the trace with traceID
+// buffered by a processor returned by [NewTailSamplingProcessor] are kept.
+//
+// A policy needs to be safe to call concurrently and must not retain spans.
+type TailSamplingPolicy func(traceID trace.TraceID, spans []ReadOnlySpan) bool
+
+// KeepErrorTraces returns a TailSamplingPolicy keeping the traces with a span
+// with an Error status.
+func KeepErrorTraces() TailSamplingPolicy {
+	return func(_ trace.TraceID, spans []ReadOnlySpan) bool {
+		for _, s := range spans {
+			if s.Status().Code == codes.Error {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// KeepSlowTraces returns a TailSamplingPolicy keeping the traces lasting at
+// least threshold, from the start of their first span to the end of their
+// last span.
+func KeepSlowTraces(threshold time.Duration) TailSamplingPolicy {
+	return func(_ trace.TraceID, spans []ReadOnlySpan) bool {
+		if len(spans) == 0 {
+			return false
+		}
+		start, end := spans[0].StartTime(), spans[0].EndTime()
+		for _, s := range spans[1:] {
+			if s.StartTime().Before(start) {
+				start = s.StartTime()
+			}
+			if s.EndTime().After(end) {
+				end = s.EndTime()
+			}
+		}
+		return end.Sub(start) >= threshold
+	}
+}
+
+// KeepTraceIDRatio returns a TailSamplingPolicy keeping a given fraction of
+// the traces, based on their trace ID like [TraceIDRatioBased]. It can be
+// used as a probabilistic fallback of other policies.
+func KeepTraceIDRatio(fraction float64) TailSamplingPolicy {
+	sampler := TraceIDRatioBased(fraction)
+	return func(traceID trace.TraceID, _ []ReadOnlySpan) bool {
+		return sampler.ShouldSample(SamplingParameters{TraceID: traceID}).Decision == RecordAndSample
+	}
+}
+
+// TailSamplingOption configures a SpanProcessor returned by
+// [NewTailSamplingProcessor].
+type TailSamplingOption interface {
+	apply(tailSamplingConfig) tailSamplingConfig
+}
+
+type tailSamplingConfig struct {
+	policies         []TailSamplingPolicy
+	decisionWait     time.Duration
+	maxTraceLifetime time.Duration
+	maxTraces        int
+}
+
+type tailSamplingOptionFunc func(tailSamplingConfig) tailSamplingConfig
+
+func (fn tailSamplingOptionFunc) apply(c tailSamplingConfig) tailSamplingConfig {
+	return fn(c)
+}
+
+// WithTailSamplingPolicies adds policies to the processor. A trace is kept
+// if any of the policies keeps it. If no policy is added, all the traces are
+// kept.
+func WithTailSamplingPolicies(policies ...TailSamplingPolicy) TailSamplingOption {
+	return tailSamplingOptionFunc(func(c tailSamplingConfig) tailSamplingConfig {
+		c.policies = append(c.policies, policies...)
+		return c
+	})
+}
+
+// WithTailSamplingDecisionWait sets the maximum duration the spans of a trace
+// are buffered for, from the start of its first span, before its sampling
+// decision is made. The default is 30 seconds. If wait is less than or equal
+// to zero, the default is used.
+func WithTailSamplingDecisionWait(wait time.Duration) TailSamplingOption {
+	return tailSamplingOptionFunc(func(c tailSamplingConfig) tailSamplingConfig {
+		if wait > 0 {
+			c.decisionWait = wait
+		}
+		return c
+	})
+}
+
+// WithTailSamplingMaxTraceLifetime sets the maximum duration a trace is
+// tracked for, from the start of its first span, while none of its spans
+// ended. Its decision is otherwise postponed until one of its spans ends,
+// which never happens for a span that is not ended, e.g. because of a bug in
+// the instrumentation. Once lifetime elapsed, the trace is forgotten and the
+// spans ending afterward are decided on their own. The default is 5 minutes.
+// If lifetime is less than or equal to zero, the default is used.
+func WithTailSamplingMaxTraceLifetime(lifetime time.Duration) TailSamplingOption {
+	return tailSamplingOptionFunc(func(c tailSamplingConfig) tailSamplingConfig {
+		if lifetime > 0 {
+			c.maxTraceLifetime = lifetime
+		}
+		return c
+	})
+}
+
+// WithTailSamplingMaxTraces sets the maximum number of traces tracked at
+// once. Once it is reached, the oldest trace is decided with the spans it
+// buffered and forgotten to track a new one. The spans of the forgotten trace
+// ending afterward are decided on their own. The default is 50000. If n is
+// less than one, the default is used.
+func WithTailSamplingMaxTraces(n int) TailSamplingOption {
+	return tailSamplingOptionFunc(func(c tailSamplingConfig) tailSamplingConfig {
+		if n > 0 {
+			c.maxTraces = n
+		}
+		return c
+	})
+}
+
+// tailTrace holds the state of a trace of a tailSamplingProcessor.
+type tailTrace struct {
+	id trace.TraceID
+	// elem holds the trace in the order the traces started to be tracked.
+	elem *list.Element
+	// start is when the trace started to be tracked.
+	start time.Time
+	// localRoot is whether the root span of the trace was started in this
+	// process.
+	localRoot bool
+	// active is the number of started spans that have not ended.
+	active int
+	// spans are the ended spans buffered until the decision.
+	spans []ReadOnlySpan
+	// decided is whether the sampling decision is made, and keep the
+	// decision.
+	decided, keep bool
+	// timer makes the decision once the decision wait elapsed, and then
+	// forgets the decision.
+	timer *time.Timer
+}
+
+// tailSamplingProcessor is a SpanProcessor that buffers the spans of each
+// trace until a sampling decision is made for the whole trace.
+type tailSamplingProcessor struct {
+	next SpanProcessor
+	cfg  tailSamplingConfig
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*tailTrace
+	// order holds the tracked traces in the order they started to be tracked,
+	// oldest first.
+	order    *list.List
+	shutdown bool
+}
+
+var _ SpanProcessor = (*tailSamplingProcessor)(nil)
+
+// NewTailSamplingProcessor returns a SpanProcessor that buffers the ended
+// spans of each trace and passes them to next only if the trace is kept by
+// one of the policies added with [WithTailSamplingPolicies]. This allows to
+// keep all the traces with errors or high latency, which head sampling
+// cannot identify when the traces start, while sampling the others.
+//
+// The sampling decision of a trace is made once the decision wait set with
+// [WithTailSamplingDecisionWait] elapsed since its first span started. If the
+// root span of the trace was started in this process, the decision is made as
+// soon as all the spans started in this process have ended. The spans of a
+// trace ending after its decision follow it for another decision wait. A
+// trace whose spans never end is forgotten after the lifetime set with
+// [WithTailSamplingMaxTraceLifetime]. Only the spans of this process are
+// considered: the decisions of the processes taking part in a distributed
+// trace may differ.
+//
+// The spans are buffered in memory until the decision is made. The memory use
+// is bounded by the number of spans ended within a decision wait, and by the
+// maximum number of traces tracked at once set with
+// [WithTailSamplingMaxTraces].
+//
+// All the started spans are passed to the OnStart method of next, as the
+// decision is only made once they end. Next is typically a
+// BatchSpanProcessor exporting the kept spans.
+func NewTailSamplingProcessor(next SpanProcessor, opts ...TailSamplingOption) SpanProcessor {
+	cfg := tailSamplingConfig{
+		decisionWait:     defaultTailSamplingDecisionWait,
+		maxTraceLifetime: defaultTailSamplingMaxTraceLifetime,
+		maxTraces:        defaultTailSamplingMaxTraces,
+	}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &tailSamplingProcessor{
+		next:   next,
+		cfg:    cfg,
+		traces: make(map[trace.TraceID]*tailTrace),
+		order:  list.New(),
+	}
+}
+
+// OnStart tracks s as an active span of its trace and passes it to the next
+// processor.
+func (p *tailSamplingProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	var kept []ReadOnlySpan
+	p.mu.Lock()
+	if !p.shutdown {
+		var t *tailTrace
+		t, kept = p.trace(s.SpanContext().TraceID())
+		if !t.decided {
+			t.active++
+			if !s.Parent().IsValid() {
+				t.localRoot = true
+			}
+		}
+	}
+	p.mu.Unlock()
+
+	p.forward(kept)
+	p.next.OnStart(parent, s)
+}
+
+// OnEnd buffers s until the sampling decision of its trace is made, and
+// passes it to the next processor if the trace is kept.
+func (p *tailSamplingProcessor) OnEnd(s ReadOnlySpan) {
+	id := s.SpanContext().TraceID()
+
+	p.mu.Lock()
+	if p.shutdown {
+		p.mu.Unlock()
+		return
+	}
+	t, kept := p.trace(id)
+	switch {
+	case t.decided:
+		if t.keep {
+			kept = append(kept, s)
+		}
+	default:
+		t.spans = append(t.spans, s)
+		t.active--
+		// Without a local root span, more spans of the trace may start in
+		// this process. Wait for the decision wait to elapse instead.
+		if t.active <= 0 && t.localRoot {
+			kept = append(kept, p.decide(id, t)...)
+		}
+	}
+	p.mu.Unlock()
+
+	p.forward(kept)
+}
+
+// trace returns the state of the trace with id, created if needed. If the
+// oldest trace is evicted to create it, the spans of the evicted trace that
+// are kept are also returned. It needs to be called with p.mu held.
+func (p *tailSamplingProcessor) trace(id trace.TraceID) (*tailTrace, []ReadOnlySpan) {
+	if t, ok := p.traces[id]; ok {
+		return t, nil
+	}
+
+	var kept []ReadOnlySpan
+	if p.order.Len() >= p.cfg.maxTraces {
+		kept = p.evict(p.order.Front().Value.(*tailTrace))
+	}
+	t := &tailTrace{id: id, start: time.Now()}
+	t.elem = p.order.PushBack(t)
+	t.timer = time.AfterFunc(p.cfg.decisionWait, func() { p.expire(id, t) })
+	p.traces[id] = t
+	return t, kept
+}
+
+// evict makes the sampling decision of t if it is not made yet and forgets
+// it. The buffered spans of t are returned if it is kept. It needs to be
+// called with p.mu held.
+func (p *tailSamplingProcessor) evict(t *tailTrace) []ReadOnlySpan {
+	var kept []ReadOnlySpan
+	if !t.decided && len(t.spans) > 0 {
+		kept = p.decide(t.id, t)
+	}
+	p.forget(t)
+	return kept
+}
+
+// forget stops tracking t. It needs to be called with p.mu held.
+func (p *tailSamplingProcessor) forget(t *tailTrace) {
+	t.timer.Stop()
+	p.order.Remove(t.elem)
+	delete(p.traces, t.id)
+}
+
+// decide makes the sampling decision of the trace with id and returns its
+// buffered spans if it is kept. The decision is forgotten after another
+// decision wait. It needs to be called with p.mu held.
+func (p *tailSamplingProcessor) decide(id trace.TraceID, t *tailTrace) []ReadOnlySpan {
+	spans := t.spans
+	t.spans, t.decided = nil, true
+	t.keep = len(p.cfg.policies) == 0
+	for _, policy := range p.cfg.policies {
+		if policy(id, spans) {
+			t.keep = true
+			break
+		}
+	}
+	t.timer.Reset(p.cfg.decisionWait)
+	if !t.keep {
+		return nil
+	}
+	return spans
+}
+
+// expire makes the sampling decision of the trace with id once its decision
+// wait elapsed, or forgets its decision.
+func (p *tailSamplingProcessor) expire(id trace.TraceID, t *tailTrace) {
+	var kept []ReadOnlySpan
+	p.mu.Lock()
+	switch {
+	case p.traces[id] != t:
+		// Shut down.
+	case t.decided:
+		p.forget(t)
+	case len(t.spans) == 0:
+		// No span ended yet, wait for them to base the decision on until the
+		// trace outlived its maximum lifetime.
+		remaining := p.cfg.maxTraceLifetime - time.Since(t.start)
+		if remaining <= 0 {
+			p.forget(t)
+			break
+		}
+		t.timer.Reset(min(p.cfg.decisionWait, remaining))
+	default:
+		kept = p.decide(id, t)
+	}
+	p.mu.Unlock()
+
+	p.forward(kept)
+}
+
+// decideAll makes the sampling decision of all the buffered traces and
+// returns the spans of the kept ones. It needs to be called with p.mu held.
+func (p *tailSamplingProcessor) decideAll() []ReadOnlySpan {
+	var kept []ReadOnlySpan
+	for id, t := range p.traces {
+		if !t.decided && len(t.spans) > 0 {
+			kept = append(kept, p.decide(id, t)...)
+		}
+	}
+	return kept
+}
+
+func (p *tailSamplingProcessor) forward(spans []ReadOnlySpan) {
+	for _, s := range spans {
+		p.next.OnEnd(s)
+	}
+}
+
+// Shutdown makes the sampling decision of all the buffered traces, passes
+// the kept spans to the next processor, and shuts it down.
+func (p *tailSamplingProcessor) Shutdown(ctx context.Context) error {
+	p.mu.Lock()
+	if p.shutdown {
+		p.mu.Unlock()
+		return nil
+	}
+	p.shutdown = true
+	kept := p.decideAll()
+	for _, t := range p.traces {
+		t.timer.Stop()
+	}
+	clear(p.traces)
+	p.order.Init()
+	p.mu.Unlock()
+
+	p.forward(kept)
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush makes the sampling decision of all the buffered traces, passes
+// the kept spans to the next processor, and flushes it.
+func (p *tailSamplingProcessor) ForceFlush(ctx context.Context) error {
+	p.mu.Lock()
+	kept := p.decideAll()
+	p.mu.Unlock()
+
+	p.forward(kept)
+	return p.next.ForceFlush(ctx)
+}
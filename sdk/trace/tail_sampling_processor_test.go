@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// endedRecorder is a SpanProcessor recording the names of the ended spans.
+// It is safe to use concurrently.
+type endedRecorder struct {
+	mu       sync.Mutex
+	started  int
+	ended    []string
+	flushed  int
+	shutdown int
+}
+
+func (r *endedRecorder) OnStart(context.Context, ReadWriteSpan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started++
+}
+
+func (r *endedRecorder) OnEnd(s ReadOnlySpan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ended = append(r.ended, s.Name())
+}
+
+func (r *endedRecorder) ForceFlush(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.flushed++
+	return nil
+}
+
+func (r *endedRecorder) Shutdown(context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.shutdown++
+	return nil
+}
+
+func (r *endedRecorder) Ended() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.ended...)
+}
+
+func TestTailSamplingProcessorPolicies(t *testing.T) {
+	next := new(endedRecorder)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewTailSamplingProcessor(next, WithTailSamplingPolicies(
+		KeepErrorTraces(),
+		KeepSlowTraces(time.Minute),
+	)))
+	tr := tp.Tracer("TailSamplingProcessor")
+
+	// A trace is decided once all its spans ended.
+	ctx, root := tr.Start(t.Context(), "error-root")
+	_, child := tr.Start(ctx, "error-child")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+	assert.Empty(t, next.Ended(), "span passed before decision")
+	root.End()
+	assert.Equal(t, []string{"error-child", "error-root"}, next.Ended())
+
+	start := time.Now()
+	ctx, root = tr.Start(t.Context(), "slow-root", trace.WithTimestamp(start))
+	_, child = tr.Start(ctx, "slow-child", trace.WithTimestamp(start))
+	child.End(trace.WithTimestamp(start.Add(time.Second)))
+	root.End(trace.WithTimestamp(start.Add(time.Minute)))
+
+	ctx, root = tr.Start(t.Context(), "fast-root")
+	_, child = tr.Start(ctx, "fast-child")
+	child.End()
+	root.End()
+
+	assert.Equal(t, []string{"error-child", "error-root", "slow-child", "slow-root"}, next.Ended())
+	assert.Equal(t, 6, next.started, "started spans not passed")
+}
+
+func TestTailSamplingProcessorNoPolicy(t *testing.T) {
+	next := new(endedRecorder)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewTailSamplingProcessor(next))
+	_, span := tp.Tracer("TailSamplingProcessor").Start(t.Context(), "span")
+	span.End()
+	assert.Equal(t, []string{"span"}, next.Ended())
+}
+
+func TestKeepTraceIDRatio(t *testing.T) {
+	assert.True(t, KeepTraceIDRatio(1)(trace.TraceID{0xff}, nil))
+	assert.False(t, KeepTraceIDRatio(0)(trace.TraceID{}, nil))
+	low := trace.TraceID{8: 0x01}
+	high := trace.TraceID{8: 0xff}
+	assert.True(t, KeepTraceIDRatio(0.5)(low, nil))
+	assert.False(t, KeepTraceIDRatio(0.5)(high, nil))
+}
+
+func TestKeepSlowTraces(t *testing.T) {
+	assert.False(t, KeepSlowTraces(0)(trace.TraceID{}, nil))
+}
+
+func TestTailSamplingProcessorDecisionWait(t *testing.T) {
+	next := new(endedRecorder)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewTailSamplingProcessor(next,
+		WithTailSamplingPolicies(KeepErrorTraces()),
+		WithTailSamplingDecisionWait(10*time.Millisecond),
+	))
+	tr := tp.Tracer("TailSamplingProcessor")
+
+	ctx, root := tr.Start(t.Context(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+
+	// The root span is still active, the trace is decided once the decision
+	// wait elapsed.
+	require.Eventually(t, func() bool {
+		return len(next.Ended()) == 1
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"child"}, next.Ended())
+
+	// Spans ending after the decision follow it.
+	root.End()
+	assert.Equal(t, []string{"child", "root"}, next.Ended())
+}
+
+func TestTailSamplingProcessorDecisionWaitNoEndedSpan(t *testing.T) {
+	next := new(endedRecorder)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewTailSamplingProcessor(next,
+		WithTailSamplingPolicies(KeepSlowTraces(20*time.Millisecond)),
+		WithTailSamplingDecisionWait(time.Millisecond),
+	))
+
+	// The decision is postponed until spans end.
+	_, span := tp.Tracer("TailSamplingProcessor").Start(t.Context(), "slow")
+	time.Sleep(20 * time.Millisecond)
+	span.End()
+	assert.Equal(t, []string{"slow"}, next.Ended())
+}
+
+func TestTailSamplingProcessorFlushShutdown(t *testing.T) {
+	next := new(endedRecorder)
+	p := NewTailSamplingProcessor(next)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+	tr := tp.Tracer("TailSamplingProcessor")
+
+	ctx, root := tr.Start(t.Context(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	assert.Empty(t, next.Ended())
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+	assert.Equal(t, []string{"child"}, next.Ended())
+	assert.Equal(t, 1, next.flushed)
+
+	ctx, other := tr.Start(t.Context(), "other")
+	_, otherChild := tr.Start(ctx, "other-child")
+	otherChild.End()
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, []string{"child", "other-child"}, next.Ended())
+	assert.Equal(t, 1, next.shutdown)
+
+	// Spans ended after shutdown are dropped.
+	root.End()
+	other.End()
+	assert.Equal(t, []string{"child", "other-child"}, next.Ended())
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, next.shutdown)
+}
+
+func TestTailSamplingProcessorMaxTraceLifetime(t *testing.T) {
+	next := new(endedRecorder)
+	p := NewTailSamplingProcessor(next,
+		WithTailSamplingDecisionWait(time.Millisecond),
+		WithTailSamplingMaxTraceLifetime(10*time.Millisecond),
+	).(*tailSamplingProcessor)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+
+	// The span never ends, its trace is forgotten once its lifetime elapsed.
+	_, span := tp.Tracer("TailSamplingProcessor").Start(t.Context(), "leaked")
+	require.Eventually(t, func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return len(p.traces) == 0
+	}, time.Second, time.Millisecond)
+
+	// A span ending afterward is decided on its own once the decision wait
+	// elapsed.
+	span.End()
+	assert.Eventually(t, func() bool {
+		return slices.Equal([]string{"leaked"}, next.Ended())
+	}, time.Second, time.Millisecond)
+}
+
+func TestTailSamplingProcessorMaxTraces(t *testing.T) {
+	next := new(endedRecorder)
+	p := NewTailSamplingProcessor(next,
+		WithTailSamplingPolicies(KeepErrorTraces()),
+		WithTailSamplingMaxTraces(1),
+	).(*tailSamplingProcessor)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(p)
+	tr := tp.Tracer("TailSamplingProcessor")
+
+	ctx, first := tr.Start(t.Context(), "first")
+	_, child := tr.Start(ctx, "first-child")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+	assert.Empty(t, next.Ended(), "span passed before decision")
+
+	// The first trace is decided with its buffered spans and forgotten to
+	// track the second one.
+	_, second := tr.Start(t.Context(), "second")
+	assert.Equal(t, []string{"first-child"}, next.Ended())
+	p.mu.Lock()
+	assert.Len(t, p.traces, 1, "tracked traces")
+	assert.Equal(t, 1, p.order.Len(), "tracked traces order")
+	p.mu.Unlock()
+
+	second.End()
+	first.End()
+	require.NoError(t, tp.Shutdown(t.Context()))
+}
+
+func TestTailSamplingProcessorRemoteParent(t *testing.T) {
+	next := new(endedRecorder)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(NewTailSamplingProcessor(next,
+		WithTailSamplingPolicies(KeepErrorTraces()),
+		WithTailSamplingDecisionWait(10*time.Millisecond),
+	))
+	tr := tp.Tracer("TailSamplingProcessor")
+
+	ctx := trace.ContextWithRemoteSpanContext(t.Context(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+
+	// The root span of the trace is not local, more spans may start after
+	// all the started ones ended.
+	_, span := tr.Start(ctx, "first")
+	span.End()
+	assert.Empty(t, next.Ended(), "decided before the decision wait")
+
+	_, span = tr.Start(ctx, "second")
+	span.SetStatus(codes.Error, "failed")
+	span.End()
+	require.Eventually(t, func() bool {
+		return len(next.Ended()) == 2
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"first", "second"}, next.Ended())
+}
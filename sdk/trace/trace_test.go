@@ -893,6 +893,19 @@ func TestSetSpanStatus(t *testing.T) {
 	}
 }
 
+func TestSetSpanStatusWithOkStatusDescription(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()), WithOkStatusDescription())
+
+	span := startSpan(tp, "SpanStatus")
+	span.SetStatus(codes.Error, "failed")
+	span.SetStatus(codes.Ok, "canary passed")
+	span.SetStatus(codes.Error, "ignored once Ok")
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	assert.Equal(t, Status{Code: codes.Ok, Description: "canary passed"}, got.status)
+}
+
 func TestSetSpanStatusWithoutMessageWhenStatusIsNotError(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
@@ -3028,3 +3041,45 @@ func BenchmarkTraceStart(b *testing.B) {
 		})
 	}
 }
+
+func TestStartStackTrace(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	tracer := tp.Tracer("TestStartStackTrace")
+
+	stackTrace := func(name string) (string, bool) {
+		t.Helper()
+		s, ok := te.GetSpan(name)
+		require.True(t, ok, "span %q not exported", name)
+		for _, kv := range s.Attributes() {
+			if kv.Key == semconv.CodeStacktraceKey {
+				return kv.Value.AsString(), true
+			}
+		}
+		return "", false
+	}
+
+	ctx, parent := tracer.Start(t.Context(), "with", trace.WithStartStackTrace(2))
+	_, span := tracer.Start(ctx, "without")
+	span.End()
+	parent.End()
+
+	got, ok := stackTrace("with")
+	require.True(t, ok, "stack trace not recorded")
+	assert.Contains(t, got, "TestStartStackTrace", "start call site")
+	assert.NotContains(t, got, "(*tracer).Start", "SDK frames")
+	assert.Len(t, strings.Split(got, "\n"), 4, "depth not applied")
+	assert.False(t, strings.HasPrefix(got, "\n"), "leading new line")
+
+	_, ok = stackTrace("without")
+	assert.False(t, ok, "stack trace recorded without option")
+
+	// Only sampled spans capture stack traces.
+	tp = NewTracerProvider(WithSampler(recordOnlySampler{}))
+	_, span = tp.Tracer("TestStartStackTrace").Start(t.Context(), "unsampled", trace.WithStartStackTrace(2))
+	require.True(t, span.IsRecording())
+	require.False(t, span.SpanContext().IsSampled())
+	ro, ok := span.(ReadOnlySpan)
+	require.True(t, ok)
+	assert.Empty(t, ro.Attributes())
+}
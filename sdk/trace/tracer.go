@@ -5,10 +5,13 @@ package trace
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/internal/stack"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/embedded"
 )
@@ -18,6 +21,9 @@ type tracer struct {
 
 	provider             *TracerProvider
 	instrumentationScope instrumentation.Scope
+	// spanLimits are the limits of the spans of the tracer, the ones of its
+	// scope if any, else the ones of the provider.
+	spanLimits SpanLimits
 
 	inst observ.Tracer
 }
@@ -49,6 +55,17 @@ func (tr *tracer) Start(
 	}
 
 	s := tr.newSpan(ctx, name, &config)
+	if rs, ok := s.(*recordingSpan); ok {
+		if depth := config.StackTraceDepth(); depth > 0 && rs.spanContext.IsSampled() {
+			// Skip Start to record where the span is started from.
+			st := stack.Format(stack.Callers(1, depth))
+			rs.SetAttributes(semconv.CodeStacktrace(strings.TrimPrefix(st, "\n")))
+		}
+		if tr.provider.leakGrace > 0 {
+			// Skip Start to report where the span is started from.
+			rs.leakTimer = detectLeak(rs, tr.provider.leakGrace, 1)
+		}
+	}
 	newCtx := trace.ContextWithSpan(ctx, s)
 	if tr.inst.Enabled() {
 		if o, ok := s.(interface{ setOrigCtx(context.Context) }); ok {
@@ -160,9 +177,11 @@ func (tr *tracer) newRecordingSpan(
 		spanKind:    trace.ValidateSpanKind(config.SpanKind()),
 		name:        name,
 		startTime:   startTime,
-		events:      newEvictedQueueEvent(tr.provider.spanLimits.EventCountLimit),
-		links:       newEvictedQueueLink(tr.provider.spanLimits.LinkCountLimit),
+		events:      newEvictedQueueEvent(tr.spanLimits.EventCountLimit),
+		links:       newEvictedQueueLink(tr.spanLimits.LinkCountLimit),
 		tracer:      tr,
+
+		truncationExempt: tr.provider.truncationExempt.keys(ctx),
 	}
 
 	for _, l := range config.Links() {
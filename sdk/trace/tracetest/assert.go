@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestingT is an interface that implements [testing.T], but without the
+// private method of [testing.TB], so other testing packages can rely on it as
+// well.
+// The methods in this interface must match the [testing.TB] interface.
+type TestingT interface {
+	Helper()
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+
+	Error(...any)
+	// DO NOT CHANGE: any modification will not be backwards compatible and
+	// must never be done outside of a new major release.
+}
+
+// Matcher matches a SpanStub. It returns the reasons s does not match, or nil
+// if s matches.
+type Matcher func(s SpanStub) []string
+
+// HasName returns a Matcher matching the spans named name.
+func HasName(name string) Matcher {
+	return func(s SpanStub) []string {
+		if s.Name != name {
+			return []string{notEqualStr("Name", name, s.Name)}
+		}
+		return nil
+	}
+}
+
+// HasKind returns a Matcher matching the spans of kind.
+func HasKind(kind trace.SpanKind) Matcher {
+	return func(s SpanStub) []string {
+		if s.SpanKind != kind {
+			return []string{notEqualStr("SpanKind", kind, s.SpanKind)}
+		}
+		return nil
+	}
+}
+
+// HasTraceID returns a Matcher matching the spans of the trace with traceID.
+func HasTraceID(traceID trace.TraceID) Matcher {
+	return func(s SpanStub) []string {
+		if s.SpanContext.TraceID() != traceID {
+			return []string{notEqualStr("TraceID", traceID, s.SpanContext.TraceID())}
+		}
+		return nil
+	}
+}
+
+// HasAttributes returns a Matcher matching the spans with all of attrs. The
+// spans may have other attributes.
+func HasAttributes(attrs ...attribute.KeyValue) Matcher {
+	return func(s SpanStub) []string {
+		return missingAttributes("Attribute", attrs, s.Attributes)
+	}
+}
+
+// HasStatus returns a Matcher matching the spans with the status code and
+// description.
+func HasStatus(code codes.Code, description string) Matcher {
+	return func(s SpanStub) []string {
+		var reasons []string
+		if s.Status.Code != code {
+			reasons = append(reasons, notEqualStr("Status.Code", code, s.Status.Code))
+		}
+		if s.Status.Description != description {
+			reasons = append(reasons, notEqualStr("Status.Description", description, s.Status.Description))
+		}
+		return reasons
+	}
+}
+
+// HasEvent returns a Matcher matching the spans with an event named name
+// with all of attrs. The event may have other attributes.
+func HasEvent(name string, attrs ...attribute.KeyValue) Matcher {
+	return func(s SpanStub) []string {
+		var reasons []string
+		for _, e := range s.Events {
+			if e.Name != name {
+				continue
+			}
+			r := missingAttributes(fmt.Sprintf("Event %q attribute", name), attrs, e.Attributes)
+			if len(r) == 0 {
+				return nil
+			}
+			reasons = append(reasons, r...)
+		}
+		if reasons == nil {
+			names := make([]string, len(s.Events))
+			for i, e := range s.Events {
+				names[i] = e.Name
+			}
+			reasons = []string{fmt.Sprintf("Event %q missing:\nactual: %q", name, names)}
+		}
+		return reasons
+	}
+}
+
+// IsRoot returns a Matcher matching the spans without a parent.
+func IsRoot() Matcher {
+	return func(s SpanStub) []string {
+		if s.Parent.IsValid() {
+			return []string{fmt.Sprintf("Parent not root:\nactual: %s", s.Parent.SpanID())}
+		}
+		return nil
+	}
+}
+
+// ChildOf returns a Matcher matching the direct children of parent.
+func ChildOf(parent SpanStub) Matcher {
+	return func(s SpanStub) []string {
+		if s.Parent.TraceID() != parent.SpanContext.TraceID() ||
+			s.Parent.SpanID() != parent.SpanContext.SpanID() {
+			return []string{fmt.Sprintf(
+				"Parent not equal:\nexpected: %s (%q)\nactual: %s",
+				parent.SpanContext.SpanID(), parent.Name, s.Parent.SpanID(),
+			)}
+		}
+		return nil
+	}
+}
+
+// Match returns the reasons s does not match all of matchers, or nil if s
+// matches.
+func Match(s SpanStub, matchers ...Matcher) []string {
+	var reasons []string
+	for _, m := range matchers {
+		reasons = append(reasons, m(s)...)
+	}
+	return reasons
+}
+
+// Find returns the first span of spans matching all of matchers, and whether
+// one was found.
+func (s SpanStubs) Find(matchers ...Matcher) (SpanStub, bool) {
+	for _, span := range s {
+		if len(Match(span, matchers...)) == 0 {
+			return span, true
+		}
+	}
+	return SpanStub{}, false
+}
+
+// Assert asserts that s matches all of matchers. Each mismatch is reported.
+func Assert(t TestingT, s SpanStub, matchers ...Matcher) bool {
+	t.Helper()
+
+	if r := Match(s, matchers...); len(r) > 0 {
+		t.Error(fmt.Sprintf("Span %q does not match:\n%s", s.Name, strings.Join(r, "\n")))
+		return false
+	}
+	return true
+}
+
+// AssertContains asserts that one of spans matches all of matchers. If none
+// does, the mismatches of each span are reported.
+func AssertContains(t TestingT, spans SpanStubs, matchers ...Matcher) bool {
+	t.Helper()
+
+	var b strings.Builder
+	for i, s := range spans {
+		r := Match(s, matchers...)
+		if len(r) == 0 {
+			return true
+		}
+		_, _ = fmt.Fprintf(&b, "\nSpan %d %q:\n%s", i, s.Name, strings.Join(r, "\n"))
+	}
+	t.Error(fmt.Sprintf("No span of %d matches:%s", len(spans), b.String()))
+	return false
+}
+
+// missingAttributes returns the reasons actual does not hold all of expected.
+func missingAttributes(prefix string, expected, actual []attribute.KeyValue) []string {
+	var reasons []string
+	for _, want := range expected {
+		got, ok := findAttribute(actual, want.Key)
+		switch {
+		case !ok:
+			reasons = append(reasons, fmt.Sprintf("%s %q missing:\nexpected: %s", prefix, want.Key, want.Value.Emit()))
+		case !equalValue(want.Value, got):
+			reasons = append(reasons, notEqualStr(fmt.Sprintf("%s %q", prefix, want.Key), want.Value.Emit(), got.Emit()))
+		}
+	}
+	return reasons
+}
+
+// findAttribute returns the value of the last attribute of attrs with key.
+func findAttribute(attrs []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+	for i := len(attrs) - 1; i >= 0; i-- {
+		if attrs[i].Key == key {
+			return attrs[i].Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func equalValue(a, b attribute.Value) bool {
+	if a.Type() != b.Type() {
+		return false
+	}
+	// Sets compare values of all types, including slices and maps.
+	sa := attribute.NewSet(attribute.KeyValue{Key: "v", Value: a})
+	sb := attribute.NewSet(attribute.KeyValue{Key: "v", Value: b})
+	return sa.Equivalent() == sb.Equivalent()
+}
+
+func notEqualStr(prefix string, expected, actual any) string {
+	return fmt.Sprintf("%s not equal:\nexpected: %v\nactual: %v", prefix, expected, actual)
+}
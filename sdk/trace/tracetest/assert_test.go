@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordingT is a TestingT recording the reported errors.
+type recordingT struct {
+	errors []string
+}
+
+func (*recordingT) Helper() {}
+
+func (t *recordingT) Error(args ...any) {
+	t.errors = append(t.errors, fmt.Sprint(args...))
+}
+
+func recordSpans(t *testing.T) SpanStubs {
+	sr := NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	tr := tp.Tracer("TestAssert")
+
+	ctx, parent := tr.Start(t.Context(), "parent", trace.WithSpanKind(trace.SpanKindServer))
+	_, child := tr.Start(ctx, "child", trace.WithAttributes(
+		attribute.String("key", "value"),
+		attribute.StringSlice("slice", []string{"a", "b"}),
+	))
+	child.AddEvent("event", trace.WithAttributes(attribute.Int("n", 1)))
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+	parent.End()
+
+	return SpanStubsFromReadOnlySpans(sr.Ended())
+}
+
+func TestAssert(t *testing.T) {
+	spans := recordSpans(t)
+	parent, ok := spans.Find(HasName("parent"))
+	require.True(t, ok)
+	child, ok := spans.Find(ChildOf(parent))
+	require.True(t, ok)
+	assert.Equal(t, "child", child.Name)
+
+	rt := new(recordingT)
+	assert.True(t, Assert(rt, parent, HasName("parent"), HasKind(trace.SpanKindServer), IsRoot()))
+	assert.True(t, Assert(rt, child,
+		HasName("child"),
+		HasKind(trace.SpanKindInternal),
+		HasAttributes(attribute.String("key", "value"), attribute.StringSlice("slice", []string{"a", "b"})),
+		HasStatus(codes.Error, "failed"),
+		HasEvent("event"),
+		HasEvent("event", attribute.Int("n", 1)),
+		ChildOf(parent),
+	))
+	assert.True(t, AssertContains(rt, spans, HasName("child"), ChildOf(parent)))
+	assert.Empty(t, rt.errors)
+}
+
+func TestAssertFailure(t *testing.T) {
+	spans := recordSpans(t)
+	parent, _ := spans.Find(HasName("parent"))
+	child, _ := spans.Find(HasName("child"))
+
+	for _, tt := range []struct {
+		name    string
+		matcher Matcher
+		want    []string
+	}{
+		{
+			name:    "Name",
+			matcher: HasName("other"),
+			want:    []string{"Name not equal:\nexpected: other\nactual: child"},
+		},
+		{
+			name:    "Kind",
+			matcher: HasKind(trace.SpanKindClient),
+			want:    []string{"SpanKind not equal:\nexpected: client\nactual: internal"},
+		},
+		{
+			name:    "AttributeMissing",
+			matcher: HasAttributes(attribute.Bool("missing", true)),
+			want:    []string{"Attribute \"missing\" missing:\nexpected: true"},
+		},
+		{
+			name:    "AttributeValue",
+			matcher: HasAttributes(attribute.String("key", "other"), attribute.String("slice", "a")),
+			want: []string{
+				"Attribute \"key\" not equal:\nexpected: other\nactual: value",
+				"Attribute \"slice\" not equal:\nexpected: a\nactual: [\"a\",\"b\"]",
+			},
+		},
+		{
+			name:    "Status",
+			matcher: HasStatus(codes.Ok, ""),
+			want: []string{
+				"Status.Code not equal:\nexpected: Ok\nactual: Error",
+				"Status.Description not equal:\nexpected: \nactual: failed",
+			},
+		},
+		{
+			name:    "EventMissing",
+			matcher: HasEvent("other"),
+			want:    []string{"Event \"other\" missing:\nactual: [\"event\"]"},
+		},
+		{
+			name:    "EventAttribute",
+			matcher: HasEvent("event", attribute.Int("n", 2)),
+			want:    []string{"Event \"event\" attribute \"n\" not equal:\nexpected: 2\nactual: 1"},
+		},
+		{
+			name:    "Root",
+			matcher: IsRoot(),
+			want:    []string{fmt.Sprintf("Parent not root:\nactual: %s", parent.SpanContext.SpanID())},
+		},
+		{
+			name:    "ChildOf",
+			matcher: ChildOf(child),
+			want: []string{fmt.Sprintf(
+				"Parent not equal:\nexpected: %s (\"child\")\nactual: %s",
+				child.SpanContext.SpanID(), parent.SpanContext.SpanID(),
+			)},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.matcher(child))
+
+			rt := new(recordingT)
+			assert.False(t, Assert(rt, child, tt.matcher))
+			require.Len(t, rt.errors, 1)
+			for _, r := range tt.want {
+				assert.Contains(t, rt.errors[0], r)
+			}
+		})
+	}
+}
+
+func TestAssertContainsFailure(t *testing.T) {
+	spans := recordSpans(t)
+	_, ok := spans.Find(HasName("other"))
+	assert.False(t, ok)
+
+	rt := new(recordingT)
+	assert.False(t, AssertContains(rt, spans, HasName("other")))
+	require.Len(t, rt.errors, 1)
+	assert.Equal(t, "No span of 2 matches:"+
+		"\nSpan 0 \"child\":\nName not equal:\nexpected: other\nactual: child"+
+		"\nSpan 1 \"parent\":\nName not equal:\nexpected: other\nactual: parent", rt.errors[0])
+}
@@ -8,6 +8,7 @@ package tracetest
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -32,15 +33,47 @@ func (*NoopExporter) Shutdown(context.Context) error { return nil }
 
 var _ trace.SpanExporter = (*InMemoryExporter)(nil)
 
-// NewInMemoryExporter returns a new InMemoryExporter.
-func NewInMemoryExporter() *InMemoryExporter {
-	return new(InMemoryExporter)
+// NewInMemoryExporter returns a new InMemoryExporter configured with opts.
+func NewInMemoryExporter(opts ...InMemoryExporterOption) *InMemoryExporter {
+	imsb := new(InMemoryExporter)
+	for _, opt := range opts {
+		opt.applyInMemoryExporter(imsb)
+	}
+	return imsb
+}
+
+// InMemoryExporterOption configures an InMemoryExporter.
+type InMemoryExporterOption interface {
+	applyInMemoryExporter(*InMemoryExporter)
+}
+
+type inMemoryExporterOptionFunc func(*InMemoryExporter)
+
+func (fn inMemoryExporterOptionFunc) applyInMemoryExporter(imsb *InMemoryExporter) {
+	fn(imsb)
+}
+
+// WithCapacity sets the maximum number of spans stored by an
+// InMemoryExporter. Once it is reached, the oldest spans are dropped to store
+// the newly exported ones, bounding the memory used by long-running tests.
+//
+// By default, or if capacity is less than or equal to zero, all the exported
+// spans are stored.
+func WithCapacity(capacity int) InMemoryExporterOption {
+	return inMemoryExporterOptionFunc(func(imsb *InMemoryExporter) {
+		imsb.capacity = max(capacity, 0)
+	})
 }
 
 // InMemoryExporter is an exporter that stores all received spans in-memory.
 type InMemoryExporter struct {
 	mu sync.Mutex
 	ss SpanStubs
+	// capacity is the maximum number of spans stored. It is unlimited if
+	// zero.
+	capacity int
+	// exported is notified when spans are exported.
+	exported signal
 }
 
 // ExportSpans handles export of spans by storing them in memory.
@@ -48,6 +81,13 @@ func (imsb *InMemoryExporter) ExportSpans(_ context.Context, spans []trace.ReadO
 	imsb.mu.Lock()
 	defer imsb.mu.Unlock()
 	imsb.ss = append(imsb.ss, SpanStubsFromReadOnlySpans(spans)...)
+	if imsb.capacity > 0 && len(imsb.ss) > imsb.capacity {
+		// Drop the oldest spans.
+		n := copy(imsb.ss, imsb.ss[len(imsb.ss)-imsb.capacity:])
+		clear(imsb.ss[n:])
+		imsb.ss = imsb.ss[:n]
+	}
+	imsb.exported.notify()
 	return nil
 }
 
@@ -72,3 +112,64 @@ func (imsb *InMemoryExporter) GetSpans() SpanStubs {
 	copy(ret, imsb.ss)
 	return ret
 }
+
+// GetSpansMatching returns the current in-memory stored spans matching all of
+// matchers, e.g. HasName or HasTraceID.
+func (imsb *InMemoryExporter) GetSpansMatching(matchers ...Matcher) SpanStubs {
+	imsb.mu.Lock()
+	defer imsb.mu.Unlock()
+	var ret SpanStubs
+	for _, s := range imsb.ss {
+		if len(Match(s, matchers...)) == 0 {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
+
+// WaitForSpans waits until at least n spans are stored in memory, e.g. when
+// they are exported by a BatchSpanProcessor, and returns the stored spans.
+//
+// If ctx is done before, the spans stored so far are returned with an error
+// wrapping the error of ctx. As no more spans than the capacity set with
+// WithCapacity are stored, n needs to be at most this capacity.
+func (imsb *InMemoryExporter) WaitForSpans(ctx context.Context, n int) (SpanStubs, error) {
+	for {
+		imsb.mu.Lock()
+		if len(imsb.ss) >= n {
+			imsb.mu.Unlock()
+			return imsb.GetSpans(), nil
+		}
+		ch := imsb.exported.wait()
+		imsb.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			spans := imsb.GetSpans()
+			return spans, fmt.Errorf("waiting for %d spans, got %d: %w", n, len(spans), ctx.Err())
+		}
+	}
+}
+
+// signal notifies the goroutines waiting for a change of the state guarded by
+// a mutex. Its methods need to be called with the mutex held.
+type signal struct {
+	ch chan struct{}
+}
+
+// wait returns a channel closed by the next call to notify.
+func (s *signal) wait() <-chan struct{} {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	return s.ch
+}
+
+// notify notifies the goroutines waiting for a change.
+func (s *signal) notify() {
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+}
@@ -4,11 +4,18 @@
 package tracetest
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 // TestNoop tests only that the no-op does not crash in different scenarios.
@@ -46,3 +53,67 @@ func TestNewInMemoryExporter(t *testing.T) {
 	assert.Len(t, sds, 1)
 	assert.Equal(t, input[0], sds[0])
 }
+
+func TestInMemoryExporterWaitForSpans(t *testing.T) {
+	imsb := NewInMemoryExporter()
+	tp := trace.NewTracerProvider(trace.WithBatcher(imsb, trace.WithBatchTimeout(time.Millisecond)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+
+	tr := tp.Tracer("TestInMemoryExporterWaitForSpans")
+	for range 3 {
+		_, span := tr.Start(t.Context(), "span")
+		span.End()
+	}
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+	spans, err := imsb.WaitForSpans(ctx, 3)
+	require.NoError(t, err)
+	assert.Len(t, spans, 3)
+
+	ctx, cancel = context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	spans, err = imsb.WaitForSpans(ctx, 4)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, spans, 3)
+}
+
+func TestInMemoryExporterCapacity(t *testing.T) {
+	imsb := NewInMemoryExporter(WithCapacity(3))
+
+	input := make(SpanStubs, 5)
+	for i := range input {
+		input[i] = SpanStub{Name: fmt.Sprintf("span %d", i)}
+	}
+	require.NoError(t, imsb.ExportSpans(t.Context(), input[:2].Snapshots()))
+	assert.Equal(t, input[:2], imsb.GetSpans())
+	require.NoError(t, imsb.ExportSpans(t.Context(), input[2:].Snapshots()))
+	assert.Equal(t, input[2:], imsb.GetSpans(), "oldest spans not dropped")
+
+	imsb = NewInMemoryExporter(WithCapacity(-1))
+	require.NoError(t, imsb.ExportSpans(t.Context(), input.Snapshots()))
+	assert.Len(t, imsb.GetSpans(), 5)
+}
+
+func TestInMemoryExporterGetSpansMatching(t *testing.T) {
+	sc := func(tid byte) oteltrace.SpanContext {
+		return oteltrace.NewSpanContext(oteltrace.SpanContextConfig{
+			TraceID: oteltrace.TraceID{tid},
+			SpanID:  oteltrace.SpanID{1},
+		})
+	}
+	input := SpanStubs{
+		{Name: "a", SpanContext: sc(1), Attributes: []attribute.KeyValue{attribute.Int("n", 1)}},
+		{Name: "b", SpanContext: sc(1), Status: trace.Status{Code: codes.Error}},
+		{Name: "a", SpanContext: sc(2), Attributes: []attribute.KeyValue{attribute.Int("n", 2)}},
+	}
+	imsb := NewInMemoryExporter()
+	require.NoError(t, imsb.ExportSpans(t.Context(), input.Snapshots()))
+
+	assert.Equal(t, input, imsb.GetSpansMatching())
+	assert.Equal(t, SpanStubs{input[0], input[2]}, imsb.GetSpansMatching(HasName("a")))
+	assert.Equal(t, input[:2], imsb.GetSpansMatching(HasTraceID(oteltrace.TraceID{1})))
+	assert.Equal(t, SpanStubs{input[2]}, imsb.GetSpansMatching(HasName("a"), HasAttributes(attribute.Int("n", 2))))
+	assert.Equal(t, SpanStubs{input[1]}, imsb.GetSpansMatching(HasStatus(codes.Error, "")))
+	assert.Empty(t, imsb.GetSpansMatching(HasName("c")))
+}
@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ sdktrace.IDGenerator = (*DeterministicIDGenerator)(nil)
+
+// DeterministicIDGenerator is an IDGenerator generating the same sequence of
+// valid trace and span IDs for the same seed. It can be used with
+// sdktrace.WithIDGenerator to make the IDs of the spans of a test
+// reproducible, e.g. for golden files or example output.
+//
+// The IDs only depend on the seed and on the order they are generated in.
+// The spans of a test need to be started in a deterministic order, e.g. not
+// concurrently, for their IDs to be reproducible.
+type DeterministicIDGenerator struct {
+	mu   sync.Mutex
+	seed uint64
+	rng  *rand.Rand
+}
+
+// NewDeterministicIDGenerator returns a DeterministicIDGenerator generating
+// the sequence of IDs of seed.
+func NewDeterministicIDGenerator(seed uint64) *DeterministicIDGenerator {
+	g := &DeterministicIDGenerator{seed: seed}
+	g.Reset()
+	return g
+}
+
+// NewIDs returns the next trace and span IDs of the sequence.
+//
+// This method is safe to be called concurrently.
+func (g *DeterministicIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var tid trace.TraceID
+	for !tid.IsValid() {
+		binary.BigEndian.PutUint64(tid[:8], g.rng.Uint64())
+		binary.BigEndian.PutUint64(tid[8:], g.rng.Uint64())
+	}
+	return tid, g.spanID()
+}
+
+// NewSpanID returns the next span ID of the sequence.
+//
+// This method is safe to be called concurrently.
+func (g *DeterministicIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.spanID()
+}
+
+// Reset restarts the sequence of IDs from its beginning.
+//
+// This method is safe to be called concurrently.
+func (g *DeterministicIDGenerator) Reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.rng = rand.New(rand.NewPCG(g.seed, g.seed)) //nolint:gosec // Deterministic IDs are the purpose of the generator.
+}
+
+// spanID returns the next span ID of the sequence. It needs to be called with
+// g.mu held.
+func (g *DeterministicIDGenerator) spanID() trace.SpanID {
+	var sid trace.SpanID
+	for !sid.IsValid() {
+		binary.BigEndian.PutUint64(sid[:], g.rng.Uint64())
+	}
+	return sid
+}
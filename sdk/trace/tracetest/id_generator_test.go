@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestDeterministicIDGenerator(t *testing.T) {
+	record := func(g sdktrace.IDGenerator) SpanStubs {
+		sr := NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr), sdktrace.WithIDGenerator(g))
+		tr := tp.Tracer("TestDeterministicIDGenerator")
+		for range 2 {
+			ctx, parent := tr.Start(t.Context(), "parent")
+			_, child := tr.Start(ctx, "child")
+			child.End()
+			parent.End()
+		}
+		return SpanStubsFromReadOnlySpans(sr.Ended())
+	}
+
+	g := NewDeterministicIDGenerator(1)
+	first := record(g)
+	require.Len(t, first, 4)
+	for _, s := range first {
+		assert.True(t, s.SpanContext.IsValid())
+	}
+	assert.Equal(t, first[0].SpanContext.TraceID(), first[1].SpanContext.TraceID())
+	assert.NotEqual(t, first[0].SpanContext.TraceID(), first[2].SpanContext.TraceID())
+	assert.NotEqual(t, first[0].SpanContext.SpanID(), first[1].SpanContext.SpanID())
+
+	ids := func(spans SpanStubs) []string {
+		out := make([]string, len(spans))
+		for i, s := range spans {
+			out[i] = s.SpanContext.TraceID().String() + "/" + s.SpanContext.SpanID().String()
+		}
+		return out
+	}
+	assert.Equal(t, ids(first), ids(record(NewDeterministicIDGenerator(1))), "same seed")
+	assert.NotEqual(t, ids(first), ids(record(NewDeterministicIDGenerator(2))), "other seed")
+
+	assert.NotEqual(t, ids(first), ids(record(g)), "sequence restarted")
+	g.Reset()
+	assert.Equal(t, ids(first), ids(record(g)), "sequence not restarted")
+}
@@ -0,0 +1,632 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Marshal returns the stable JSON encoding of spans, e.g. to store them in a
+// golden file. The encoding is indented and its fields are always in the same
+// order, so equal spans are encoded identically. Attribute values are encoded
+// like in the OTLP JSON encoding.
+//
+// Use Unmarshal to decode spans from the encoding, and AssertEqual to compare
+// them while ignoring the timestamps or IDs that change with each test run.
+func Marshal(spans SpanStubs) ([]byte, error) {
+	out := make([]jsonSpan, len(spans))
+	for i, s := range spans {
+		out[i] = toJSONSpan(s)
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Unmarshal returns the spans encoded in data by Marshal.
+//
+// The arrays of attribute values of a single scalar type are decoded as the
+// slice values of this type, e.g. attribute.StringSliceValue for an array of
+// strings.
+func Unmarshal(data []byte) (SpanStubs, error) {
+	var in []jsonSpan
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, err
+	}
+	if len(in) == 0 {
+		return nil, nil
+	}
+	spans := make(SpanStubs, len(in))
+	for i, s := range in {
+		var err error
+		spans[i], err = s.stub()
+		if err != nil {
+			return nil, fmt.Errorf("span %d %q: %w", i, s.Name, err)
+		}
+	}
+	return spans, nil
+}
+
+// Option allows for fine grain control over how AssertEqual operates.
+type Option interface {
+	apply(cfg config) config
+}
+
+type config struct {
+	ignoreTimestamps bool
+	ignoreIDs        bool
+}
+
+type fnOption func(cfg config) config
+
+func (fn fnOption) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// IgnoreTimestamps disables checking if the start and end times of the spans,
+// and the times of their events, are different.
+func IgnoreTimestamps() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreTimestamps = true
+		return cfg
+	})
+}
+
+// IgnoreIDs disables checking if the trace and span IDs of the spans are
+// different. The relations between the spans are still checked: the IDs are
+// compared by order of appearance, so the parents and links of the spans need
+// to refer to the same spans.
+func IgnoreIDs() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreIDs = true
+		return cfg
+	})
+}
+
+// AssertEqual asserts that the expected and actual spans are equal once
+// encoded by Marshal, e.g. that the spans recorded by a test are the ones
+// decoded from a golden file. Each span not equal is reported.
+func AssertEqual(t TestingT, expected, actual SpanStubs, opts ...Option) bool {
+	t.Helper()
+
+	var cfg config
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	e, err := normalize(expected, cfg)
+	if err != nil {
+		t.Error(fmt.Sprintf("expected spans: %v", err))
+		return false
+	}
+	a, err := normalize(actual, cfg)
+	if err != nil {
+		t.Error(fmt.Sprintf("actual spans: %v", err))
+		return false
+	}
+
+	var reasons []string
+	if len(e) != len(a) {
+		reasons = append(reasons, notEqualStr("Spans length", len(e), len(a)))
+	}
+	for i := range min(len(e), len(a)) {
+		if e[i] != a[i] {
+			reasons = append(reasons, notEqualStr(fmt.Sprintf("Span %d", i), e[i], a[i]))
+		}
+	}
+	for i := len(a); i < len(e); i++ {
+		reasons = append(reasons, fmt.Sprintf("Span %d missing:\nexpected: %s", i, e[i]))
+	}
+	for i := len(e); i < len(a); i++ {
+		reasons = append(reasons, fmt.Sprintf("Span %d unexpected:\nactual: %s", i, a[i]))
+	}
+	if len(reasons) > 0 {
+		t.Error(strings.Join(reasons, "\n"))
+		return false
+	}
+	return true
+}
+
+// normalize returns the encodings of each of spans, with the fields ignored
+// by cfg replaced.
+func normalize(spans SpanStubs, cfg config) ([]string, error) {
+	ids := newIDMapper()
+	out := make([]string, len(spans))
+	for i, s := range spans {
+		if cfg.ignoreTimestamps {
+			s.StartTime, s.EndTime = time.Time{}, time.Time{}
+			events := make([]tracesdk.Event, len(s.Events))
+			for j, e := range s.Events {
+				e.Time = time.Time{}
+				events[j] = e
+			}
+			s.Events = events
+		}
+		if cfg.ignoreIDs {
+			s.SpanContext = ids.spanContext(s.SpanContext)
+			s.Parent = ids.spanContext(s.Parent)
+			links := make([]tracesdk.Link, len(s.Links))
+			for j, l := range s.Links {
+				l.SpanContext = ids.spanContext(l.SpanContext)
+				links[j] = l
+			}
+			s.Links = links
+		}
+		data, err := json.MarshalIndent(toJSONSpan(s), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("span %d %q: %w", i, s.Name, err)
+		}
+		out[i] = string(data)
+	}
+	return out, nil
+}
+
+// idMapper replaces trace and span IDs with IDs numbered by order of
+// appearance.
+type idMapper struct {
+	traceIDs map[trace.TraceID]trace.TraceID
+	spanIDs  map[trace.SpanID]trace.SpanID
+}
+
+func newIDMapper() *idMapper {
+	return &idMapper{
+		traceIDs: make(map[trace.TraceID]trace.TraceID),
+		spanIDs:  make(map[trace.SpanID]trace.SpanID),
+	}
+}
+
+// spanContext returns sc with its IDs replaced.
+func (m *idMapper) spanContext(sc trace.SpanContext) trace.SpanContext {
+	tid, sid := sc.TraceID(), sc.SpanID()
+	if tid.IsValid() {
+		id, ok := m.traceIDs[tid]
+		if !ok {
+			binary.BigEndian.PutUint64(id[8:], uint64(len(m.traceIDs)+1))
+			m.traceIDs[tid] = id
+		}
+		tid = id
+	}
+	if sid.IsValid() {
+		id, ok := m.spanIDs[sid]
+		if !ok {
+			binary.BigEndian.PutUint64(id[:], uint64(len(m.spanIDs)+1))
+			m.spanIDs[sid] = id
+		}
+		sid = id
+	}
+	return sc.WithTraceID(tid).WithSpanID(sid)
+}
+
+// jsonSpan is the JSON encoding of a SpanStub.
+type jsonSpan struct {
+	Name                   string           `json:"name"`
+	SpanContext            jsonSpanContext  `json:"spanContext"`
+	Parent                 *jsonSpanContext `json:"parent,omitempty"`
+	Kind                   string           `json:"kind"`
+	StartTime              *time.Time       `json:"startTime,omitempty"`
+	EndTime                *time.Time       `json:"endTime,omitempty"`
+	Attributes             []jsonKeyValue   `json:"attributes,omitempty"`
+	Events                 []jsonEvent      `json:"events,omitempty"`
+	Links                  []jsonLink       `json:"links,omitempty"`
+	Status                 *jsonStatus      `json:"status,omitempty"`
+	DroppedAttributesCount int              `json:"droppedAttributesCount,omitempty"`
+	DroppedEventsCount     int              `json:"droppedEventsCount,omitempty"`
+	DroppedLinksCount      int              `json:"droppedLinksCount,omitempty"`
+	ChildSpanCount         int              `json:"childSpanCount,omitempty"`
+	Resource               *jsonResource    `json:"resource,omitempty"`
+	Scope                  *jsonScope       `json:"scope,omitempty"`
+}
+
+type jsonSpanContext struct {
+	TraceID    string `json:"traceId,omitempty"`
+	SpanID     string `json:"spanId,omitempty"`
+	TraceState string `json:"traceState,omitempty"`
+	Flags      uint8  `json:"flags,omitempty"`
+	Remote     bool   `json:"remote,omitempty"`
+}
+
+type jsonEvent struct {
+	Name                   string         `json:"name"`
+	Time                   *time.Time     `json:"time,omitempty"`
+	Attributes             []jsonKeyValue `json:"attributes,omitempty"`
+	DroppedAttributesCount int            `json:"droppedAttributesCount,omitempty"`
+}
+
+type jsonLink struct {
+	SpanContext            jsonSpanContext `json:"spanContext"`
+	Attributes             []jsonKeyValue  `json:"attributes,omitempty"`
+	DroppedAttributesCount int             `json:"droppedAttributesCount,omitempty"`
+}
+
+type jsonStatus struct {
+	Code        codes.Code `json:"code"`
+	Description string     `json:"description,omitempty"`
+}
+
+type jsonResource struct {
+	SchemaURL  string         `json:"schemaUrl,omitempty"`
+	Attributes []jsonKeyValue `json:"attributes,omitempty"`
+}
+
+type jsonScope struct {
+	Name       string         `json:"name,omitempty"`
+	Version    string         `json:"version,omitempty"`
+	SchemaURL  string         `json:"schemaUrl,omitempty"`
+	Attributes []jsonKeyValue `json:"attributes,omitempty"`
+}
+
+type jsonKeyValue struct {
+	Key   string    `json:"key"`
+	Value jsonValue `json:"value"`
+}
+
+// jsonValue is the OTLP JSON encoding of an attribute value. An empty value
+// has none of its fields set.
+type jsonValue struct {
+	StringValue *string     `json:"stringValue,omitempty"`
+	BoolValue   *bool       `json:"boolValue,omitempty"`
+	IntValue    *string     `json:"intValue,omitempty"`
+	DoubleValue *float64    `json:"doubleValue,omitempty"`
+	BytesValue  *[]byte     `json:"bytesValue,omitempty"`
+	ArrayValue  *jsonArray  `json:"arrayValue,omitempty"`
+	KvlistValue *jsonKvlist `json:"kvlistValue,omitempty"`
+}
+
+type jsonArray struct {
+	Values []jsonValue `json:"values"`
+}
+
+type jsonKvlist struct {
+	Values []jsonKeyValue `json:"values"`
+}
+
+func toJSONSpan(s SpanStub) jsonSpan {
+	out := jsonSpan{
+		Name:                   s.Name,
+		SpanContext:            toJSONSpanContext(s.SpanContext),
+		Kind:                   s.SpanKind.String(),
+		StartTime:              toJSONTime(s.StartTime),
+		EndTime:                toJSONTime(s.EndTime),
+		Attributes:             toJSONKeyValues(s.Attributes),
+		DroppedAttributesCount: s.DroppedAttributes,
+		DroppedEventsCount:     s.DroppedEvents,
+		DroppedLinksCount:      s.DroppedLinks,
+		ChildSpanCount:         s.ChildSpanCount,
+	}
+	if s.Parent.IsValid() || s.Parent.IsRemote() {
+		p := toJSONSpanContext(s.Parent)
+		out.Parent = &p
+	}
+	for _, e := range s.Events {
+		out.Events = append(out.Events, jsonEvent{
+			Name:                   e.Name,
+			Time:                   toJSONTime(e.Time),
+			Attributes:             toJSONKeyValues(e.Attributes),
+			DroppedAttributesCount: e.DroppedAttributeCount,
+		})
+	}
+	for _, l := range s.Links {
+		out.Links = append(out.Links, jsonLink{
+			SpanContext:            toJSONSpanContext(l.SpanContext),
+			Attributes:             toJSONKeyValues(l.Attributes),
+			DroppedAttributesCount: l.DroppedAttributeCount,
+		})
+	}
+	if s.Status != (tracesdk.Status{}) {
+		out.Status = &jsonStatus{Code: s.Status.Code, Description: s.Status.Description}
+	}
+	if s.Resource != nil && (s.Resource.SchemaURL() != "" || s.Resource.Len() > 0) {
+		out.Resource = &jsonResource{
+			SchemaURL:  s.Resource.SchemaURL(),
+			Attributes: toJSONKeyValues(s.Resource.Attributes()),
+		}
+	}
+	scope := s.InstrumentationScope
+	if scope.Name == "" && scope.Version == "" && scope.SchemaURL == "" {
+		scope = s.InstrumentationLibrary
+	}
+	if scope.Name != "" || scope.Version != "" || scope.SchemaURL != "" || scope.Attributes.Len() > 0 {
+		out.Scope = &jsonScope{
+			Name:       scope.Name,
+			Version:    scope.Version,
+			SchemaURL:  scope.SchemaURL,
+			Attributes: toJSONKeyValues(scope.Attributes.ToSlice()),
+		}
+	}
+	return out
+}
+
+func (s jsonSpan) stub() (SpanStub, error) {
+	out := SpanStub{
+		Name:              s.Name,
+		StartTime:         fromJSONTime(s.StartTime),
+		EndTime:           fromJSONTime(s.EndTime),
+		DroppedAttributes: s.DroppedAttributesCount,
+		DroppedEvents:     s.DroppedEventsCount,
+		DroppedLinks:      s.DroppedLinksCount,
+		ChildSpanCount:    s.ChildSpanCount,
+	}
+
+	var err error
+	if out.SpanContext, err = s.SpanContext.spanContext(); err != nil {
+		return SpanStub{}, fmt.Errorf("span context: %w", err)
+	}
+	if s.Parent != nil {
+		if out.Parent, err = s.Parent.spanContext(); err != nil {
+			return SpanStub{}, fmt.Errorf("parent: %w", err)
+		}
+	}
+	if out.SpanKind, err = parseSpanKind(s.Kind); err != nil {
+		return SpanStub{}, err
+	}
+	if out.Attributes, err = fromJSONKeyValues(s.Attributes); err != nil {
+		return SpanStub{}, fmt.Errorf("attributes: %w", err)
+	}
+	for i, e := range s.Events {
+		attrs, err := fromJSONKeyValues(e.Attributes)
+		if err != nil {
+			return SpanStub{}, fmt.Errorf("event %d attributes: %w", i, err)
+		}
+		out.Events = append(out.Events, tracesdk.Event{
+			Name:                  e.Name,
+			Attributes:            attrs,
+			DroppedAttributeCount: e.DroppedAttributesCount,
+			Time:                  fromJSONTime(e.Time),
+		})
+	}
+	for i, l := range s.Links {
+		sc, err := l.SpanContext.spanContext()
+		if err != nil {
+			return SpanStub{}, fmt.Errorf("link %d: %w", i, err)
+		}
+		attrs, err := fromJSONKeyValues(l.Attributes)
+		if err != nil {
+			return SpanStub{}, fmt.Errorf("link %d attributes: %w", i, err)
+		}
+		out.Links = append(out.Links, tracesdk.Link{
+			SpanContext:           sc,
+			Attributes:            attrs,
+			DroppedAttributeCount: l.DroppedAttributesCount,
+		})
+	}
+	if s.Status != nil {
+		out.Status = tracesdk.Status{Code: s.Status.Code, Description: s.Status.Description}
+	}
+	if s.Resource != nil {
+		attrs, err := fromJSONKeyValues(s.Resource.Attributes)
+		if err != nil {
+			return SpanStub{}, fmt.Errorf("resource attributes: %w", err)
+		}
+		out.Resource = resource.NewWithAttributes(s.Resource.SchemaURL, attrs...)
+	}
+	if s.Scope != nil {
+		attrs, err := fromJSONKeyValues(s.Scope.Attributes)
+		if err != nil {
+			return SpanStub{}, fmt.Errorf("scope attributes: %w", err)
+		}
+		out.InstrumentationScope = instrumentation.Scope{
+			Name:       s.Scope.Name,
+			Version:    s.Scope.Version,
+			SchemaURL:  s.Scope.SchemaURL,
+			Attributes: attribute.NewSet(attrs...),
+		}
+		out.InstrumentationLibrary = out.InstrumentationScope
+	}
+	return out, nil
+}
+
+func toJSONSpanContext(sc trace.SpanContext) jsonSpanContext {
+	out := jsonSpanContext{
+		TraceState: sc.TraceState().String(),
+		Flags:      uint8(sc.TraceFlags()),
+		Remote:     sc.IsRemote(),
+	}
+	if sc.HasTraceID() {
+		out.TraceID = sc.TraceID().String()
+	}
+	if sc.HasSpanID() {
+		out.SpanID = sc.SpanID().String()
+	}
+	return out
+}
+
+func (sc jsonSpanContext) spanContext() (trace.SpanContext, error) {
+	cfg := trace.SpanContextConfig{
+		TraceFlags: trace.TraceFlags(sc.Flags),
+		Remote:     sc.Remote,
+	}
+	var err error
+	if sc.TraceID != "" {
+		if cfg.TraceID, err = trace.TraceIDFromHex(sc.TraceID); err != nil {
+			return trace.SpanContext{}, err
+		}
+	}
+	if sc.SpanID != "" {
+		if cfg.SpanID, err = trace.SpanIDFromHex(sc.SpanID); err != nil {
+			return trace.SpanContext{}, err
+		}
+	}
+	if cfg.TraceState, err = trace.ParseTraceState(sc.TraceState); err != nil {
+		return trace.SpanContext{}, err
+	}
+	return trace.NewSpanContext(cfg), nil
+}
+
+func parseSpanKind(kind string) (trace.SpanKind, error) {
+	for k := trace.SpanKindUnspecified; k <= trace.SpanKindConsumer; k++ {
+		if k.String() == kind {
+			return k, nil
+		}
+	}
+	return trace.SpanKindUnspecified, fmt.Errorf("invalid span kind %q", kind)
+}
+
+func toJSONTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	t = t.UTC()
+	return &t
+}
+
+func fromJSONTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func toJSONKeyValues(attrs []attribute.KeyValue) []jsonKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]jsonKeyValue, len(attrs))
+	for i, kv := range attrs {
+		out[i] = jsonKeyValue{Key: string(kv.Key), Value: toJSONValue(kv.Value)}
+	}
+	return out
+}
+
+func toJSONValue(v attribute.Value) jsonValue {
+	var out jsonValue
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		out.BoolValue = &b
+	case attribute.INT64:
+		i := strconv.FormatInt(v.AsInt64(), 10)
+		out.IntValue = &i
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		out.DoubleValue = &f
+	case attribute.STRING:
+		s := v.AsString()
+		out.StringValue = &s
+	case attribute.BYTESLICE:
+		b := v.AsByteSlice()
+		out.BytesValue = &b
+	case attribute.BOOLSLICE:
+		out.ArrayValue = toJSONArray(v.AsBoolSlice(), attribute.BoolValue)
+	case attribute.INT64SLICE:
+		out.ArrayValue = toJSONArray(v.AsInt64Slice(), attribute.Int64Value)
+	case attribute.FLOAT64SLICE:
+		out.ArrayValue = toJSONArray(v.AsFloat64Slice(), attribute.Float64Value)
+	case attribute.STRINGSLICE:
+		out.ArrayValue = toJSONArray(v.AsStringSlice(), attribute.StringValue)
+	case attribute.SLICE:
+		out.ArrayValue = toJSONArray(v.AsSlice(), func(v attribute.Value) attribute.Value { return v })
+	case attribute.MAP:
+		out.KvlistValue = &jsonKvlist{Values: toJSONKeyValues(v.AsMap())}
+		if out.KvlistValue.Values == nil {
+			out.KvlistValue.Values = []jsonKeyValue{}
+		}
+	}
+	return out
+}
+
+func toJSONArray[T any](s []T, value func(T) attribute.Value) *jsonArray {
+	out := &jsonArray{Values: make([]jsonValue, len(s))}
+	for i, v := range s {
+		out.Values[i] = toJSONValue(value(v))
+	}
+	return out
+}
+
+func fromJSONKeyValues(kvs []jsonKeyValue) ([]attribute.KeyValue, error) {
+	if len(kvs) == 0 {
+		return nil, nil
+	}
+	out := make([]attribute.KeyValue, len(kvs))
+	for i, kv := range kvs {
+		v, err := kv.Value.value()
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", kv.Key, err)
+		}
+		out[i] = attribute.KeyValue{Key: attribute.Key(kv.Key), Value: v}
+	}
+	return out, nil
+}
+
+func (v jsonValue) value() (attribute.Value, error) {
+	switch {
+	case v.StringValue != nil:
+		return attribute.StringValue(*v.StringValue), nil
+	case v.BoolValue != nil:
+		return attribute.BoolValue(*v.BoolValue), nil
+	case v.IntValue != nil:
+		i, err := strconv.ParseInt(*v.IntValue, 10, 64)
+		if err != nil {
+			return attribute.Value{}, err
+		}
+		return attribute.Int64Value(i), nil
+	case v.DoubleValue != nil:
+		return attribute.Float64Value(*v.DoubleValue), nil
+	case v.BytesValue != nil:
+		return attribute.ByteSliceValue(*v.BytesValue), nil
+	case v.ArrayValue != nil:
+		return v.ArrayValue.value()
+	case v.KvlistValue != nil:
+		kvs, err := fromJSONKeyValues(v.KvlistValue.Values)
+		if err != nil {
+			return attribute.Value{}, err
+		}
+		return attribute.MapValue(kvs...), nil
+	}
+	return attribute.Value{}, nil
+}
+
+// value returns the value of a, as a slice value of the type of its values if
+// they all have the same scalar type.
+func (a jsonArray) value() (attribute.Value, error) {
+	values := make([]attribute.Value, len(a.Values))
+	for i, jv := range a.Values {
+		v, err := jv.value()
+		if err != nil {
+			return attribute.Value{}, err
+		}
+		values[i] = v
+	}
+	if len(values) == 0 {
+		return attribute.SliceValue(), nil
+	}
+
+	typ := values[0].Type()
+	for _, v := range values[1:] {
+		if v.Type() != typ {
+			return attribute.SliceValue(values...), nil
+		}
+	}
+	switch typ {
+	case attribute.BOOL:
+		return attribute.BoolSliceValue(mapValues(values, attribute.Value.AsBool)), nil
+	case attribute.INT64:
+		return attribute.Int64SliceValue(mapValues(values, attribute.Value.AsInt64)), nil
+	case attribute.FLOAT64:
+		return attribute.Float64SliceValue(mapValues(values, attribute.Value.AsFloat64)), nil
+	case attribute.STRING:
+		return attribute.StringSliceValue(mapValues(values, attribute.Value.AsString)), nil
+	}
+	return attribute.SliceValue(values...), nil
+}
+
+func mapValues[T any](values []attribute.Value, as func(attribute.Value) T) []T {
+	out := make([]T, len(values))
+	for i, v := range values {
+		out[i] = as(v)
+	}
+	return out
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func jsonTestSpans(t *testing.T, g sdktrace.IDGenerator, start time.Time) SpanStubs {
+	sr := NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(sr),
+		sdktrace.WithIDGenerator(g),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			"https://opentelemetry.io/schemas/1.0.0",
+			attribute.String("service.name", "svc"),
+		)),
+	)
+	tr := tp.Tracer(
+		"TestJSON",
+		trace.WithInstrumentationVersion("v1"),
+		trace.WithInstrumentationAttributes(attribute.Bool("scope", true)),
+	)
+
+	ts, err := trace.ParseTraceState("k=v")
+	require.NoError(t, err)
+	remote := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+		Remote:     true,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(t.Context(), remote)
+	ctx, parent := tr.Start(ctx, "parent", trace.WithSpanKind(trace.SpanKindServer), trace.WithTimestamp(start))
+	_, child := tr.Start(ctx, "child",
+		trace.WithTimestamp(start.Add(time.Second)),
+		trace.WithLinks(trace.Link{
+			SpanContext: parent.SpanContext(),
+			Attributes:  []attribute.KeyValue{attribute.Int("link", 1)},
+		}),
+		trace.WithAttributes(
+			attribute.Bool("bool", true),
+			attribute.Int64("int", -1),
+			attribute.Float64("float", 1.5),
+			attribute.String("string", "s"),
+			attribute.BoolSlice("bools", []bool{true, false}),
+			attribute.Int64Slice("ints", []int64{1, 2}),
+			attribute.Float64Slice("floats", []float64{1.5}),
+			attribute.StringSlice("strings", []string{"a", "b"}),
+			attribute.ByteSlice("bytes", []byte("bytes")),
+			attribute.Slice("slice", attribute.StringValue("a"), attribute.IntValue(1)),
+			attribute.Map("map", attribute.String("k", "v")),
+			attribute.KeyValue{Key: "empty"},
+		),
+	)
+	child.AddEvent("event", trace.WithTimestamp(start.Add(2*time.Second)),
+		trace.WithAttributes(attribute.String("e", "v")))
+	child.SetStatus(codes.Error, "failed")
+	child.End(trace.WithTimestamp(start.Add(3 * time.Second)))
+	parent.End(trace.WithTimestamp(start.Add(4 * time.Second)))
+
+	return SpanStubsFromReadOnlySpans(sr.Ended())
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	spans := jsonTestSpans(t, NewDeterministicIDGenerator(1), time.Unix(1, 0))
+
+	data, err := Marshal(spans)
+	require.NoError(t, err)
+	got, err := Unmarshal(data)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	again, err := Marshal(got)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(again))
+	assert.Equal(t, string(data), string(again), "encoding not stable")
+
+	rt := new(recordingT)
+	assert.True(t, AssertEqual(rt, spans, got), rt.errors)
+
+	child := got[0]
+	assert.Equal(t, spans[0].SpanContext, child.SpanContext)
+	assert.Equal(t, spans[0].Parent, child.Parent)
+	assert.Equal(t, spans[0].Attributes, child.Attributes)
+	assert.Equal(t, spans[0].Links, child.Links)
+	assert.Equal(t, spans[0].Status, child.Status)
+	assert.True(t, spans[0].StartTime.Equal(child.StartTime))
+	assert.Equal(t, spans[0].Resource, child.Resource)
+	assert.Equal(t, instrumentation.Scope{
+		Name:       "TestJSON",
+		Version:    "v1",
+		Attributes: attribute.NewSet(attribute.Bool("scope", true)),
+	}, child.InstrumentationScope)
+
+	parent := got[1]
+	assert.Equal(t, spans[1].Parent, parent.Parent, "remote parent")
+	assert.Equal(t, trace.SpanKindServer, parent.SpanKind)
+}
+
+func TestUnmarshalEmpty(t *testing.T) {
+	data, err := Marshal(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "[]\n", string(data))
+	spans, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Empty(t, spans)
+}
+
+func TestUnmarshalError(t *testing.T) {
+	for _, data := range []string{
+		`{`,
+		`[{"name":"span","kind":"other"}]`,
+		`[{"name":"span","kind":"internal","spanContext":{"traceId":"x"}}]`,
+		`[{"name":"span","kind":"internal","spanContext":{},"attributes":[{"key":"k","value":{"intValue":"x"}}]}]`,
+	} {
+		_, err := Unmarshal([]byte(data))
+		assert.Error(t, err, data)
+	}
+}
+
+func TestAssertEqual(t *testing.T) {
+	start := time.Unix(1, 0)
+	expected := jsonTestSpans(t, NewDeterministicIDGenerator(1), start)
+	actual := jsonTestSpans(t, NewDeterministicIDGenerator(2), start.Add(time.Hour))
+
+	rt := new(recordingT)
+	assert.False(t, AssertEqual(rt, expected, actual))
+	assert.False(t, AssertEqual(rt, expected, actual, IgnoreIDs()))
+	assert.False(t, AssertEqual(rt, expected, actual, IgnoreTimestamps()))
+	assert.Len(t, rt.errors, 3)
+
+	rt = new(recordingT)
+	assert.True(t, AssertEqual(rt, expected, actual, IgnoreIDs(), IgnoreTimestamps()), rt.errors)
+
+	// The relations between the spans are checked when the IDs are ignored.
+	actual[0].Parent = actual[0].Parent.WithSpanID(trace.SpanID{9})
+	assert.False(t, AssertEqual(rt, expected, actual, IgnoreIDs(), IgnoreTimestamps()))
+
+	rt = new(recordingT)
+	assert.False(t, AssertEqual(rt, expected, expected[:1]))
+	require.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "Spans length not equal:\nexpected: 2\nactual: 1")
+	assert.Contains(t, rt.errors[0], "Span 1 missing:")
+}
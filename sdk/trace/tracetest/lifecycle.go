@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var (
+	// ErrUsedAfterShutdown is wrapped by the violations reported by a
+	// LifecycleChecker when OnStart, OnEnd, or ForceFlush is called after
+	// Shutdown.
+	ErrUsedAfterShutdown = errors.New("span processor used after shutdown")
+
+	// ErrShutdownTwice is wrapped by the violations reported by a
+	// LifecycleChecker when Shutdown is called more than once.
+	ErrShutdownTwice = errors.New("span processor shut down more than once")
+)
+
+// LifecycleChecker is a SpanProcessor that checks the calls it receives
+// against the lifecycle of a SpanProcessor. It reports calls to OnStart,
+// OnEnd, and ForceFlush made after Shutdown, and calls to Shutdown made more
+// than once.
+//
+// It can be registered with a TracerProvider to test how code behaves when
+// spans are started and ended concurrently with the shutdown of the
+// TracerProvider. See the SpanProcessor documentation of
+// [go.opentelemetry.io/otel/sdk/trace] for the guarantees provided.
+type LifecycleChecker struct {
+	onViolation func(error)
+
+	mu         sync.Mutex
+	shutdown   bool
+	started    int
+	ended      int
+	violations []error
+}
+
+var _ sdktrace.SpanProcessor = (*LifecycleChecker)(nil)
+
+// NewLifecycleChecker returns a new LifecycleChecker. If onViolation is not
+// nil, it is called synchronously with each violation detected, e.g. to fail
+// a test or to log the stack of the offending call.
+func NewLifecycleChecker(onViolation func(error)) *LifecycleChecker {
+	return &LifecycleChecker{onViolation: onViolation}
+}
+
+// OnStart counts started spans.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.started++
+	if c.shutdown {
+		c.violate(fmt.Errorf("%w: OnStart of span %s", ErrUsedAfterShutdown, s.SpanContext().SpanID()))
+	}
+}
+
+// OnEnd counts ended spans.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) OnEnd(s sdktrace.ReadOnlySpan) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ended++
+	if c.shutdown {
+		c.violate(fmt.Errorf("%w: OnEnd of span %s", ErrUsedAfterShutdown, s.SpanContext().SpanID()))
+	}
+}
+
+// Shutdown marks the LifecycleChecker as shut down.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) Shutdown(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.shutdown {
+		c.violate(ErrShutdownTwice)
+	}
+	c.shutdown = true
+	return nil
+}
+
+// ForceFlush does nothing other than checking it is not called after
+// Shutdown.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) ForceFlush(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.shutdown {
+		c.violate(fmt.Errorf("%w: ForceFlush", ErrUsedAfterShutdown))
+	}
+	return nil
+}
+
+// violate records err. It needs to be called while holding c.mu.
+func (c *LifecycleChecker) violate(err error) {
+	c.violations = append(c.violations, err)
+	if c.onViolation != nil {
+		c.onViolation(err)
+	}
+}
+
+// IsShutdown reports whether Shutdown has been called.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) IsShutdown() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.shutdown
+}
+
+// Started returns the number of calls to OnStart.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) Started() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.started
+}
+
+// Ended returns the number of calls to OnEnd.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) Ended() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ended
+}
+
+// Violations returns a copy of the violations detected.
+//
+// This method is safe to be called concurrently.
+func (c *LifecycleChecker) Violations() []error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	dst := make([]error, len(c.violations))
+	copy(dst, c.violations)
+	return dst
+}
@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestLifecycleChecker(t *testing.T) {
+	var reported []error
+	c := NewLifecycleChecker(func(err error) { reported = append(reported, err) })
+	ctx := t.Context()
+	_, span := sdktrace.NewTracerProvider().Tracer(t.Name()).Start(ctx, "span")
+	s := span.(sdktrace.ReadWriteSpan)
+
+	c.OnStart(ctx, s)
+	c.OnEnd(s)
+	require.NoError(t, c.ForceFlush(ctx))
+	assert.Empty(t, c.Violations())
+	assert.False(t, c.IsShutdown())
+
+	require.NoError(t, c.Shutdown(ctx))
+	assert.True(t, c.IsShutdown())
+	assert.Empty(t, c.Violations())
+
+	c.OnStart(ctx, s)
+	c.OnEnd(s)
+	require.NoError(t, c.ForceFlush(ctx))
+	require.NoError(t, c.Shutdown(ctx))
+
+	violations := c.Violations()
+	require.Len(t, violations, 4)
+	assert.ErrorIs(t, violations[0], ErrUsedAfterShutdown)
+	assert.ErrorContains(t, violations[0], "OnStart")
+	assert.ErrorIs(t, violations[1], ErrUsedAfterShutdown)
+	assert.ErrorContains(t, violations[1], "OnEnd")
+	assert.ErrorIs(t, violations[2], ErrUsedAfterShutdown)
+	assert.ErrorContains(t, violations[2], "ForceFlush")
+	assert.ErrorIs(t, violations[3], ErrShutdownTwice)
+	assert.Equal(t, violations, reported)
+
+	assert.Equal(t, 2, c.Started())
+	assert.Equal(t, 2, c.Ended())
+}
+
+func TestLifecycleCheckerWithTracerProvider(t *testing.T) {
+	c := NewLifecycleChecker(nil)
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(c))
+	tr := tp.Tracer(t.Name())
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				_, span := tr.Start(t.Context(), "span")
+				span.End()
+			}
+		})
+	}
+	require.NoError(t, tp.Shutdown(t.Context()))
+	wg.Wait()
+
+	// Spans may be ended concurrently with the shutdown, but the processor is
+	// only shut down once and no call is made after Shutdown returns.
+	for _, err := range c.Violations() {
+		assert.ErrorIs(t, err, ErrUsedAfterShutdown)
+	}
+	n := len(c.Violations())
+	_, span := tr.Start(t.Context(), "after shutdown")
+	span.End()
+	assert.Len(t, c.Violations(), n)
+}
@@ -5,6 +5,7 @@ package tracetest
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
@@ -17,6 +18,8 @@ type SpanRecorder struct {
 
 	endedMu sync.RWMutex
 	ended   []sdktrace.ReadOnlySpan
+	// endedSignal is notified when spans are ended.
+	endedSignal signal
 }
 
 var _ sdktrace.SpanProcessor = (*SpanRecorder)(nil)
@@ -42,6 +45,7 @@ func (sr *SpanRecorder) OnEnd(s sdktrace.ReadOnlySpan) {
 	sr.endedMu.Lock()
 	defer sr.endedMu.Unlock()
 	sr.ended = append(sr.ended, s)
+	sr.endedSignal.notify()
 }
 
 // Shutdown does nothing.
@@ -92,3 +96,29 @@ func (sr *SpanRecorder) Ended() []sdktrace.ReadOnlySpan {
 	copy(dst, sr.ended)
 	return dst
 }
+
+// WaitForEnded waits until at least n ended spans are recorded and returns a
+// copy of all ended spans that have been recorded.
+//
+// If ctx is done before, the ended spans recorded so far are returned with an
+// error wrapping the error of ctx.
+//
+// This method is safe to be called concurrently.
+func (sr *SpanRecorder) WaitForEnded(ctx context.Context, n int) ([]sdktrace.ReadOnlySpan, error) {
+	for {
+		sr.endedMu.Lock()
+		if len(sr.ended) >= n {
+			sr.endedMu.Unlock()
+			return sr.Ended(), nil
+		}
+		ch := sr.endedSignal.wait()
+		sr.endedMu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			spans := sr.Ended()
+			return spans, fmt.Errorf("waiting for %d ended spans, got %d: %w", n, len(spans), ctx.Err())
+		}
+	}
+}
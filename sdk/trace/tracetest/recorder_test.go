@@ -7,8 +7,10 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
@@ -136,3 +138,24 @@ func TestResetConcurrentSafe(t *testing.T) {
 	assert.Empty(t, sr.Started())
 	assert.Empty(t, sr.Ended())
 }
+
+func TestSpanRecorderWaitForEnded(t *testing.T) {
+	sr := NewSpanRecorder()
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Go(func() { sr.OnEnd(new(roSpan)) })
+	}
+	ctx, cancel := context.WithTimeout(t.Context(), time.Minute)
+	defer cancel()
+	spans, err := sr.WaitForEnded(ctx, 3)
+	require.NoError(t, err)
+	assert.Len(t, spans, 3)
+	wg.Wait()
+
+	ctx, cancel = context.WithCancel(t.Context())
+	cancel()
+	spans, err = sr.WaitForEnded(ctx, 4)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, spans, 3)
+}
@@ -0,0 +1,159 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanTree is the parent/child hierarchy of SpanStubs.
+type SpanTree struct {
+	roots []*SpanNode
+	nodes []*SpanNode
+	byID  map[spanKey]*SpanNode
+}
+
+// SpanNode is a span of a SpanTree.
+type SpanNode struct {
+	// Span is the span of the node.
+	Span SpanStub
+
+	parent   *SpanNode
+	children []*SpanNode
+}
+
+// spanKey identifies a span.
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+func keyOf(sc trace.SpanContext) spanKey {
+	return spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+}
+
+// NewSpanTree returns the SpanTree of spans.
+//
+// The roots of the tree are the spans without a parent, and the spans whose
+// parent is not one of spans, e.g. the spans with a remote parent. The roots
+// and the children of each span are ordered by start time, and by their order
+// in spans for equal start times.
+func NewSpanTree(spans SpanStubs) *SpanTree {
+	t := &SpanTree{
+		nodes: make([]*SpanNode, len(spans)),
+		byID:  make(map[spanKey]*SpanNode, len(spans)),
+	}
+	for i, s := range spans {
+		n := &SpanNode{Span: s}
+		t.nodes[i] = n
+		t.byID[keyOf(s.SpanContext)] = n
+	}
+	for _, n := range t.nodes {
+		p, ok := t.byID[keyOf(n.Span.Parent)]
+		if !n.Span.Parent.IsValid() || !ok || p == n {
+			t.roots = append(t.roots, n)
+			continue
+		}
+		n.parent = p
+		p.children = append(p.children, n)
+	}
+
+	sortNodes(t.roots)
+	for _, n := range t.nodes {
+		sortNodes(n.children)
+	}
+	return t
+}
+
+func sortNodes(nodes []*SpanNode) {
+	slices.SortStableFunc(nodes, func(a, b *SpanNode) int {
+		return a.Span.StartTime.Compare(b.Span.StartTime)
+	})
+}
+
+// Roots returns the roots of t.
+func (t *SpanTree) Roots() []*SpanNode {
+	return slices.Clone(t.roots)
+}
+
+// Find returns the node of the span with the SpanContext sc, or nil if t
+// holds no such span.
+func (t *SpanTree) Find(sc trace.SpanContext) *SpanNode {
+	return t.byID[keyOf(sc)]
+}
+
+// FindByName returns the nodes of the spans named name, in the order of the
+// SpanStubs t is created from.
+func (t *SpanTree) FindByName(name string) []*SpanNode {
+	var out []*SpanNode
+	for _, n := range t.nodes {
+		if n.Span.Name == name {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// Walk calls fn for each node of t in depth-first order, with the depth of
+// the node, 0 for the roots. The children of a node are not walked if fn
+// returns false for it.
+func (t *SpanTree) Walk(fn func(n *SpanNode, depth int) bool) {
+	for _, n := range t.roots {
+		n.walk(fn, 0)
+	}
+}
+
+// String returns the names of the spans of t, each on its own line and
+// indented by its depth, e.g. for failure messages.
+func (t *SpanTree) String() string {
+	var b strings.Builder
+	t.Walk(func(n *SpanNode, depth int) bool {
+		_, _ = b.WriteString(strings.Repeat("  ", depth))
+		_, _ = b.WriteString(n.Span.Name)
+		_ = b.WriteByte('\n')
+		return true
+	})
+	return b.String()
+}
+
+// Parent returns the node of the parent of n, or nil if n is a root.
+func (n *SpanNode) Parent() *SpanNode {
+	return n.parent
+}
+
+// Children returns the nodes of the direct children of n.
+func (n *SpanNode) Children() []*SpanNode {
+	return slices.Clone(n.children)
+}
+
+// Child returns the node of the first direct child of n named name, or nil
+// if n has no such child.
+func (n *SpanNode) Child(name string) *SpanNode {
+	i := slices.IndexFunc(n.children, func(c *SpanNode) bool { return c.Span.Name == name })
+	if i < 0 {
+		return nil
+	}
+	return n.children[i]
+}
+
+// Depth returns the number of ancestors of n.
+func (n *SpanNode) Depth() int {
+	var d int
+	for p := n.parent; p != nil; p = p.parent {
+		d++
+	}
+	return d
+}
+
+func (n *SpanNode) walk(fn func(*SpanNode, int) bool, depth int) {
+	if !fn(n, depth) {
+		return
+	}
+	for _, c := range n.children {
+		c.walk(fn, depth+1)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func stub(name string, tid, sid, pid byte, start int64) SpanStub {
+	sc := func(id byte) trace.SpanContext {
+		if id == 0 {
+			return trace.SpanContext{}
+		}
+		return trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{tid},
+			SpanID:  trace.SpanID{id},
+		})
+	}
+	return SpanStub{
+		Name:        name,
+		SpanContext: sc(sid),
+		Parent:      sc(pid),
+		StartTime:   time.Unix(start, 0),
+	}
+}
+
+func TestSpanTree(t *testing.T) {
+	spans := SpanStubs{
+		stub("grandchild", 1, 3, 2, 3),
+		stub("child-b", 1, 4, 1, 4),
+		stub("child-a", 1, 2, 1, 2),
+		stub("root", 1, 1, 0, 1),
+		// A span with a parent not recorded is a root.
+		stub("remote", 2, 5, 9, 0),
+		// Span IDs are scoped by trace.
+		stub("other", 2, 2, 0, 5),
+	}
+	tree := NewSpanTree(spans)
+
+	roots := tree.Roots()
+	require.Len(t, roots, 3)
+	assert.Equal(t, "remote", roots[0].Span.Name)
+	assert.Equal(t, "root", roots[1].Span.Name)
+	assert.Equal(t, "other", roots[2].Span.Name)
+	assert.Empty(t, roots[2].Children())
+
+	root := roots[1]
+	assert.Nil(t, root.Parent())
+	children := root.Children()
+	require.Len(t, children, 2)
+	assert.Equal(t, "child-a", children[0].Span.Name)
+	assert.Equal(t, "child-b", children[1].Span.Name)
+	assert.Same(t, root, children[0].Parent())
+
+	grandchild := root.Child("child-a").Child("grandchild")
+	require.NotNil(t, grandchild)
+	assert.Equal(t, 2, grandchild.Depth())
+	assert.Nil(t, root.Child("grandchild"))
+
+	assert.Same(t, grandchild, tree.Find(spans[0].SpanContext))
+	assert.Nil(t, tree.Find(stub("", 3, 3, 0, 0).SpanContext))
+	found := tree.FindByName("child-b")
+	require.Len(t, found, 1)
+	assert.Same(t, children[1], found[0])
+	assert.Empty(t, tree.FindByName("missing"))
+
+	assert.Equal(t, "remote\nroot\n  child-a\n    grandchild\n  child-b\nother\n", tree.String())
+
+	var walked []string
+	tree.Walk(func(n *SpanNode, depth int) bool {
+		walked = append(walked, n.Span.Name)
+		assert.Equal(t, n.Depth(), depth)
+		return n.Span.Name != "child-a"
+	})
+	assert.Equal(t, []string{"remote", "root", "child-a", "child-b", "other"}, walked)
+}
+
+func TestSpanTreeEmpty(t *testing.T) {
+	tree := NewSpanTree(nil)
+	assert.Empty(t, tree.Roots())
+	assert.Empty(t, tree.String())
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"maps"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// truncationExemptions are the attribute keys exempt from the attribute value
+// length limit of the spans of a TracerProvider.
+type truncationExemptions struct {
+	// always are the keys exempt for all the spans.
+	always map[attribute.Key]struct{}
+	// gated are the keys exempt for the spans started with a context whose
+	// baggage has the member they are mapped from.
+	gated map[baggageGate]map[attribute.Key]struct{}
+}
+
+// baggageGate is a baggage member gating truncation exemptions.
+type baggageGate struct {
+	key, value string
+}
+
+// with returns a copy of e with keys exempt for the spans started with a
+// context whose baggage has the gate member, or for all the spans if gate is
+// the zero value.
+func (e *truncationExemptions) with(gate baggageGate, keys []attribute.Key) *truncationExemptions {
+	out := &truncationExemptions{}
+	if e != nil {
+		out.always = maps.Clone(e.always)
+		out.gated = make(map[baggageGate]map[attribute.Key]struct{}, len(e.gated))
+		for k, v := range e.gated {
+			out.gated[k] = maps.Clone(v)
+		}
+	}
+
+	if gate == (baggageGate{}) {
+		out.always = addKeys(out.always, keys)
+		return out
+	}
+	if out.gated == nil {
+		out.gated = make(map[baggageGate]map[attribute.Key]struct{})
+	}
+	out.gated[gate] = addKeys(out.gated[gate], keys)
+	return out
+}
+
+// addKeys adds keys to set, allocated if nil, and returns it.
+func addKeys(set map[attribute.Key]struct{}, keys []attribute.Key) map[attribute.Key]struct{} {
+	if set == nil {
+		set = make(map[attribute.Key]struct{}, len(keys))
+	}
+	for _, k := range keys {
+		set[k] = struct{}{}
+	}
+	return set
+}
+
+// keys returns the keys exempt for a span started with ctx. The returned map
+// must not be modified.
+func (e *truncationExemptions) keys(ctx context.Context) map[attribute.Key]struct{} {
+	if e == nil {
+		return nil
+	}
+	if len(e.gated) == 0 {
+		return e.always
+	}
+	bag := baggage.FromContext(ctx)
+	if bag.Len() == 0 {
+		return e.always
+	}
+
+	var out map[attribute.Key]struct{}
+	for gate, keys := range e.gated {
+		if m := bag.Member(gate.key); m.Key() == "" || m.Value() != gate.value {
+			continue
+		}
+		if out == nil {
+			out = maps.Clone(e.always)
+			if out == nil {
+				out = make(map[attribute.Key]struct{}, len(keys))
+			}
+		}
+		maps.Copy(out, keys)
+	}
+	if out == nil {
+		return e.always
+	}
+	return out
+}
+
+// WithTruncationExemptKeys returns a TracerProviderOption exempting the
+// attributes with keys from the AttributeValueLengthLimit of the SpanLimits
+// of all the spans, e.g. to keep "db.statement" whole while truncating the
+// other attributes.
+//
+// Using this option multiple times, the exempt keys are combined.
+func WithTruncationExemptKeys(keys ...attribute.Key) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if len(keys) == 0 {
+			return cfg
+		}
+		cfg.truncationExempt = cfg.truncationExempt.with(baggageGate{}, keys)
+		return cfg
+	})
+}
+
+// WithBaggageTruncationExemptKeys returns a TracerProviderOption exempting the
+// attributes with keys from the AttributeValueLengthLimit of the SpanLimits
+// of the spans started with a context whose baggage has a member with key and
+// value. This allows to record full attribute values on demand, e.g. for the
+// requests of a debugging session, without lifting the limit for all the
+// spans.
+//
+// Baggage is usually propagated from the remote callers of the process: any
+// client able to send requests can set the member and make the spans of its
+// requests record attribute values of any length, increasing the memory use
+// of the process and the size of the exported data. Value is therefore
+// required to match, and should be a secret shared with the trusted clients
+// only. Propagators extracting baggage from untrusted clients should be
+// avoided or the member removed at the trust boundary.
+//
+// If key or value is empty, the option does nothing. Using this option
+// multiple times, the exempt keys are combined.
+func WithBaggageTruncationExemptKeys(key, value string, keys ...attribute.Key) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if key == "" || value == "" || len(keys) == 0 {
+			return cfg
+		}
+		cfg.truncationExempt = cfg.truncationExempt.with(baggageGate{key: key, value: value}, keys)
+		return cfg
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTruncationExemptionsKeys(t *testing.T) {
+	var e *truncationExemptions
+	assert.Nil(t, e.keys(t.Context()))
+
+	debugGate := baggageGate{key: "debug", value: "s3cr3t"}
+	e = e.with(baggageGate{}, []attribute.Key{"a"})
+	e2 := e.with(debugGate, []attribute.Key{"b"})
+	e2 = e2.with(debugGate, []attribute.Key{"c"})
+	e2 = e2.with(baggageGate{key: "trace", value: "1"}, []attribute.Key{"d"})
+	assert.Equal(t, map[attribute.Key]struct{}{"a": {}}, e.always, "receiver modified")
+	assert.Empty(t, e.gated, "receiver modified")
+
+	debug, err := baggage.NewMember("debug", "s3cr3t")
+	require.NoError(t, err)
+	wrongValue, err := baggage.NewMember("debug", "1")
+	require.NoError(t, err)
+	other, err := baggage.NewMember("other", "1")
+	require.NoError(t, err)
+
+	withBaggage := func(members ...baggage.Member) context.Context {
+		bag, err := baggage.New(members...)
+		require.NoError(t, err)
+		return baggage.ContextWithBaggage(t.Context(), bag)
+	}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want map[attribute.Key]struct{}
+	}{
+		{
+			name: "NoBaggage",
+			ctx:  t.Context(),
+			want: map[attribute.Key]struct{}{"a": {}},
+		},
+		{
+			name: "OtherMember",
+			ctx:  withBaggage(other),
+			want: map[attribute.Key]struct{}{"a": {}},
+		},
+		{
+			name: "WrongValue",
+			ctx:  withBaggage(wrongValue),
+			want: map[attribute.Key]struct{}{"a": {}},
+		},
+		{
+			name: "GatingMember",
+			ctx:  withBaggage(other, debug),
+			want: map[attribute.Key]struct{}{"a": {}, "b": {}, "c": {}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, e2.keys(tt.ctx))
+		})
+	}
+
+	gated := (*truncationExemptions)(nil).with(debugGate, []attribute.Key{"b"})
+	assert.Nil(t, gated.keys(t.Context()))
+	assert.Equal(t, map[attribute.Key]struct{}{"b": {}}, gated.keys(withBaggage(debug)))
+}
+
+func TestWithTruncationExemptKeys(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithSpanLimits(SpanLimits{AttributeValueLengthLimit: 3}),
+		WithTruncationExemptKeys("always"),
+		WithBaggageTruncationExemptKeys("debug", "s3cr3t", "db.statement"),
+		WithBaggageTruncationExemptKeys("debug", "", "other"),
+	)
+	tracer := tp.Tracer("TestWithTruncationExemptKeys")
+
+	m, err := baggage.NewMember("debug", "s3cr3t")
+	require.NoError(t, err)
+	bag, err := baggage.New(m)
+	require.NoError(t, err)
+	m, err = baggage.NewMember("debug", "")
+	require.NoError(t, err)
+	emptyBag, err := baggage.New(m)
+	require.NoError(t, err)
+
+	attrs := []attribute.KeyValue{
+		attribute.String("always", "abcdef"),
+		attribute.String("db.statement", "SELECT 1"),
+		attribute.String("other", "abcdef"),
+	}
+	start := func(ctx context.Context, name string) {
+		_, span := tracer.Start(ctx, name, trace.WithAttributes(attrs[0]))
+		span.SetAttributes(attrs[1:]...)
+		span.End()
+	}
+	start(t.Context(), "plain")
+	start(baggage.ContextWithBaggage(t.Context(), bag), "debug")
+	start(baggage.ContextWithBaggage(t.Context(), emptyBag), "empty")
+
+	s, ok := te.GetSpan("plain")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("always", "abcdef"),
+		attribute.String("db.statement", "SEL"),
+		attribute.String("other", "abc"),
+	}, s.Attributes())
+
+	s, ok = te.GetSpan("debug")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("always", "abcdef"),
+		attribute.String("db.statement", "SELECT 1"),
+		attribute.String("other", "abc"),
+	}, s.Attributes())
+
+	// A gate with an empty value is ignored.
+	s, ok = te.GetSpan("empty")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("always", "abcdef"),
+		attribute.String("db.statement", "SEL"),
+		attribute.String("other", "abc"),
+	}, s.Attributes())
+}
+
+func TestWithTruncationExemptKeysOverCapacity(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithSpanLimits(SpanLimits{AttributeValueLengthLimit: 3, AttributeCountLimit: 2}),
+		WithTruncationExemptKeys("db.statement"),
+	)
+	_, span := tp.Tracer("TestWithTruncationExemptKeysOverCapacity").Start(t.Context(), "span")
+	span.SetAttributes(attribute.String("db.statement", "SELECT 1"), attribute.String("other", "abcdef"))
+	span.SetAttributes(
+		attribute.String("db.statement", "SELECT 2"),
+		attribute.String("other", "ghijkl"),
+		attribute.String("dropped", "x"),
+	)
+	span.End()
+
+	s, ok := te.GetSpan("span")
+	require.True(t, ok)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.statement", "SELECT 2"),
+		attribute.String("other", "ghi"),
+	}, s.Attributes())
+	assert.Equal(t, 1, s.DroppedAttributes())
+}
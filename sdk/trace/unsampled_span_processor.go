@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import "context"
+
+// WithUnsampledProcessor registers the SpanProcessor proc with a
+// TracerProvider, like WithSpanProcessor, but proc only receives the spans
+// that are recorded and not sampled, i.e. the spans of a
+// [RecordOnly] sampling decision. Sampled spans are not passed to proc.
+//
+// This can be used to route the spans dropped by sampling to a separate,
+// cheaper, pipeline. For example, to compute accurate statistics of the
+// unsampled traffic in the process, or to export it to a low cost backend.
+// The Sampler of the TracerProvider needs to return RecordOnly for the
+// spans to be recorded; spans that are dropped are never processed.
+//
+// If proc is a BatchSpanProcessor or a SimpleSpanProcessor, it exports the
+// unsampled spans it receives instead of ignoring them. proc should not be
+// registered with other TracerProviders.
+//
+// The registered SpanProcessor can be unregistered by passing proc to
+// UnregisterSpanProcessor.
+func WithUnsampledProcessor(proc SpanProcessor) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		switch p := proc.(type) {
+		case *batchSpanProcessor:
+			p.exportUnsampled.Store(true)
+		case *simpleSpanProcessor:
+			p.exportUnsampled.Store(true)
+		}
+		cfg.processors = append(cfg.processors, &unsampledProcessor{SpanProcessor: proc})
+		return cfg
+	})
+}
+
+// unsampledProcessor is a SpanProcessor that passes the spans that are not
+// sampled to the wrapped SpanProcessor.
+type unsampledProcessor struct {
+	SpanProcessor
+}
+
+// OnStart calls the OnStart method of the wrapped SpanProcessor if s is not
+// sampled.
+func (p *unsampledProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	if !s.SpanContext().IsSampled() {
+		p.SpanProcessor.OnStart(parent, s)
+	}
+}
+
+// OnEnding calls the OnEnding method of the wrapped SpanProcessor, if it is an
+// OnEndingSpanProcessor, if s is not sampled.
+func (p *unsampledProcessor) OnEnding(s ReadWriteSpan) {
+	if sp, ok := p.SpanProcessor.(OnEndingSpanProcessor); ok && !s.SpanContext().IsSampled() {
+		sp.OnEnding(s)
+	}
+}
+
+// OnEnd calls the OnEnd method of the wrapped SpanProcessor if s is not
+// sampled.
+func (p *unsampledProcessor) OnEnd(s ReadOnlySpan) {
+	if !s.SpanContext().IsSampled() {
+		p.SpanProcessor.OnEnd(s)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// nameSampler records spans named "unsampled" without sampling them
+// and samples all other spans.
+type nameSampler struct{}
+
+func (nameSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	if p.Name == "unsampled" {
+		return SamplingResult{Decision: RecordOnly}
+	}
+	return SamplingResult{Decision: RecordAndSample}
+}
+
+func (nameSampler) Description() string { return "nameSampler" }
+
+func TestWithUnsampledProcessor(t *testing.T) {
+	sampled, unsampled := new(resourceRecorder), new(resourceRecorder)
+	tp := NewTracerProvider(
+		WithSampler(nameSampler{}),
+		WithSpanProcessor(sampled),
+		WithUnsampledProcessor(unsampled),
+	)
+	tr := tp.Tracer("TestWithUnsampledProcessor")
+
+	_, span := tr.Start(t.Context(), "sampled")
+	span.End()
+	_, span = tr.Start(t.Context(), "unsampled")
+	span.End()
+
+	// All recorded spans are passed to regular processors.
+	assert.Len(t, sampled.started, 2)
+	assert.Len(t, sampled.ended, 2)
+	assert.Len(t, unsampled.started, 1)
+	assert.Len(t, unsampled.ended, 1)
+
+	assert.Equal(t, []SpanProcessor{sampled, unsampled}, tp.SpanProcessors())
+	tp.UnregisterSpanProcessor(unsampled)
+	assert.Equal(t, 1, unsampled.shutdown)
+	assert.Equal(t, []SpanProcessor{sampled}, tp.SpanProcessors())
+}
+
+func TestWithUnsampledProcessorExport(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		proc func(SpanExporter) SpanProcessor
+	}{
+		{
+			name: "BatchSpanProcessor",
+			proc: func(e SpanExporter) SpanProcessor { return NewBatchSpanProcessor(e) },
+		},
+		{
+			name: "SimpleSpanProcessor",
+			proc: NewSimpleSpanProcessor,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sampledExp, unsampledExp := NewTestExporter(), NewTestExporter()
+			tp := NewTracerProvider(
+				WithSampler(nameSampler{}),
+				WithSpanProcessor(tc.proc(sampledExp)),
+				WithUnsampledProcessor(tc.proc(unsampledExp)),
+			)
+			t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+			tr := tp.Tracer("TestWithUnsampledProcessorExport")
+
+			_, span := tr.Start(t.Context(), "sampled")
+			span.End()
+			_, span = tr.Start(t.Context(), "unsampled")
+			span.End()
+			require.NoError(t, tp.ForceFlush(t.Context()))
+
+			require.Equal(t, 1, sampledExp.Len())
+			_, ok := sampledExp.GetSpan("sampled")
+			assert.True(t, ok, "sampled span exported")
+
+			require.Equal(t, 1, unsampledExp.Len())
+			got, ok := unsampledExp.GetSpan("unsampled")
+			require.True(t, ok, "unsampled span exported")
+			assert.False(t, got.SpanContext().IsSampled())
+		})
+	}
+}
+
+func TestWithUnsampledProcessorNotApplied(t *testing.T) {
+	bsp := NewBatchSpanProcessor(NewTestExporter()).(*batchSpanProcessor)
+	t.Cleanup(func() { _ = bsp.Shutdown(context.Background()) })
+	ssp := NewSimpleSpanProcessor(NewTestExporter()).(*simpleSpanProcessor)
+
+	_ = WithUnsampledProcessor(bsp)
+	_ = WithUnsampledProcessor(ssp)
+	assert.False(t, bsp.exportUnsampled.Load(), "batch processor mutated before option applied")
+	assert.False(t, ssp.exportUnsampled.Load(), "simple processor mutated before option applied")
+}
@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages // import "go.opentelemetry.io/otel/sdk/trace/zpages"
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+var pageTemplate = template.Must(template.New("page").Funcs(template.FuncMap{
+	"duration": func(s SpanInfo, now time.Time) time.Duration {
+		return s.Duration(now).Round(time.Microsecond)
+	},
+}).Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Spans</title></head>
+<body>
+<h1>Spans</h1>
+<p>Snapshot taken at {{.Time.Format "2006-01-02T15:04:05.000Z07:00"}}.</p>
+<h2>Summary</h2>
+<table border="1">
+<tr><th>Name</th><th>Active</th><th>Ended</th><th>Errors</th>
+{{- range .Buckets}}<th>{{.}}</th>{{end}}</tr>
+{{- range .Summaries}}
+<tr><td><a href="?name={{.Name}}">{{.Name}}</a></td><td>{{.Active}}</td><td>{{.Ended}}</td><td>{{.Errors}}</td>
+{{- range .LatencyCounts}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</table>
+{{- define "spans"}}
+<table border="1">
+<tr><th>Name</th><th>Trace ID</th><th>Span ID</th><th>Parent ID</th><th>Kind</th><th>Start</th><th>Duration</th>
+<th>Status</th><th>Attributes</th></tr>
+{{- $now := .Now}}
+{{- range .Spans}}
+<tr><td>{{.Name}}</td><td>{{.SpanContext.TraceID}}</td><td>{{.SpanContext.SpanID}}</td>
+<td>{{if .Parent.HasSpanID}}{{.Parent.SpanID}}{{end}}</td><td>{{.SpanKind}}</td>
+<td>{{.StartTime.Format "2006-01-02T15:04:05.000Z07:00"}}</td><td>{{duration . $now}}</td>
+<td>{{.Status.Code}}{{with .Status.Description}}: {{.}}{{end}}</td>
+<td>{{range .Attributes}}{{.Key}}={{.Value.Emit}} {{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+<h2>Active spans</h2>
+{{with .ActiveDropped}}<p>{{.}} spans not tracked, the maximum number of active spans was reached.</p>{{end}}
+{{template "spans" .ActiveSpans}}
+<h2>Recently ended spans</h2>
+{{template "spans" .EndedSpans}}
+</body>
+</html>
+`))
+
+// spansData is the data of the "spans" template.
+type spansData struct {
+	Now   time.Time
+	Spans []SpanInfo
+}
+
+// pageData is the data of the page template.
+type pageData struct {
+	Snapshot
+	Buckets     []string
+	ActiveSpans spansData
+	EndedSpans  spansData
+}
+
+// NewHandler returns an http.Handler serving the state of p as an HTML page
+// listing the summaries of the spans of each name, the active spans, and the
+// recently ended spans.
+//
+// The "name" query parameter filters the listed spans by name. The "format"
+// query parameter set to "json" serves the Snapshot of p as JSON instead.
+//
+// The pages expose the names and attributes of the spans. Only serve them to
+// trusted clients, e.g. on a debugging port bound to localhost.
+func NewHandler(p *SpanProcessor) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		snap := p.Snapshot()
+		if name := r.URL.Query().Get("name"); name != "" {
+			snap.Active = filterName(snap.Active, name)
+			snap.Ended = filterName(snap.Ended, name)
+		}
+
+		if r.URL.Query().Get("format") == "json" {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(snap); err != nil {
+				otel.Handle(err)
+			}
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		err := pageTemplate.Execute(w, pageData{
+			Snapshot:    snap,
+			Buckets:     bucketLabels(),
+			ActiveSpans: spansData{Now: snap.Time, Spans: snap.Active},
+			EndedSpans:  spansData{Now: snap.Time, Spans: snap.Ended},
+		})
+		if err != nil {
+			otel.Handle(err)
+		}
+	})
+}
+
+// bucketLabels returns the labels of the latency buckets.
+func bucketLabels() []string {
+	labels := make([]string, 0, len(latencyBoundaries)+1)
+	for _, b := range latencyBoundaries {
+		labels = append(labels, "<"+b.String())
+	}
+	if n := len(latencyBoundaries); n > 0 {
+		labels = append(labels, ">="+latencyBoundaries[n-1].String())
+	}
+	return labels
+}
+
+// filterName returns the spans of spans with name.
+func filterName(spans []SpanInfo, name string) []SpanInfo {
+	var out []SpanInfo
+	for _, s := range spans {
+		if s.Name == name {
+			out = append(out, s)
+		}
+	}
+	return out
+}
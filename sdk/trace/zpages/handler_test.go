@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestHandler(t *testing.T) {
+	p := NewSpanProcessor()
+	tr := newTracer(t, p)
+	_, active := tr.Start(t.Context(), "active")
+	defer active.End()
+	_, ended := tr.Start(t.Context(), "ended<script>")
+	ended.SetAttributes(attribute.String("key", "value"))
+	ended.End()
+
+	h := NewHandler(p)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tracez", http.NoBody))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	assert.Contains(t, body, "active")
+	assert.Contains(t, body, "ended&lt;script&gt;")
+	assert.NotContains(t, body, "ended<script>")
+	assert.Contains(t, body, "key=value")
+	assert.Contains(t, body, active.SpanContext().TraceID().String())
+	assert.Contains(t, body, "&lt;10µs")
+}
+
+func TestHandlerJSON(t *testing.T) {
+	p := NewSpanProcessor()
+	tr := newTracer(t, p)
+	for _, name := range []string{"a", "b"} {
+		_, s := tr.Start(t.Context(), name)
+		s.End()
+	}
+
+	h := NewHandler(p)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tracez?format=json&name=a", http.NoBody))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var got struct {
+		Ended []struct {
+			Name string
+		}
+		Summaries []NameSummary
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got.Ended, 1)
+	assert.Equal(t, "a", got.Ended[0].Name)
+	assert.Len(t, got.Summaries, 2, "summaries filtered")
+}
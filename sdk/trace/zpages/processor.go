@@ -0,0 +1,346 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package zpages provides a SpanProcessor keeping the active and recently
+// ended spans of a process in memory, and an http.Handler serving them as
+// debugging pages. This allows to diagnose hung or slow requests without any
+// telemetry backend.
+package zpages // import "go.opentelemetry.io/otel/sdk/trace/zpages"
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultRecentEnded is the default number of recently ended spans kept.
+const defaultRecentEnded = 256
+
+// defaultMaxActive is the default maximum number of active spans tracked.
+const defaultMaxActive = 1024
+
+// latencyBoundaries are the exclusive upper bounds of the latency buckets.
+var latencyBoundaries = []time.Duration{
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+	100 * time.Second,
+}
+
+// LatencyBoundaries returns the exclusive upper bounds of the latency buckets
+// of the durations of the ended spans counted by a SpanProcessor: 10µs,
+// 100µs, 1ms, 10ms, 100ms, 1s, 10s, and 100s. The last bucket counts the spans
+// lasting at least the last boundary.
+func LatencyBoundaries() []time.Duration {
+	return slices.Clone(latencyBoundaries)
+}
+
+// Option configures a SpanProcessor.
+type Option interface {
+	apply(config) config
+}
+
+type config struct {
+	recentEnded int
+	maxActive   int
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(c config) config {
+	return fn(c)
+}
+
+// WithRecentEnded sets the number of recently ended spans kept by the
+// SpanProcessor. The default is 256. If n is negative, the default is used.
+// If n is zero, no ended span is kept, only their latencies are counted.
+func WithRecentEnded(n int) Option {
+	return optionFunc(func(c config) config {
+		if n >= 0 {
+			c.recentEnded = n
+		}
+		return c
+	})
+}
+
+// WithMaxActive sets the maximum number of active spans tracked by the
+// SpanProcessor. The default is 1024. If n is negative, the default is used.
+//
+// The spans starting while n spans are tracked are neither listed nor counted
+// as active, they are only counted once they end. Their number is reported
+// by the ActiveDropped field of the Snapshot.
+func WithMaxActive(n int) Option {
+	return optionFunc(func(c config) config {
+		if n >= 0 {
+			c.maxActive = n
+		}
+		return c
+	})
+}
+
+// SpanInfo describes a span kept by a SpanProcessor.
+type SpanInfo struct {
+	Name        string
+	SpanContext trace.SpanContext
+	Parent      trace.SpanContext
+	SpanKind    trace.SpanKind
+	StartTime   time.Time
+	// EndTime is the zero time if the span is active.
+	EndTime    time.Time
+	Status     sdktrace.Status
+	Attributes []attribute.KeyValue
+}
+
+// Duration returns the duration of the span, or the time elapsed since it
+// started, at now, if it is active.
+func (s SpanInfo) Duration(now time.Time) time.Duration {
+	if s.EndTime.IsZero() {
+		return now.Sub(s.StartTime)
+	}
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// NameSummary summarizes the spans with a name.
+type NameSummary struct {
+	Name string
+	// Active is the number of active spans.
+	Active int
+	// Ended is the number of ended spans.
+	Ended uint64
+	// Errors is the number of ended spans with an Error status.
+	Errors uint64
+	// LatencyCounts are the numbers of ended spans in each latency bucket
+	// bounded by LatencyBoundaries. It has one more bucket than there are
+	// boundaries.
+	LatencyCounts []uint64
+}
+
+// Snapshot is the state of a SpanProcessor at a point in time.
+type Snapshot struct {
+	// Time is the time the snapshot was taken.
+	Time time.Time
+	// Active are the active spans, oldest first.
+	Active []SpanInfo
+	// ActiveDropped is the number of spans not tracked as active because
+	// the maximum number of active spans was reached when they started.
+	ActiveDropped uint64
+	// Ended are the recently ended spans, most recently ended first.
+	Ended []SpanInfo
+	// Summaries are the summaries of the spans of each name, sorted by
+	// name.
+	Summaries []NameSummary
+}
+
+// spanKey identifies a span.
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+func keyOf(sc trace.SpanContext) spanKey {
+	return spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+}
+
+// activeSpan is an active span and the name it started with.
+type activeSpan struct {
+	span sdktrace.ReadWriteSpan
+	name string
+}
+
+// nameStats are the statistics of the spans with a name.
+type nameStats struct {
+	active int
+	ended  uint64
+	errors uint64
+	counts []uint64
+}
+
+// SpanProcessor is a SpanProcessor keeping the active and recently ended
+// spans in memory, and counting the latencies of the ended spans of each
+// name.
+//
+// The active spans are referenced until they end, they are not copied. The
+// memory use is bounded by the maximum number of active spans tracked, the
+// number of recently ended spans kept, and the number of distinct span names.
+// Use span names of low cardinality.
+type SpanProcessor struct {
+	mu            sync.Mutex
+	active        map[spanKey]activeSpan
+	maxActive     int
+	activeDropped uint64
+	// ended is a ring buffer of the recently ended spans, next is the index
+	// of the next span to store in it.
+	ended []sdktrace.ReadOnlySpan
+	next  int
+	full  bool
+	stats map[string]*nameStats
+}
+
+var _ sdktrace.SpanProcessor = (*SpanProcessor)(nil)
+
+// NewSpanProcessor returns a new SpanProcessor. It needs to be registered
+// with a TracerProvider to observe its spans.
+func NewSpanProcessor(opts ...Option) *SpanProcessor {
+	cfg := config{recentEnded: defaultRecentEnded, maxActive: defaultMaxActive}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &SpanProcessor{
+		active:    make(map[spanKey]activeSpan),
+		maxActive: cfg.maxActive,
+		ended:     make([]sdktrace.ReadOnlySpan, cfg.recentEnded),
+		stats:     make(map[string]*nameStats),
+	}
+}
+
+// OnStart tracks s as an active span, unless the maximum number of active
+// spans is reached.
+func (p *SpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.active) >= p.maxActive {
+		p.activeDropped++
+		return
+	}
+
+	name := s.Name()
+	p.active[keyOf(s.SpanContext())] = activeSpan{span: s, name: name}
+	p.statsOf(name).active++
+}
+
+// OnEnd tracks s as a recently ended span and counts its latency.
+func (p *SpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := keyOf(s.SpanContext())
+	if started, ok := p.active[key]; ok {
+		delete(p.active, key)
+		// The span may have been renamed since it started.
+		p.statsOf(started.name).active--
+	}
+
+	st := p.statsOf(s.Name())
+	st.ended++
+	if s.Status().Code == codes.Error {
+		st.errors++
+	}
+	st.counts[latencyBucket(s.EndTime().Sub(s.StartTime()))]++
+
+	if len(p.ended) > 0 {
+		p.ended[p.next] = s
+		p.next++
+		if p.next == len(p.ended) {
+			p.next, p.full = 0, true
+		}
+	}
+}
+
+// statsOf returns the statistics of the spans with name, created if needed.
+// It needs to be called with p.mu held.
+func (p *SpanProcessor) statsOf(name string) *nameStats {
+	st, ok := p.stats[name]
+	if !ok {
+		st = &nameStats{counts: make([]uint64, len(latencyBoundaries)+1)}
+		p.stats[name] = st
+	}
+	return st
+}
+
+// latencyBucket returns the index of the latency bucket of d.
+func latencyBucket(d time.Duration) int {
+	i, _ := slices.BinarySearch(latencyBoundaries, d)
+	if i < len(latencyBoundaries) && latencyBoundaries[i] == d {
+		// The boundaries are exclusive upper bounds.
+		i++
+	}
+	return i
+}
+
+// Shutdown releases the tracked spans.
+func (p *SpanProcessor) Shutdown(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	clear(p.active)
+	clear(p.ended)
+	p.next, p.full = 0, false
+	return nil
+}
+
+// ForceFlush does nothing.
+func (*SpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// Snapshot returns the current state of p.
+func (p *SpanProcessor) Snapshot() Snapshot {
+	p.mu.Lock()
+	active := make([]sdktrace.ReadWriteSpan, 0, len(p.active))
+	for _, s := range p.active {
+		active = append(active, s.span)
+	}
+
+	var ended []sdktrace.ReadOnlySpan
+	if p.full {
+		ended = append(ended, p.ended[p.next:]...)
+	}
+	ended = append(ended, p.ended[:p.next]...)
+
+	activeDropped := p.activeDropped
+	summaries := make([]NameSummary, 0, len(p.stats))
+	for name, st := range p.stats {
+		summaries = append(summaries, NameSummary{
+			Name:          name,
+			Active:        st.active,
+			Ended:         st.ended,
+			Errors:        st.errors,
+			LatencyCounts: slices.Clone(st.counts),
+		})
+	}
+	p.mu.Unlock()
+
+	// Read the active spans without holding the lock, they synchronize
+	// their own state.
+	snap := Snapshot{
+		Time:          time.Now(),
+		Active:        make([]SpanInfo, len(active)),
+		ActiveDropped: activeDropped,
+		Ended:         make([]SpanInfo, len(ended)),
+		Summaries:     summaries,
+	}
+	for i, s := range active {
+		snap.Active[i] = spanInfo(s)
+	}
+	slices.SortFunc(snap.Active, func(a, b SpanInfo) int { return a.StartTime.Compare(b.StartTime) })
+	for i, s := range ended {
+		snap.Ended[len(ended)-1-i] = spanInfo(s)
+	}
+	slices.SortFunc(snap.Summaries, func(a, b NameSummary) int { return cmp.Compare(a.Name, b.Name) })
+	return snap
+}
+
+func spanInfo(s sdktrace.ReadOnlySpan) SpanInfo {
+	return SpanInfo{
+		Name:        s.Name(),
+		SpanContext: s.SpanContext(),
+		Parent:      s.Parent(),
+		SpanKind:    s.SpanKind(),
+		StartTime:   s.StartTime(),
+		EndTime:     s.EndTime(),
+		Status:      s.Status(),
+		Attributes:  s.Attributes(),
+	}
+}
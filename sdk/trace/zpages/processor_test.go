@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package zpages
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTracer(t *testing.T, p *SpanProcessor) trace.Tracer {
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(p))
+	t.Cleanup(func() {
+		//nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		assert.NoError(t, tp.Shutdown(context.Background()))
+	})
+	return tp.Tracer("zpages")
+}
+
+func TestSpanProcessorSnapshot(t *testing.T) {
+	p := NewSpanProcessor()
+	tr := newTracer(t, p)
+	start := time.Now()
+
+	ctx, parent := tr.Start(t.Context(), "parent", trace.WithTimestamp(start))
+	_, child := tr.Start(ctx, "child", trace.WithTimestamp(start.Add(time.Second)))
+	child.SetStatus(codes.Error, "failed")
+	child.End(trace.WithTimestamp(start.Add(time.Second + 5*time.Millisecond)))
+	_, other := tr.Start(ctx, "child", trace.WithTimestamp(start.Add(2*time.Second)))
+	other.End(trace.WithTimestamp(start.Add(3 * time.Second)))
+
+	snap := p.Snapshot()
+	require.Len(t, snap.Active, 1)
+	assert.Equal(t, "parent", snap.Active[0].Name)
+	assert.Equal(t, parent.SpanContext(), snap.Active[0].SpanContext)
+	assert.True(t, snap.Active[0].EndTime.IsZero())
+
+	require.Len(t, snap.Ended, 2)
+	assert.Equal(t, other.SpanContext(), snap.Ended[0].SpanContext, "most recently ended not first")
+	assert.Equal(t, child.SpanContext(), snap.Ended[1].SpanContext)
+	assert.Equal(t, parent.SpanContext().SpanID(), snap.Ended[1].Parent.SpanID())
+	assert.Equal(t, codes.Error, snap.Ended[1].Status.Code)
+	assert.Equal(t, 5*time.Millisecond, snap.Ended[1].Duration(snap.Time))
+
+	want := []NameSummary{
+		{Name: "child", Ended: 2, Errors: 1, LatencyCounts: make([]uint64, len(latencyBoundaries)+1)},
+		{Name: "parent", Active: 1, LatencyCounts: make([]uint64, len(latencyBoundaries)+1)},
+	}
+	want[0].LatencyCounts[3] = 1 // [1ms, 10ms)
+	want[0].LatencyCounts[6] = 1 // [1s, 10s)
+	assert.Equal(t, want, snap.Summaries)
+
+	parent.End()
+	snap = p.Snapshot()
+	assert.Empty(t, snap.Active)
+	assert.Len(t, snap.Ended, 3)
+	assert.Equal(t, 0, snap.Summaries[1].Active)
+	assert.Equal(t, uint64(1), snap.Summaries[1].Ended)
+}
+
+func TestSpanProcessorRecentEnded(t *testing.T) {
+	p := NewSpanProcessor(WithRecentEnded(2))
+	tr := newTracer(t, p)
+	for _, name := range []string{"a", "b", "c"} {
+		_, s := tr.Start(t.Context(), name)
+		s.End()
+	}
+
+	snap := p.Snapshot()
+	require.Len(t, snap.Ended, 2)
+	assert.Equal(t, "c", snap.Ended[0].Name)
+	assert.Equal(t, "b", snap.Ended[1].Name)
+	assert.Len(t, snap.Summaries, 3, "evicted spans not counted")
+}
+
+func TestSpanProcessorNoRecentEnded(t *testing.T) {
+	p := NewSpanProcessor(WithRecentEnded(0))
+	tr := newTracer(t, p)
+	_, s := tr.Start(t.Context(), "span")
+	s.End()
+
+	snap := p.Snapshot()
+	assert.Empty(t, snap.Ended)
+	require.Len(t, snap.Summaries, 1)
+	assert.Equal(t, uint64(1), snap.Summaries[0].Ended)
+}
+
+func TestSpanProcessorMaxActive(t *testing.T) {
+	p := NewSpanProcessor(WithMaxActive(1))
+	tr := newTracer(t, p)
+	_, a := tr.Start(t.Context(), "a")
+	_, b := tr.Start(t.Context(), "b")
+
+	snap := p.Snapshot()
+	require.Len(t, snap.Active, 1)
+	assert.Equal(t, "a", snap.Active[0].Name)
+	assert.Equal(t, uint64(1), snap.ActiveDropped)
+
+	b.End()
+	a.End()
+	snap = p.Snapshot()
+	assert.Empty(t, snap.Active)
+	require.Len(t, snap.Summaries, 2)
+	for _, s := range snap.Summaries {
+		assert.Zero(t, s.Active, s.Name)
+		assert.Equal(t, uint64(1), s.Ended, "untracked span not counted once ended: %s", s.Name)
+	}
+}
+
+func TestSpanProcessorRenamed(t *testing.T) {
+	p := NewSpanProcessor()
+	tr := newTracer(t, p)
+	_, s := tr.Start(t.Context(), "before")
+	s.SetName("after")
+	s.End()
+
+	snap := p.Snapshot()
+	require.Len(t, snap.Summaries, 2)
+	assert.Equal(t, NameSummary{
+		Name:          "after",
+		Ended:         1,
+		LatencyCounts: snap.Summaries[0].LatencyCounts,
+	}, snap.Summaries[0])
+	assert.Equal(t, "before", snap.Summaries[1].Name)
+	assert.Equal(t, 0, snap.Summaries[1].Active)
+}
+
+func TestSpanProcessorShutdown(t *testing.T) {
+	p := NewSpanProcessor()
+	tr := newTracer(t, p)
+	_, active := tr.Start(t.Context(), "active")
+	defer active.End()
+	_, ended := tr.Start(t.Context(), "ended")
+	ended.End()
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	snap := p.Snapshot()
+	assert.Empty(t, snap.Active)
+	assert.Empty(t, snap.Ended)
+}
+
+func TestLatencyBucket(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want int
+	}{
+		{0, 0},
+		{10*time.Microsecond - 1, 0},
+		{10 * time.Microsecond, 1},
+		{time.Millisecond, 3},
+		{100 * time.Second, 8},
+		{time.Hour, 8},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, latencyBucket(tt.d), tt.d)
+	}
+}
+
+func TestLatencyBoundaries(t *testing.T) {
+	b := LatencyBoundaries()
+	assert.Equal(t, latencyBoundaries, b)
+	b[0] = 0
+	assert.Equal(t, 10*time.Microsecond, latencyBoundaries[0], "boundaries modified")
+}
@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// defaultGracePeriod is the grace period used by HandleShutdownSignals if a
+// non-positive one is provided.
+const defaultGracePeriod = 5 * time.Second
+
+// ShutdownProvider is a telemetry provider that can be flushed and shut down,
+// like the TracerProvider, MeterProvider, and LoggerProvider of the SDK.
+type ShutdownProvider interface {
+	// ForceFlush exports all pending telemetry.
+	ForceFlush(context.Context) error
+	// Shutdown flushes all pending telemetry and releases the resources held
+	// by the provider.
+	Shutdown(context.Context) error
+}
+
+// Variables used for testing.
+var (
+	signalNotify = signal.Notify
+	signalStop   = signal.Stop
+)
+
+// HandleShutdownSignals handles the os.Interrupt and SIGTERM signals and
+// shuts down providers.
+//
+// The returned context is canceled when one of the signals is received, or
+// when ctx is done, to notify the application to stop. Once the application
+// has stopped producing telemetry, it calls the returned shutdown function to
+// flush all providers and then shut them down in the order they are passed.
+// Providers that produce telemetry using other providers, e.g. a
+// TracerProvider whose span processors record metrics with a MeterProvider,
+// need to be passed first. The shutdown function waits at most gracePeriod
+// for the flushes and shutdowns to complete. If gracePeriod is not positive,
+// a grace period of 5 seconds is used.
+//
+// The shutdown function stops the handling of signals. It can be called
+// multiple times, only the first call shuts down the providers and all calls
+// return its result.
+//
+//	ctx, shutdown := otel.HandleShutdownSignals(context.Background(), 10*time.Second, tp, lp, mp)
+//	defer func() {
+//		if err := shutdown(); err != nil {
+//			log.Print(err)
+//		}
+//	}()
+//	run(ctx) // Returns once ctx is canceled.
+func HandleShutdownSignals(
+	ctx context.Context,
+	gracePeriod time.Duration,
+	providers ...ShutdownProvider,
+) (context.Context, func() error) {
+	if gracePeriod <= 0 {
+		gracePeriod = defaultGracePeriod
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	sig := make(chan os.Signal, 1)
+	signalNotify(sig, os.Interrupt, syscall.SIGTERM)
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-sig:
+			cancel()
+		case <-done:
+		}
+	}()
+
+	var (
+		once sync.Once
+		err  error
+	)
+	shutdown := func() error {
+		once.Do(func() {
+			signalStop(sig)
+			close(done)
+			cancel()
+			err = shutdownProviders(gracePeriod, providers)
+		})
+		return err
+	}
+	return ctx, shutdown
+}
+
+// shutdownProviders flushes all providers before shutting them down in order,
+// waiting at most gracePeriod.
+func shutdownProviders(gracePeriod time.Duration, providers []ShutdownProvider) error {
+	// The context passed to HandleShutdownSignals is likely canceled at this
+	// point, do not derive from it.
+	ctx, cancel := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancel()
+
+	var err error
+	for _, p := range providers {
+		err = errors.Join(err, p.ForceFlush(ctx))
+	}
+	for _, p := range providers {
+		err = errors.Join(err, p.Shutdown(ctx))
+	}
+	return err
+}
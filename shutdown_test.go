@@ -0,0 +1,130 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shutdownRecorder records the flushes and shutdowns of providers in a
+// shared log.
+type shutdownRecorder struct {
+	name string
+	log  *[]string
+	err  error
+
+	deadline time.Time
+}
+
+func (p *shutdownRecorder) ForceFlush(ctx context.Context) error {
+	*p.log = append(*p.log, p.name+".ForceFlush")
+	p.deadline, _ = ctx.Deadline()
+	return nil
+}
+
+func (p *shutdownRecorder) Shutdown(ctx context.Context) error {
+	*p.log = append(*p.log, p.name+".Shutdown")
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return p.err
+}
+
+// fakeSignals replaces the signal handling with a channel the test can send
+// to.
+func fakeSignals(t *testing.T) (send func(), stopped func() bool) {
+	var (
+		mu   sync.Mutex
+		ch   chan<- os.Signal
+		stop bool
+	)
+	origNotify, origStop := signalNotify, signalStop
+	t.Cleanup(func() { signalNotify, signalStop = origNotify, origStop })
+	signalNotify = func(c chan<- os.Signal, _ ...os.Signal) {
+		mu.Lock()
+		defer mu.Unlock()
+		ch = c
+	}
+	signalStop = func(chan<- os.Signal) {
+		mu.Lock()
+		defer mu.Unlock()
+		stop = true
+	}
+	return func() {
+			mu.Lock()
+			defer mu.Unlock()
+			ch <- os.Interrupt
+		}, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return stop
+		}
+}
+
+func TestHandleShutdownSignals(t *testing.T) {
+	send, stopped := fakeSignals(t)
+
+	var log []string
+	tp := &shutdownRecorder{name: "tp", log: &log}
+	mp := &shutdownRecorder{name: "mp", log: &log, err: assert.AnError}
+
+	ctx, shutdown := HandleShutdownSignals(t.Context(), time.Minute, tp, mp)
+	assert.NoError(t, ctx.Err())
+
+	send()
+	select {
+	case <-ctx.Done():
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "context not canceled on signal")
+	}
+	assert.Empty(t, log, "providers shut down before the application stopped")
+
+	err := shutdown()
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.True(t, stopped(), "signal handling not stopped")
+	want := []string{"tp.ForceFlush", "mp.ForceFlush", "tp.Shutdown", "mp.Shutdown"}
+	assert.Equal(t, want, log)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), tp.deadline, 10*time.Second)
+
+	// Only the first call shuts down the providers.
+	assert.Equal(t, err, shutdown())
+	assert.Len(t, log, len(want))
+}
+
+func TestHandleShutdownSignalsWithoutSignal(t *testing.T) {
+	_, stopped := fakeSignals(t)
+
+	var log []string
+	tp := &shutdownRecorder{name: "tp", log: &log}
+
+	ctx, shutdown := HandleShutdownSignals(t.Context(), 0, tp)
+	require.NoError(t, shutdown())
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.True(t, stopped())
+	assert.Equal(t, []string{"tp.ForceFlush", "tp.Shutdown"}, log)
+	assert.WithinDuration(t, time.Now().Add(defaultGracePeriod), tp.deadline, time.Second)
+}
+
+func TestHandleShutdownSignalsParentCanceled(t *testing.T) {
+	fakeSignals(t)
+
+	var log []string
+	tp := &shutdownRecorder{name: "tp", log: &log}
+
+	parent, cancel := context.WithCancel(t.Context())
+	ctx, shutdown := HandleShutdownSignals(parent, time.Second, tp)
+	cancel()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+
+	// The providers are shut down with a context not derived from parent.
+	assert.NoError(t, shutdown())
+	assert.Equal(t, []string{"tp.ForceFlush", "tp.Shutdown"}, log)
+}
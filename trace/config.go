@@ -69,6 +69,9 @@ type SpanConfig struct {
 	newRoot    bool
 	spanKind   SpanKind
 	stackTrace bool
+	// stackTraceDepth is the maximum number of frames of the stack trace
+	// captured when the Span is started.
+	stackTraceDepth int
 }
 
 // Attributes describe the associated qualities of a Span.
@@ -86,6 +89,12 @@ func (cfg *SpanConfig) StackTrace() bool {
 	return cfg.stackTrace
 }
 
+// StackTraceDepth returns the maximum number of frames of the stack trace to
+// capture when the Span is started. If it is zero, no stack trace is captured.
+func (cfg *SpanConfig) StackTraceDepth() int {
+	return cfg.stackTraceDepth
+}
+
 // Links are the associations a Span has with other Spans.
 func (cfg *SpanConfig) Links() []Link {
 	return cfg.links
@@ -305,6 +314,20 @@ func WithNewRoot() SpanStartOption {
 	})
 }
 
+// WithStartStackTrace sets the maximum number of frames of the stack trace
+// captured where the Span is started. The stack trace is recorded by the SDK
+// as the "code.stacktrace" attribute of sampled Spans, which helps locating
+// where unexpected Spans originate. If depth is less than or equal to zero, no
+// stack trace is captured.
+//
+// Capturing a stack trace is expensive. Use it sparingly.
+func WithStartStackTrace(depth int) SpanStartOption {
+	return spanOptionFunc(func(cfg SpanConfig) SpanConfig {
+		cfg.stackTraceDepth = max(depth, 0)
+		return cfg
+	})
+}
+
 // WithSpanKind sets the SpanKind of a Span.
 func WithSpanKind(kind SpanKind) SpanStartOption {
 	return spanOptionFunc(func(cfg SpanConfig) SpanConfig {
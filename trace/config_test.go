@@ -140,6 +140,20 @@ func TestNewSpanConfig(t *testing.T) {
 				spanKind: SpanKindConsumer,
 			},
 		},
+		{
+			[]SpanStartOption{
+				WithStartStackTrace(16),
+			},
+			SpanConfig{
+				stackTraceDepth: 16,
+			},
+		},
+		{
+			[]SpanStartOption{
+				WithStartStackTrace(-1),
+			},
+			SpanConfig{},
+		},
 		{
 			// Everything should work together.
 			[]SpanStartOption{
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+var (
+	// Compile-time check this implements the OpenTelemetry API.
+
+	_ trace.TracerProvider = (*StrictTracerProvider)(nil)
+	_ trace.Tracer         = strictTracer{}
+)
+
+// Violation describes a span that was started using a
+// [StrictTracerProvider].
+type Violation struct {
+	// TracerName is the name of the Tracer used to start the span.
+	TracerName string
+	// SpanName is the name of the started span.
+	SpanName string
+}
+
+// String returns a human readable representation of v.
+func (v Violation) String() string {
+	return fmt.Sprintf("span %q started by tracer %q", v.SpanName, v.TracerName)
+}
+
+// StrictOption configures a [StrictTracerProvider].
+type StrictOption interface {
+	apply(strictConfig) strictConfig
+}
+
+type strictConfig struct {
+	panic bool
+}
+
+type strictOptionFunc func(strictConfig) strictConfig
+
+func (fn strictOptionFunc) apply(c strictConfig) strictConfig {
+	return fn(c)
+}
+
+// WithPanic configures a [StrictTracerProvider] to panic when a span is
+// started instead of only recording a [Violation].
+func WithPanic() StrictOption {
+	return strictOptionFunc(func(c strictConfig) strictConfig {
+		c.panic = true
+		return c
+	})
+}
+
+// StrictTracerProvider is an OpenTelemetry No-Op TracerProvider that reports
+// any span started with it.
+//
+// It is intended for tests that expect no telemetry to be produced. For
+// example, setting it as the global TracerProvider helps catch code that
+// unintentionally uses the global TracerProvider instead of one that was
+// explicitly provided.
+//
+// Acquiring a Tracer is not considered a violation, only starting a span is.
+// Spans started are non-recording and behave the same as the ones created by
+// a [Tracer].
+//
+// Use [NewStrictTracerProvider] to create a StrictTracerProvider.
+type StrictTracerProvider struct {
+	embedded.TracerProvider
+
+	cfg strictConfig
+
+	mu         sync.Mutex
+	violations []Violation
+}
+
+// NewStrictTracerProvider returns a StrictTracerProvider that does not record
+// any telemetry and reports every span started with it.
+//
+// By default, violations are recorded and can be inspected with
+// [StrictTracerProvider.Violations]. Use [WithPanic] to panic instead.
+func NewStrictTracerProvider(opts ...StrictOption) *StrictTracerProvider {
+	var cfg strictConfig
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return &StrictTracerProvider{cfg: cfg}
+}
+
+// Tracer returns an OpenTelemetry Tracer that does not record any telemetry
+// and reports every span started with it.
+func (p *StrictTracerProvider) Tracer(name string, _ ...trace.TracerOption) trace.Tracer {
+	return strictTracer{provider: p, name: name}
+}
+
+// Violations returns all violations recorded since the StrictTracerProvider
+// was created or last reset.
+func (p *StrictTracerProvider) Violations() []Violation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return slices.Clone(p.violations)
+}
+
+// Reset clears all recorded violations.
+func (p *StrictTracerProvider) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.violations = nil
+}
+
+func (p *StrictTracerProvider) report(v Violation) {
+	if p.cfg.panic {
+		panic("noop: unexpected " + v.String())
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.violations = append(p.violations, v)
+}
+
+type strictTracer struct {
+	embedded.Tracer
+
+	provider *StrictTracerProvider
+	name     string
+}
+
+// Start reports a violation to the provider and then behaves the same as
+// [Tracer.Start].
+func (t strictTracer) Start(
+	ctx context.Context,
+	spanName string,
+	opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	t.provider.report(Violation{TracerName: t.name, SpanName: spanName})
+	return Tracer{}.Start(ctx, spanName, opts...)
+}
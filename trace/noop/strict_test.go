@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package noop
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictTracerProviderRecords(t *testing.T) {
+	tp := NewStrictTracerProvider()
+	tracer := tp.Tracer("tracer")
+	assert.Empty(t, tp.Violations(), "acquiring a Tracer is not a violation")
+
+	_, span := tracer.Start(t.Context(), "span")
+	assert.False(t, span.IsRecording(), "span should not record")
+	span.End()
+
+	want := []Violation{{TracerName: "tracer", SpanName: "span"}}
+	assert.Equal(t, want, tp.Violations())
+
+	tp.Reset()
+	assert.Empty(t, tp.Violations(), "reset")
+}
+
+func TestStrictTracerProviderPanic(t *testing.T) {
+	tp := NewStrictTracerProvider(WithPanic())
+	tracer := tp.Tracer("tracer")
+	assert.PanicsWithValue(t, `noop: unexpected span "span" started by tracer "tracer"`, func() {
+		_, _ = tracer.Start(t.Context(), "span")
+	})
+	assert.Empty(t, tp.Violations())
+}
+
+func TestStrictTracerProviderConcurrentSafe(t *testing.T) {
+	const goroutines = 10
+
+	tp := NewStrictTracerProvider()
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Go(func() {
+			_, _ = tp.Tracer("tracer").Start(t.Context(), "span")
+			_ = tp.Violations()
+		})
+	}
+	wg.Wait()
+	assert.Len(t, tp.Violations(), goroutines)
+}
@@ -57,7 +57,8 @@ type Span interface {
 	// SetStatus sets the status of the Span in the form of a code and a
 	// description, provided the status hasn't already been set to a higher
 	// value before (OK > Error > Unset). The description is only included in a
-	// status when the code is for an error.
+	// status when the code is for an error, unless the implementation is
+	// configured to record it for the OK code as well.
 	SetStatus(code codes.Code, description string)
 
 	// SetName sets the Span name.
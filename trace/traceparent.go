@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+const (
+	// traceParentLen is the length of a version 00 traceparent value:
+	// version "-" trace-id "-" parent-id "-" trace-flags.
+	traceParentLen = 2 + 1 + 32 + 1 + 16 + 1 + 2
+
+	// invalidTraceParentVersion is the traceparent version that is never
+	// valid.
+	invalidTraceParentVersion = 0xff
+
+	errInvalidTraceParent        errorConst = "traceparent must be formatted as version-traceid-parentid-traceflags"
+	errInvalidTraceParentVersion errorConst = "traceparent version must be lowercase hex, other than ff"
+	errInvalidTraceParentFlags   errorConst = "traceparent trace-flags must be lowercase hex without reserved flags"
+)
+
+// TraceParent returns the W3C Trace Context traceparent header value of sc,
+// e.g. "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01". Only the
+// sampled and random trace flags are encoded.
+//
+// An empty string is returned if sc is not valid.
+//
+// This is useful to reference a span where the TextMapPropagator of the
+// go.opentelemetry.io/otel/propagation package cannot be used, e.g. in log
+// messages or test fixtures. Use ParseTraceParent to parse the value.
+func (sc SpanContext) TraceParent() string {
+	if !sc.IsValid() {
+		return ""
+	}
+
+	var b [traceParentLen]byte
+	b[0], b[1], b[2] = '0', '0', '-'
+	tid := sc.traceID.hexBytes()
+	copy(b[3:35], tid[:])
+	b[35] = '-'
+	sid := sc.spanID.hexBytes()
+	copy(b[36:52], sid[:])
+	b[52] = '-'
+	flags := byte(sc.traceFlags & (FlagsSampled | FlagsRandom))
+	b[53], b[54] = hexLU[flags>>4], hexLU[flags&0xf]
+	return string(b[:])
+}
+
+// ParseTraceParent returns the remote SpanContext encoded in s, a W3C Trace
+// Context traceparent header value. See more at
+// https://www.w3.org/TR/trace-context/#traceparent-header
+//
+// Only the sampled and random trace flags are kept. Values of versions
+// greater than 00 are parsed as version 00 values, ignoring any additional
+// field. An error is returned if s is not a valid traceparent value.
+func ParseTraceParent(s string) (SpanContext, error) {
+	if len(s) < traceParentLen || s[2] != '-' || s[35] != '-' || s[52] != '-' {
+		return SpanContext{}, errInvalidTraceParent
+	}
+
+	version, ok := hexByte(s[0:2])
+	if !ok || version == invalidTraceParentVersion {
+		return SpanContext{}, errInvalidTraceParentVersion
+	}
+	// Only versions greater than 00 can have additional fields. A trailing
+	// delimiter, as sent by some B3 implementations, is accepted.
+	if len(s) > traceParentLen &&
+		(s[traceParentLen] != '-' || (version == 0 && len(s) > traceParentLen+1)) {
+		return SpanContext{}, errInvalidTraceParent
+	}
+
+	traceID, err := TraceIDFromHex(s[3:35])
+	if err != nil {
+		return SpanContext{}, err
+	}
+	spanID, err := SpanIDFromHex(s[36:52])
+	if err != nil {
+		return SpanContext{}, err
+	}
+
+	flags, ok := hexByte(s[53:55])
+	if !ok || (version == 0 && flags > byte(FlagsSampled|FlagsRandom)) {
+		return SpanContext{}, errInvalidTraceParentFlags
+	}
+
+	return NewSpanContext(SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: TraceFlags(flags) & (FlagsSampled | FlagsRandom),
+		Remote:     true,
+	}), nil
+}
+
+// hexByte returns the byte encoded by the two lowercase hex characters of h.
+func hexByte(h string) (byte, bool) {
+	hi, lo := hexRev[h[0]], hexRev[h[1]]
+	if (hi|lo)&0xf0 != 0 {
+		return 0, false
+	}
+	return hi<<4 | lo, true
+}
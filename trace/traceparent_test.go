@@ -0,0 +1,162 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	traceParentTraceID = TraceID{
+		0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6,
+		0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36,
+	}
+	traceParentSpanID = SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+)
+
+func TestSpanContextTraceParent(t *testing.T) {
+	tests := []struct {
+		name string
+		sc   SpanContext
+		want string
+	}{
+		{
+			name: "Invalid",
+			sc:   SpanContext{},
+			want: "",
+		},
+		{
+			name: "NotSampled",
+			sc:   NewSpanContext(SpanContextConfig{TraceID: traceParentTraceID, SpanID: traceParentSpanID}),
+			want: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+		},
+		{
+			name: "Sampled",
+			sc: NewSpanContext(SpanContextConfig{
+				TraceID:    traceParentTraceID,
+				SpanID:     traceParentSpanID,
+				TraceFlags: FlagsSampled,
+			}),
+			want: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		},
+		{
+			name: "UnknownFlags",
+			sc: NewSpanContext(SpanContextConfig{
+				TraceID:    traceParentTraceID,
+				SpanID:     traceParentSpanID,
+				TraceFlags: 0xff,
+			}),
+			want: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.sc.TraceParent())
+		})
+	}
+}
+
+func TestParseTraceParent(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		flags   TraceFlags
+		wantErr error
+	}{
+		{name: "NotSampled", in: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"},
+		{name: "Sampled", in: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", flags: FlagsSampled},
+		{
+			name:  "SampledRandom",
+			in:    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-03",
+			flags: FlagsSampled | FlagsRandom,
+		},
+		{
+			name:  "FutureVersion",
+			in:    "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-09-extra",
+			flags: FlagsSampled,
+		},
+		{name: "TrailingDelimiter", in: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00-"},
+		{name: "Empty", in: "", wantErr: errInvalidTraceParent},
+		{name: "Short", in: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-0", wantErr: errInvalidTraceParent},
+		{name: "Delimiter", in: "00_4bf92f3577b34da6a3ce929d0e0e4736_00f067aa0ba902b7_01", wantErr: errInvalidTraceParent},
+		{
+			name:    "Version00Extra",
+			in:      "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+			wantErr: errInvalidTraceParent,
+		},
+		{
+			name:    "FutureVersionNoDelimiter",
+			in:      "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01extra",
+			wantErr: errInvalidTraceParent,
+		},
+		{
+			name:    "VersionFF",
+			in:      "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantErr: errInvalidTraceParentVersion,
+		},
+		{
+			name:    "UppercaseVersion",
+			in:      "0A-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			wantErr: errInvalidTraceParentVersion,
+		},
+		{
+			name:    "UppercaseTraceID",
+			in:      "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+			wantErr: errInvalidHexID,
+		},
+		{
+			name:    "ZeroTraceID",
+			in:      "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+			wantErr: errNilTraceID,
+		},
+		{
+			name:    "ZeroSpanID",
+			in:      "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+			wantErr: errNilSpanID,
+		},
+		{
+			name:    "Version00ReservedFlags",
+			in:      "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-09",
+			wantErr: errInvalidTraceParentFlags,
+		},
+		{
+			name:    "InvalidFlags",
+			in:      "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-0g",
+			wantErr: errInvalidTraceParentFlags,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, err := ParseTraceParent(tt.in)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, SpanContext{}, sc)
+				return
+			}
+
+			require.NoError(t, err)
+			want := NewSpanContext(SpanContextConfig{
+				TraceID:    traceParentTraceID,
+				SpanID:     traceParentSpanID,
+				TraceFlags: tt.flags,
+				Remote:     true,
+			})
+			assert.Equal(t, want, sc)
+		})
+	}
+}
+
+func TestTraceParentRoundTrip(t *testing.T) {
+	sc := NewSpanContext(SpanContextConfig{
+		TraceID:    traceParentTraceID,
+		SpanID:     traceParentSpanID,
+		TraceFlags: FlagsSampled | FlagsRandom,
+	})
+	got, err := ParseTraceParent(sc.TraceParent())
+	require.NoError(t, err)
+	assert.True(t, sc.WithRemote(true).Equal(got))
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+)
+
+// WithSpan starts a span named name with tracer and calls fn with a context
+// containing that span. The span is ended when fn returns.
+//
+// If fn returns an error, it is recorded on the span, the span status is set
+// to [codes.Error], and the error is returned. The span is ended even if fn
+// panics, allowing an implementation to record the panic.
+//
+// The opts are passed to the Start method of tracer.
+func WithSpan(
+	ctx context.Context,
+	tracer Tracer,
+	name string,
+	fn func(context.Context) error,
+	opts ...SpanStartOption,
+) error {
+	_, err := WithSpanValue(ctx, tracer, name, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	}, opts...)
+	return err
+}
+
+// WithSpanValue is like [WithSpan] but also returns the value returned by fn.
+func WithSpanValue[T any](
+	ctx context.Context,
+	tracer Tracer,
+	name string,
+	fn func(context.Context) (T, error),
+	opts ...SpanStartOption,
+) (T, error) {
+	ctx, span := tracer.Start(ctx, name, opts...)
+	// End is deferred directly, not wrapped in a closure, so implementations
+	// are able to recover and record a panic raised by fn.
+	defer span.End()
+
+	v, err := fn(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return v, err
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+)
+
+type recordingSpan struct {
+	noopSpan
+
+	name     string
+	opts     []SpanStartOption
+	errs     []error
+	code     codes.Code
+	desc     string
+	ended    bool
+	panicked any
+}
+
+func (s *recordingSpan) RecordError(err error, _ ...EventOption) { s.errs = append(s.errs, err) }
+
+func (s *recordingSpan) SetStatus(code codes.Code, desc string) { s.code, s.desc = code, desc }
+
+func (s *recordingSpan) End(...SpanEndOption) {
+	s.ended = true
+	s.panicked = recover()
+	if s.panicked != nil {
+		panic(s.panicked)
+	}
+}
+
+type recordingTracer struct {
+	noopTracer
+
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...SpanStartOption) (context.Context, Span) {
+	s := &recordingSpan{name: name, opts: opts}
+	t.spans = append(t.spans, s)
+	return ContextWithSpan(ctx, s), s
+}
+
+func TestWithSpan(t *testing.T) {
+	tracer := &recordingTracer{}
+	var got Span
+	err := WithSpan(t.Context(), tracer, "op", func(ctx context.Context) error {
+		got = SpanFromContext(ctx)
+		return nil
+	}, WithSpanKind(SpanKindServer))
+	require.NoError(t, err)
+
+	require.Len(t, tracer.spans, 1)
+	s := tracer.spans[0]
+	assert.Same(t, s, got)
+	assert.Equal(t, "op", s.name)
+	cfg := NewSpanStartConfig(s.opts...)
+	assert.Equal(t, SpanKindServer, cfg.SpanKind())
+	assert.True(t, s.ended)
+	assert.Empty(t, s.errs)
+	assert.Equal(t, codes.Unset, s.code)
+}
+
+func TestWithSpanError(t *testing.T) {
+	tracer := &recordingTracer{}
+	want := errors.New("failed")
+
+	err := WithSpan(t.Context(), tracer, "op", func(context.Context) error { return want })
+	assert.ErrorIs(t, err, want)
+
+	s := tracer.spans[0]
+	assert.True(t, s.ended)
+	assert.Equal(t, []error{want}, s.errs)
+	assert.Equal(t, codes.Error, s.code)
+	assert.Equal(t, "failed", s.desc)
+}
+
+func TestWithSpanPanic(t *testing.T) {
+	tracer := &recordingTracer{}
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = WithSpan(t.Context(), tracer, "op", func(context.Context) error { panic("boom") })
+	})
+	s := tracer.spans[0]
+	assert.True(t, s.ended)
+	assert.Equal(t, "boom", s.panicked, "panic not recoverable by span End")
+}
+
+func TestWithSpanValue(t *testing.T) {
+	tracer := &recordingTracer{}
+
+	v, err := WithSpanValue(t.Context(), tracer, "op", func(context.Context) (int, error) {
+		return 42, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 42, v)
+
+	want := errors.New("failed")
+	v, err = WithSpanValue(t.Context(), tracer, "op", func(context.Context) (int, error) {
+		return 1, want
+	})
+	assert.ErrorIs(t, err, want)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, codes.Error, tracer.spans[1].code)
+}
+
+func TestWithSpanNoop(t *testing.T) {
+	tracer := NewNoopTracerProvider().Tracer("test")
+	err := WithSpan(t.Context(), tracer, "op", func(context.Context) error { return nil })
+	assert.NoError(t, err)
+}